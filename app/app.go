@@ -11,6 +11,7 @@ import (
 	"github.com/cryptix-network/cryptixd/infrastructure/db/database"
 	"github.com/cryptix-network/cryptixd/infrastructure/db/database/ldb"
 	"github.com/cryptix-network/cryptixd/infrastructure/logger"
+	"github.com/cryptix-network/cryptixd/infrastructure/metrics"
 	"github.com/cryptix-network/cryptixd/infrastructure/os/execenv"
 	"github.com/cryptix-network/cryptixd/infrastructure/os/limits"
 	"github.com/cryptix-network/cryptixd/infrastructure/os/signal"
@@ -97,6 +98,11 @@ func (app *cryptixdApp) main(startedChan chan<- struct{}) error {
 	}
 	profiling.TrackHeap(app.cfg.AppDir, log)
 
+	// Enable the metrics server if requested.
+	if app.cfg.Metrics != "" {
+		metrics.Start(app.cfg.Metrics, log)
+	}
+
 	// Return now if an interrupt signal was triggered.
 	if signal.InterruptRequested(interrupt) {
 		return nil
@@ -179,6 +179,54 @@ const (
 	CmdConsensusAtomicStateHash
 	CmdRequestAtomicTokenStateHash
 	CmdAtomicTokenStateHash
+	CmdGetAtomicAssetRequestMessage
+	CmdGetAtomicAssetResponseMessage
+	CmdGetAtomicAssetsRequestMessage
+	CmdGetAtomicAssetsResponseMessage
+	CmdGetAtomicBalancesByOwnerRequestMessage
+	CmdGetAtomicBalancesByOwnerResponseMessage
+	CmdGetAtomicNonceRequestMessage
+	CmdGetAtomicNonceResponseMessage
+	CmdGetLiquidityPoolRequestMessage
+	CmdGetLiquidityPoolResponseMessage
+	CmdNotifyAtomicStateChangedRequestMessage
+	CmdNotifyAtomicStateChangedResponseMessage
+	CmdAtomicStateChangedNotificationMessage
+	CmdStopNotifyingAtomicStateChangedRequestMessage
+	CmdStopNotifyingAtomicStateChangedResponseMessage
+	CmdSubmitFastIntentRequestMessage
+	CmdSubmitFastIntentResponseMessage
+	CmdGetFastIntentStatusRequestMessage
+	CmdGetFastIntentStatusResponseMessage
+	CmdGetAtomicHistoryByOwnerRequestMessage
+	CmdGetAtomicHistoryByOwnerResponseMessage
+	CmdGetAtomicHistoryByAssetRequestMessage
+	CmdGetAtomicHistoryByAssetResponseMessage
+	CmdSimulateAtomicTransactionRequestMessage
+	CmdSimulateAtomicTransactionResponseMessage
+	CmdGetAtomicBalanceProofRequestMessage
+	CmdGetAtomicBalanceProofResponseMessage
+	CmdGetStrongNodeClaimsRequestMessage
+	CmdGetStrongNodeClaimsResponseMessage
+	CmdNotifyBlockProducerClaimWinnerRequestMessage
+	CmdNotifyBlockProducerClaimWinnerResponseMessage
+	CmdBlockProducerClaimWinnerNotificationMessage
+	CmdBanNodeIDRequestMessage
+	CmdBanNodeIDResponseMessage
+	CmdUnbanNodeIDRequestMessage
+	CmdUnbanNodeIDResponseMessage
+	CmdListBansRequestMessage
+	CmdListBansResponseMessage
+	CmdGetAntiFraudStateRequestMessage
+	CmdGetAntiFraudStateResponseMessage
+	CmdCompactBlock
+	CmdRequestBlockTransactions
+	CmdBlockTransactions
+	CmdTransactionPackage
+	CmdSubmitTransactionPackageRequestMessage
+	CmdSubmitTransactionPackageResponseMessage
+	CmdGetTransactionStatusRequestMessage
+	CmdGetTransactionStatusResponseMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -238,6 +286,10 @@ var ProtocolMessageCommandToString = map[MessageCommand]string{
 	CmdConsensusAtomicStateHash:                    "ConsensusAtomicStateHash",
 	CmdRequestAtomicTokenStateHash:                 "RequestAtomicTokenStateHash",
 	CmdAtomicTokenStateHash:                        "AtomicTokenStateHash",
+	CmdCompactBlock:                                "CompactBlock",
+	CmdRequestBlockTransactions:                    "RequestBlockTransactions",
+	CmdBlockTransactions:                           "BlockTransactions",
+	CmdTransactionPackage:                          "TransactionPackage",
 }
 
 // RPCMessageCommandToString maps all MessageCommands to their string representation
@@ -332,6 +384,50 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetFeeEstimateResponseMessage:                              "GetFeeEstimateResponse",
 	CmdSubmitTransactionReplacementRequestMessage:                 "SubmitTransactionReplacementRequest",
 	CmdSubmitTransactionReplacementResponseMessage:                "SubmitTransactionReplacementResponse",
+	CmdGetAtomicAssetRequestMessage:                               "GetAtomicAssetRequest",
+	CmdGetAtomicAssetResponseMessage:                              "GetAtomicAssetResponse",
+	CmdGetAtomicAssetsRequestMessage:                              "GetAtomicAssetsRequest",
+	CmdGetAtomicAssetsResponseMessage:                             "GetAtomicAssetsResponse",
+	CmdGetAtomicBalancesByOwnerRequestMessage:                     "GetAtomicBalancesByOwnerRequest",
+	CmdGetAtomicBalancesByOwnerResponseMessage:                    "GetAtomicBalancesByOwnerResponse",
+	CmdGetAtomicNonceRequestMessage:                               "GetAtomicNonceRequest",
+	CmdGetAtomicNonceResponseMessage:                              "GetAtomicNonceResponse",
+	CmdGetLiquidityPoolRequestMessage:                             "GetLiquidityPoolRequest",
+	CmdGetLiquidityPoolResponseMessage:                            "GetLiquidityPoolResponse",
+	CmdNotifyAtomicStateChangedRequestMessage:                     "NotifyAtomicStateChangedRequest",
+	CmdNotifyAtomicStateChangedResponseMessage:                    "NotifyAtomicStateChangedResponse",
+	CmdAtomicStateChangedNotificationMessage:                      "AtomicStateChangedNotification",
+	CmdStopNotifyingAtomicStateChangedRequestMessage:              "StopNotifyingAtomicStateChangedRequest",
+	CmdStopNotifyingAtomicStateChangedResponseMessage:             "StopNotifyingAtomicStateChangedResponse",
+	CmdSubmitFastIntentRequestMessage:                             "SubmitFastIntentRequest",
+	CmdSubmitFastIntentResponseMessage:                            "SubmitFastIntentResponse",
+	CmdGetFastIntentStatusRequestMessage:                          "GetFastIntentStatusRequest",
+	CmdGetFastIntentStatusResponseMessage:                         "GetFastIntentStatusResponse",
+	CmdGetAtomicHistoryByOwnerRequestMessage:                      "GetAtomicHistoryByOwnerRequest",
+	CmdGetAtomicHistoryByOwnerResponseMessage:                     "GetAtomicHistoryByOwnerResponse",
+	CmdGetAtomicHistoryByAssetRequestMessage:                      "GetAtomicHistoryByAssetRequest",
+	CmdGetAtomicHistoryByAssetResponseMessage:                     "GetAtomicHistoryByAssetResponse",
+	CmdSimulateAtomicTransactionRequestMessage:                    "SimulateAtomicTransactionRequest",
+	CmdSimulateAtomicTransactionResponseMessage:                   "SimulateAtomicTransactionResponse",
+	CmdGetAtomicBalanceProofRequestMessage:                        "GetAtomicBalanceProofRequest",
+	CmdGetAtomicBalanceProofResponseMessage:                       "GetAtomicBalanceProofResponse",
+	CmdGetStrongNodeClaimsRequestMessage:                          "GetStrongNodeClaimsRequest",
+	CmdGetStrongNodeClaimsResponseMessage:                         "GetStrongNodeClaimsResponse",
+	CmdNotifyBlockProducerClaimWinnerRequestMessage:               "NotifyBlockProducerClaimWinnerRequest",
+	CmdNotifyBlockProducerClaimWinnerResponseMessage:              "NotifyBlockProducerClaimWinnerResponse",
+	CmdBlockProducerClaimWinnerNotificationMessage:                "BlockProducerClaimWinnerNotification",
+	CmdBanNodeIDRequestMessage:                                    "BanNodeIDRequest",
+	CmdBanNodeIDResponseMessage:                                   "BanNodeIDResponse",
+	CmdUnbanNodeIDRequestMessage:                                  "UnbanNodeIDRequest",
+	CmdUnbanNodeIDResponseMessage:                                 "UnbanNodeIDResponse",
+	CmdListBansRequestMessage:                                     "ListBansRequest",
+	CmdListBansResponseMessage:                                    "ListBansResponse",
+	CmdGetAntiFraudStateRequestMessage:                            "GetAntiFraudStateRequest",
+	CmdGetAntiFraudStateResponseMessage:                           "GetAntiFraudStateResponse",
+	CmdSubmitTransactionPackageRequestMessage:                     "SubmitTransactionPackageRequest",
+	CmdSubmitTransactionPackageResponseMessage:                    "SubmitTransactionPackageResponse",
+	CmdGetTransactionStatusRequestMessage:                         "GetTransactionStatusRequest",
+	CmdGetTransactionStatusResponseMessage:                        "GetTransactionStatusResponse",
 }
 
 // Message is an interface that describes a cryptix message. A type that
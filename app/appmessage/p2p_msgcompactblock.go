@@ -0,0 +1,47 @@
+package appmessage
+
+// MaxCompactBlockTransactions is the maximum number of transactions, short
+// IDs and prefilled transactions together, that a compact block can describe.
+const MaxCompactBlockTransactions = MaxInvPerMsg
+
+// PrefilledTransaction is a transaction sent in full within a compact block,
+// along with its index in the block
+type PrefilledTransaction struct {
+	Index       uint32
+	Transaction *MsgTx
+}
+
+// MsgCompactBlock implements the Message interface and represents a cryptix
+// CompactBlock message. It is sent instead of a MsgBlock in response to a
+// relay block request, and describes the block transactions by short IDs,
+// which the receiver resolves against its mempool. Transactions the receiver
+// can't have in its mempool, like the coinbase, are prefilled.
+//
+// ShortIDs holds the short IDs of all the transactions that aren't prefilled,
+// in block order.
+type MsgCompactBlock struct {
+	baseMessage
+	Header                MsgBlockHeader
+	ShortIDNonce          uint64
+	ShortIDs              []uint64
+	PrefilledTransactions []*PrefilledTransaction
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgCompactBlock) Command() MessageCommand {
+	return CmdCompactBlock
+}
+
+// NewMsgCompactBlock returns a new cryptix CompactBlock message that conforms
+// to the Message interface. See MsgCompactBlock for details.
+func NewMsgCompactBlock(header *MsgBlockHeader, shortIDNonce uint64, shortIDs []uint64,
+	prefilledTransactions []*PrefilledTransaction) *MsgCompactBlock {
+
+	return &MsgCompactBlock{
+		Header:                *header,
+		ShortIDNonce:          shortIDNonce,
+		ShortIDs:              shortIDs,
+		PrefilledTransactions: prefilledTransactions,
+	}
+}
@@ -18,6 +18,10 @@ type MsgRequestAddresses struct {
 	baseMessage
 	IncludeAllSubnetworks bool
 	SubnetworkID          *externalapi.DomainSubnetworkID
+
+	// IncludeOnionAddresses is set by nodes that understand Tor v3 onion
+	// addresses, and are therefore sent them as well
+	IncludeOnionAddresses bool
 }
 
 // Command returns the protocol command string for the message. This is part
@@ -0,0 +1,31 @@
+package appmessage
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+)
+
+// MsgRequestBlockTransactions implements the Message interface and represents
+// a cryptix RequestBlockTransactions message. It is used to request the
+// transactions of a compact block that the requester couldn't find in its
+// mempool, by their indexes in the block.
+type MsgRequestBlockTransactions struct {
+	baseMessage
+	BlockHash *externalapi.DomainHash
+	Indexes   []uint32
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgRequestBlockTransactions) Command() MessageCommand {
+	return CmdRequestBlockTransactions
+}
+
+// NewMsgRequestBlockTransactions returns a new cryptix RequestBlockTransactions
+// message that conforms to the Message interface. See
+// MsgRequestBlockTransactions for details.
+func NewMsgRequestBlockTransactions(blockHash *externalapi.DomainHash, indexes []uint32) *MsgRequestBlockTransactions {
+	return &MsgRequestBlockTransactions{
+		BlockHash: blockHash,
+		Indexes:   indexes,
+	}
+}
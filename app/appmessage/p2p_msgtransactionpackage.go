@@ -0,0 +1,31 @@
+package appmessage
+
+import (
+	miningmanagermodel "github.com/cryptix-network/cryptixd/domain/miningmanager/model"
+)
+
+// MaxTransactionPackageTransactions is the maximum number of transactions in a
+// MsgTransactionPackage. It's the maximum package size the mempool accepts.
+const MaxTransactionPackageTransactions = miningmanagermodel.MaxTransactionPackageTransactions
+
+// MsgTransactionPackage implements the Message interface and represents a
+// cryptix TransactionPackage message. It holds a topologically sorted set of
+// dependent transactions, which are validated together rather than one by one.
+type MsgTransactionPackage struct {
+	baseMessage
+	Transactions []*MsgTx
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgTransactionPackage) Command() MessageCommand {
+	return CmdTransactionPackage
+}
+
+// NewMsgTransactionPackage returns a new cryptix TransactionPackage message that
+// conforms to the Message interface. See MsgTransactionPackage for details.
+func NewMsgTransactionPackage(transactions []*MsgTx) *MsgTransactionPackage {
+	return &MsgTransactionPackage{
+		Transactions: transactions,
+	}
+}
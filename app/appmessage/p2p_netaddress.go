@@ -6,6 +6,7 @@ package appmessage
 
 import (
 	"net"
+	"strconv"
 
 	"github.com/cryptix-network/cryptixd/util/mstime"
 )
@@ -22,6 +23,12 @@ type NetAddress struct {
 	// Port the peer is using. This is encoded in big endian on the appmessage
 	// which differs from most everything else.
 	Port uint16
+
+	// OnionV3 is the public key of the peer's Tor v3 hidden service, or all
+	// zeros if the peer is reached by IP. The IP of an onion address is its
+	// OnionCat stand-in (see OnionV3IP), so that code keyed by IP keeps
+	// working with it.
+	OnionV3 [OnionV3PubKeySize]byte
 }
 
 // TCPAddress converts the NetAddress to *net.TCPAddr
@@ -60,5 +67,8 @@ func NewNetAddress(addr *net.TCPAddr) *NetAddress {
 }
 
 func (na NetAddress) String() string {
+	if na.IsOnionV3() {
+		return net.JoinHostPort(na.Host(), strconv.Itoa(int(na.Port)))
+	}
 	return na.TCPAddress().String()
 }
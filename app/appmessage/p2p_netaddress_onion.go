@@ -0,0 +1,113 @@
+package appmessage
+
+import (
+	"bytes"
+	"encoding/base32"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+// OnionV3PubKeySize is the size of the ed25519 public key a Tor v3 onion
+// address encodes
+const OnionV3PubKeySize = 32
+
+const (
+	onionV3Version      = 0x03
+	onionV3ChecksumSize = 2
+	onionV3Suffix       = ".onion"
+	onionV3HostLength   = 56 // base32 of pubkey + checksum + version
+)
+
+// onionCatPrefix is the fd87:d87e:eb43::/48 prefix OnionCat maps onion
+// addresses into
+var onionCatPrefix = []byte{0xfd, 0x87, 0xd8, 0x7e, 0xeb, 0x43}
+
+var onionV3Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewNetAddressOnionV3 returns a new NetAddress of the Tor v3 hidden service
+// with the given public key and port
+func NewNetAddressOnionV3(pubKey [OnionV3PubKeySize]byte, port uint16) *NetAddress {
+	netAddress := NewNetAddressIPPort(OnionV3IP(pubKey), port)
+	netAddress.OnionV3 = pubKey
+	return netAddress
+}
+
+// IsOnionV3 returns whether the address is a Tor v3 onion address
+func (na *NetAddress) IsOnionV3() bool {
+	return na.OnionV3 != [OnionV3PubKeySize]byte{}
+}
+
+// Host returns the host part of the address: the .onion host name for onion
+// addresses and the IP for all others
+func (na *NetAddress) Host() string {
+	if na.IsOnionV3() {
+		return OnionV3Host(na.OnionV3)
+	}
+	return na.IP.String()
+}
+
+// OnionV3IP returns the IPv6 stand-in of the onion address with the given
+// public key: the OnionCat prefix followed by the first 80 bits of the key
+func OnionV3IP(pubKey [OnionV3PubKeySize]byte) net.IP {
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, onionCatPrefix)
+	copy(ip[len(onionCatPrefix):], pubKey[:])
+	return ip
+}
+
+// IsOnionCatIP returns whether the given IP is inside the OnionCat range
+func IsOnionCatIP(ip net.IP) bool {
+	ip16 := ip.To16()
+	return ip16 != nil && ip.To4() == nil && bytes.HasPrefix(ip16, onionCatPrefix)
+}
+
+func onionV3Checksum(pubKey [OnionV3PubKeySize]byte) []byte {
+	hasher := sha3.New256()
+	hasher.Write([]byte(".onion checksum"))
+	hasher.Write(pubKey[:])
+	hasher.Write([]byte{onionV3Version})
+	return hasher.Sum(nil)[:onionV3ChecksumSize]
+}
+
+// OnionV3Host returns the .onion host name of the Tor v3 hidden service with
+// the given public key
+func OnionV3Host(pubKey [OnionV3PubKeySize]byte) string {
+	encoded := make([]byte, 0, OnionV3PubKeySize+onionV3ChecksumSize+1)
+	encoded = append(encoded, pubKey[:]...)
+	encoded = append(encoded, onionV3Checksum(pubKey)...)
+	encoded = append(encoded, onionV3Version)
+	return strings.ToLower(onionV3Encoding.EncodeToString(encoded)) + onionV3Suffix
+}
+
+// IsOnionHost returns whether the given host name is an onion host name
+func IsOnionHost(host string) bool {
+	return strings.HasSuffix(strings.ToLower(host), onionV3Suffix)
+}
+
+// ParseOnionV3Host returns the public key encoded in the given Tor v3 .onion
+// host name
+func ParseOnionV3Host(host string) ([OnionV3PubKeySize]byte, error) {
+	var pubKey [OnionV3PubKeySize]byte
+	if !IsOnionHost(host) {
+		return pubKey, errors.Errorf("%s is not an onion host", host)
+	}
+	encoded := strings.ToUpper(strings.TrimSuffix(strings.ToLower(host), onionV3Suffix))
+	if len(encoded) != onionV3HostLength {
+		return pubKey, errors.Errorf("%s is not a Tor v3 onion host", host)
+	}
+	decoded, err := onionV3Encoding.DecodeString(encoded)
+	if err != nil {
+		return pubKey, errors.Wrapf(err, "%s is not a valid onion host", host)
+	}
+	if decoded[len(decoded)-1] != onionV3Version {
+		return pubKey, errors.Errorf("%s has unsupported onion version %d", host, decoded[len(decoded)-1])
+	}
+	copy(pubKey[:], decoded[:OnionV3PubKeySize])
+	if !bytes.Equal(decoded[OnionV3PubKeySize:OnionV3PubKeySize+onionV3ChecksumSize], onionV3Checksum(pubKey)) {
+		return pubKey, errors.Errorf("%s has a bad checksum", host)
+	}
+	return pubKey, nil
+}
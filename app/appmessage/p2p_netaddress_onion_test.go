@@ -0,0 +1,44 @@
+package appmessage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOnionV3Host(t *testing.T) {
+	const host = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion"
+
+	pubKey, err := ParseOnionV3Host(host)
+	if err != nil {
+		t.Fatalf("ParseOnionV3Host: %s", err)
+	}
+	if OnionV3Host(pubKey) != host {
+		t.Fatalf("Unexpected onion host. Want: %s, got: %s", host, OnionV3Host(pubKey))
+	}
+	if _, err := ParseOnionV3Host(strings.ToUpper(host)); err != nil {
+		t.Fatalf("ParseOnionV3Host unexpectedly failed for an upper case host: %s", err)
+	}
+
+	netAddress := NewNetAddressOnionV3(pubKey, 19111)
+	if !netAddress.IsOnionV3() {
+		t.Fatalf("Onion address unexpectedly not an onion address")
+	}
+	if !IsOnionCatIP(netAddress.IP) {
+		t.Fatalf("Onion address IP %s is not in the OnionCat range", netAddress.IP)
+	}
+	if netAddress.String() != host+":19111" {
+		t.Fatalf("Unexpected onion address string: %s", netAddress.String())
+	}
+
+	badHosts := []string{
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczab.onion", // bad checksum
+		"3g2upl4pq6kufc4m.onion", // v2
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.com",
+		"1.2.3.4",
+	}
+	for _, badHost := range badHosts {
+		if _, err := ParseOnionV3Host(badHost); err == nil {
+			t.Errorf("ParseOnionV3Host unexpectedly succeeded for %s", badHost)
+		}
+	}
+}
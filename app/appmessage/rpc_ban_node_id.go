@@ -0,0 +1,43 @@
+package appmessage
+
+// BanNodeIDRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type BanNodeIDRequestMessage struct {
+	baseMessage
+
+	NodeID          string
+	Reason          string
+	DurationSeconds uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *BanNodeIDRequestMessage) Command() MessageCommand {
+	return CmdBanNodeIDRequestMessage
+}
+
+// NewBanNodeIDRequestMessage returns an instance of the message
+func NewBanNodeIDRequestMessage(nodeID string, reason string, durationSeconds uint64) *BanNodeIDRequestMessage {
+	return &BanNodeIDRequestMessage{
+		NodeID:          nodeID,
+		Reason:          reason,
+		DurationSeconds: durationSeconds,
+	}
+}
+
+// BanNodeIDResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type BanNodeIDResponseMessage struct {
+	baseMessage
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *BanNodeIDResponseMessage) Command() MessageCommand {
+	return CmdBanNodeIDResponseMessage
+}
+
+// NewBanNodeIDResponseMessage returns a instance of the message
+func NewBanNodeIDResponseMessage() *BanNodeIDResponseMessage {
+	return &BanNodeIDResponseMessage{}
+}
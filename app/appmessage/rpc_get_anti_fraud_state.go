@@ -0,0 +1,79 @@
+package appmessage
+
+// GetAntiFraudStateRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAntiFraudStateRequestMessage struct {
+	baseMessage
+	IncludeEntries bool
+	IncludeDiff    bool
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAntiFraudStateRequestMessage) Command() MessageCommand {
+	return CmdGetAntiFraudStateRequestMessage
+}
+
+// NewGetAntiFraudStateRequestMessage returns an instance of the message
+func NewGetAntiFraudStateRequestMessage(includeEntries bool, includeDiff bool) *GetAntiFraudStateRequestMessage {
+	return &GetAntiFraudStateRequestMessage{
+		IncludeEntries: includeEntries,
+		IncludeDiff:    includeDiff,
+	}
+}
+
+// GetAntiFraudStateResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAntiFraudStateResponseMessage struct {
+	baseMessage
+	RuntimeEnabled    bool
+	PeerFallback      bool
+	SeedEnabled       bool
+	HasSnapshot       bool
+	Source            string
+	SnapshotSeq       uint64
+	GeneratedAtMs     uint64
+	SigningKeyID      uint32
+	RootHash          string
+	BannedIPCount     uint32
+	BannedNodeIDCount uint32
+	HashWindow        []string
+	PeerVotes         []*RPCAntiFraudPeerVote
+	Matches           []*RPCAntiFraudEntryMatch
+	BannedIPs         []string
+	BannedNodeIDs     []string
+	Diff              *RPCAntiFraudSnapshotDiff
+
+	Error *RPCError
+}
+
+// RPCAntiFraudPeerVote is a signed AntiFraud snapshot offered by a peer
+type RPCAntiFraudPeerVote struct {
+	PeerID       string
+	SnapshotSeq  uint64
+	RootHash     string
+	ReceivedAtMs int64
+}
+
+// RPCAntiFraudEntryMatch is an entry of the active AntiFraud snapshot
+// together with the connected peers it cuts
+type RPCAntiFraudEntryMatch struct {
+	Entry    string
+	IsNodeID bool
+	Peers    []string
+}
+
+// RPCAntiFraudSnapshotDiff lists the entries that changed between the
+// previous and the active AntiFraud snapshots
+type RPCAntiFraudSnapshotDiff struct {
+	PreviousSnapshotSeq uint64
+	PreviousRootHash    string
+	AddedIPs            []string
+	RemovedIPs          []string
+	AddedNodeIDs        []string
+	RemovedNodeIDs      []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAntiFraudStateResponseMessage) Command() MessageCommand {
+	return CmdGetAntiFraudStateResponseMessage
+}
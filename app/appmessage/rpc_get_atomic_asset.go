@@ -0,0 +1,92 @@
+package appmessage
+
+// GetAtomicAssetRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicAssetRequestMessage struct {
+	baseMessage
+	AssetID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicAssetRequestMessage) Command() MessageCommand {
+	return CmdGetAtomicAssetRequestMessage
+}
+
+// NewGetAtomicAssetRequestMessage returns a instance of the message
+func NewGetAtomicAssetRequestMessage(assetID string) *GetAtomicAssetRequestMessage {
+	return &GetAtomicAssetRequestMessage{
+		AssetID: assetID,
+	}
+}
+
+// RPCAtomicAsset is a CAT asset as recorded in the Atomic consensus state.
+// 128-bit token amounts are represented as decimal strings.
+type RPCAtomicAsset struct {
+	AssetID              string
+	CreatorOwnerID       string
+	AssetClass           uint32
+	TokenVersion         uint32
+	MintAuthorityOwnerID string
+	Decimals             uint32
+	SupplyMode           uint32
+	MaxSupply            string
+	TotalSupply          string
+	Name                 string
+	Symbol               string
+	Metadata             string
+	PlatformTag          string
+	CreatedBlockHash     string
+	CreatedDAAScore      uint64
+	CreatedAt            uint64
+	Liquidity            *RPCLiquidityPool
+}
+
+// RPCLiquidityFeeRecipient is a fee recipient of a CAT liquidity pool
+type RPCLiquidityFeeRecipient struct {
+	OwnerID        string
+	Address        string
+	UnclaimedSompi uint64
+}
+
+// RPCLiquidityPool is the bonding-curve pool of a liquidity-class CAT asset
+type RPCLiquidityPool struct {
+	PoolNonce                           uint64
+	CurveVersion                        uint32
+	CurveMode                           uint32
+	IndividualVirtualCPayReservesSompi  uint64
+	IndividualVirtualTokenMultiplierBPS uint32
+	RealCPayReservesSompi               uint64
+	RealTokenReserves                   string
+	VirtualCPayReserves                 uint64
+	VirtualTokenReserves                string
+	UnclaimedFeeTotalSompi              uint64
+	FeeBPS                              uint32
+	FeeRecipients                       []*RPCLiquidityFeeRecipient
+	VaultOutpoint                       *RPCOutpoint
+	VaultValueSompi                     uint64
+	UnlockTargetSompi                   uint64
+	Unlocked                            bool
+}
+
+// GetAtomicAssetResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicAssetResponseMessage struct {
+	baseMessage
+	Asset     *RPCAtomicAsset
+	BlockHash string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicAssetResponseMessage) Command() MessageCommand {
+	return CmdGetAtomicAssetResponseMessage
+}
+
+// NewGetAtomicAssetResponseMessage returns a instance of the message
+func NewGetAtomicAssetResponseMessage(asset *RPCAtomicAsset, blockHash string) *GetAtomicAssetResponseMessage {
+	return &GetAtomicAssetResponseMessage{
+		Asset:     asset,
+		BlockHash: blockHash,
+	}
+}
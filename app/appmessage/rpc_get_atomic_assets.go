@@ -0,0 +1,47 @@
+package appmessage
+
+// GetAtomicAssetsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicAssetsRequestMessage struct {
+	baseMessage
+	StartAssetID string
+	Limit        uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicAssetsRequestMessage) Command() MessageCommand {
+	return CmdGetAtomicAssetsRequestMessage
+}
+
+// NewGetAtomicAssetsRequestMessage returns a instance of the message
+func NewGetAtomicAssetsRequestMessage(startAssetID string, limit uint32) *GetAtomicAssetsRequestMessage {
+	return &GetAtomicAssetsRequestMessage{
+		StartAssetID: startAssetID,
+		Limit:        limit,
+	}
+}
+
+// GetAtomicAssetsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicAssetsResponseMessage struct {
+	baseMessage
+	Assets      []*RPCAtomicAsset
+	NextAssetID string
+	BlockHash   string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicAssetsResponseMessage) Command() MessageCommand {
+	return CmdGetAtomicAssetsResponseMessage
+}
+
+// NewGetAtomicAssetsResponseMessage returns a instance of the message
+func NewGetAtomicAssetsResponseMessage(assets []*RPCAtomicAsset, nextAssetID string, blockHash string) *GetAtomicAssetsResponseMessage {
+	return &GetAtomicAssetsResponseMessage{
+		Assets:      assets,
+		NextAssetID: nextAssetID,
+		BlockHash:   blockHash,
+	}
+}
@@ -0,0 +1,54 @@
+package appmessage
+
+// GetAtomicBalanceProofRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicBalanceProofRequestMessage struct {
+	baseMessage
+	AssetID string
+	OwnerID string
+	Address string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicBalanceProofRequestMessage) Command() MessageCommand {
+	return CmdGetAtomicBalanceProofRequestMessage
+}
+
+// NewGetAtomicBalanceProofRequestMessage returns a instance of the message
+func NewGetAtomicBalanceProofRequestMessage(assetID string, ownerID string, address string) *GetAtomicBalanceProofRequestMessage {
+	return &GetAtomicBalanceProofRequestMessage{
+		AssetID: assetID,
+		OwnerID: ownerID,
+		Address: address,
+	}
+}
+
+// GetAtomicBalanceProofResponseMessage is an appmessage corresponding to
+// its respective RPC message.
+//
+// The UTXO commitment in the header of BlockHash is the Atomic header
+// commitment of RawUTXOCommitment and AtomicMerkleRoot. Siblings are ordered
+// from the root downwards. OtherLeafPath and OtherLeafEntryHash are only set
+// for proofs of absence that end at another leaf.
+type GetAtomicBalanceProofResponseMessage struct {
+	baseMessage
+	BlockHash          string
+	DAAScore           uint64
+	UTXOCommitment     string
+	RawUTXOCommitment  string
+	AtomicMerkleRoot   string
+	AssetID            string
+	OwnerID            string
+	Balance            string
+	Included           bool
+	Siblings           []string
+	OtherLeafPath      string
+	OtherLeafEntryHash string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicBalanceProofResponseMessage) Command() MessageCommand {
+	return CmdGetAtomicBalanceProofResponseMessage
+}
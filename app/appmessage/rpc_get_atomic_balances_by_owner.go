@@ -0,0 +1,55 @@
+package appmessage
+
+// GetAtomicBalancesByOwnerRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicBalancesByOwnerRequestMessage struct {
+	baseMessage
+	OwnerID string
+	Address string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicBalancesByOwnerRequestMessage) Command() MessageCommand {
+	return CmdGetAtomicBalancesByOwnerRequestMessage
+}
+
+// NewGetAtomicBalancesByOwnerRequestMessage returns a instance of the message
+func NewGetAtomicBalancesByOwnerRequestMessage(ownerID string, address string) *GetAtomicBalancesByOwnerRequestMessage {
+	return &GetAtomicBalancesByOwnerRequestMessage{
+		OwnerID: ownerID,
+		Address: address,
+	}
+}
+
+// RPCAtomicBalance is the balance an owner holds of a single CAT asset
+type RPCAtomicBalance struct {
+	AssetID string
+	Balance string
+}
+
+// GetAtomicBalancesByOwnerResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicBalancesByOwnerResponseMessage struct {
+	baseMessage
+	OwnerID   string
+	Balances  []*RPCAtomicBalance
+	BlockHash string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicBalancesByOwnerResponseMessage) Command() MessageCommand {
+	return CmdGetAtomicBalancesByOwnerResponseMessage
+}
+
+// NewGetAtomicBalancesByOwnerResponseMessage returns a instance of the message
+func NewGetAtomicBalancesByOwnerResponseMessage(ownerID string, balances []*RPCAtomicBalance,
+	blockHash string) *GetAtomicBalancesByOwnerResponseMessage {
+
+	return &GetAtomicBalancesByOwnerResponseMessage{
+		OwnerID:   ownerID,
+		Balances:  balances,
+		BlockHash: blockHash,
+	}
+}
@@ -0,0 +1,51 @@
+package appmessage
+
+// GetAtomicHistoryByAssetRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicHistoryByAssetRequestMessage struct {
+	baseMessage
+	AssetID string
+	Cursor  string
+	Limit   uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicHistoryByAssetRequestMessage) Command() MessageCommand {
+	return CmdGetAtomicHistoryByAssetRequestMessage
+}
+
+// NewGetAtomicHistoryByAssetRequestMessage returns a instance of the message
+func NewGetAtomicHistoryByAssetRequestMessage(assetID string, cursor string, limit uint32) *GetAtomicHistoryByAssetRequestMessage {
+	return &GetAtomicHistoryByAssetRequestMessage{
+		AssetID: assetID,
+		Cursor:  cursor,
+		Limit:   limit,
+	}
+}
+
+// GetAtomicHistoryByAssetResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicHistoryByAssetResponseMessage struct {
+	baseMessage
+	AssetID    string
+	Entries    []*RPCAtomicHistoryEntry
+	NextCursor string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicHistoryByAssetResponseMessage) Command() MessageCommand {
+	return CmdGetAtomicHistoryByAssetResponseMessage
+}
+
+// NewGetAtomicHistoryByAssetResponseMessage returns a instance of the message
+func NewGetAtomicHistoryByAssetResponseMessage(assetID string, entries []*RPCAtomicHistoryEntry,
+	nextCursor string) *GetAtomicHistoryByAssetResponseMessage {
+
+	return &GetAtomicHistoryByAssetResponseMessage{
+		AssetID:    assetID,
+		Entries:    entries,
+		NextCursor: nextCursor,
+	}
+}
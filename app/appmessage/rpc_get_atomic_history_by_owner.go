@@ -0,0 +1,57 @@
+package appmessage
+
+// GetAtomicHistoryByOwnerRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicHistoryByOwnerRequestMessage struct {
+	baseMessage
+	OwnerID string
+	Cursor  string
+	Limit   uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicHistoryByOwnerRequestMessage) Command() MessageCommand {
+	return CmdGetAtomicHistoryByOwnerRequestMessage
+}
+
+// NewGetAtomicHistoryByOwnerRequestMessage returns a instance of the message
+func NewGetAtomicHistoryByOwnerRequestMessage(ownerID string, cursor string, limit uint32) *GetAtomicHistoryByOwnerRequestMessage {
+	return &GetAtomicHistoryByOwnerRequestMessage{
+		OwnerID: ownerID,
+		Cursor:  cursor,
+		Limit:   limit,
+	}
+}
+
+// RPCAtomicHistoryEntry is a token event recorded by the Atomic index
+type RPCAtomicHistoryEntry struct {
+	AcceptingDAAScore uint64
+	Event             *RPCAtomicEvent
+}
+
+// GetAtomicHistoryByOwnerResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicHistoryByOwnerResponseMessage struct {
+	baseMessage
+	OwnerID    string
+	Entries    []*RPCAtomicHistoryEntry
+	NextCursor string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicHistoryByOwnerResponseMessage) Command() MessageCommand {
+	return CmdGetAtomicHistoryByOwnerResponseMessage
+}
+
+// NewGetAtomicHistoryByOwnerResponseMessage returns a instance of the message
+func NewGetAtomicHistoryByOwnerResponseMessage(ownerID string, entries []*RPCAtomicHistoryEntry,
+	nextCursor string) *GetAtomicHistoryByOwnerResponseMessage {
+
+	return &GetAtomicHistoryByOwnerResponseMessage{
+		OwnerID:    ownerID,
+		Entries:    entries,
+		NextCursor: nextCursor,
+	}
+}
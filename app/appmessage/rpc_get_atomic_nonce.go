@@ -0,0 +1,49 @@
+package appmessage
+
+// GetAtomicNonceRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicNonceRequestMessage struct {
+	baseMessage
+	OwnerID string
+	Address string
+	AssetID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicNonceRequestMessage) Command() MessageCommand {
+	return CmdGetAtomicNonceRequestMessage
+}
+
+// NewGetAtomicNonceRequestMessage returns a instance of the message
+func NewGetAtomicNonceRequestMessage(ownerID string, address string, assetID string) *GetAtomicNonceRequestMessage {
+	return &GetAtomicNonceRequestMessage{
+		OwnerID: ownerID,
+		Address: address,
+		AssetID: assetID,
+	}
+}
+
+// GetAtomicNonceResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAtomicNonceResponseMessage struct {
+	baseMessage
+	OwnerID   string
+	NextNonce uint64
+	BlockHash string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAtomicNonceResponseMessage) Command() MessageCommand {
+	return CmdGetAtomicNonceResponseMessage
+}
+
+// NewGetAtomicNonceResponseMessage returns a instance of the message
+func NewGetAtomicNonceResponseMessage(ownerID string, nextNonce uint64, blockHash string) *GetAtomicNonceResponseMessage {
+	return &GetAtomicNonceResponseMessage{
+		OwnerID:   ownerID,
+		NextNonce: nextNonce,
+		BlockHash: blockHash,
+	}
+}
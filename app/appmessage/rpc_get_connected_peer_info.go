@@ -53,4 +53,5 @@ type GetConnectedPeerInfoMessage struct {
 	TimeConnected             int64
 	IsIBDPeer                 bool
 	UnifiedNodeID             string
+	BanScore                  uint32
 }
@@ -0,0 +1,55 @@
+package appmessage
+
+// GetFastIntentStatusRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetFastIntentStatusRequestMessage struct {
+	baseMessage
+	IntentID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetFastIntentStatusRequestMessage) Command() MessageCommand {
+	return CmdGetFastIntentStatusRequestMessage
+}
+
+// NewGetFastIntentStatusRequestMessage returns a instance of the message
+func NewGetFastIntentStatusRequestMessage(intentID string) *GetFastIntentStatusRequestMessage {
+	return &GetFastIntentStatusRequestMessage{
+		IntentID: intentID,
+	}
+}
+
+// GetFastIntentStatusResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetFastIntentStatusResponseMessage struct {
+	baseMessage
+	IntentID          string
+	TransactionID     string
+	Status            string
+	MaxFee            uint64
+	ClientCreatedAtMs uint64
+	ReceivedAtMs      uint64
+	MicroblockTimeMs  uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetFastIntentStatusResponseMessage) Command() MessageCommand {
+	return CmdGetFastIntentStatusResponseMessage
+}
+
+// NewGetFastIntentStatusResponseMessage returns a instance of the message
+func NewGetFastIntentStatusResponseMessage(intentID string, transactionID string, status string, maxFee uint64,
+	clientCreatedAtMs uint64, receivedAtMs uint64, microblockTimeMs uint64) *GetFastIntentStatusResponseMessage {
+
+	return &GetFastIntentStatusResponseMessage{
+		IntentID:          intentID,
+		TransactionID:     transactionID,
+		Status:            status,
+		MaxFee:            maxFee,
+		ClientCreatedAtMs: clientCreatedAtMs,
+		ReceivedAtMs:      receivedAtMs,
+		MicroblockTimeMs:  microblockTimeMs,
+	}
+}
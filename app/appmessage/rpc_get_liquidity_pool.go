@@ -0,0 +1,43 @@
+package appmessage
+
+// GetLiquidityPoolRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetLiquidityPoolRequestMessage struct {
+	baseMessage
+	AssetID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetLiquidityPoolRequestMessage) Command() MessageCommand {
+	return CmdGetLiquidityPoolRequestMessage
+}
+
+// NewGetLiquidityPoolRequestMessage returns a instance of the message
+func NewGetLiquidityPoolRequestMessage(assetID string) *GetLiquidityPoolRequestMessage {
+	return &GetLiquidityPoolRequestMessage{
+		AssetID: assetID,
+	}
+}
+
+// GetLiquidityPoolResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetLiquidityPoolResponseMessage struct {
+	baseMessage
+	Pool      *RPCLiquidityPool
+	BlockHash string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetLiquidityPoolResponseMessage) Command() MessageCommand {
+	return CmdGetLiquidityPoolResponseMessage
+}
+
+// NewGetLiquidityPoolResponseMessage returns a instance of the message
+func NewGetLiquidityPoolResponseMessage(pool *RPCLiquidityPool, blockHash string) *GetLiquidityPoolResponseMessage {
+	return &GetLiquidityPoolResponseMessage{
+		Pool:      pool,
+		BlockHash: blockHash,
+	}
+}
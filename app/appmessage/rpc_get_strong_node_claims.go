@@ -0,0 +1,68 @@
+package appmessage
+
+// GetStrongNodeClaimsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetStrongNodeClaimsRequestMessage struct {
+	baseMessage
+	IncludeWinningClaims bool
+	IncludePendingClaims bool
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetStrongNodeClaimsRequestMessage) Command() MessageCommand {
+	return CmdGetStrongNodeClaimsRequestMessage
+}
+
+// NewGetStrongNodeClaimsRequestMessage returns a instance of the message
+func NewGetStrongNodeClaimsRequestMessage(includeWinningClaims bool, includePendingClaims bool) *GetStrongNodeClaimsRequestMessage {
+	return &GetStrongNodeClaimsRequestMessage{
+		IncludeWinningClaims: includeWinningClaims,
+		IncludePendingClaims: includePendingClaims,
+	}
+}
+
+// GetStrongNodeClaimsResponseMessage is an appmessage corresponding to
+// its respective RPC message.
+//
+// Entries are ordered by ClaimedBlocks, descending. WinningClaims are ordered
+// from the oldest block in the claim window, and PendingClaims by the time
+// they were received.
+type GetStrongNodeClaimsResponseMessage struct {
+	baseMessage
+	Enabled          bool
+	HardforkActive   bool
+	RuntimeAvailable bool
+	WindowSize       uint32
+	ConflictTotal    uint64
+	Entries          []*RPCStrongNodeClaimEntry
+	WinningClaims    []*RPCBlockProducerClaim
+	PendingClaims    []*RPCBlockProducerClaim
+
+	Error *RPCError
+}
+
+// RPCStrongNodeClaimEntry is the number of claim window blocks credited to a
+// single node
+type RPCStrongNodeClaimEntry struct {
+	NodeID             string
+	PublicKeyXOnly     string
+	ClaimedBlocks      uint32
+	ShareBPS           uint32
+	LastClaimBlockHash string
+	LastClaimTimeMs    uint64
+}
+
+// RPCBlockProducerClaim is a block producer claim of a single node for a
+// single block
+type RPCBlockProducerClaim struct {
+	BlockHash      string
+	NodeID         string
+	PublicKeyXOnly string
+	ClaimID        string
+	ReceivedAtMs   uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetStrongNodeClaimsResponseMessage) Command() MessageCommand {
+	return CmdGetStrongNodeClaimsResponseMessage
+}
@@ -0,0 +1,65 @@
+package appmessage
+
+// The statuses GetTransactionStatusResponseMessage reports a transaction in
+const (
+	TransactionStatusInMempool = "in_mempool"
+	TransactionStatusOrphan    = "orphan"
+	TransactionStatusRejected  = "rejected"
+	TransactionStatusAccepted  = "accepted"
+	TransactionStatusUnknown   = "unknown"
+)
+
+// GetTransactionStatusRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionStatusRequestMessage struct {
+	baseMessage
+	TransactionID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionStatusRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionStatusRequestMessage
+}
+
+// NewGetTransactionStatusRequestMessage returns a instance of the message
+func NewGetTransactionStatusRequestMessage(transactionID string) *GetTransactionStatusRequestMessage {
+	return &GetTransactionStatusRequestMessage{
+		TransactionID: transactionID,
+	}
+}
+
+// GetTransactionStatusResponseMessage is an appmessage corresponding to
+// its respective RPC message. Only the fields relevant to Status are set.
+type GetTransactionStatusResponseMessage struct {
+	baseMessage
+	Status string
+
+	FeeRate                 float64
+	PackageFeeRate          float64
+	FeeRateRank             uint64
+	MempoolTransactionCount uint64
+	AtomicSlots             []string
+
+	MissingOutpoints []*RPCOutpoint
+
+	RejectCode   string
+	RejectReason string
+	RejectedAtMs int64
+
+	AcceptingBlockHash string
+	Confirmations      uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionStatusResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionStatusResponseMessage
+}
+
+// NewGetTransactionStatusResponseMessage returns a instance of the message
+func NewGetTransactionStatusResponseMessage(status string) *GetTransactionStatusResponseMessage {
+	return &GetTransactionStatusResponseMessage{
+		Status: status,
+	}
+}
@@ -0,0 +1,57 @@
+package appmessage
+
+// ListBansRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type ListBansRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *ListBansRequestMessage) Command() MessageCommand {
+	return CmdListBansRequestMessage
+}
+
+// NewListBansRequestMessage returns an instance of the message
+func NewListBansRequestMessage() *ListBansRequestMessage {
+	return &ListBansRequestMessage{}
+}
+
+// ListBansResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type ListBansResponseMessage struct {
+	baseMessage
+
+	BannedIPs     []*RPCBannedIP
+	BannedNodeIDs []*RPCBannedNodeID
+
+	Error *RPCError
+}
+
+// RPCBannedIP is a banned IP address
+type RPCBannedIP struct {
+	IP          string
+	BannedAtMs  int64
+	ExpiresAtMs int64
+}
+
+// RPCBannedNodeID is a banned unified node ID. ExpiresAtMs is 0 for
+// bans that never expire.
+type RPCBannedNodeID struct {
+	NodeID      string
+	Reason      string
+	BannedAtMs  int64
+	ExpiresAtMs int64
+}
+
+// Command returns the protocol command string for the message
+func (msg *ListBansResponseMessage) Command() MessageCommand {
+	return CmdListBansResponseMessage
+}
+
+// NewListBansResponseMessage returns a instance of the message
+func NewListBansResponseMessage(bannedIPs []*RPCBannedIP, bannedNodeIDs []*RPCBannedNodeID) *ListBansResponseMessage {
+	return &ListBansResponseMessage{
+		BannedIPs:     bannedIPs,
+		BannedNodeIDs: bannedNodeIDs,
+	}
+}
@@ -0,0 +1,83 @@
+package appmessage
+
+// NotifyAtomicStateChangedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyAtomicStateChangedRequestMessage struct {
+	baseMessage
+	OwnerIDs []string
+	AssetIDs []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyAtomicStateChangedRequestMessage) Command() MessageCommand {
+	return CmdNotifyAtomicStateChangedRequestMessage
+}
+
+// NewNotifyAtomicStateChangedRequestMessage returns a instance of the message
+func NewNotifyAtomicStateChangedRequestMessage(ownerIDs []string, assetIDs []string) *NotifyAtomicStateChangedRequestMessage {
+	return &NotifyAtomicStateChangedRequestMessage{
+		OwnerIDs: ownerIDs,
+		AssetIDs: assetIDs,
+	}
+}
+
+// NotifyAtomicStateChangedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyAtomicStateChangedResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyAtomicStateChangedResponseMessage) Command() MessageCommand {
+	return CmdNotifyAtomicStateChangedResponseMessage
+}
+
+// NewNotifyAtomicStateChangedResponseMessage returns a instance of the message
+func NewNotifyAtomicStateChangedResponseMessage() *NotifyAtomicStateChangedResponseMessage {
+	return &NotifyAtomicStateChangedResponseMessage{}
+}
+
+// AtomicStateChangedNotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type AtomicStateChangedNotificationMessage struct {
+	baseMessage
+	Events []*RPCAtomicEvent
+}
+
+// RPCAtomicEvent is a token event caused by a transaction accepted by a
+// chain block. Reversed events undo an event of a block that was removed
+// from the selected parent chain.
+type RPCAtomicEvent struct {
+	Kind               string
+	TransactionID      string
+	AcceptingBlockHash string
+	AssetID            string
+	OwnerID            string
+	Amount             string
+	CPayAmountSompi    uint64
+	Recipients         []string
+	BalanceChanges     []*RPCAtomicBalanceChange
+	Pool               *RPCLiquidityPool
+	Reversed           bool
+}
+
+// RPCAtomicBalanceChange is the change of a single balance caused by an
+// RPCAtomicEvent
+type RPCAtomicBalanceChange struct {
+	OwnerID string
+	Before  string
+	After   string
+}
+
+// Command returns the protocol command string for the message
+func (msg *AtomicStateChangedNotificationMessage) Command() MessageCommand {
+	return CmdAtomicStateChangedNotificationMessage
+}
+
+// NewAtomicStateChangedNotificationMessage returns a instance of the message
+func NewAtomicStateChangedNotificationMessage(events []*RPCAtomicEvent) *AtomicStateChangedNotificationMessage {
+	return &AtomicStateChangedNotificationMessage{
+		Events: events,
+	}
+}
@@ -0,0 +1,53 @@
+package appmessage
+
+// NotifyBlockProducerClaimWinnerRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyBlockProducerClaimWinnerRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyBlockProducerClaimWinnerRequestMessage) Command() MessageCommand {
+	return CmdNotifyBlockProducerClaimWinnerRequestMessage
+}
+
+// NewNotifyBlockProducerClaimWinnerRequestMessage returns an instance of the message
+func NewNotifyBlockProducerClaimWinnerRequestMessage() *NotifyBlockProducerClaimWinnerRequestMessage {
+	return &NotifyBlockProducerClaimWinnerRequestMessage{}
+}
+
+// NotifyBlockProducerClaimWinnerResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyBlockProducerClaimWinnerResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyBlockProducerClaimWinnerResponseMessage) Command() MessageCommand {
+	return CmdNotifyBlockProducerClaimWinnerResponseMessage
+}
+
+// NewNotifyBlockProducerClaimWinnerResponseMessage returns an instance of the message
+func NewNotifyBlockProducerClaimWinnerResponseMessage() *NotifyBlockProducerClaimWinnerResponseMessage {
+	return &NotifyBlockProducerClaimWinnerResponseMessage{}
+}
+
+// BlockProducerClaimWinnerNotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type BlockProducerClaimWinnerNotificationMessage struct {
+	baseMessage
+	Winners []*RPCBlockProducerClaim
+}
+
+// Command returns the protocol command string for the message
+func (msg *BlockProducerClaimWinnerNotificationMessage) Command() MessageCommand {
+	return CmdBlockProducerClaimWinnerNotificationMessage
+}
+
+// NewBlockProducerClaimWinnerNotificationMessage returns an instance of the message
+func NewBlockProducerClaimWinnerNotificationMessage(winners []*RPCBlockProducerClaim) *BlockProducerClaimWinnerNotificationMessage {
+	return &BlockProducerClaimWinnerNotificationMessage{
+		Winners: winners,
+	}
+}
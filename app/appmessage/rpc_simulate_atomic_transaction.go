@@ -0,0 +1,88 @@
+package appmessage
+
+// SimulateAtomicTransactionRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type SimulateAtomicTransactionRequestMessage struct {
+	baseMessage
+	Transaction *RPCTransaction
+}
+
+// Command returns the protocol command string for the message
+func (msg *SimulateAtomicTransactionRequestMessage) Command() MessageCommand {
+	return CmdSimulateAtomicTransactionRequestMessage
+}
+
+// NewSimulateAtomicTransactionRequestMessage returns a instance of the message
+func NewSimulateAtomicTransactionRequestMessage(transaction *RPCTransaction) *SimulateAtomicTransactionRequestMessage {
+	return &SimulateAtomicTransactionRequestMessage{
+		Transaction: transaction,
+	}
+}
+
+// SimulateAtomicTransactionResponseMessage is an appmessage corresponding to
+// its respective RPC message.
+//
+// ValidationError holds the reason the transaction itself is invalid, e.g. a
+// missing signature. The Atomic payload is still simulated when only such
+// errors were found. AtomicError holds the Atomic rule the payload violates,
+// in which case no changes are returned.
+type SimulateAtomicTransactionResponseMessage struct {
+	baseMessage
+	TransactionID   string
+	DAAScore        uint64
+	Fee             uint64
+	ValidationError string
+	AtomicError     string
+	BalanceChanges  []*RPCAtomicSimulatedBalanceChange
+	NonceChanges    []*RPCAtomicSimulatedNonceChange
+	PoolChanges     []*RPCAtomicSimulatedPoolChange
+	StateGrowth     *RPCAtomicStateGrowth
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *SimulateAtomicTransactionResponseMessage) Command() MessageCommand {
+	return CmdSimulateAtomicTransactionResponseMessage
+}
+
+// NewSimulateAtomicTransactionResponseMessage returns a instance of the message
+func NewSimulateAtomicTransactionResponseMessage(transactionID string) *SimulateAtomicTransactionResponseMessage {
+	return &SimulateAtomicTransactionResponseMessage{
+		TransactionID: transactionID,
+	}
+}
+
+// RPCAtomicSimulatedBalanceChange is the change of a single Atomic balance
+type RPCAtomicSimulatedBalanceChange struct {
+	AssetID string
+	OwnerID string
+	Before  string
+	After   string
+}
+
+// RPCAtomicSimulatedNonceChange is the change of a single Atomic nonce. AssetID is
+// empty for owner-scoped nonces.
+type RPCAtomicSimulatedNonceChange struct {
+	OwnerID string
+	AssetID string
+	Before  uint64
+	After   uint64
+}
+
+// RPCAtomicSimulatedPoolChange is the change of a single liquidity pool. Before is nil
+// for pools created by the transaction.
+type RPCAtomicSimulatedPoolChange struct {
+	AssetID string
+	Before  *RPCLiquidityPool
+	After   *RPCLiquidityPool
+}
+
+// RPCAtomicStateGrowth is the number of new Atomic state entries a transaction creates
+type RPCAtomicStateGrowth struct {
+	NewAssets          uint64
+	NewBalanceKeys     uint64
+	NewNonceKeys       uint64
+	NewPools           uint64
+	NewAnchorOwnerKeys uint64
+}
@@ -0,0 +1,34 @@
+package appmessage
+
+// StopNotifyingAtomicStateChangedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type StopNotifyingAtomicStateChangedRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *StopNotifyingAtomicStateChangedRequestMessage) Command() MessageCommand {
+	return CmdStopNotifyingAtomicStateChangedRequestMessage
+}
+
+// NewStopNotifyingAtomicStateChangedRequestMessage returns a instance of the message
+func NewStopNotifyingAtomicStateChangedRequestMessage() *StopNotifyingAtomicStateChangedRequestMessage {
+	return &StopNotifyingAtomicStateChangedRequestMessage{}
+}
+
+// StopNotifyingAtomicStateChangedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type StopNotifyingAtomicStateChangedResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *StopNotifyingAtomicStateChangedResponseMessage) Command() MessageCommand {
+	return CmdStopNotifyingAtomicStateChangedResponseMessage
+}
+
+// NewStopNotifyingAtomicStateChangedResponseMessage returns a instance of the message
+func NewStopNotifyingAtomicStateChangedResponseMessage() *StopNotifyingAtomicStateChangedResponseMessage {
+	return &StopNotifyingAtomicStateChangedResponseMessage{}
+}
@@ -0,0 +1,51 @@
+package appmessage
+
+// SubmitFastIntentRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type SubmitFastIntentRequestMessage struct {
+	baseMessage
+	Transaction       *RPCTransaction
+	IntentNonce       uint64
+	ClientCreatedAtMs uint64
+	MaxFee            uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *SubmitFastIntentRequestMessage) Command() MessageCommand {
+	return CmdSubmitFastIntentRequestMessage
+}
+
+// NewSubmitFastIntentRequestMessage returns a instance of the message
+func NewSubmitFastIntentRequestMessage(transaction *RPCTransaction, intentNonce uint64,
+	clientCreatedAtMs uint64, maxFee uint64) *SubmitFastIntentRequestMessage {
+
+	return &SubmitFastIntentRequestMessage{
+		Transaction:       transaction,
+		IntentNonce:       intentNonce,
+		ClientCreatedAtMs: clientCreatedAtMs,
+		MaxFee:            maxFee,
+	}
+}
+
+// SubmitFastIntentResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type SubmitFastIntentResponseMessage struct {
+	baseMessage
+	IntentID      string
+	TransactionID string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *SubmitFastIntentResponseMessage) Command() MessageCommand {
+	return CmdSubmitFastIntentResponseMessage
+}
+
+// NewSubmitFastIntentResponseMessage returns a instance of the message
+func NewSubmitFastIntentResponseMessage(intentID string, transactionID string) *SubmitFastIntentResponseMessage {
+	return &SubmitFastIntentResponseMessage{
+		IntentID:      intentID,
+		TransactionID: transactionID,
+	}
+}
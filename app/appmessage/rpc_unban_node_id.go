@@ -0,0 +1,39 @@
+package appmessage
+
+// UnbanNodeIDRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type UnbanNodeIDRequestMessage struct {
+	baseMessage
+
+	NodeID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *UnbanNodeIDRequestMessage) Command() MessageCommand {
+	return CmdUnbanNodeIDRequestMessage
+}
+
+// NewUnbanNodeIDRequestMessage returns an instance of the message
+func NewUnbanNodeIDRequestMessage(nodeID string) *UnbanNodeIDRequestMessage {
+	return &UnbanNodeIDRequestMessage{
+		NodeID: nodeID,
+	}
+}
+
+// UnbanNodeIDResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type UnbanNodeIDResponseMessage struct {
+	baseMessage
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *UnbanNodeIDResponseMessage) Command() MessageCommand {
+	return CmdUnbanNodeIDResponseMessage
+}
+
+// NewUnbanNodeIDResponseMessage returns a instance of the message
+func NewUnbanNodeIDResponseMessage() *UnbanNodeIDResponseMessage {
+	return &UnbanNodeIDResponseMessage{}
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync/atomic"
 
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
@@ -11,6 +12,7 @@ import (
 	"github.com/cryptix-network/cryptixd/app/protocol"
 	"github.com/cryptix-network/cryptixd/app/rpc"
 	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/atomicindex"
 	"github.com/cryptix-network/cryptixd/domain/consensus"
 	"github.com/cryptix-network/cryptixd/domain/utxoindex"
 	"github.com/cryptix-network/cryptixd/infrastructure/config"
@@ -22,6 +24,10 @@ import (
 	"github.com/cryptix-network/cryptixd/util/panics"
 )
 
+// mempoolFilename is the name of the file in the app directory the mempool is
+// saved to on shutdown and loaded from on startup
+const mempoolFilename = "mempool.dat"
+
 // ComponentManager is a wrapper for all the cryptixd services
 type ComponentManager struct {
 	cfg               *config.Config
@@ -69,6 +75,14 @@ func (a *ComponentManager) Stop() {
 	}
 
 	a.protocolManager.Close()
+
+	savedTransactions, err := a.protocolManager.Context().Domain().MiningManager().SaveMempool(mempoolPath(a.cfg))
+	if err != nil {
+		log.Errorf("Error saving the mempool: %+v", err)
+	} else {
+		log.Infof("Saved %d mempool transactions", savedTransactions)
+	}
+
 	close(a.protocolManager.Context().Domain().ConsensusEventsChannel())
 
 	return
@@ -94,6 +108,13 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		return nil, err
 	}
 
+	loadedTransactions, err := domain.MiningManager().LoadMempool(mempoolPath(cfg))
+	if err != nil {
+		log.Errorf("Error loading the saved mempool: %+v", err)
+	} else if loadedTransactions > 0 {
+		log.Infof("Loaded %d saved mempool transactions", loadedTransactions)
+	}
+
 	netAdapter, err := netadapter.NewNetAdapter(cfg)
 	if err != nil {
 		return nil, err
@@ -114,6 +135,16 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		log.Infof("UTXO index started")
 	}
 
+	var atomicIndex *atomicindex.AtomicIndex
+	if cfg.AtomicIndex {
+		atomicIndex, err = atomicindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Atomic index started")
+	}
+
 	connectionManager, err := connmanager.New(cfg, netAdapter, addressManager)
 	if err != nil {
 		return nil, err
@@ -122,7 +153,7 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
-	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, domain.ConsensusEventsChannel(), interrupt)
+	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, atomicIndex, domain.ConsensusEventsChannel(), interrupt)
 
 	return &ComponentManager{
 		cfg:               cfg,
@@ -143,6 +174,7 @@ func setupRPC(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	atomicIndex *atomicindex.AtomicIndex,
 	consensusEventsChan chan externalapi.ConsensusEvent,
 	shutDownChan chan<- struct{},
 ) *rpc.Manager {
@@ -155,6 +187,7 @@ func setupRPC(
 		connectionManager,
 		addressManager,
 		utxoIndex,
+		atomicIndex,
 		consensusEventsChan,
 		shutDownChan,
 	)
@@ -165,10 +198,15 @@ func setupRPC(
 		}
 	})
 	protocolManager.SetOnPruningPointUTXOSetOverrideHandler(rpcManager.NotifyPruningPointUTXOSetOverride)
+	protocolManager.SetOnBlockProducerClaimWinnerHandler(rpcManager.NotifyBlockProducerClaimWinner)
 
 	return rpcManager
 }
 
+func mempoolPath(cfg *config.Config) string {
+	return filepath.Join(cfg.AppDir, mempoolFilename)
+}
+
 // P2PNodeID returns the network ID associated with this ComponentManager
 func (a *ComponentManager) P2PNodeID() *id.ID {
 	return a.netAdapter.ID()
@@ -0,0 +1,233 @@
+// Package compactblocks builds compact blocks and reconstructs blocks out of
+// them.
+//
+// A compact block carries the header of a block and a short ID for each of
+// its transactions, so that a peer that already has most of the transactions
+// in its mempool doesn't have to receive them again. Short IDs are the low 48
+// bits of a BLAKE3 hash of the transaction hash, keyed by the block hash and a
+// per-message nonce, so that colliding transactions can't be crafted ahead of
+// time. Transactions that the receiver can't have, like the coinbase, are
+// prefilled instead.
+package compactblocks
+
+import (
+	"encoding/binary"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/merkle"
+	"github.com/pkg/errors"
+	"github.com/zeebo/blake3"
+)
+
+const shortIDMask = 1<<48 - 1
+
+type shortIDHasher struct {
+	hasher *blake3.Hasher
+}
+
+func newShortIDHasher(blockHash *externalapi.DomainHash, nonce uint64) *shortIDHasher {
+	keyData := binary.LittleEndian.AppendUint64(blockHash.ByteSlice(), nonce)
+	key := blake3.Sum256(keyData)
+	hasher, err := blake3.NewKeyed(key[:])
+	if err != nil {
+		// Can only fail on a key of the wrong size
+		panic(err)
+	}
+	return &shortIDHasher{hasher: hasher}
+}
+
+func (h *shortIDHasher) shortID(transaction *externalapi.DomainTransaction) uint64 {
+	return h.shortIDOfHash(consensushashing.TransactionHash(transaction))
+}
+
+func (h *shortIDHasher) shortIDOfHash(transactionHash *externalapi.DomainHash) uint64 {
+	h.hasher.Reset()
+	h.hasher.Write(transactionHash.ByteSlice())
+	return binary.LittleEndian.Uint64(h.hasher.Sum(nil)) & shortIDMask
+}
+
+// New returns the compact block message of the given block
+func New(block *externalapi.DomainBlock, nonce uint64) *appmessage.MsgCompactBlock {
+	hasher := newShortIDHasher(consensushashing.BlockHash(block), nonce)
+
+	shortIDs := make([]uint64, 0, len(block.Transactions))
+	var prefilledTransactions []*appmessage.PrefilledTransaction
+	for i, transaction := range block.Transactions {
+		// The coinbase never makes it to a mempool
+		if i == 0 {
+			prefilledTransactions = append(prefilledTransactions, &appmessage.PrefilledTransaction{
+				Index:       uint32(i),
+				Transaction: appmessage.DomainTransactionToMsgTx(transaction),
+			})
+			continue
+		}
+		shortIDs = append(shortIDs, hasher.shortID(transaction))
+	}
+
+	return appmessage.NewMsgCompactBlock(appmessage.DomainBlockHeaderToBlockHeader(block.Header),
+		nonce, shortIDs, prefilledTransactions)
+}
+
+// PartialBlock is a block that is being reconstructed out of a compact block
+type PartialBlock struct {
+	header       externalapi.BlockHeader
+	transactions []*externalapi.DomainTransaction
+
+	// fromMempool marks the transactions that were matched by short ID,
+	// rather than sent by the peer
+	fromMempool []bool
+}
+
+// TransactionLookup returns the mempool transactions whose short ID, as
+// computed by the given function out of the transaction hash, is one of the
+// given short IDs. Taking the hash lets the mempool hash its transactions once
+// rather than for every compact block.
+type TransactionLookup func(shortIDs map[uint64]struct{},
+	shortID func(transactionHash *externalapi.DomainHash) uint64) []*externalapi.DomainTransaction
+
+// Reconstruct fills in as many of the transactions of the given compact block
+// as it can out of the mempool transactions returned by the given lookup.
+// Transactions whose short ID matches no mempool transaction, or more than one,
+// are left missing.
+func Reconstruct(compactBlock *appmessage.MsgCompactBlock, lookup TransactionLookup) (*PartialBlock, error) {
+
+	transactionCount := len(compactBlock.ShortIDs) + len(compactBlock.PrefilledTransactions)
+	if transactionCount == 0 {
+		return nil, errors.New("compact block has no transactions")
+	}
+	if len(compactBlock.PrefilledTransactions) == 0 || compactBlock.PrefilledTransactions[0].Index != 0 {
+		return nil, errors.New("compact block doesn't prefill its coinbase transaction")
+	}
+
+	partialBlock := &PartialBlock{
+		header:       appmessage.BlockHeaderToDomainBlockHeader(&compactBlock.Header),
+		transactions: make([]*externalapi.DomainTransaction, transactionCount),
+		fromMempool:  make([]bool, transactionCount),
+	}
+
+	isPrefilled := make([]bool, transactionCount)
+	previousIndex := -1
+	for _, prefilledTransaction := range compactBlock.PrefilledTransactions {
+		index := int(prefilledTransaction.Index)
+		if index <= previousIndex || index >= transactionCount {
+			return nil, errors.Errorf("compact block prefilled transaction index %d is out of order or range", index)
+		}
+		previousIndex = index
+		isPrefilled[index] = true
+		partialBlock.transactions[index] = appmessage.MsgTxToDomainTransaction(prefilledTransaction.Transaction)
+	}
+
+	hasher := newShortIDHasher(partialBlock.Hash(), compactBlock.ShortIDNonce)
+
+	// A short ID that appears twice in the block, or that matches more than
+	// a single mempool transaction, can't be resolved locally
+	shortIDCounts := make(map[uint64]int, len(compactBlock.ShortIDs))
+	for _, shortID := range compactBlock.ShortIDs {
+		shortIDCounts[shortID]++
+	}
+	uniqueShortIDs := make(map[uint64]struct{}, len(shortIDCounts))
+	for shortID, count := range shortIDCounts {
+		if count == 1 {
+			uniqueShortIDs[shortID] = struct{}{}
+		}
+	}
+	candidates := make(map[uint64]*externalapi.DomainTransaction)
+	ambiguous := make(map[uint64]struct{})
+	if len(uniqueShortIDs) > 0 {
+		for _, transaction := range lookup(uniqueShortIDs, hasher.shortIDOfHash) {
+			shortID := hasher.shortID(transaction)
+			if _, ok := uniqueShortIDs[shortID]; !ok {
+				continue
+			}
+			if _, ok := candidates[shortID]; ok {
+				ambiguous[shortID] = struct{}{}
+				continue
+			}
+			candidates[shortID] = transaction
+		}
+	}
+
+	shortIDIndex := 0
+	for i := range partialBlock.transactions {
+		if isPrefilled[i] {
+			continue
+		}
+		shortID := compactBlock.ShortIDs[shortIDIndex]
+		shortIDIndex++
+		if _, ok := ambiguous[shortID]; ok {
+			continue
+		}
+		if transaction, ok := candidates[shortID]; ok {
+			partialBlock.transactions[i] = transaction
+			partialBlock.fromMempool[i] = true
+		}
+	}
+
+	return partialBlock, nil
+}
+
+// Hash returns the hash of the block
+func (b *PartialBlock) Hash() *externalapi.DomainHash {
+	return consensushashing.HeaderHash(b.header)
+}
+
+// MissingIndexes returns the indexes of the transactions that are still
+// missing
+func (b *PartialBlock) MissingIndexes() []uint32 {
+	var missingIndexes []uint32
+	for i, transaction := range b.transactions {
+		if transaction == nil {
+			missingIndexes = append(missingIndexes, uint32(i))
+		}
+	}
+	return missingIndexes
+}
+
+// Fill fills in the missing transactions, in the order returned by
+// MissingIndexes
+func (b *PartialBlock) Fill(transactions []*externalapi.DomainTransaction) error {
+	missingIndexes := b.MissingIndexes()
+	if len(transactions) != len(missingIndexes) {
+		return errors.Errorf("got %d transactions for %d missing ones", len(transactions), len(missingIndexes))
+	}
+	for i, index := range missingIndexes {
+		b.transactions[index] = transactions[i]
+	}
+	return nil
+}
+
+// HasValidMerkleRoot returns whether all the transactions are in, and match
+// the hash merkle root of the header. A mismatch means that a short ID was
+// resolved to the wrong mempool transaction, or that the peer sent the wrong
+// transactions.
+func (b *PartialBlock) HasValidMerkleRoot() bool {
+	if len(b.MissingIndexes()) > 0 {
+		return false
+	}
+	return merkle.CalculateHashMerkleRoot(b.transactions).Equal(b.header.HashMerkleRoot())
+}
+
+// DropMempoolTransactions marks the transactions that were resolved out of
+// the mempool as missing, and returns whether there were any
+func (b *PartialBlock) DropMempoolTransactions() bool {
+	dropped := false
+	for i, isFromMempool := range b.fromMempool {
+		if isFromMempool {
+			b.transactions[i] = nil
+			b.fromMempool[i] = false
+			dropped = true
+		}
+	}
+	return dropped
+}
+
+// Block returns the reconstructed block. It must only be called once no
+// transaction is missing.
+func (b *PartialBlock) Block() *externalapi.DomainBlock {
+	return &externalapi.DomainBlock{
+		Header:       b.header,
+		Transactions: b.transactions,
+	}
+}
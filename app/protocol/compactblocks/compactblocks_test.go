@@ -0,0 +1,160 @@
+package compactblocks
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/blockheader"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/merkle"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/subnetworks"
+)
+
+func testTransaction(value uint64, payload []byte) *externalapi.DomainTransaction {
+	subnetworkID := subnetworks.SubnetworkIDNative
+	if len(payload) > 0 {
+		subnetworkID = subnetworks.SubnetworkIDCoinbase
+	}
+	return &externalapi.DomainTransaction{
+		Inputs: []*externalapi.DomainTransactionInput{{
+			PreviousOutpoint: externalapi.DomainOutpoint{Index: uint32(value)},
+			SignatureScript:  []byte{byte(value), 1, 2, 3},
+		}},
+		Outputs:      []*externalapi.DomainTransactionOutput{{Value: value, ScriptPublicKey: &externalapi.ScriptPublicKey{}}},
+		SubnetworkID: subnetworkID,
+		Payload:      payload,
+	}
+}
+
+func testBlock(transactionCount int) *externalapi.DomainBlock {
+	transactions := []*externalapi.DomainTransaction{testTransaction(0, []byte("coinbase"))}
+	for i := 1; i < transactionCount; i++ {
+		transactions = append(transactions, testTransaction(uint64(i), nil))
+	}
+	header := blockheader.NewImmutableBlockHeader(1, nil, merkle.CalculateHashMerkleRoot(transactions),
+		&externalapi.DomainHash{}, &externalapi.DomainHash{}, 1, 1, 1, 1, 1, big.NewInt(1), &externalapi.DomainHash{})
+	return &externalapi.DomainBlock{Header: header, Transactions: transactions}
+}
+
+// lookupIn returns a TransactionLookup over the given mempool transactions
+func lookupIn(mempoolTransactions []*externalapi.DomainTransaction) TransactionLookup {
+	return func(shortIDs map[uint64]struct{},
+		shortID func(transactionHash *externalapi.DomainHash) uint64) []*externalapi.DomainTransaction {
+
+		var transactions []*externalapi.DomainTransaction
+		for _, transaction := range mempoolTransactions {
+			if _, ok := shortIDs[shortID(consensushashing.TransactionHash(transaction))]; ok {
+				transactions = append(transactions, transaction.Clone())
+			}
+		}
+		return transactions
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	block := testBlock(6)
+	compactBlock := New(block, 42)
+	if len(compactBlock.PrefilledTransactions) != 1 || len(compactBlock.ShortIDs) != 5 {
+		t.Fatalf("Unexpected compact block: %d prefilled transactions and %d short IDs",
+			len(compactBlock.PrefilledTransactions), len(compactBlock.ShortIDs))
+	}
+
+	// The mempool has some of the block transactions, and an unrelated one
+	mempool := []*externalapi.DomainTransaction{
+		block.Transactions[4], block.Transactions[1], block.Transactions[2], testTransaction(100, nil),
+	}
+	partialBlock, err := Reconstruct(compactBlock, lookupIn(mempool))
+	if err != nil {
+		t.Fatalf("Reconstruct: %s", err)
+	}
+	if !partialBlock.Hash().Equal(consensushashing.BlockHash(block)) {
+		t.Fatalf("Reconstructed block has the wrong hash")
+	}
+	missingIndexes := partialBlock.MissingIndexes()
+	if len(missingIndexes) != 2 || missingIndexes[0] != 3 || missingIndexes[1] != 5 {
+		t.Fatalf("Unexpected missing indexes %v", missingIndexes)
+	}
+	if partialBlock.HasValidMerkleRoot() {
+		t.Fatalf("A block with missing transactions has a valid merkle root")
+	}
+
+	err = partialBlock.Fill([]*externalapi.DomainTransaction{block.Transactions[3]})
+	if err == nil {
+		t.Fatalf("Fill unexpectedly accepted too few transactions")
+	}
+	err = partialBlock.Fill([]*externalapi.DomainTransaction{block.Transactions[3], block.Transactions[5]})
+	if err != nil {
+		t.Fatalf("Fill: %s", err)
+	}
+	if !partialBlock.HasValidMerkleRoot() {
+		t.Fatalf("The reconstructed block doesn't match its merkle root")
+	}
+	if !consensushashing.BlockHash(partialBlock.Block()).Equal(consensushashing.BlockHash(block)) {
+		t.Fatalf("Reconstructed block has the wrong hash")
+	}
+}
+
+func TestReconstructPayloadTransaction(t *testing.T) {
+	block := testBlock(3)
+	block.Transactions[2].SubnetworkID = subnetworks.SubnetworkIDPayload
+	block.Transactions[2].Payload = []byte("payload")
+	block.Header = blockheader.NewImmutableBlockHeader(1, nil, merkle.CalculateHashMerkleRoot(block.Transactions),
+		&externalapi.DomainHash{}, &externalapi.DomainHash{}, 1, 1, 1, 1, 1, big.NewInt(1), &externalapi.DomainHash{})
+
+	// Only the coinbase is prefilled, payload transactions are sent by short ID
+	compactBlock := New(block, 3)
+	if len(compactBlock.PrefilledTransactions) != 1 || len(compactBlock.ShortIDs) != 2 {
+		t.Fatalf("Unexpected compact block: %d prefilled transactions and %d short IDs",
+			len(compactBlock.PrefilledTransactions), len(compactBlock.ShortIDs))
+	}
+
+	partialBlock, err := Reconstruct(compactBlock, lookupIn(block.Transactions[1:]))
+	if err != nil {
+		t.Fatalf("Reconstruct: %s", err)
+	}
+	if !partialBlock.HasValidMerkleRoot() {
+		t.Fatalf("The reconstructed block doesn't match its merkle root")
+	}
+}
+
+func TestReconstructMalleatedTransaction(t *testing.T) {
+	block := testBlock(3)
+	compactBlock := New(block, 7)
+
+	// A transaction with the same ID but a different signature doesn't
+	// match, since short IDs cover the transaction hash
+	malleated := block.Transactions[1].Clone()
+	malleated.Inputs[0].SignatureScript = []byte{9}
+	partialBlock, err := Reconstruct(compactBlock, lookupIn([]*externalapi.DomainTransaction{malleated, block.Transactions[2]}))
+	if err != nil {
+		t.Fatalf("Reconstruct: %s", err)
+	}
+	missingIndexes := partialBlock.MissingIndexes()
+	if len(missingIndexes) != 1 || missingIndexes[0] != 1 {
+		t.Fatalf("Unexpected missing indexes %v", missingIndexes)
+	}
+
+	if !partialBlock.DropMempoolTransactions() {
+		t.Fatalf("DropMempoolTransactions didn't drop the mempool transaction")
+	}
+	if missingIndexes := partialBlock.MissingIndexes(); len(missingIndexes) != 2 {
+		t.Fatalf("Unexpected missing indexes %v after dropping mempool transactions", missingIndexes)
+	}
+}
+
+func TestReconstructInvalidCompactBlock(t *testing.T) {
+	compactBlock := New(testBlock(3), 1)
+	compactBlock.PrefilledTransactions[0].Index = 3
+	_, err := Reconstruct(compactBlock, lookupIn(nil))
+	if err == nil {
+		t.Fatalf("Reconstruct unexpectedly accepted a compact block without a coinbase")
+	}
+
+	compactBlock = New(testBlock(3), 1)
+	compactBlock.PrefilledTransactions = append(compactBlock.PrefilledTransactions, compactBlock.PrefilledTransactions[0])
+	_, err = Reconstruct(compactBlock, lookupIn(nil))
+	if err == nil {
+		t.Fatalf("Reconstruct unexpectedly accepted a repeated prefilled index")
+	}
+}
@@ -0,0 +1,242 @@
+package fastintents
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/hashes"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxIntents is the maximal number of intents kept in the pool
+	MaxIntents = 10_000
+
+	// MaxIntentIDsPerMessage is the maximal number of intent IDs a single
+	// microblock or intent request may carry
+	MaxIntentIDsPerMessage = 1_000
+
+	// IntentLifetimeMs is how long an intent is kept after it was received
+	IntentLifetimeMs = 10 * 60 * 1000
+
+	// maxClientClockSkewMs is how far in the future ClientCreatedAtMs may be
+	maxClientClockSkewMs = 30 * 1000
+
+	expireIntervalMs = 1000
+)
+
+var (
+	// ErrIntentAlreadyKnown is returned when an intent with the same ID is already in the pool
+	ErrIntentAlreadyKnown = errors.New("fast intent is already known")
+
+	// ErrIntentConflict is returned when an intent spends an outpoint spent by another intent
+	ErrIntentConflict = errors.New("fast intent conflicts with another intent")
+
+	// ErrPoolFull is returned when the pool holds MaxIntents intents
+	ErrPoolFull = errors.New("fast intent pool is full")
+
+	// ErrInvalidIntent is returned for intents that can never be accepted by
+	// this node
+	ErrInvalidIntent = errors.New("invalid fast intent")
+
+	// ErrStaleIntent is returned for intents created too far in the past or future
+	ErrStaleIntent = errors.New("stale fast intent")
+)
+
+// Status is the status of an intent in the pool
+type Status uint8
+
+const (
+	// StatusPending is the status of intents not yet included in a microblock
+	StatusPending Status = iota
+
+	// StatusMicroblocked is the status of intents included in an announced microblock
+	StatusMicroblocked
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPending:
+		return "pending"
+	case StatusMicroblocked:
+		return "microblocked"
+	}
+	return "unknown"
+}
+
+// Intent is a fast intent held by the pool
+type Intent struct {
+	ID                *externalapi.DomainHash
+	Transaction       *externalapi.DomainTransaction
+	Nonce             uint64
+	ClientCreatedAtMs uint64
+	MaxFee            uint64
+
+	ReceivedAtMs     uint64
+	Status           Status
+	MicroblockTimeMs uint64
+}
+
+// CalculateIntentID returns the ID of the intent with the given fields. It
+// commits to the ID of the base transaction, so intents with the same ID
+// always carry the same transaction.
+func CalculateIntentID(transaction *externalapi.DomainTransaction, nonce uint64,
+	clientCreatedAtMs uint64, maxFee uint64) *externalapi.DomainHash {
+
+	writer := hashes.NewFastIntentIDWriter()
+	writer.InfallibleWrite(consensushashing.TransactionID(transaction).ByteSlice())
+	var fields [24]byte
+	binary.LittleEndian.PutUint64(fields[0:8], nonce)
+	binary.LittleEndian.PutUint64(fields[8:16], clientCreatedAtMs)
+	binary.LittleEndian.PutUint64(fields[16:24], maxFee)
+	writer.InfallibleWrite(fields[:])
+	return writer.Finalize()
+}
+
+// Pool holds the fast intents known to this node, deduplicated by intent ID
+type Pool struct {
+	mutex          sync.Mutex
+	intents        map[externalapi.DomainHash]*Intent
+	spentOutpoints map[externalapi.DomainOutpoint]*externalapi.DomainHash
+	lastExpireMs   uint64
+}
+
+// NewPool returns a new empty Pool
+func NewPool() *Pool {
+	return &Pool{
+		intents:        make(map[externalapi.DomainHash]*Intent),
+		spentOutpoints: make(map[externalapi.DomainOutpoint]*externalapi.DomainHash),
+	}
+}
+
+// CheckIntent checks the parts of an intent that do not depend on the UTXO
+// set: its ID, MaxFee and creation time
+func CheckIntent(intent *Intent, nowMs uint64) error {
+	if intent.ID == nil || intent.Transaction == nil {
+		return errors.Wrapf(ErrInvalidIntent, "missing intent ID or base transaction")
+	}
+	expectedID := CalculateIntentID(intent.Transaction, intent.Nonce, intent.ClientCreatedAtMs, intent.MaxFee)
+	if !expectedID.Equal(intent.ID) {
+		return errors.Wrapf(ErrInvalidIntent, "intent ID %s does not match its contents, expected %s",
+			intent.ID, expectedID)
+	}
+	if intent.MaxFee == 0 {
+		return errors.Wrapf(ErrInvalidIntent, "intent %s has a zero MaxFee", intent.ID)
+	}
+	if intent.ClientCreatedAtMs > nowMs+maxClientClockSkewMs {
+		return errors.Wrapf(ErrStaleIntent, "intent %s was created %dms in the future",
+			intent.ID, intent.ClientCreatedAtMs-nowMs)
+	}
+	if intent.ClientCreatedAtMs+IntentLifetimeMs < nowMs {
+		return errors.Wrapf(ErrStaleIntent, "intent %s was created %dms ago",
+			intent.ID, nowMs-intent.ClientCreatedAtMs)
+	}
+	return nil
+}
+
+// Add adds an intent whose base transaction was already validated to the pool
+func (p *Pool) Add(intent *Intent, nowMs uint64) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.expire(nowMs)
+
+	if _, ok := p.intents[*intent.ID]; ok {
+		return errors.Wrapf(ErrIntentAlreadyKnown, "intent %s", intent.ID)
+	}
+	for _, input := range intent.Transaction.Inputs {
+		if conflictingID, ok := p.spentOutpoints[input.PreviousOutpoint]; ok {
+			return errors.Wrapf(ErrIntentConflict, "intent %s spends %s, which is spent by intent %s",
+				intent.ID, input.PreviousOutpoint, conflictingID)
+		}
+	}
+	if len(p.intents) >= MaxIntents {
+		return errors.Wrapf(ErrPoolFull, "cannot add intent %s", intent.ID)
+	}
+
+	added := *intent
+	added.ReceivedAtMs = nowMs
+	added.Status = StatusPending
+	added.MicroblockTimeMs = 0
+	p.intents[*intent.ID] = &added
+	for _, input := range intent.Transaction.Inputs {
+		p.spentOutpoints[input.PreviousOutpoint] = added.ID
+	}
+	return nil
+}
+
+// Has returns whether the pool holds an intent with the given ID
+func (p *Pool) Has(intentID *externalapi.DomainHash) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	_, ok := p.intents[*intentID]
+	return ok
+}
+
+// Get returns a copy of the intent with the given ID
+func (p *Pool) Get(intentID *externalapi.DomainHash) (*Intent, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	intent, ok := p.intents[*intentID]
+	if !ok {
+		return nil, false
+	}
+	intentCopy := *intent
+	return &intentCopy, true
+}
+
+// MarkMicroblock records that the given intents were included in a
+// microblock announced at microblockTimeMs. It returns whether any known
+// intent changed status, and the IDs that are not in the pool.
+func (p *Pool) MarkMicroblock(microblockTimeMs uint64, intentIDs []*externalapi.DomainHash) (
+	changed bool, unknownIDs []*externalapi.DomainHash) {
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for _, intentID := range intentIDs {
+		intent, ok := p.intents[*intentID]
+		if !ok {
+			unknownIDs = append(unknownIDs, intentID)
+			continue
+		}
+		if intent.Status == StatusMicroblocked && intent.MicroblockTimeMs <= microblockTimeMs {
+			continue
+		}
+		intent.Status = StatusMicroblocked
+		intent.MicroblockTimeMs = microblockTimeMs
+		changed = true
+	}
+	return changed, unknownIDs
+}
+
+// Count returns the number of intents in the pool
+func (p *Pool) Count() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return len(p.intents)
+}
+
+// expire removes the intents received more than IntentLifetimeMs ago. The
+// pool is scanned at most once per expireIntervalMs.
+func (p *Pool) expire(nowMs uint64) {
+	if nowMs < p.lastExpireMs+expireIntervalMs {
+		return
+	}
+	p.lastExpireMs = nowMs
+
+	for intentID, intent := range p.intents {
+		if intent.ReceivedAtMs+IntentLifetimeMs >= nowMs {
+			continue
+		}
+		for _, input := range intent.Transaction.Inputs {
+			delete(p.spentOutpoints, input.PreviousOutpoint)
+		}
+		delete(p.intents, intentID)
+	}
+}
@@ -0,0 +1,120 @@
+package fastintents
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/subnetworks"
+)
+
+const testNowMs = 1_700_000_000_000
+
+func testIntent(outpointTag byte, nonce uint64) *Intent {
+	var previousID [externalapi.DomainHashSize]byte
+	previousID[0] = outpointTag
+	transaction := &externalapi.DomainTransaction{
+		Version: 0,
+		Inputs: []*externalapi.DomainTransactionInput{{
+			PreviousOutpoint: *externalapi.NewDomainOutpoint(externalapi.NewDomainTransactionIDFromByteArray(&previousID), 0),
+		}},
+		Outputs: []*externalapi.DomainTransactionOutput{{
+			Value:           1000,
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{0x51}},
+		}},
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+	}
+	const maxFee = 5000
+	return &Intent{
+		ID:                CalculateIntentID(transaction, nonce, testNowMs, maxFee),
+		Transaction:       transaction,
+		Nonce:             nonce,
+		ClientCreatedAtMs: testNowMs,
+		MaxFee:            maxFee,
+	}
+}
+
+func TestCheckIntent(t *testing.T) {
+	intent := testIntent(1, 1)
+	if err := CheckIntent(intent, testNowMs); err != nil {
+		t.Fatalf("CheckIntent: %s", err)
+	}
+
+	tampered := *intent
+	tampered.MaxFee++
+	if err := CheckIntent(&tampered, testNowMs); !errors.Is(err, ErrInvalidIntent) {
+		t.Fatalf("expected ErrInvalidIntent for a tampered intent, got %v", err)
+	}
+
+	if err := CheckIntent(intent, testNowMs+IntentLifetimeMs+1); !errors.Is(err, ErrStaleIntent) {
+		t.Fatalf("expected ErrStaleIntent for an old intent, got %v", err)
+	}
+	if err := CheckIntent(intent, testNowMs-maxClientClockSkewMs-1); !errors.Is(err, ErrStaleIntent) {
+		t.Fatalf("expected ErrStaleIntent for a future intent, got %v", err)
+	}
+}
+
+func TestPoolDeduplicatesAndRejectsConflicts(t *testing.T) {
+	pool := NewPool()
+	intent := testIntent(1, 1)
+	if err := pool.Add(intent, testNowMs); err != nil {
+		t.Fatalf("Add: %s", err)
+	}
+	if err := pool.Add(intent, testNowMs); !errors.Is(err, ErrIntentAlreadyKnown) {
+		t.Fatalf("expected ErrIntentAlreadyKnown, got %v", err)
+	}
+	// Same outpoint, different nonce
+	if err := pool.Add(testIntent(1, 2), testNowMs); !errors.Is(err, ErrIntentConflict) {
+		t.Fatalf("expected ErrIntentConflict, got %v", err)
+	}
+	if err := pool.Add(testIntent(2, 1), testNowMs); err != nil {
+		t.Fatalf("Add: %s", err)
+	}
+	if pool.Count() != 2 {
+		t.Fatalf("expected 2 intents, got %d", pool.Count())
+	}
+}
+
+func TestPoolMarkMicroblock(t *testing.T) {
+	pool := NewPool()
+	intent := testIntent(1, 1)
+	if err := pool.Add(intent, testNowMs); err != nil {
+		t.Fatalf("Add: %s", err)
+	}
+	unknown := testIntent(3, 1)
+
+	changed, unknownIDs := pool.MarkMicroblock(testNowMs+10, []*externalapi.DomainHash{intent.ID, unknown.ID})
+	if !changed {
+		t.Fatalf("expected the microblock to change the pool")
+	}
+	if len(unknownIDs) != 1 || !unknownIDs[0].Equal(unknown.ID) {
+		t.Fatalf("unexpected unknown IDs %v", unknownIDs)
+	}
+	got, ok := pool.Get(intent.ID)
+	if !ok || got.Status != StatusMicroblocked || got.MicroblockTimeMs != testNowMs+10 {
+		t.Fatalf("unexpected intent after microblock %+v", got)
+	}
+
+	// Re-announcing the same or a later microblock is not a change
+	changed, _ = pool.MarkMicroblock(testNowMs+20, []*externalapi.DomainHash{intent.ID})
+	if changed {
+		t.Fatalf("expected a later microblock not to change the pool")
+	}
+}
+
+func TestPoolExpiresIntents(t *testing.T) {
+	pool := NewPool()
+	intent := testIntent(1, 1)
+	if err := pool.Add(intent, testNowMs); err != nil {
+		t.Fatalf("Add: %s", err)
+	}
+
+	// Once the first intent expired its outpoint can be spent by another intent
+	later := uint64(testNowMs + IntentLifetimeMs + 1)
+	if err := pool.Add(testIntent(1, 2), later); err != nil {
+		t.Fatalf("Add after expiration: %s", err)
+	}
+	if pool.Has(intent.ID) {
+		t.Fatalf("expected the first intent to expire")
+	}
+}
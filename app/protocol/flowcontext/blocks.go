@@ -228,6 +228,7 @@ func (f *FlowContext) TrySetIBDRunning(ibdPeer *peerpkg.Peer) bool {
 		return false
 	}
 	f.ibdPeer = ibdPeer
+	ibdRunningMetric.Set(1)
 	log.Infof("IBD started with peer %s", ibdPeer)
 
 	return true
@@ -243,6 +244,7 @@ func (f *FlowContext) UnsetIBDRunning() {
 	}
 
 	f.ibdPeer = nil
+	ibdRunningMetric.Set(0)
 }
 
 // IBDPeer returns the current IBD peer or null if the node is not
@@ -0,0 +1,193 @@
+package flowcontext
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/protocol/fastintents"
+	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/constants"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter"
+	"github.com/cryptix-network/cryptixd/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// SubmitFastIntent validates a locally submitted fast intent, adds it to the
+// intent pool and the mempool, and relays it to fastchain peers. A zero
+// clientCreatedAtMs is replaced with the current time.
+func (f *FlowContext) SubmitFastIntent(transaction *externalapi.DomainTransaction, nonce uint64,
+	clientCreatedAtMs uint64, maxFee uint64) (*externalapi.DomainHash, error) {
+
+	if clientCreatedAtMs == 0 {
+		clientCreatedAtMs = uint64(mstime.Now().UnixMilliseconds())
+	}
+	intent := &fastintents.Intent{
+		ID:                fastintents.CalculateIntentID(transaction, nonce, clientCreatedAtMs, maxFee),
+		Transaction:       transaction,
+		Nonce:             nonce,
+		ClientCreatedAtMs: clientCreatedAtMs,
+		MaxFee:            maxFee,
+	}
+	err := f.addFastIntent(intent, nil)
+	if err != nil {
+		return nil, err
+	}
+	return intent.ID, nil
+}
+
+// FastIntent returns the fast intent with the given ID, if it is in the intent pool
+func (f *FlowContext) FastIntent(intentID *externalapi.DomainHash) (*fastintents.Intent, bool) {
+	return f.fastIntents.Get(intentID)
+}
+
+// HandleFastIntent validates a fast intent received from peer, adds it to the
+// intent pool and relays it. Intents that are invalid, already known, conflict
+// with known intents or do not apply to the current UTXO set are dropped.
+func (f *FlowContext) HandleFastIntent(peer *peerpkg.Peer, message *appmessage.MsgFastIntent) error {
+	intent := &fastintents.Intent{
+		ID:                message.IntentID,
+		Transaction:       appmessage.MsgTxToDomainTransaction(message.BaseTransaction),
+		Nonce:             message.IntentNonce,
+		ClientCreatedAtMs: message.ClientCreatedAtMs,
+		MaxFee:            message.MaxFee,
+	}
+	if f.fastIntents.Has(intent.ID) {
+		return nil
+	}
+
+	err := f.addFastIntent(intent, peer)
+	if err == nil {
+		return nil
+	}
+	// The intent ID scheme and the fee bounds are local policy rather than
+	// consensus rules, so peers relaying intents that fail them are not banned
+	if errors.Is(err, fastintents.ErrInvalidIntent) || errors.Is(err, fastintents.ErrIntentAlreadyKnown) || errors.Is(err, fastintents.ErrIntentConflict) ||
+		errors.Is(err, fastintents.ErrPoolFull) || errors.Is(err, fastintents.ErrStaleIntent) ||
+		errors.As(err, &ruleerrors.RuleError{}) {
+
+		log.Debugf("Dropping fast intent %s from %s: %s", intent.ID, peer, err)
+		return nil
+	}
+	return err
+}
+
+// FastIntentMessages returns the fast intent messages of the given intent IDs
+// that are in the intent pool
+func (f *FlowContext) FastIntentMessages(intentIDs []*externalapi.DomainHash) []*appmessage.MsgFastIntent {
+	messages := make([]*appmessage.MsgFastIntent, 0, len(intentIDs))
+	for _, intentID := range intentIDs {
+		intent, ok := f.fastIntents.Get(intentID)
+		if !ok {
+			continue
+		}
+		messages = append(messages, fastIntentToMessage(intent))
+	}
+	return messages
+}
+
+// HandleFastMicroblock records a microblock announced by peer and relays it
+// if it changed the status of any known intent. It returns the announced
+// intent IDs that are not in the intent pool.
+func (f *FlowContext) HandleFastMicroblock(peer *peerpkg.Peer, message *appmessage.MsgFastMicroblock) (
+	[]*externalapi.DomainHash, error) {
+
+	if len(message.IntentIDs) > fastintents.MaxIntentIDsPerMessage {
+		return nil, protocolerrors.Errorf(true, "fast microblock from %s has %d intent IDs, the maximum is %d",
+			peer, len(message.IntentIDs), fastintents.MaxIntentIDsPerMessage)
+	}
+
+	changed, unknownIDs := f.fastIntents.MarkMicroblock(message.MicroblockTimeMs, message.IntentIDs)
+	if changed {
+		err := f.broadcastToFastchainPeers(message, peer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return unknownIDs, nil
+}
+
+func (f *FlowContext) addFastIntent(intent *fastintents.Intent, sourcePeer *peerpkg.Peer) error {
+	nowMs := uint64(mstime.Now().UnixMilliseconds())
+	err := fastintents.CheckIntent(intent, nowMs)
+	if err != nil {
+		return err
+	}
+
+	populatedTransaction := intent.Transaction.Clone()
+	err = f.Domain().Consensus().ValidateTransactionAndPopulateWithConsensusData(populatedTransaction)
+	if err != nil {
+		return err
+	}
+	minimumFee := f.minimumFastIntentFee(populatedTransaction.Mass)
+	if populatedTransaction.Fee < minimumFee {
+		return errors.Wrapf(fastintents.ErrInvalidIntent, "intent %s pays a fee of %d, the minimum is %d",
+			intent.ID, populatedTransaction.Fee, minimumFee)
+	}
+	if populatedTransaction.Fee > intent.MaxFee {
+		return errors.Wrapf(fastintents.ErrInvalidIntent, "intent %s pays a fee of %d, above its MaxFee of %d",
+			intent.ID, populatedTransaction.Fee, intent.MaxFee)
+	}
+
+	err = f.fastIntents.Add(intent, nowMs)
+	if err != nil {
+		return err
+	}
+
+	// The base transaction still has to be mined. A mempool rejection, e.g.
+	// because of a conflicting mempool transaction, does not invalidate the intent.
+	err = f.AddTransaction(intent.Transaction.Clone(), false)
+	if err != nil {
+		log.Debugf("Base transaction of fast intent %s was not added to the mempool: %s", intent.ID, err)
+	}
+
+	return f.broadcastToFastchainPeers(fastIntentToMessage(intent), sourcePeer)
+}
+
+// minimumFastIntentFee returns the minimal fee of a base transaction with the
+// given mass. It is the same as the minimum relay fee of the mempool.
+func (f *FlowContext) minimumFastIntentFee(mass uint64) uint64 {
+	minimumRelayTransactionFee := uint64(f.Config().MinRelayTxFee)
+	minimumFee := (mass * minimumRelayTransactionFee) / 1000
+	if minimumFee == 0 && minimumRelayTransactionFee > 0 {
+		minimumFee = minimumRelayTransactionFee
+	}
+	if minimumFee > constants.MaxSompi {
+		minimumFee = constants.MaxSompi
+	}
+	return minimumFee
+}
+
+// broadcastToFastchainPeers relays the given message to the peers advertising
+// fastchain support, as long as the local node advertises it as well
+func (f *FlowContext) broadcastToFastchainPeers(message appmessage.Message, sourcePeer *peerpkg.Peer) error {
+	if !f.Config().Fastchain {
+		return nil
+	}
+
+	peers := f.Peers()
+	targets := make([]*netadapter.NetConnection, 0, len(peers))
+	for _, peer := range peers {
+		if sourcePeer != nil && peer.ID().IsEqual(sourcePeer.ID()) {
+			continue
+		}
+		if peer.Services()&appmessage.SFNodeHFAFastchain == 0 {
+			continue
+		}
+		targets = append(targets, peer.Connection())
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	return f.NetAdapter().P2PBroadcast(targets, message)
+}
+
+func fastIntentToMessage(intent *fastintents.Intent) *appmessage.MsgFastIntent {
+	return &appmessage.MsgFastIntent{
+		IntentID:          intent.ID,
+		BaseTransaction:   appmessage.DomainTransactionToMsgTx(intent.Transaction),
+		IntentNonce:       intent.Nonce,
+		ClientCreatedAtMs: intent.ClientCreatedAtMs,
+		MaxFee:            intent.MaxFee,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cryptix-network/cryptixd/app/protocol/fastintents"
 	"github.com/cryptix-network/cryptixd/app/protocol/strongnodeclaims"
 	"github.com/cryptix-network/cryptixd/util/mstime"
 
@@ -30,6 +31,10 @@ type OnPruningPointUTXOSetOverrideHandler func() error
 // when a transaction is added to the mempool
 type OnTransactionAddedToMempoolHandler func()
 
+// OnBlockProducerClaimWinnerHandler is a handler function that's triggered
+// when chain blocks in the strong-node claim window get a new winning claim
+type OnBlockProducerClaimWinnerHandler func(winners []strongnodeclaims.ClaimSnapshot) error
+
 // FlowContext holds state that is relevant to more than one flow or one peer, and allows communication between
 // different flows that can be associated to different peers.
 type FlowContext struct {
@@ -39,12 +44,15 @@ type FlowContext struct {
 	addressManager    *addressmanager.AddressManager
 	connectionManager *connmanager.ConnectionManager
 	strongNodeClaims  *strongnodeclaims.Engine
+	fastIntents       *fastintents.Pool
+	reputation        *peerpkg.Reputation
 
 	timeStarted int64
 
 	onNewBlockTemplateHandler            OnNewBlockTemplateHandler
 	onPruningPointUTXOSetOverrideHandler OnPruningPointUTXOSetOverrideHandler
 	onTransactionAddedToMempoolHandler   OnTransactionAddedToMempoolHandler
+	onBlockProducerClaimWinnerHandler    OnBlockProducerClaimWinnerHandler
 
 	lastRebroadcastTime         time.Time
 	sharedRequestedTransactions *SharedRequestedTransactions
@@ -81,6 +89,8 @@ func New(cfg *config.Config, domain domain.Domain, addressManager *addressmanage
 		addressManager:                   addressManager,
 		connectionManager:                connectionManager,
 		strongNodeClaims:                 strongnodeclaims.New(true, cfg.ActiveNetParams.Name, cfg.AppDir),
+		fastIntents:                      fastintents.NewPool(),
+		reputation:                       peerpkg.NewReputation(cfg.BanThreshold),
 		sharedRequestedTransactions:      NewSharedRequestedTransactions(),
 		sharedRequestedBlocks:            NewSharedRequestedBlocks(),
 		peers:                            make(map[id.ID]*peerpkg.Peer),
@@ -125,3 +135,8 @@ func (f *FlowContext) SetOnPruningPointUTXOSetOverrideHandler(onPruningPointUTXO
 func (f *FlowContext) SetOnTransactionAddedToMempoolHandler(onTransactionAddedToMempoolHandler OnTransactionAddedToMempoolHandler) {
 	f.onTransactionAddedToMempoolHandler = onTransactionAddedToMempoolHandler
 }
+
+// SetOnBlockProducerClaimWinnerHandler sets the onBlockProducerClaimWinner handler
+func (f *FlowContext) SetOnBlockProducerClaimWinnerHandler(onBlockProducerClaimWinnerHandler OnBlockProducerClaimWinnerHandler) {
+	f.onBlockProducerClaimWinnerHandler = onBlockProducerClaimWinnerHandler
+}
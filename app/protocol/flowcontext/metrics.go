@@ -0,0 +1,22 @@
+package flowcontext
+
+import (
+	"strconv"
+
+	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/cryptix-network/cryptixd/infrastructure/metrics"
+)
+
+var (
+	peersMetric = metrics.NewGaugeVec("cryptixd_peers",
+		"Number of ready peers by connection direction and protocol version", "direction", "protocol_version")
+	ibdRunningMetric = metrics.NewGauge("cryptixd_ibd_running", "1 while the node is in IBD, 0 otherwise")
+)
+
+func peersMetricOf(peer *peerpkg.Peer) *metrics.Gauge {
+	direction := "inbound"
+	if peer.IsOutbound() {
+		direction = "outbound"
+	}
+	return peersMetric.WithLabelValues(direction, strconv.FormatUint(uint64(peer.ProtocolVersion()), 10))
+}
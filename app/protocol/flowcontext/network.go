@@ -30,9 +30,11 @@ func (f *FlowContext) AddToPeers(peer *peerpkg.Peer) error {
 		}
 		log.Warnf("Replacing stale ready peer %s with new connection %s", existingPeer, peer)
 		delete(f.peers, *peer.ID())
+		peersMetricOf(existingPeer).Dec()
 	}
 
 	f.peers[*peer.ID()] = peer
+	peersMetricOf(peer).Inc()
 
 	return nil
 }
@@ -44,6 +46,7 @@ func (f *FlowContext) RemoveFromPeers(peer *peerpkg.Peer) {
 
 	if existingPeer, ok := f.peers[*peer.ID()]; ok && existingPeer == peer {
 		delete(f.peers, *peer.ID())
+		peersMetricOf(peer).Dec()
 	}
 }
 
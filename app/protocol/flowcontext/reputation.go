@@ -0,0 +1,60 @@
+package flowcontext
+
+import (
+	"net"
+
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/connmanager"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter"
+	"github.com/pkg/errors"
+)
+
+// PenalizePeer adds the given misbehavior to the ban score of the peer behind
+// netConnection. Once the score reaches --banthreshold the peer is banned, if
+// banning is enabled, and PenalizePeer returns true. The caller is responsible
+// for disconnecting a banned peer.
+func (f *FlowContext) PenalizePeer(netConnection *netadapter.NetConnection,
+	misbehavior protocolerrors.Misbehavior, reason string) (isBanned bool) {
+
+	ip, nodeID := reputationKeys(netConnection)
+	score, reachedThreshold := f.reputation.Penalize(ip, nodeID, misbehavior)
+	log.Debugf("Ban score of %s increased to %d (%s: %s)", netConnection, score, misbehavior, reason)
+	if !reachedThreshold || !f.Config().EnableBanning {
+		return false
+	}
+
+	log.Warnf("Banning %s (ban score %d, reason: %s)", netConnection, score, reason)
+	var err error
+	if nodeID != nil {
+		err = f.connectionManager.BanByUnifiedNodeID(*nodeID, reason, connmanager.ProtocolViolationBanDuration)
+	} else {
+		err = f.connectionManager.Ban(netConnection)
+	}
+	if errors.Is(err, connmanager.ErrCannotBanPermanent) {
+		log.Debugf("Not banning %s: %s", netConnection, err)
+		return false
+	}
+	if err != nil {
+		panic(err)
+	}
+	f.reputation.Forget(ip, nodeID)
+	return true
+}
+
+// BanScore returns the current ban score of the peer behind netConnection
+func (f *FlowContext) BanScore(netConnection *netadapter.NetConnection) uint32 {
+	return f.reputation.Score(reputationKeys(netConnection))
+}
+
+// reputationKeys returns the IP and unified node ID the peer behind
+// netConnection is scored by. Onion inbound peers all share the IP of the
+// local Tor daemon, so they are scored by their node ID alone.
+func reputationKeys(netConnection *netadapter.NetConnection) (ip net.IP, nodeID *[32]byte) {
+	if !netConnection.IsOnionInbound() {
+		ip = netConnection.NetAddress().IP
+	}
+	if unifiedNodeID, hasUnifiedNodeID := netConnection.UnifiedNodeID(); hasUnifiedNodeID {
+		nodeID = &unifiedNodeID
+	}
+	return ip, nodeID
+}
@@ -87,9 +87,10 @@ func (f *FlowContext) HandleBlockProducerClaim(peer *peerpkg.Peer, message *appm
 		return nil
 	case strongnodeclaims.IngestAccepted:
 		f.strongNodeClaims.MaybeFlush()
+		f.notifyBlockProducerClaimWinners()
 		return nil
 	case strongnodeclaims.IngestStrike:
-		return protocolerrors.Errorf(true, "invalid block producer claim: %s", outcome.Reason)
+		return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorBadClaim, "invalid block producer claim: %s", outcome.Reason)
 	default:
 		return nil
 	}
@@ -136,6 +137,7 @@ func (f *FlowContext) BroadcastLocalBlockProducerClaim(blockHash *externalapi.Do
 		return
 	}
 	f.strongNodeClaims.MaybeFlush()
+	f.notifyBlockProducerClaimWinners()
 	if err := f.broadcastBlockProducerClaim(claim, nil); err != nil {
 		log.Debugf("failed relaying local block producer claim for %s: %s", blockHash, err)
 	}
@@ -168,5 +170,21 @@ func (f *FlowContext) refreshStrongNodeClaimsWindow() error {
 	}
 
 	f.strongNodeClaims.MaybeFlush()
+	f.notifyBlockProducerClaimWinners()
 	return nil
 }
+
+// StrongNodeClaims returns the strong-node claims engine, or nil if it's not available
+func (f *FlowContext) StrongNodeClaims() *strongnodeclaims.Engine {
+	return f.strongNodeClaims
+}
+
+func (f *FlowContext) notifyBlockProducerClaimWinners() {
+	winners := f.strongNodeClaims.TakeWinnerChanges()
+	if len(winners) == 0 || f.onBlockProducerClaimWinnerHandler == nil {
+		return
+	}
+	if err := f.onBlockProducerClaimWinnerHandler(winners); err != nil {
+		log.Warnf("failed notifying block producer claim winners: %s", err)
+	}
+}
@@ -0,0 +1,65 @@
+package flowcontext
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter"
+)
+
+// AddTransactionPackage validates the given topologically sorted transactions together,
+// adds them to the mempool and propagates them.
+func (f *FlowContext) AddTransactionPackage(transactions []*externalapi.DomainTransaction) error {
+	acceptedTransactions, err := f.Domain().MiningManager().ValidateAndInsertTransactionPackage(transactions, true)
+	if err != nil {
+		return err
+	}
+
+	return f.OnTransactionPackageAccepted(transactions, acceptedTransactions, nil)
+}
+
+// OnTransactionPackageAccepted relays a transaction package that was added to the mempool
+// to the peers that support package relay, other than the peer it came from, and announces
+// the accepted transactions to all peers. A package that added no new transaction isn't
+// relayed again, so that packages don't bounce between peers.
+func (f *FlowContext) OnTransactionPackageAccepted(transactions []*externalapi.DomainTransaction,
+	acceptedTransactions []*externalapi.DomainTransaction, sourcePeer *peerpkg.Peer) error {
+
+	if len(acceptedTransactions) == 0 {
+		return nil
+	}
+	log.Debugf("Accepted transaction package of %d transactions into mempool (%d total accepted including unorphaned)",
+		len(transactions), len(acceptedTransactions))
+	f.OnTransactionAddedToMempool()
+
+	err := f.broadcastTransactionPackage(transactions, sourcePeer)
+	if err != nil {
+		return err
+	}
+	return f.EnqueueTransactionIDsForPropagation(consensushashing.TransactionIDs(acceptedTransactions))
+}
+
+func (f *FlowContext) broadcastTransactionPackage(transactions []*externalapi.DomainTransaction,
+	sourcePeer *peerpkg.Peer) error {
+
+	targets := make([]*netadapter.NetConnection, 0, len(f.Peers()))
+	for _, peer := range f.Peers() {
+		if sourcePeer != nil && peer.ID().IsEqual(sourcePeer.ID()) {
+			continue
+		}
+		if !peer.SupportsPackageRelay() {
+			continue
+		}
+		targets = append(targets, peer.Connection())
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	msgTxs := make([]*appmessage.MsgTx, len(transactions))
+	for i, transaction := range transactions {
+		msgTxs[i] = appmessage.DomainTransactionToMsgTx(transaction)
+	}
+	return f.NetAdapter().P2PBroadcast(targets, appmessage.NewMsgTransactionPackage(msgTxs))
+}
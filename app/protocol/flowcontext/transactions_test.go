@@ -43,10 +43,20 @@ func (m *fakeMiningManager) AllTransactions(bool, bool) ([]*externalapi.DomainTr
 	panic("not implemented")
 }
 
+func (m *fakeMiningManager) TransactionsByShortID(map[uint64]struct{},
+	func(*externalapi.DomainHash) uint64) []*externalapi.DomainTransaction {
+
+	panic("not implemented")
+}
+
 func (m *fakeMiningManager) TransactionCount(bool, bool) int {
 	return 0
 }
 
+func (m *fakeMiningManager) GetFeeEstimate() miningmanager.FeeEstimate {
+	panic("not implemented")
+}
+
 func (m *fakeMiningManager) HandleNewBlockTransactions([]*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error) {
 	panic("not implemented")
 }
@@ -59,6 +69,14 @@ func (m *fakeMiningManager) ValidateAndInsertTransaction(*externalapi.DomainTran
 	panic("not implemented")
 }
 
+func (m *fakeMiningManager) TransactionStatus(*externalapi.DomainTransactionID) (miningmanagerapi.TransactionStatus, error) {
+	panic("not implemented")
+}
+
+func (m *fakeMiningManager) ValidateAndInsertTransactionPackage([]*externalapi.DomainTransaction, bool) ([]*externalapi.DomainTransaction, error) {
+	panic("not implemented")
+}
+
 func (m *fakeMiningManager) RevalidateHighPriorityTransactions() ([]*externalapi.DomainTransaction, error) {
 	panic("not implemented")
 }
@@ -71,6 +89,14 @@ func (m *fakeMiningManager) ExpireLowPriorityTransactions() (int, int, error) {
 	return 0, 0, nil
 }
 
+func (m *fakeMiningManager) SaveMempool(string) (int, error) {
+	panic("not implemented")
+}
+
+func (m *fakeMiningManager) LoadMempool(string) (int, error) {
+	panic("not implemented")
+}
+
 type testDomain struct {
 	mining miningmanager.MiningManager
 }
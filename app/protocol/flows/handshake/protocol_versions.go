@@ -1,6 +1,9 @@
 package handshake
 
-import "github.com/pkg/errors"
+import (
+	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/pkg/errors"
+)
 
 const (
 	legacyProtocolVersion   = uint32(5)
@@ -21,5 +24,5 @@ func minAcceptableProtocolVersionFor(context HandleHandshakeContext) (uint32, er
 }
 
 func maxAcceptableProtocolVersion() uint32 {
-	return hardforkProtocolVersion
+	return peerpkg.PackageRelayProtocolVersion
 }
@@ -94,6 +94,12 @@ func (flow *receiveVersionFlow) start() (*appmessage.NetAddress, error) {
 	if err != nil {
 		return nil, protocolerrors.Wrapf(false, err, "invalid unified node identity")
 	}
+	// A peer on the encrypted transport already proved it owns an identity,
+	// and must not claim another one here
+	if transportPubKeyXOnly, ok := flow.peer.Connection().TransportPubKeyXOnly(); ok &&
+		(peerUnifiedNodePubKeyXOnly == nil || *peerUnifiedNodePubKeyXOnly != transportPubKeyXOnly) {
+		return nil, protocolerrors.New(false, "unified node identity doesn't match the encrypted transport identity")
+	}
 	if !allowSelfConnections && hardforkActive && isSelfUnifiedNodeID(peerUnifiedNodeID, flow.NetAdapter().UnifiedNodeID()) {
 		return nil, protocolerrors.New(false, "connected to self unified node identity")
 	}
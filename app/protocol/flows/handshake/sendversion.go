@@ -94,6 +94,11 @@ func (flow *sendVersionFlow) start() error {
 	// from already post-HF peers.
 	msg.Services |= appmessage.SFNodeCryptixAtomic
 	msg.Services |= appmessage.SFNodeStrongNodeClaims
+	// The fast intent ID scheme is not shared with other implementations yet,
+	// so fastchain support is only advertised when enabled by the operator.
+	if flow.Config().Fastchain {
+		msg.Services |= appmessage.SFNodeHFAFastchain
+	}
 	if flow.Config().IsArchivalNode {
 		msg.Services |= appmessage.SFNodeArchival
 	}
@@ -27,6 +27,7 @@ func ReceiveAddresses(context ReceiveAddressesContext, incomingRoute *router.Rou
 
 	subnetworkID := peer.SubnetworkID()
 	msgGetAddresses := appmessage.NewMsgRequestAddresses(false, subnetworkID)
+	msgGetAddresses.IncludeOnionAddresses = true
 	err := outgoingRoute.Enqueue(msgGetAddresses)
 	if err != nil {
 		return err
@@ -46,7 +47,7 @@ func ReceiveAddresses(context ReceiveAddressesContext, incomingRoute *router.Rou
 		if addr == nil {
 			continue
 		}
-		key := addr.TCPAddress().String()
+		key := addr.String()
 		if _, exists := unique[key]; exists {
 			continue
 		}
@@ -59,5 +60,5 @@ func ReceiveAddresses(context ReceiveAddressesContext, incomingRoute *router.Rou
 	for _, addr := range unique {
 		deduped = append(deduped, addr)
 	}
-	return context.AddressManager().AddAddresses(deduped...)
+	return context.AddressManager().AddAddressesFromSource(peer.Connection().NetAddress(), deduped...)
 }
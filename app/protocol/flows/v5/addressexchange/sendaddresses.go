@@ -18,10 +18,11 @@ type SendAddressesContext interface {
 // SendAddresses sends addresses to a peer that requests it.
 func SendAddresses(context SendAddressesContext, incomingRoute *router.Route, outgoingRoute *router.Route) error {
 	for {
-		_, err := incomingRoute.Dequeue()
+		message, err := incomingRoute.Dequeue()
 		if err != nil {
 			return err
 		}
+		msgRequestAddresses := message.(*appmessage.MsgRequestAddresses)
 
 		var addresses []*appmessage.NetAddress
 		requireVerified := context.IsPayloadHfActive() && context.IsAntiFraudRuntimeEnabled()
@@ -30,6 +31,9 @@ func SendAddresses(context SendAddressesContext, incomingRoute *router.Route, ou
 		} else {
 			addresses = context.AddressManager().Addresses()
 		}
+		if !msgRequestAddresses.IncludeOnionAddresses {
+			addresses = withoutOnionAddresses(addresses)
+		}
 		msgAddresses := appmessage.NewMsgAddresses(shuffleAddresses(addresses))
 
 		err = outgoingRoute.Enqueue(msgAddresses)
@@ -39,6 +43,18 @@ func SendAddresses(context SendAddressesContext, incomingRoute *router.Route, ou
 	}
 }
 
+// withoutOnionAddresses filters out the onion addresses, for peers that
+// don't understand them
+func withoutOnionAddresses(addresses []*appmessage.NetAddress) []*appmessage.NetAddress {
+	filtered := make([]*appmessage.NetAddress, 0, len(addresses))
+	for _, address := range addresses {
+		if !address.IsOnionV3() {
+			filtered = append(filtered, address)
+		}
+	}
+	return filtered
+}
+
 // shuffleAddresses randomizes the given addresses sent if there are more than the maximum allowed in one message.
 func shuffleAddresses(addresses []*appmessage.NetAddress) []*appmessage.NetAddress {
 	addressCount := len(addresses)
@@ -10,9 +10,11 @@ import (
 
 	"github.com/cryptix-network/cryptixd/app/appmessage"
 	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
 	"github.com/cryptix-network/cryptixd/domain"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
 	"github.com/cryptix-network/cryptixd/infrastructure/config"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
 )
@@ -38,6 +40,7 @@ type AtomicStateAuditContext interface {
 	IsPayloadHfActive() bool
 	Peers() []*peerpkg.Peer
 	ShutdownChan() <-chan struct{}
+	PenalizePeer(netConnection *netadapter.NetConnection, misbehavior protocolerrors.Misbehavior, reason string) bool
 }
 
 type atomicStateAuditFlow struct {
@@ -229,13 +232,14 @@ func (flow *atomicStateAuditFlow) runAudit() error {
 	if len(response.StateHash) != externalapi.DomainHashSize {
 		log.Warnf("[atomic-bootstrap:p2p] healthy-state audit rejected peer %s at DAA-rendezvous block %s (daa=%d): invalid state hash size %d",
 			flow.peer, anchor.blockHash, anchor.daaScore, len(response.StateHash))
-		return nil
+		return flow.penalizeMalformedAuditResponse("invalid Atomic state hash size")
 	}
 
 	localHash := anchor.stateHash[:]
 	if !bytes.Equal(localHash, response.StateHash) {
 		log.Infof("[atomic-bootstrap:p2p] healthy-state audit inconclusive at DAA-rendezvous block %s (daa=%d) with peer %s: local consensus Atomic state hash %s differs from peer consensus Atomic state hash %s; local consensus remains authoritative and no repair is applied from a single peer response",
 			anchor.blockHash, anchor.daaScore, flow.peer, hex.EncodeToString(localHash), hex.EncodeToString(response.StateHash))
+		atomicAuditMismatches.Inc()
 		return nil
 	}
 
@@ -265,7 +269,7 @@ func (flow *atomicStateAuditFlow) runAudit() error {
 	if len(tokenResponse.StateHash) != externalapi.DomainHashSize {
 		log.Warnf("[atomic-bootstrap:p2p] token-state audit rejected peer %s at DAA-rendezvous block %s (daa=%d): invalid state hash size %d",
 			flow.peer, anchor.blockHash, anchor.daaScore, len(tokenResponse.StateHash))
-		return nil
+		return flow.penalizeMalformedAuditResponse("invalid Atomic token checkpoint hash size")
 	}
 
 	localTokenHash, hasLocalTokenState, err := flow.Domain().Consensus().GetAtomicTokenStateHash(anchor.blockHash)
@@ -288,6 +292,7 @@ func (flow *atomicStateAuditFlow) runAudit() error {
 		log.Infof("[atomic-bootstrap:p2p] token-state audit inconclusive at DAA-rendezvous block %s (daa=%d) with peer %s: local Atomic token checkpoint hash %s differs from peer Atomic token checkpoint hash %s; local state remains authoritative and no repair is applied from a single peer response",
 			anchor.blockHash, anchor.daaScore, flow.peer, hex.EncodeToString(localTokenHash[:]), hex.EncodeToString(tokenResponse.StateHash))
 		flow.logLocalAtomicTokenDebug(anchor)
+		atomicAuditMismatches.Inc()
 		return nil
 	}
 
@@ -296,6 +301,16 @@ func (flow *atomicStateAuditFlow) runAudit() error {
 	return nil
 }
 
+// penalizeMalformedAuditResponse adds a malformed audit response to the ban
+// score of the peer. A well-formed response that merely differs from the local
+// state is not penalized: it can't tell whether the peer or this node diverged.
+func (flow *atomicStateAuditFlow) penalizeMalformedAuditResponse(reason string) error {
+	if flow.PenalizePeer(flow.peer.Connection(), protocolerrors.MisbehaviorMalformedAtomicAudit, reason) {
+		return protocolerrors.Errorf(false, "banned after repeated malformed Atomic audit responses: %s", reason)
+	}
+	return nil
+}
+
 func (flow *atomicStateAuditFlow) logLocalAtomicTokenDebug(anchor *atomicAuditAnchor) {
 	reporter, ok := flow.Domain().Consensus().(atomicTokenDebugReporter)
 	if !ok {
@@ -409,7 +424,8 @@ func (flow *atomicStateAuditFlow) requestConsensusAtomicStateHash(anchor *atomic
 		}
 		response, ok := message.(*appmessage.MsgConsensusAtomicStateHash)
 		if !ok {
-			return nil, errors.Errorf("unexpected Atomic audit response message: %s", message.Command())
+			return nil, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage,
+				"unexpected Atomic audit response message: %s", message.Command())
 		}
 		if response.ResponseID() != requestID {
 			log.Debugf("[atomic-bootstrap:p2p] ignoring stale Atomic audit response from %s with response_id=%d, expected=%d",
@@ -442,7 +458,8 @@ func (flow *atomicStateAuditFlow) requestAtomicTokenStateHash(anchor *atomicAudi
 		}
 		response, ok := message.(*appmessage.MsgAtomicTokenStateHash)
 		if !ok {
-			return nil, errors.Errorf("unexpected Atomic token audit response message: %s", message.Command())
+			return nil, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage,
+				"unexpected Atomic token audit response message: %s", message.Command())
 		}
 		if response.ResponseID() != requestID {
 			log.Debugf("[atomic-bootstrap:p2p] ignoring stale Atomic token audit response from %s with response_id=%d, expected=%d",
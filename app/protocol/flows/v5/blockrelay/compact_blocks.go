@@ -0,0 +1,87 @@
+package blockrelay
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/protocol/common"
+	"github.com/cryptix-network/cryptixd/app/protocol/compactblocks"
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+)
+
+// reconstructCompactBlock rebuilds the requested block out of the given compact block and the mempool, and
+// requests the transactions it can't find from the peer.
+func (flow *handleRelayInvsFlow) reconstructCompactBlock(requestHash *externalapi.DomainHash,
+	compactBlock *appmessage.MsgCompactBlock) (*externalapi.DomainBlock, error) {
+
+	partialBlock, err := compactblocks.Reconstruct(compactBlock, flow.Domain().MiningManager().TransactionsByShortID)
+	if err != nil {
+		return nil, protocolerrors.Wrap(true, err, "invalid compact block")
+	}
+	blockHash := partialBlock.Hash()
+	if !blockHash.Equal(requestHash) {
+		return nil, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "got unrequested block %s", blockHash)
+	}
+
+	missingCount := len(partialBlock.MissingIndexes())
+	err = flow.requestMissingBlockTransactions(partialBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// A merkle root mismatch is most likely a short ID that matched the wrong mempool transaction. The block
+	// is then completed out of the peer's transactions alone, and if it's still wrong it's for consensus to
+	// reject.
+	if !partialBlock.HasValidMerkleRoot() && partialBlock.DropMempoolTransactions() {
+		log.Debugf("Compact block %s doesn't match its merkle root, requesting all its transactions", blockHash)
+		err = flow.requestMissingBlockTransactions(partialBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debugf("Reconstructed compact block %s with %d of %d transactions requested from %s",
+		blockHash, missingCount, len(compactBlock.ShortIDs)+len(compactBlock.PrefilledTransactions), flow.peer)
+	return partialBlock.Block(), nil
+}
+
+func (flow *handleRelayInvsFlow) requestMissingBlockTransactions(partialBlock *compactblocks.PartialBlock) error {
+	missingIndexes := partialBlock.MissingIndexes()
+	if len(missingIndexes) == 0 {
+		return nil
+	}
+
+	blockHash := partialBlock.Hash()
+	err := flow.outgoingRoute.Enqueue(appmessage.NewMsgRequestBlockTransactions(blockHash, missingIndexes))
+	if err != nil {
+		return err
+	}
+
+	for {
+		message, err := flow.incomingRoute.DequeueWithTimeout(common.DefaultTimeout)
+		if err != nil {
+			return err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.MsgInvRelayBlock:
+			flow.invsQueue = append(flow.invsQueue, invRelayBlock{Hash: message.Hash, IsOrphanRoot: false})
+		case *appmessage.MsgBlockTransactions:
+			if !message.BlockHash.Equal(blockHash) {
+				return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage,
+					"got transactions of unrequested block %s", message.BlockHash)
+			}
+			transactions := make([]*externalapi.DomainTransaction, len(message.Transactions))
+			for i, msgTx := range message.Transactions {
+				transactions[i] = appmessage.MsgTxToDomainTransaction(msgTx)
+			}
+			err := partialBlock.Fill(transactions)
+			if err != nil {
+				return protocolerrors.Wrapf(true, err, "invalid transactions for compact block %s", blockHash)
+			}
+			return nil
+		default:
+			return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage,
+				"unexpected %s message while waiting for the transactions of compact block %s", message.Command(), blockHash)
+		}
+	}
+}
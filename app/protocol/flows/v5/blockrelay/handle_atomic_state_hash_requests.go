@@ -51,7 +51,7 @@ func (flow *handleAtomicStateHashRequestsFlow) start() error {
 				return err
 			}
 		default:
-			return protocolerrors.Errorf(true, "unexpected Atomic state hash request message: %s", message.Command())
+			return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "unexpected Atomic state hash request message: %s", message.Command())
 		}
 	}
 }
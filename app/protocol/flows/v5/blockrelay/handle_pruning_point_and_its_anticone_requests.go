@@ -173,7 +173,7 @@ func HandlePruningPointAndItsAnticoneRequests(context PruningPointAndItsAnticone
 						return err
 					}
 					if _, ok := message.(*appmessage.MsgRequestNextPruningPointAndItsAnticoneBlocks); !ok {
-						return protocolerrors.Errorf(true, "received unexpected message type. "+
+						return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 							"expected: %s, got: %s", appmessage.CmdRequestNextPruningPointAndItsAnticoneBlocks, message.Command())
 					}
 				}
@@ -256,7 +256,7 @@ func sendTrustedAtomicStateChunks(incomingRoute *router.Route, outgoingRoute *ro
 				return err
 			}
 			if _, ok := message.(*appmessage.MsgRequestNextPruningPointAtomicStateChunk); !ok {
-				return protocolerrors.Errorf(true, "received unexpected message type. "+
+				return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 					"expected: %s, got: %s", appmessage.CmdRequestNextPruningPointAtomicStateChunk, message.Command())
 			}
 		}
@@ -2,11 +2,13 @@ package blockrelay
 
 import (
 	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/protocol/compactblocks"
 	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
 	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
 	"github.com/cryptix-network/cryptixd/domain"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/cryptix-network/cryptixd/util/random"
 	"github.com/pkg/errors"
 )
 
@@ -17,7 +19,9 @@ type RelayBlockRequestsContext interface {
 }
 
 // HandleRelayBlockRequests listens to appmessage.MsgRequestRelayBlocks messages and sends
-// their corresponding blocks to the requesting peer.
+// their corresponding blocks to the requesting peer. Peers that support compact blocks get
+// them as compact blocks, and may follow up with appmessage.MsgRequestBlockTransactions
+// messages for the transactions they are missing.
 func HandleRelayBlockRequests(context RelayBlockRequestsContext, incomingRoute *router.Route,
 	outgoingRoute *router.Route, peer *peerpkg.Peer) error {
 
@@ -26,43 +30,95 @@ func HandleRelayBlockRequests(context RelayBlockRequestsContext, incomingRoute *
 		if err != nil {
 			return err
 		}
-		getRelayBlocksMessage := message.(*appmessage.MsgRequestRelayBlocks)
-		log.Debugf("Got request for relay blocks with hashes %s", getRelayBlocksMessage.Hashes)
-		for _, hash := range getRelayBlocksMessage.Hashes {
-			blockInfo, err := context.Domain().Consensus().GetBlockInfo(hash)
-			if err != nil {
-				return errors.Wrapf(err, "unable to fetch requested block status for hash %s", hash)
-			}
-			if blockInfo.BlockStatus == externalapi.StatusDisqualifiedFromChain || blockInfo.BlockStatus == externalapi.StatusInvalid {
-				log.Warnf("Not serving relay block %s to peer %s because it is not UTXO/Atomic-valid: status=%s",
-					hash, peer, blockInfo.BlockStatus)
-				continue
-			}
+		switch message := message.(type) {
+		case *appmessage.MsgRequestRelayBlocks:
+			err = sendRelayBlocks(context, outgoingRoute, peer, message)
+		case *appmessage.MsgRequestBlockTransactions:
+			err = sendBlockTransactions(context, outgoingRoute, message)
+		default:
+			err = protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage,
+				"unexpected %s message in HandleRelayBlockRequests", message.Command())
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
 
-			// Fetch the block from the database.
-			block, found, err := context.Domain().Consensus().GetBlock(hash)
-			if err != nil {
-				return errors.Wrapf(err, "unable to fetch requested block hash %s", hash)
-			}
+func sendRelayBlocks(context RelayBlockRequestsContext, outgoingRoute *router.Route, peer *peerpkg.Peer,
+	getRelayBlocksMessage *appmessage.MsgRequestRelayBlocks) error {
 
-			if !found {
-				return protocolerrors.Errorf(false, "Relay block %s not found", hash)
-			}
+	log.Debugf("Got request for relay blocks with hashes %s", getRelayBlocksMessage.Hashes)
+	for _, hash := range getRelayBlocksMessage.Hashes {
+		blockInfo, err := context.Domain().Consensus().GetBlockInfo(hash)
+		if err != nil {
+			return errors.Wrapf(err, "unable to fetch requested block status for hash %s", hash)
+		}
+		if blockInfo.BlockStatus == externalapi.StatusDisqualifiedFromChain || blockInfo.BlockStatus == externalapi.StatusInvalid {
+			log.Warnf("Not serving relay block %s to peer %s because it is not UTXO/Atomic-valid: status=%s",
+				hash, peer, blockInfo.BlockStatus)
+			continue
+		}
+
+		// Fetch the block from the database.
+		block, found, err := context.Domain().Consensus().GetBlock(hash)
+		if err != nil {
+			return errors.Wrapf(err, "unable to fetch requested block hash %s", hash)
+		}
+
+		if !found {
+			return protocolerrors.Errorf(false, "Relay block %s not found", hash)
+		}
 
-			// TODO (Partial nodes): Convert block to partial block if needed
+		// TODO (Partial nodes): Convert block to partial block if needed
 
-			for _, claim := range context.BlockProducerClaimsForBlock(hash) {
-				if err := outgoingRoute.Enqueue(claim); err != nil {
-					return err
-				}
+		for _, claim := range context.BlockProducerClaimsForBlock(hash) {
+			if err := outgoingRoute.Enqueue(claim); err != nil {
+				return err
 			}
-			blockMessage := appmessage.DomainBlockToMsgBlock(block)
-			blockMessage.SetResponseID(getRelayBlocksMessage.RequestID())
-			err = outgoingRoute.Enqueue(blockMessage)
+		}
+		if peer.SupportsCompactBlocks() {
+			shortIDNonce, err := random.Uint64()
 			if err != nil {
 				return err
 			}
-			log.Debugf("Relayed block with hash %s", hash)
+			compactBlockMessage := compactblocks.New(block, shortIDNonce)
+			compactBlockMessage.SetResponseID(getRelayBlocksMessage.RequestID())
+			err = outgoingRoute.Enqueue(compactBlockMessage)
+		} else {
+			blockMessage := appmessage.DomainBlockToMsgBlock(block)
+			blockMessage.SetResponseID(getRelayBlocksMessage.RequestID())
+			err = outgoingRoute.Enqueue(blockMessage)
+		}
+		if err != nil {
+			return err
+		}
+		log.Debugf("Relayed block with hash %s", hash)
+	}
+	return nil
+}
+
+func sendBlockTransactions(context RelayBlockRequestsContext, outgoingRoute *router.Route,
+	request *appmessage.MsgRequestBlockTransactions) error {
+
+	block, found, err := context.Domain().Consensus().GetBlock(request.BlockHash)
+	if err != nil {
+		return errors.Wrapf(err, "unable to fetch block %s for its transactions", request.BlockHash)
+	}
+	if !found {
+		return protocolerrors.Errorf(false, "block %s of the requested transactions not found", request.BlockHash)
+	}
+
+	transactions := make([]*appmessage.MsgTx, len(request.Indexes))
+	for i, index := range request.Indexes {
+		if index >= uint32(len(block.Transactions)) {
+			return protocolerrors.Errorf(true, "requested transaction %d of block %s that has only %d transactions",
+				index, request.BlockHash, len(block.Transactions))
 		}
+		transactions[i] = appmessage.DomainTransactionToMsgTx(block.Transactions[index])
 	}
+
+	response := appmessage.NewMsgBlockTransactions(request.BlockHash, transactions)
+	response.SetResponseID(request.RequestID())
+	return outgoingRoute.Enqueue(response)
 }
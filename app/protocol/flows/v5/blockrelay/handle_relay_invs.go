@@ -319,7 +319,7 @@ func (flow *handleRelayInvsFlow) readInv() (invRelayBlock, error) {
 
 	msgInv, ok := msg.(*appmessage.MsgInvRelayBlock)
 	if !ok {
-		return invRelayBlock{}, protocolerrors.Errorf(true, "unexpected %s message in the block relay handleRelayInvsFlow while "+
+		return invRelayBlock{}, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "unexpected %s message in the block relay handleRelayInvsFlow while "+
 			"expecting an inv message", msg.Command())
 	}
 	return invRelayBlock{Hash: msgInv.Hash, IsOrphanRoot: false}, nil
@@ -341,24 +341,20 @@ func (flow *handleRelayInvsFlow) requestBlock(requestHash *externalapi.DomainHas
 		return nil, false, err
 	}
 
-	msgBlock, err := flow.readMsgBlock()
+	block, err := flow.readBlock(requestHash)
 	if err != nil {
 		return nil, false, err
 	}
 
-	block := appmessage.MsgBlockToDomainBlock(msgBlock)
-	blockHash := consensushashing.BlockHash(block)
-	if !blockHash.Equal(requestHash) {
-		return nil, false, protocolerrors.Errorf(true, "got unrequested block %s", blockHash)
-	}
-
 	return block, false, nil
 }
 
-// readMsgBlock returns the next msgBlock in msgChan, and populates invsQueue with any inv messages that meanwhile arrive.
+// readBlock returns the next block in msgChan, and populates invsQueue with any inv messages that meanwhile arrive.
+// Compact blocks are reconstructed before they are returned.
 //
-// Note: this function assumes msgChan can contain only appmessage.MsgInvRelayBlock and appmessage.MsgBlock messages.
-func (flow *handleRelayInvsFlow) readMsgBlock() (msgBlock *appmessage.MsgBlock, err error) {
+// Note: this function assumes msgChan can contain only appmessage.MsgInvRelayBlock, appmessage.MsgBlock and
+// appmessage.MsgCompactBlock messages.
+func (flow *handleRelayInvsFlow) readBlock(requestHash *externalapi.DomainHash) (*externalapi.DomainBlock, error) {
 	for {
 		message, err := flow.incomingRoute.DequeueWithTimeout(common.DefaultTimeout)
 		if err != nil {
@@ -369,7 +365,14 @@ func (flow *handleRelayInvsFlow) readMsgBlock() (msgBlock *appmessage.MsgBlock,
 		case *appmessage.MsgInvRelayBlock:
 			flow.invsQueue = append(flow.invsQueue, invRelayBlock{Hash: message.Hash, IsOrphanRoot: false})
 		case *appmessage.MsgBlock:
-			return message, nil
+			block := appmessage.MsgBlockToDomainBlock(message)
+			blockHash := consensushashing.BlockHash(block)
+			if !blockHash.Equal(requestHash) {
+				return nil, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "got unrequested block %s", blockHash)
+			}
+			return block, nil
+		case *appmessage.MsgCompactBlock:
+			return flow.reconstructCompactBlock(requestHash, message)
 		default:
 			return nil, errors.Errorf("unexpected message %s", message.Command())
 		}
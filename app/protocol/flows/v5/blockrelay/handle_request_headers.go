@@ -107,7 +107,7 @@ func (flow *handleRequestHeadersFlow) start() error {
 				return err
 			}
 			if _, ok := message.(*appmessage.MsgRequestNextHeaders); !ok {
-				return protocolerrors.Errorf(true, "received unexpected message type. "+
+				return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 					"expected: %s, got: %s", appmessage.CmdRequestNextHeaders, message.Command())
 			}
 
@@ -399,7 +399,7 @@ func (flow *handleIBDFlow) getSyncerChainBlockLocator(
 		}
 		return message.BlockLocatorHashes, nil
 	default:
-		return nil, protocolerrors.Errorf(true, "received unexpected message type. "+
+		return nil, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 			"expected: %s, got: %s", appmessage.CmdIBDChainBlockLocator, message.Command())
 	}
 }
@@ -557,7 +557,7 @@ func (flow *handleIBDFlow) receiveHeaders() (msgIBDBlock *appmessage.BlockHeader
 		return nil, true, nil
 	default:
 		return nil, false,
-			protocolerrors.Errorf(true, "received unexpected message type. "+
+			protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 				"expected: %s or %s, got: %s",
 				appmessage.CmdBlockHeaders,
 				appmessage.CmdDoneHeaders,
@@ -708,7 +708,7 @@ func (flow *handleIBDFlow) receiveAndInsertPruningPointUTXOSet(
 			return false, nil
 
 		default:
-			return false, protocolerrors.Errorf(true, "received unexpected message type. "+
+			return false, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 				"expected: %s or %s or %s, got: %s", appmessage.CmdPruningPointUTXOSetChunk,
 				appmessage.CmdDonePruningPointUTXOSetChunks, appmessage.CmdUnexpectedPruningPoint, message.Command(),
 			)
@@ -784,7 +784,7 @@ func (flow *handleIBDFlow) syncMissingBlockBodies(highHash *externalapi.DomainHa
 
 			msgIBDBlock, ok := message.(*appmessage.MsgIBDBlock)
 			if !ok {
-				return protocolerrors.Errorf(true, "received unexpected message type. "+
+				return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 					"expected: %s, got: %s", appmessage.CmdIBDBlock, message.Command())
 			}
 
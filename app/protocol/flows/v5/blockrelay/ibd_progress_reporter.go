@@ -37,7 +37,9 @@ func (ipr *ibdProgressReporter) reportProgress(processedDelta int, highestProces
 		// Avoid a negative diff
 		relativeDAAScore = highestProcessedDAAScore - ipr.lowDAAScore
 	}
-	progressPercent := int((float64(relativeDAAScore) / float64(ipr.totalDAAScoreDifference)) * 100)
+	progressRatio := float64(relativeDAAScore) / float64(ipr.totalDAAScoreDifference)
+	ibdProgressMetric.WithLabelValues(ipr.objectName).Set(progressRatio)
+	progressPercent := int(progressRatio * 100)
 	if progressPercent > ipr.lastReportedProgressPercent {
 		log.Infof("IBD: Processed %d %s (%d%%)", ipr.processed, ipr.objectName, progressPercent)
 		ipr.lastReportedProgressPercent = progressPercent
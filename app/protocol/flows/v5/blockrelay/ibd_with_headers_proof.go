@@ -146,7 +146,7 @@ func (flow *handleIBDFlow) syncAndValidatePruningPointProof() (*externalapi.Doma
 	}
 	pruningPointProofMessage, ok := message.(*appmessage.MsgPruningPointProof)
 	if !ok {
-		return nil, 0, protocolerrors.Errorf(true, "received unexpected message type. "+
+		return nil, 0, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 			"expected: %s, got: %s", appmessage.CmdPruningPointProof, message.Command())
 	}
 	pruningPointProof := appmessage.MsgPruningPointProofToDomainPruningPointProof(pruningPointProofMessage)
@@ -249,7 +249,7 @@ func (flow *handleIBDFlow) syncPruningPointsAndPruningPointAnticone(proofPruning
 
 	msgTrustedData, ok := message.(*appmessage.MsgTrustedData)
 	if !ok {
-		return protocolerrors.Errorf(true, "received unexpected message type. "+
+		return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 			"expected: %s, got: %s", appmessage.CmdTrustedData, message.Command())
 	}
 
@@ -418,7 +418,7 @@ func (flow *handleIBDFlow) receiveTrustedAtomicStateChunks(
 
 		chunk, ok := message.(*appmessage.MsgTrustedAtomicStateChunk)
 		if !ok {
-			return nil, protocolerrors.Errorf(true, "received unexpected message type. "+
+			return nil, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 				"expected: %s, got: %s", appmessage.CmdTrustedAtomicStateChunk, message.Command())
 		}
 
@@ -463,7 +463,7 @@ func (flow *handleIBDFlow) drainTrustedAtomicStateChunks(
 
 		chunk, ok := message.(*appmessage.MsgTrustedAtomicStateChunk)
 		if !ok {
-			return protocolerrors.Errorf(true, "received unexpected message type. "+
+			return protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 				"expected: %s, got: %s", appmessage.CmdTrustedAtomicStateChunk, message.Command())
 		}
 
@@ -600,7 +600,7 @@ func (flow *handleIBDFlow) receiveBlockWithTrustedData() (*appmessage.MsgBlockWi
 		return nil, true, nil
 	default:
 		return nil, false,
-			protocolerrors.Errorf(true, "received unexpected message type. "+
+			protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 				"expected: %s or %s, got: %s",
 				(&appmessage.MsgBlockWithTrustedData{}).Command(),
 				(&appmessage.MsgDoneBlocksWithTrustedData{}).Command(),
@@ -617,7 +617,7 @@ func (flow *handleIBDFlow) receivePruningPoints() (*appmessage.MsgPruningPoints,
 	msgPruningPoints, ok := message.(*appmessage.MsgPruningPoints)
 	if !ok {
 		return nil,
-			protocolerrors.Errorf(true, "received unexpected message type. "+
+			protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "received unexpected message type. "+
 				"expected: %s, got: %s", appmessage.CmdPruningPoints, message.Command())
 	}
 
@@ -0,0 +1,10 @@
+package blockrelay
+
+import "github.com/cryptix-network/cryptixd/infrastructure/metrics"
+
+var (
+	ibdProgressMetric = metrics.NewGaugeVec("cryptixd_ibd_progress_ratio",
+		"Progress of the current IBD stage, between 0 and 1, by the kind of object being synced", "stage")
+	atomicAuditMismatches = metrics.NewCounter("cryptixd_atomic_audit_mismatches_total",
+		"Number of Atomic state audits whose result didn't match the one of the audited peer")
+)
@@ -0,0 +1,87 @@
+package hfa
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/protocol/fastintents"
+	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleFastIntentsContext is the interface for the context needed for the HandleFastIntents flow.
+type HandleFastIntentsContext interface {
+	HandleFastIntent(peer *peerpkg.Peer, message *appmessage.MsgFastIntent) error
+	HandleFastMicroblock(peer *peerpkg.Peer, message *appmessage.MsgFastMicroblock) ([]*externalapi.DomainHash, error)
+	FastIntentMessages(intentIDs []*externalapi.DomainHash) []*appmessage.MsgFastIntent
+}
+
+type handleFastIntentsFlow struct {
+	HandleFastIntentsContext
+	incomingRoute, outgoingRoute *router.Route
+	peer                         *peerpkg.Peer
+}
+
+// HandleFastIntents handles fast intent gossip, fast intent requests and
+// fast microblock announcements. Intents announced in a microblock that are
+// not known yet are requested from the announcing peer.
+func HandleFastIntents(context HandleFastIntentsContext, incomingRoute *router.Route,
+	outgoingRoute *router.Route, peer *peerpkg.Peer) error {
+
+	flow := &handleFastIntentsFlow{
+		HandleFastIntentsContext: context,
+		incomingRoute:            incomingRoute,
+		outgoingRoute:            outgoingRoute,
+		peer:                     peer,
+	}
+	return flow.start()
+}
+
+func (flow *handleFastIntentsFlow) start() error {
+	for {
+		message, err := flow.incomingRoute.Dequeue()
+		if err != nil {
+			return err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.MsgFastIntent:
+			err = flow.HandleFastIntent(flow.peer, message)
+		case *appmessage.MsgRequestFastIntents:
+			err = flow.handleRequestFastIntents(message)
+		case *appmessage.MsgFastMicroblock:
+			err = flow.handleFastMicroblock(message)
+		default:
+			err = protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "unexpected message %s in HandleFastIntents", message.Command())
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (flow *handleFastIntentsFlow) handleRequestFastIntents(message *appmessage.MsgRequestFastIntents) error {
+	if len(message.IntentIDs) > fastintents.MaxIntentIDsPerMessage {
+		return protocolerrors.Errorf(true, "fast intent request from %s has %d intent IDs, the maximum is %d",
+			flow.peer, len(message.IntentIDs), fastintents.MaxIntentIDsPerMessage)
+	}
+
+	for _, intentMessage := range flow.FastIntentMessages(message.IntentIDs) {
+		err := flow.outgoingRoute.Enqueue(intentMessage)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (flow *handleFastIntentsFlow) handleFastMicroblock(message *appmessage.MsgFastMicroblock) error {
+	unknownIDs, err := flow.HandleFastMicroblock(flow.peer, message)
+	if err != nil {
+		return err
+	}
+	if len(unknownIDs) == 0 {
+		return nil
+	}
+	return flow.outgoingRoute.Enqueue(appmessage.NewMsgRequestFastIntents(unknownIDs))
+}
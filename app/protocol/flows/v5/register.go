@@ -33,7 +33,7 @@ func Register(m protocolManager, router *routerpkg.Router, errChan chan error, i
 	flows = append(flows, registerBlockRelayFlows(m, router, isStopping, errChan)...)
 	flows = append(flows, registerPingFlows(m, router, isStopping, errChan)...)
 	flows = append(flows, registerTransactionRelayFlow(m, router, isStopping, errChan)...)
-	flows = append(flows, registerHFACompatibilityFlows(m, router, isStopping, errChan)...)
+	flows = append(flows, registerHFAFlows(m, router, isStopping, errChan)...)
 	flows = append(flows, registerRejectsFlow(m, router, isStopping, errChan)...)
 	flows = append(flows, registerAntiFraudFlows(m, router, isStopping, errChan)...)
 	flows = append(flows, registerStrongNodeFlows(m, router, isStopping, errChan)...)
@@ -70,6 +70,7 @@ func registerBlockRelayFlows(m protocolManager, router *routerpkg.Router, isStop
 
 		m.RegisterFlowWithCapacity("HandleRelayInvs", 4096, router, []appmessage.MessageCommand{
 			appmessage.CmdInvRelayBlock, appmessage.CmdBlock, appmessage.CmdBlockLocator,
+			appmessage.CmdCompactBlock, appmessage.CmdBlockTransactions,
 		},
 			isStopping, errChan, func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
 				return blockrelay.HandleRelayInvs(m.Context(), incomingRoute,
@@ -93,7 +94,9 @@ func registerBlockRelayFlows(m protocolManager, router *routerpkg.Router, isStop
 			},
 		),
 
-		m.RegisterFlow("HandleRelayBlockRequests", router, []appmessage.MessageCommand{appmessage.CmdRequestRelayBlocks}, isStopping, errChan,
+		m.RegisterFlow("HandleRelayBlockRequests", router, []appmessage.MessageCommand{
+			appmessage.CmdRequestRelayBlocks, appmessage.CmdRequestBlockTransactions,
+		}, isStopping, errChan,
 			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
 				return blockrelay.HandleRelayBlockRequests(m.Context(), incomingRoute, outgoingRoute, peer)
 			},
@@ -223,12 +226,20 @@ func registerTransactionRelayFlow(m protocolManager, router *routerpkg.Router, i
 				return transactionrelay.HandleRequestedTransactions(m.Context(), incomingRoute, outgoingRoute)
 			},
 		),
+		m.RegisterFlow("HandleTransactionPackages", router,
+			[]appmessage.MessageCommand{appmessage.CmdTransactionPackage}, isStopping, errChan,
+			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
+				return transactionrelay.HandleTransactionPackages(m.Context(), incomingRoute, peer)
+			},
+		),
 	}
 }
 
-func registerHFACompatibilityFlows(m protocolManager, router *routerpkg.Router, isStopping *uint32, errChan chan error) []*common.Flow {
+func registerHFAFlows(m protocolManager, router *routerpkg.Router, isStopping *uint32, errChan chan error) []*common.Flow {
+	outgoingRoute := router.OutgoingRoute()
+
 	return []*common.Flow{
-		m.RegisterFlowWithCapacity("IgnoreHFAPayloads", 4096, router,
+		m.RegisterFlowWithCapacity("HandleFastIntents", 4096, router,
 			[]appmessage.MessageCommand{
 				appmessage.CmdRequestFastIntents,
 				appmessage.CmdFastIntent,
@@ -236,7 +247,7 @@ func registerHFACompatibilityFlows(m protocolManager, router *routerpkg.Router,
 			},
 			isStopping, errChan,
 			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
-				return hfa.IgnoreMessages(incomingRoute)
+				return hfa.HandleFastIntents(m.Context(), incomingRoute, outgoingRoute, peer)
 			},
 		),
 	}
@@ -129,7 +129,7 @@ func (flow *handleRelayedTransactionsFlow) readInv() (*appmessage.MsgInvTransact
 
 	inv, ok := msg.(*appmessage.MsgInvTransaction)
 	if !ok {
-		return nil, protocolerrors.Errorf(true, "unexpected %s message in the block relay flow while "+
+		return nil, protocolerrors.Misbehaviorf(protocolerrors.MisbehaviorUnsolicitedMessage, "unexpected %s message in the block relay flow while "+
 			"expecting an inv message", msg.Command())
 	}
 	return inv, nil
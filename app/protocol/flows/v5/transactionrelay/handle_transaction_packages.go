@@ -0,0 +1,75 @@
+package transactionrelay
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// TransactionPackagesContext is the interface for the context needed for the
+// HandleTransactionPackages flow.
+type TransactionPackagesContext interface {
+	Domain() domain.Domain
+	IsNearlySynced() (bool, error)
+	OnTransactionPackageAccepted(transactions []*externalapi.DomainTransaction,
+		acceptedTransactions []*externalapi.DomainTransaction, sourcePeer *peerpkg.Peer) error
+}
+
+// HandleTransactionPackages listens to appmessage.MsgTransactionPackage messages, adds their
+// transactions to the mempool together and relays the packages to the rest of the network.
+//
+// Transactions that depend on each other are relayed as a package so that a child doesn't
+// have to wait in the orphan pool for its parents, and so that a parent below the minimum
+// relay fee gets in when its child pays for it.
+func HandleTransactionPackages(context TransactionPackagesContext, incomingRoute *router.Route,
+	peer *peerpkg.Peer) error {
+
+	for {
+		message, err := incomingRoute.Dequeue()
+		if err != nil {
+			return err
+		}
+		msgTransactionPackage := message.(*appmessage.MsgTransactionPackage)
+
+		isNearlySynced, err := context.IsNearlySynced()
+		if err != nil {
+			return err
+		}
+		// Transaction relay is disabled if the node is out of sync and thus not mining
+		if !isNearlySynced {
+			continue
+		}
+
+		transactions := make([]*externalapi.DomainTransaction, len(msgTransactionPackage.Transactions))
+		for i, msgTx := range msgTransactionPackage.Transactions {
+			transactions[i] = appmessage.MsgTxToDomainTransaction(msgTx)
+		}
+
+		acceptedTransactions, err :=
+			context.Domain().MiningManager().ValidateAndInsertTransactionPackage(transactions, false)
+		if err != nil {
+			ruleErr := &mempool.RuleError{}
+			if !errors.As(err, ruleErr) {
+				return errors.Wrapf(err, "failed to process transaction package")
+			}
+			if txRuleErr := (&mempool.TxRuleError{}); errors.As(ruleErr.Err, txRuleErr) &&
+				txRuleErr.RejectCode == mempool.RejectInvalid {
+
+				return protocolerrors.Errorf(true, "rejected transaction package: %s", ruleErr)
+			}
+			log.Debugf("Rejected relayed transaction package of %d transactions from mempool: %s",
+				len(transactions), ruleErr)
+			continue
+		}
+
+		err = context.OnTransactionPackageAccepted(transactions, acceptedTransactions, peer)
+		if err != nil {
+			return err
+		}
+	}
+}
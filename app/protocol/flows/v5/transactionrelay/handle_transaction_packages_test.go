@@ -0,0 +1,95 @@
+package transactionrelay_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/protocol/flows/v5/transactionrelay"
+	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/consensus"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/constants"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/testutils"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+type mocTransactionPackagesContext struct {
+	domain           domain.Domain
+	acceptedPackages int
+}
+
+func (m *mocTransactionPackagesContext) Domain() domain.Domain {
+	return m.domain
+}
+
+func (m *mocTransactionPackagesContext) IsNearlySynced() (bool, error) {
+	return true, nil
+}
+
+func (m *mocTransactionPackagesContext) OnTransactionPackageAccepted(_ []*externalapi.DomainTransaction,
+	acceptedTransactions []*externalapi.DomainTransaction, _ *peerpkg.Peer) error {
+
+	if len(acceptedTransactions) > 0 {
+		m.acceptedPackages++
+	}
+	return nil
+}
+
+// TestHandleTransactionPackages verifies that a package that can't be added to the mempool is
+// dropped, and that a malformed package is a protocol error.
+func TestHandleTransactionPackages(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestHandleTransactionPackages")
+		if err != nil {
+			t.Fatalf("Error setting up test consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		domainInstance, err := domain.New(consensusConfig, mempool.DefaultConfig(&consensusConfig.Params), tc.Database())
+		if err != nil {
+			t.Fatalf("Failed to set up a domain instance: %v", err)
+		}
+		context := &mocTransactionPackagesContext{domain: domainInstance}
+		incomingRoute := router.NewRoute("incoming")
+		defer incomingRoute.Close()
+
+		// A package the mempool rejects, here for spending outpoints that exist nowhere, is
+		// dropped without a ban
+		orphanTransaction := testPackageTransaction(1)
+		err = incomingRoute.Enqueue(appmessage.NewMsgTransactionPackage([]*appmessage.MsgTx{orphanTransaction}))
+		if err != nil {
+			t.Fatalf("Unexpected error from incomingRoute.Enqueue: %v", err)
+		}
+		// A package holding the same transaction twice is malformed
+		duplicateTransaction := testPackageTransaction(2)
+		err = incomingRoute.Enqueue(appmessage.NewMsgTransactionPackage(
+			[]*appmessage.MsgTx{duplicateTransaction, duplicateTransaction}))
+		if err != nil {
+			t.Fatalf("Unexpected error from incomingRoute.Enqueue: %v", err)
+		}
+
+		err = transactionrelay.HandleTransactionPackages(context, incomingRoute, nil)
+		protocolErr := protocolerrors.ProtocolError{}
+		if err == nil || !errors.As(err, &protocolErr) || !protocolErr.ShouldBan {
+			t.Fatalf("Expected a banning protocol error for the malformed package, got %v", err)
+		}
+		if context.acceptedPackages != 0 {
+			t.Fatalf("Expected no accepted packages, got %d", context.acceptedPackages)
+		}
+		if transactionCount := domainInstance.MiningManager().TransactionCount(true, true); transactionCount != 0 {
+			t.Fatalf("Expected an empty mempool, got %d transactions", transactionCount)
+		}
+	})
+}
+
+func testPackageTransaction(index uint32) *appmessage.MsgTx {
+	previousOutpoint := appmessage.NewOutpoint(&externalapi.DomainTransactionID{}, index)
+	txIn := appmessage.NewTxIn(previousOutpoint, []byte{}, constants.MaxTxInSequenceNum, 1)
+	txOut := appmessage.NewTxOut(1000, &externalapi.ScriptPublicKey{Script: []byte{0x51}, Version: 0})
+	return appmessage.NewNativeMsgTx(constants.MaxTransactionVersion, []*appmessage.TxIn{txIn}, []*appmessage.TxOut{txOut})
+}
@@ -69,6 +69,12 @@ func (m *Manager) AddTransaction(tx *externalapi.DomainTransaction, allowOrphan
 	return m.context.AddTransaction(tx, allowOrphan)
 }
 
+// AddTransactionPackage validates the given topologically sorted transactions
+// together, adds them to the mempool and propagates them.
+func (m *Manager) AddTransactionPackage(transactions []*externalapi.DomainTransaction) error {
+	return m.context.AddTransactionPackage(transactions)
+}
+
 // AddBlock adds the given block to the DAG and propagates it.
 func (m *Manager) AddBlock(block *externalapi.DomainBlock) error {
 	return m.context.AddBlock(block)
@@ -107,6 +113,11 @@ func (m *Manager) SetOnTransactionAddedToMempoolHandler(onTransactionAddedToMemp
 	m.context.SetOnTransactionAddedToMempoolHandler(onTransactionAddedToMempoolHandler)
 }
 
+// SetOnBlockProducerClaimWinnerHandler sets the onBlockProducerClaimWinner handler
+func (m *Manager) SetOnBlockProducerClaimWinnerHandler(onBlockProducerClaimWinnerHandler flowcontext.OnBlockProducerClaimWinnerHandler) {
+	m.context.SetOnBlockProducerClaimWinnerHandler(onBlockProducerClaimWinnerHandler)
+}
+
 // IsIBDRunning returns true if IBD is currently marked as running
 func (m *Manager) IsIBDRunning() bool {
 	return m.context.IsIBDRunning()
@@ -14,6 +14,14 @@ import (
 	"github.com/zeebo/blake3"
 )
 
+// CompactBlocksProtocolVersion is the first protocol version that relays
+// blocks as compact blocks
+const CompactBlocksProtocolVersion = uint32(19)
+
+// PackageRelayProtocolVersion is the first protocol version that relays
+// transaction packages
+const PackageRelayProtocolVersion = uint32(20)
+
 // Peer holds data about a peer.
 type Peer struct {
 	connection *netadapter.NetConnection
@@ -87,6 +95,18 @@ func (p *Peer) ProtocolVersion() uint32 {
 	return p.protocolVersion
 }
 
+// SupportsCompactBlocks returns whether blocks are relayed to and from the
+// peer as compact blocks.
+func (p *Peer) SupportsCompactBlocks() bool {
+	return p.protocolVersion >= CompactBlocksProtocolVersion
+}
+
+// SupportsPackageRelay returns whether transaction packages are relayed to
+// and from the peer.
+func (p *Peer) SupportsPackageRelay() bool {
+	return p.protocolVersion >= PackageRelayProtocolVersion
+}
+
 // TimeConnected returns the time since the connection to this been has been started.
 func (p *Peer) TimeConnected() time.Duration {
 	return time.Since(p.connectionStarted)
@@ -0,0 +1,170 @@
+package peer
+
+import (
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+)
+
+// banScoreHalfLife is the time it takes a ban score to decay to half its value
+const banScoreHalfLife = 30 * time.Minute
+
+// misbehaviorBanScores is how much every kind of misbehavior adds to a ban
+// score. Misbehavior that can't happen by accident adds the default
+// --banthreshold, so that it keeps getting peers banned right away.
+var misbehaviorBanScores = map[protocolerrors.Misbehavior]float64{
+	protocolerrors.MisbehaviorProtocolViolation:    100,
+	protocolerrors.MisbehaviorInvalidBlock:         100,
+	protocolerrors.MisbehaviorBadClaim:             50,
+	protocolerrors.MisbehaviorMalformedAtomicAudit: 25,
+	protocolerrors.MisbehaviorUnsolicitedMessage:   20,
+	protocolerrors.MisbehaviorSlowResponse:         10,
+}
+
+type banScore struct {
+	score     float64
+	updatedAt time.Time
+}
+
+func (s *banScore) decayedScore(now time.Time) float64 {
+	elapsed := now.Sub(s.updatedAt)
+	if elapsed <= 0 {
+		return s.score
+	}
+	return s.score * math.Exp2(-float64(elapsed)/float64(banScoreHalfLife))
+}
+
+// Reputation tracks the ban scores of peers. Every peer is scored both by its
+// IP and by its unified node ID, so that neither reconnecting with a new
+// identity nor from a new address clears its score. Scores decay over time.
+type Reputation struct {
+	lock      sync.Mutex
+	threshold uint32
+	byIP      map[string]*banScore
+	byNodeID  map[[32]byte]*banScore
+	lastPrune time.Time
+
+	// now is overridden in tests
+	now func() time.Time
+}
+
+// NewReputation returns a new Reputation that reports peers whose ban score
+// reaches the given threshold
+func NewReputation(threshold uint32) *Reputation {
+	return &Reputation{
+		threshold: threshold,
+		byIP:      make(map[string]*banScore),
+		byNodeID:  make(map[[32]byte]*banScore),
+		now:       time.Now,
+	}
+}
+
+// Penalize adds the given misbehavior to the ban scores of the given IP and
+// node ID. nodeID may be nil. It returns the resulting ban score, and whether
+// it reached the ban threshold.
+func (r *Reputation) Penalize(ip net.IP, nodeID *[32]byte, misbehavior protocolerrors.Misbehavior) (
+	score uint32, reachedThreshold bool) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	r.pruneIfNeeded(now)
+
+	penalty, ok := misbehaviorBanScores[misbehavior]
+	if !ok {
+		penalty = misbehaviorBanScores[protocolerrors.MisbehaviorProtocolViolation]
+	}
+	penalize := func(entry *banScore) float64 {
+		entry.score = entry.decayedScore(now) + penalty
+		entry.updatedAt = now
+		return entry.score
+	}
+
+	highest := 0.0
+	if ip != nil {
+		key := ip.String()
+		entry, ok := r.byIP[key]
+		if !ok {
+			entry = &banScore{}
+			r.byIP[key] = entry
+		}
+		highest = math.Max(highest, penalize(entry))
+	}
+	if nodeID != nil {
+		entry, ok := r.byNodeID[*nodeID]
+		if !ok {
+			entry = &banScore{}
+			r.byNodeID[*nodeID] = entry
+		}
+		highest = math.Max(highest, penalize(entry))
+	}
+
+	score = toScore(highest)
+	return score, score >= r.threshold
+}
+
+// Score returns the current ban score of a peer with the given IP and node
+// ID, which is the higher of the two. nodeID may be nil.
+func (r *Reputation) Score(ip net.IP, nodeID *[32]byte) uint32 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	highest := 0.0
+	if ip != nil {
+		if entry, ok := r.byIP[ip.String()]; ok {
+			highest = math.Max(highest, entry.decayedScore(now))
+		}
+	}
+	if nodeID != nil {
+		if entry, ok := r.byNodeID[*nodeID]; ok {
+			highest = math.Max(highest, entry.decayedScore(now))
+		}
+	}
+	return toScore(highest)
+}
+
+// Forget clears the ban scores of the given IP and node ID, once whatever
+// they were tracked for was dealt with. nodeID may be nil.
+func (r *Reputation) Forget(ip net.IP, nodeID *[32]byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if ip != nil {
+		delete(r.byIP, ip.String())
+	}
+	if nodeID != nil {
+		delete(r.byNodeID, *nodeID)
+	}
+}
+
+// pruneIfNeeded drops the scores that decayed to nothing, at most once per
+// half-life
+func (r *Reputation) pruneIfNeeded(now time.Time) {
+	if now.Sub(r.lastPrune) < banScoreHalfLife {
+		return
+	}
+	r.lastPrune = now
+
+	for key, entry := range r.byIP {
+		if entry.decayedScore(now) < 1 {
+			delete(r.byIP, key)
+		}
+	}
+	for key, entry := range r.byNodeID {
+		if entry.decayedScore(now) < 1 {
+			delete(r.byNodeID, key)
+		}
+	}
+}
+
+func toScore(score float64) uint32 {
+	if score >= math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(score)
+}
@@ -0,0 +1,81 @@
+package peer
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+)
+
+func newTestReputation(threshold uint32) (*Reputation, *time.Time) {
+	now := time.Unix(1_700_000_000, 0)
+	reputation := NewReputation(threshold)
+	reputation.now = func() time.Time { return now }
+	return reputation, &now
+}
+
+func TestReputationThreshold(t *testing.T) {
+	reputation, _ := newTestReputation(100)
+	ip := net.ParseIP("203.0.113.7")
+
+	for i := 1; i <= 4; i++ {
+		score, reachedThreshold := reputation.Penalize(ip, nil, protocolerrors.MisbehaviorUnsolicitedMessage)
+		if score != uint32(20*i) || reachedThreshold {
+			t.Fatalf("Penalize %d: got score %d (reached threshold: %t)", i, score, reachedThreshold)
+		}
+	}
+	score, reachedThreshold := reputation.Penalize(ip, nil, protocolerrors.MisbehaviorUnsolicitedMessage)
+	if score != 100 || !reachedThreshold {
+		t.Fatalf("Fifth unsolicited message: got score %d (reached threshold: %t)", score, reachedThreshold)
+	}
+
+	// A protocol violation reaches the default threshold on its own
+	_, reachedThreshold = reputation.Penalize(net.ParseIP("203.0.113.8"), nil, protocolerrors.MisbehaviorProtocolViolation)
+	if !reachedThreshold {
+		t.Fatalf("A protocol violation didn't reach the threshold")
+	}
+}
+
+func TestReputationDecay(t *testing.T) {
+	reputation, now := newTestReputation(100)
+	ip := net.ParseIP("203.0.113.7")
+
+	reputation.Penalize(ip, nil, protocolerrors.MisbehaviorBadClaim)
+	*now = now.Add(banScoreHalfLife)
+	if score := reputation.Score(ip, nil); score != 25 {
+		t.Fatalf("Expected the score to halve after a half-life, got %d", score)
+	}
+
+	*now = now.Add(10 * banScoreHalfLife)
+	reputation.Penalize(net.ParseIP("203.0.113.8"), nil, protocolerrors.MisbehaviorSlowResponse)
+	if _, ok := reputation.byIP[ip.String()]; ok {
+		t.Fatalf("A decayed score wasn't pruned")
+	}
+}
+
+func TestReputationTracksIPAndNodeID(t *testing.T) {
+	reputation, _ := newTestReputation(100)
+	ip := net.ParseIP("203.0.113.7")
+	nodeID := &[32]byte{1}
+
+	reputation.Penalize(ip, nodeID, protocolerrors.MisbehaviorBadClaim)
+
+	// Reconnecting from another IP keeps the node ID score, and reconnecting
+	// with another identity keeps the IP score
+	if score := reputation.Score(net.ParseIP("198.51.100.1"), nodeID); score != 50 {
+		t.Fatalf("Unexpected node ID score %d", score)
+	}
+	if score := reputation.Score(ip, &[32]byte{2}); score != 50 {
+		t.Fatalf("Unexpected IP score %d", score)
+	}
+	_, reachedThreshold := reputation.Penalize(net.ParseIP("198.51.100.1"), nodeID, protocolerrors.MisbehaviorBadClaim)
+	if !reachedThreshold {
+		t.Fatalf("The node ID score didn't carry over to a new IP")
+	}
+
+	reputation.Forget(ip, nodeID)
+	if score := reputation.Score(ip, nodeID); score != 0 {
+		t.Fatalf("Unexpected score %d after Forget", score)
+	}
+}
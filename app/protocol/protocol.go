@@ -12,8 +12,8 @@ import (
 	"github.com/cryptix-network/cryptixd/app/protocol/flows/handshake"
 	peerpkg "github.com/cryptix-network/cryptixd/app/protocol/peer"
 	"github.com/cryptix-network/cryptixd/app/protocol/protocolerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/addressmanager"
-	"github.com/cryptix-network/cryptixd/infrastructure/network/connmanager"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter"
 	routerpkg "github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
@@ -148,8 +148,9 @@ func (m *Manager) routerInitializer(router *routerpkg.Router, netConnection *net
 			log.Debugf("Peer %s supports post-HF quantum-safe handshake fallback negotiation", peer)
 		}
 		switch peer.ProtocolVersion() {
-		case 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18:
-			// Protocol versions 5 through 18 currently use the v5 flow set in this node implementation.
+		case 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20:
+			// Protocol versions 5 through 20 currently use the v5 flow set in this node implementation.
+			// Version 19 only adds compact block relay on top of it, and version 20 transaction package relay.
 			flows = v5.Register(m, router, errChan, &isStopping)
 		default:
 			panic(errors.Errorf("no way to handle protocol version %d", peer.ProtocolVersion()))
@@ -397,22 +398,17 @@ func (m *Manager) routerInitializer(router *routerpkg.Router, netConnection *net
 
 func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection, outgoingRoute *routerpkg.Route) {
 	if protocolErr := (protocolerrors.ProtocolError{}); errors.As(err, &protocolErr) {
-		if m.context.Config().EnableBanning && protocolErr.ShouldBan {
-			log.Warnf("Banning %s (reason: %s)", netConnection, protocolErr.Cause)
-
-			var err error
-			if unifiedNodeID, hasUnifiedNodeID := netConnection.UnifiedNodeID(); hasUnifiedNodeID {
-				err = m.context.ConnectionManager().BanByUnifiedNodeID(unifiedNodeID)
-			} else {
-				err = m.context.ConnectionManager().Ban(netConnection)
-			}
-			if err != nil && !errors.Is(err, connmanager.ErrCannotBanPermanent) {
-				panic(err)
+		if protocolErr.ShouldBan {
+			misbehavior := protocolErr.Misbehavior
+			if misbehavior == protocolerrors.MisbehaviorProtocolViolation && errors.As(err, &ruleerrors.RuleError{}) {
+				misbehavior = protocolerrors.MisbehaviorInvalidBlock
 			}
-
-			err = outgoingRoute.Enqueue(appmessage.NewMsgReject(protocolErr.Error()))
-			if err != nil && !errors.Is(err, routerpkg.ErrRouteClosed) {
-				panic(err)
+			isBanned := m.context.PenalizePeer(netConnection, misbehavior, protocolErr.Cause.Error())
+			if isBanned {
+				err := outgoingRoute.Enqueue(appmessage.NewMsgReject(protocolErr.Error()))
+				if err != nil && !errors.Is(err, routerpkg.ErrRouteClosed) {
+					panic(err)
+				}
 			}
 		}
 		log.Infof("Disconnecting from %s (reason: %s)", netConnection, protocolErr.Cause)
@@ -421,6 +417,7 @@ func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection
 	}
 	if errors.Is(err, routerpkg.ErrTimeout) {
 		log.Warnf("Got timeout from %s. Disconnecting...", netConnection)
+		m.context.PenalizePeer(netConnection, protocolerrors.MisbehaviorSlowResponse, err.Error())
 		netConnection.Disconnect()
 		return
 	}
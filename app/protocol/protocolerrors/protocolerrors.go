@@ -1,15 +1,62 @@
 package protocolerrors
 
 import (
+	"fmt"
+
 	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
 	"github.com/pkg/errors"
 )
 
+// Misbehavior classifies a protocol violation by how much it adds to the ban
+// score of the peer that committed it
+type Misbehavior uint8
+
+const (
+	// MisbehaviorProtocolViolation is a violation without a more specific kind
+	MisbehaviorProtocolViolation Misbehavior = iota
+
+	// MisbehaviorInvalidBlock is sending a block or any other data that breaks
+	// consensus rules
+	MisbehaviorInvalidBlock
+
+	// MisbehaviorBadClaim is sending a strong-node claim that doesn't hold
+	MisbehaviorBadClaim
+
+	// MisbehaviorMalformedAtomicAudit is answering an Atomic state audit with a
+	// malformed response
+	MisbehaviorMalformedAtomicAudit
+
+	// MisbehaviorUnsolicitedMessage is sending a message that wasn't requested
+	// or isn't expected at this point
+	MisbehaviorUnsolicitedMessage
+
+	// MisbehaviorSlowResponse is not answering a request in time
+	MisbehaviorSlowResponse
+)
+
+var misbehaviorStrings = map[Misbehavior]string{
+	MisbehaviorProtocolViolation:    "protocol violation",
+	MisbehaviorInvalidBlock:         "invalid block",
+	MisbehaviorBadClaim:             "bad claim",
+	MisbehaviorMalformedAtomicAudit: "malformed atomic audit",
+	MisbehaviorUnsolicitedMessage:   "unsolicited message",
+	MisbehaviorSlowResponse:         "slow response",
+}
+
+func (m Misbehavior) String() string {
+	if str, ok := misbehaviorStrings[m]; ok {
+		return str
+	}
+	return fmt.Sprintf("unknown misbehavior %d", uint8(m))
+}
+
 // ProtocolError is an error that signifies a violation
-// of the peer-to-peer protocol
+// of the peer-to-peer protocol. If ShouldBan is set, the violation adds to the
+// ban score of the peer according to its Misbehavior.
 type ProtocolError struct {
-	ShouldBan bool
-	Cause     error
+	ShouldBan   bool
+	Misbehavior Misbehavior
+	Cause       error
 }
 
 func (e ProtocolError) Error() string {
@@ -55,6 +102,16 @@ func Wrapf(shouldBan bool, err error, format string, args ...interface{}) error
 	}
 }
 
+// Misbehaviorf formats according to a format specifier and returns the string
+// as a ProtocolError that adds the given misbehavior to the ban score of the peer.
+func Misbehaviorf(misbehavior Misbehavior, format string, args ...interface{}) error {
+	return ProtocolError{
+		ShouldBan:   true,
+		Misbehavior: misbehavior,
+		Cause:       errors.Errorf(format, args...),
+	}
+}
+
 // ConvertToBanningProtocolErrorIfRuleError converts the given error to
 // a banning protocol error if it's a rule error, and otherwise keep it
 // as is.
@@ -63,5 +120,9 @@ func ConvertToBanningProtocolErrorIfRuleError(err error, format string, args ...
 		return err
 	}
 
-	return Wrapf(true, err, format, args...)
+	return ProtocolError{
+		ShouldBan:   true,
+		Misbehavior: MisbehaviorInvalidBlock,
+		Cause:       errors.Wrapf(err, format, args...),
+	}
 }
@@ -54,6 +54,14 @@ type RuntimeSnapshot struct {
 	Entries          []ClaimEntrySnapshot
 }
 
+type ClaimSnapshot struct {
+	BlockHash      string
+	NodeID         string
+	PublicKeyXOnly string
+	ClaimID        string
+	ReceivedAtMs   uint64
+}
+
 type claimRecord struct {
 	BlockHash    [32]byte
 	NodeID       [32]byte
@@ -80,6 +88,10 @@ type engineState struct {
 	LastClaimTimeByNodeID  map[[32]byte]uint64
 	LastClaimBlockByNodeID map[[32]byte][32]byte
 
+	// WinnerChanges holds window blocks whose credited winner changed since
+	// the last TakeWinnerChanges call. It is runtime-only and never persisted.
+	WinnerChanges [][32]byte
+
 	ConflictTotal uint64
 	Dirty         bool
 	LastFlushTime time.Time
@@ -261,6 +273,7 @@ func (e *Engine) ApplyChainPathUpdate(path *externalapi.SelectedChainPath, newSi
 			e.state.WindowHashes = append(e.state.WindowHashes, key)
 			if winner, hasWinner := e.state.WinningClaimByBlock[key]; hasWinner {
 				incrementScore(e.state.ScoreByNodeID, winner.NodeID)
+				recordWinnerChange(e.state, key)
 			}
 			changed = true
 		}
@@ -351,6 +364,73 @@ func (e *Engine) Snapshot(hardforkActive bool) RuntimeSnapshot {
 	}
 }
 
+// WinningClaims returns the winning claim of every window block that has one,
+// ordered from the oldest window block to the newest.
+func (e *Engine) WinningClaims() []ClaimSnapshot {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	winners := make([]ClaimSnapshot, 0, len(e.state.WindowHashes))
+	for _, blockHash := range e.state.WindowHashes {
+		if winner, hasWinner := e.state.WinningClaimByBlock[blockHash]; hasWinner {
+			winners = append(winners, claimRecordToSnapshot(winner))
+		}
+	}
+	return winners
+}
+
+// PendingUnknownClaims returns the claims that are waiting for their block to
+// become known, ordered by the time they were received.
+func (e *Engine) PendingUnknownClaims() []ClaimSnapshot {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	pending := make([]ClaimSnapshot, 0, pendingClaimsCount(e.state))
+	for _, list := range e.state.PendingUnknownClaims {
+		for _, record := range list {
+			pending = append(pending, claimRecordToSnapshot(record))
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].ReceivedAtMs == pending[j].ReceivedAtMs {
+			if pending[i].BlockHash == pending[j].BlockHash {
+				return pending[i].NodeID < pending[j].NodeID
+			}
+			return pending[i].BlockHash < pending[j].BlockHash
+		}
+		return pending[i].ReceivedAtMs < pending[j].ReceivedAtMs
+	})
+	return pending
+}
+
+// TakeWinnerChanges returns the current winning claim of every window block
+// whose credited winner changed since the previous call, and resets the
+// change list. Blocks that left the window in the meantime are skipped.
+func (e *Engine) TakeWinnerChanges() []ClaimSnapshot {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.state.WinnerChanges) == 0 {
+		return nil
+	}
+	changes := make([]ClaimSnapshot, 0, len(e.state.WinnerChanges))
+	seen := make(map[[32]byte]struct{}, len(e.state.WinnerChanges))
+	for _, blockHash := range e.state.WinnerChanges {
+		if _, ok := seen[blockHash]; ok {
+			continue
+		}
+		seen[blockHash] = struct{}{}
+		if _, inWindow := e.state.WindowSet[blockHash]; !inWindow {
+			continue
+		}
+		if winner, hasWinner := e.state.WinningClaimByBlock[blockHash]; hasWinner {
+			changes = append(changes, claimRecordToSnapshot(winner))
+		}
+	}
+	e.state.WinnerChanges = nil
+	return changes
+}
+
 func (e *Engine) ClaimNodeIDsForBlock(blockHash [32]byte) [][32]byte {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -548,6 +628,7 @@ func insertKnownClaim(state *engineState, record claimRecord) bool {
 	claimsForBlock[record.NodeID] = record
 	if len(claimsForBlock) > 1 {
 		state.ConflictTotal++
+		claimConflicts.Inc()
 	}
 
 	newWinner := selectWinner(claimsForBlock)
@@ -560,6 +641,7 @@ func insertKnownClaim(state *engineState, record claimRecord) bool {
 		}
 		if !hadOldWinner || oldWinner.NodeID != newWinner.NodeID {
 			incrementScore(state.ScoreByNodeID, newWinner.NodeID)
+			recordWinnerChange(state, record.BlockHash)
 		}
 	}
 
@@ -568,6 +650,23 @@ func insertKnownClaim(state *engineState, record claimRecord) bool {
 	return true
 }
 
+func recordWinnerChange(state *engineState, blockHash [32]byte) {
+	state.WinnerChanges = append(state.WinnerChanges, blockHash)
+	if overflow := len(state.WinnerChanges) - CLAIM_WINDOW_SIZE_BLOCKS; overflow > 0 {
+		state.WinnerChanges = state.WinnerChanges[overflow:]
+	}
+}
+
+func claimRecordToSnapshot(record claimRecord) ClaimSnapshot {
+	return ClaimSnapshot{
+		BlockHash:      hex.EncodeToString(record.BlockHash[:]),
+		NodeID:         hex.EncodeToString(record.NodeID[:]),
+		PublicKeyXOnly: hex.EncodeToString(record.PubKeyXOnly[:]),
+		ClaimID:        hex.EncodeToString(record.ClaimID[:]),
+		ReceivedAtMs:   record.ReceivedAtMs,
+	}
+}
+
 func knownClaimEvictionCandidate(claims map[[32]byte]claimRecord, incomingNodeID [32]byte) ([32]byte, bool) {
 	var largest [32]byte
 	found := false
@@ -131,6 +131,70 @@ func TestApplyChainPathUpdateRemovesClaimStateForRemovedBlocks(t *testing.T) {
 	}
 }
 
+func TestWinningClaimsAndWinnerChanges(t *testing.T) {
+	tempDir := t.TempDir()
+	engine := New(true, "cryptix-devnet", tempDir)
+
+	const (
+		privKeyHex   = "9e335f14f1a549c374a273b014e4e6658c666b9be6bb7478085510abcba7fae2"
+		blockHashHex = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+		powNonce     = uint64(1588910)
+	)
+	claim := mustBuildSignedClaim(t, 2, privKeyHex, blockHashHex, powNonce)
+	blockHash, err := externalapi.NewDomainHashFromByteSlice(claim.BlockHash)
+	if err != nil {
+		t.Fatalf("invalid block hash in claim: %s", err)
+	}
+
+	outcome := engine.IngestClaim(claim, true, false, nil)
+	if outcome.Status != IngestAccepted || !outcome.Pending {
+		t.Fatalf("expected pending accepted claim, got %+v", outcome)
+	}
+	pending := engine.PendingUnknownClaims()
+	if len(pending) != 1 || pending[0].BlockHash != blockHashHex {
+		t.Fatalf("expected one pending claim for %s, got %+v", blockHashHex, pending)
+	}
+	if changes := engine.TakeWinnerChanges(); len(changes) != 0 {
+		t.Fatalf("expected no winner changes for a pending claim, got %d", len(changes))
+	}
+
+	engine.ApplyChainPathUpdate(
+		&externalapi.SelectedChainPath{Added: []*externalapi.DomainHash{blockHash}},
+		blockHash,
+		true,
+	)
+	if pending := engine.PendingUnknownClaims(); len(pending) != 0 {
+		t.Fatalf("expected promoted claim to leave the pending list, got %d", len(pending))
+	}
+	winners := engine.WinningClaims()
+	if len(winners) != 1 || winners[0].BlockHash != blockHashHex {
+		t.Fatalf("expected one winning claim for %s, got %+v", blockHashHex, winners)
+	}
+	changes := engine.TakeWinnerChanges()
+	if len(changes) != 1 || changes[0] != winners[0] {
+		t.Fatalf("expected the promoted winner to be reported once, got %+v", changes)
+	}
+	if changes := engine.TakeWinnerChanges(); len(changes) != 0 {
+		t.Fatalf("expected winner changes to be drained, got %d", len(changes))
+	}
+
+	// A claim from a lexicographically smaller node ID takes over the block
+	key := *blockHash.ByteArray()
+	engine.mu.Lock()
+	insertKnownClaim(engine.state, claimRecord{BlockHash: key, ReceivedAtMs: 1})
+	engine.mu.Unlock()
+
+	changes = engine.TakeWinnerChanges()
+	zeroNodeID := hex.EncodeToString(make([]byte, 32))
+	if len(changes) != 1 || changes[0].NodeID != zeroNodeID {
+		t.Fatalf("expected the new winner to be reported, got %+v", changes)
+	}
+	snapshot := engine.Snapshot(true)
+	if len(snapshot.Entries) != 1 || snapshot.Entries[0].NodeID != zeroNodeID || snapshot.ConflictTotal != 1 {
+		t.Fatalf("expected the new winner to be credited with one conflict, got %+v", snapshot)
+	}
+}
+
 func TestHardforkGatingIgnoresClaimsAndChainUpdatesPreHF(t *testing.T) {
 	tempDir := t.TempDir()
 	engine := New(true, "cryptix-devnet", tempDir)
@@ -0,0 +1,6 @@
+package strongnodeclaims
+
+import "github.com/cryptix-network/cryptixd/infrastructure/metrics"
+
+var claimConflicts = metrics.NewCounter("cryptixd_strong_node_claim_conflicts_total",
+	"Number of strong-node claims that conflicted with another claim for the same block")
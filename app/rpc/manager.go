@@ -3,8 +3,10 @@ package rpc
 import (
 	"github.com/cryptix-network/cryptixd/app/appmessage"
 	"github.com/cryptix-network/cryptixd/app/protocol"
+	"github.com/cryptix-network/cryptixd/app/protocol/strongnodeclaims"
 	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
 	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/atomicindex"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
 	"github.com/cryptix-network/cryptixd/domain/utxoindex"
 	"github.com/cryptix-network/cryptixd/infrastructure/config"
@@ -29,6 +31,7 @@ func NewManager(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	atomicIndex *atomicindex.AtomicIndex,
 	consensusEventsChan chan externalapi.ConsensusEvent,
 	shutDownChan chan<- struct{}) *Manager {
 
@@ -41,6 +44,7 @@ func NewManager(
 			connectionManager,
 			addressManager,
 			utxoIndex,
+			atomicIndex,
 			shutDownChan,
 		),
 	}
@@ -130,12 +134,19 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 		return nil
 	}
 
+	if m.context.Config.AtomicIndex {
+		err = m.updateAtomicIndex(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = m.notifyVirtualSelectedParentChainChanged(virtualChangeSet)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return m.notifyAtomicStateChanged(virtualChangeSet)
 }
 
 // NotifyNewBlockTemplate notifies the manager that a new
@@ -145,6 +156,17 @@ func (m *Manager) NotifyNewBlockTemplate() error {
 	return m.context.NotificationManager.NotifyNewBlockTemplate(notification)
 }
 
+// NotifyBlockProducerClaimWinner notifies the manager that chain blocks in the
+// strong-node claim window got a new winning claim
+func (m *Manager) NotifyBlockProducerClaimWinner(winners []strongnodeclaims.ClaimSnapshot) error {
+	if !m.context.NotificationManager.HasBlockProducerClaimWinnerListeners() {
+		return nil
+	}
+	notification := appmessage.NewBlockProducerClaimWinnerNotificationMessage(
+		rpccontext.ConvertBlockProducerClaimsToRPC(winners))
+	return m.context.NotificationManager.NotifyBlockProducerClaimWinner(notification)
+}
+
 // NotifyPruningPointUTXOSetOverride notifies the manager whenever the UTXO index
 // resets due to pruning point change via IBD.
 func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
@@ -158,6 +180,13 @@ func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 		}
 	}
 
+	if m.context.Config.AtomicIndex {
+		err := m.context.AtomicIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -191,6 +220,13 @@ func (m *Manager) notifyUTXOsChanged(virtualChangeSet *externalapi.VirtualChange
 	return m.context.NotificationManager.NotifyUTXOsChanged(utxoIndexChanges)
 }
 
+func (m *Manager) updateAtomicIndex(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.updateAtomicIndex")
+	defer onEnd()
+
+	return m.context.AtomicIndex.Update(virtualChangeSet)
+}
+
 func (m *Manager) notifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyPruningPointUTXOSetOverride")
 	defer onEnd()
@@ -236,3 +272,26 @@ func (m *Manager) notifyVirtualSelectedParentChainChanged(virtualChangeSet *exte
 
 	return nil
 }
+
+func (m *Manager) notifyAtomicStateChanged(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyAtomicStateChanged")
+	defer onEnd()
+
+	if !m.context.NotificationManager.HasAtomicStateChangedListeners() {
+		return nil
+	}
+
+	events, err := m.context.ConvertVirtualSelectedParentChainChangesToRPCAtomicEvents(
+		virtualChangeSet.VirtualSelectedParentChainChanges)
+	if err != nil {
+		// Token events cannot be computed for blocks whose selected parent
+		// only has an Atomic root, e.g. right after a pruning point sync.
+		// This must not bring the node down.
+		log.Warnf("Could not compute Atomic state changed events: %s", err)
+		return nil
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	return m.context.NotificationManager.NotifyAtomicStateChanged(events)
+}
@@ -0,0 +1,21 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/infrastructure/metrics"
+)
+
+var (
+	rpcRequestsMetric = metrics.NewCounterVec("cryptixd_rpc_requests_total",
+		"Number of handled RPC requests by command", "command")
+	rpcRequestDurationMetric = metrics.NewHistogramVec("cryptixd_rpc_request_duration_seconds",
+		"Time it took to handle RPC requests by command", metrics.DurationBuckets, "command")
+)
+
+func observeRPCRequest(command appmessage.MessageCommand, start time.Time) {
+	commandName := command.String()
+	rpcRequestsMetric.WithLabelValues(commandName).Inc()
+	rpcRequestDurationMetric.WithLabelValues(commandName).ObserveSince(start)
+}
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"time"
+
 	"github.com/cryptix-network/cryptixd/app/appmessage"
 	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
 	"github.com/cryptix-network/cryptixd/app/rpc/rpchandlers"
@@ -23,6 +25,8 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdAddPeerRequestMessage:                                     rpchandlers.HandleAddPeer,
 	appmessage.CmdSubmitTransactionRequestMessage:                           rpchandlers.HandleSubmitTransaction,
 	appmessage.CmdSubmitTransactionReplacementRequestMessage:                rpchandlers.HandleSubmitTransactionReplacement,
+	appmessage.CmdSubmitTransactionPackageRequestMessage:                    rpchandlers.HandleSubmitTransactionPackage,
+	appmessage.CmdGetTransactionStatusRequestMessage:                        rpchandlers.HandleGetTransactionStatus,
 	appmessage.CmdNotifyVirtualSelectedParentChainChangedRequestMessage:     rpchandlers.HandleNotifyVirtualSelectedParentChainChanged,
 	appmessage.CmdGetBlockRequestMessage:                                    rpchandlers.HandleGetBlock,
 	appmessage.CmdGetSubnetworkRequestMessage:                               rpchandlers.HandleGetSubnetwork,
@@ -51,7 +55,27 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage:                rpchandlers.HandleNotifyVirtualDaaScoreChanged,
 	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpchandlers.HandleNotifyNewBlockTemplate,
 	appmessage.CmdGetCoinSupplyRequestMessage:                               rpchandlers.HandleGetCoinSupply,
+	appmessage.CmdGetFeeEstimateRequestMessage:                              rpchandlers.HandleGetFeeEstimate,
 	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpchandlers.HandleGetMempoolEntriesByAddresses,
+	appmessage.CmdGetAtomicAssetRequestMessage:                              rpchandlers.HandleGetAtomicAsset,
+	appmessage.CmdGetAtomicAssetsRequestMessage:                             rpchandlers.HandleGetAtomicAssets,
+	appmessage.CmdGetAtomicBalancesByOwnerRequestMessage:                    rpchandlers.HandleGetAtomicBalancesByOwner,
+	appmessage.CmdGetAtomicNonceRequestMessage:                              rpchandlers.HandleGetAtomicNonce,
+	appmessage.CmdGetLiquidityPoolRequestMessage:                            rpchandlers.HandleGetLiquidityPool,
+	appmessage.CmdNotifyAtomicStateChangedRequestMessage:                    rpchandlers.HandleNotifyAtomicStateChanged,
+	appmessage.CmdStopNotifyingAtomicStateChangedRequestMessage:             rpchandlers.HandleStopNotifyingAtomicStateChanged,
+	appmessage.CmdSubmitFastIntentRequestMessage:                            rpchandlers.HandleSubmitFastIntent,
+	appmessage.CmdGetFastIntentStatusRequestMessage:                         rpchandlers.HandleGetFastIntentStatus,
+	appmessage.CmdGetAtomicHistoryByOwnerRequestMessage:                     rpchandlers.HandleGetAtomicHistoryByOwner,
+	appmessage.CmdGetAtomicHistoryByAssetRequestMessage:                     rpchandlers.HandleGetAtomicHistoryByAsset,
+	appmessage.CmdSimulateAtomicTransactionRequestMessage:                   rpchandlers.HandleSimulateAtomicTransaction,
+	appmessage.CmdGetAtomicBalanceProofRequestMessage:                       rpchandlers.HandleGetAtomicBalanceProof,
+	appmessage.CmdGetStrongNodeClaimsRequestMessage:                         rpchandlers.HandleGetStrongNodeClaims,
+	appmessage.CmdNotifyBlockProducerClaimWinnerRequestMessage:              rpchandlers.HandleNotifyBlockProducerClaimWinner,
+	appmessage.CmdBanNodeIDRequestMessage:                                   rpchandlers.HandleBanNodeID,
+	appmessage.CmdUnbanNodeIDRequestMessage:                                 rpchandlers.HandleUnbanNodeID,
+	appmessage.CmdListBansRequestMessage:                                    rpchandlers.HandleListBans,
+	appmessage.CmdGetAntiFraudStateRequestMessage:                           rpchandlers.HandleGetAntiFraudState,
 }
 
 func (m *Manager) routerInitializer(router *router.Router, netConnection *netadapter.NetConnection) {
@@ -84,7 +108,9 @@ func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *r
 		if !ok {
 			return err
 		}
+		start := time.Now()
 		response, err := handler(m.context, router, request)
+		observeRPCRequest(request.Command(), start)
 		if err != nil {
 			return err
 		}
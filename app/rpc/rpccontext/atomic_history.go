@@ -0,0 +1,46 @@
+package rpccontext
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/atomicindex"
+)
+
+const defaultAtomicHistoryPageSize = 100
+
+// AtomicHistoryPage returns a page of the Atomic index using the given
+// history getter, validating and decoding the RPC paging parameters
+func (ctx *Context) AtomicHistoryPage(cursorString string, limit uint32,
+	getHistory func(cursor []byte, limit int) (*atomicindex.HistoryPage, error)) (
+	entries []*appmessage.RPCAtomicHistoryEntry, nextCursor string, err error) {
+
+	if !ctx.Config.AtomicIndex {
+		return nil, "", appmessage.RPCErrorf("Method unavailable when the Atomic index is disabled")
+	}
+	if limit == 0 {
+		limit = defaultAtomicHistoryPageSize
+	}
+	if limit > atomicindex.MaxHistoryPageSize {
+		return nil, "", appmessage.RPCErrorf("Limit %d is above the maximum of %d",
+			limit, atomicindex.MaxHistoryPageSize)
+	}
+	cursor, err := hex.DecodeString(cursorString)
+	if err != nil {
+		return nil, "", appmessage.RPCErrorf("Could not decode cursor '%s': %s", cursorString, err)
+	}
+
+	page, err := getHistory(cursor, int(limit))
+	if err != nil {
+		return nil, "", err
+	}
+
+	entries = make([]*appmessage.RPCAtomicHistoryEntry, len(page.Entries))
+	for i, entry := range page.Entries {
+		entries[i] = &appmessage.RPCAtomicHistoryEntry{
+			AcceptingDAAScore: entry.AcceptingDAAScore,
+			Event:             ctx.ConvertAtomicEventToRPCAtomicEvent(entry.Event, entry.AcceptingBlockHash, false),
+		}
+	}
+	return entries, hex.EncodeToString(page.NextCursor), nil
+}
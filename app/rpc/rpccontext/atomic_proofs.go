@@ -0,0 +1,28 @@
+package rpccontext
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/pkg/errors"
+)
+
+type atomicBalanceProver interface {
+	GetAtomicBalanceProof(key atomicstate.BalanceKey) (*atomicstate.CommittedBalanceProof, error)
+}
+
+// AtomicBalanceProof returns a Merkle proof of the balance of key in the
+// Atomic state of the virtual selected parent
+func (ctx *Context) AtomicBalanceProof(key atomicstate.BalanceKey) (*atomicstate.CommittedBalanceProof, error) {
+	prover, ok := ctx.Domain.Consensus().(atomicBalanceProver)
+	if !ok {
+		return nil, appmessage.RPCErrorf("Atomic balance proofs are not available from the current consensus")
+	}
+	proof, err := prover.GetAtomicBalanceProof(key)
+	if err != nil {
+		if errors.Is(err, atomicstate.ErrMerkleRootHFNotActive) {
+			return nil, appmessage.RPCErrorf("Atomic balance proofs are not available yet: %s", err)
+		}
+		return nil, err
+	}
+	return proof, nil
+}
@@ -0,0 +1,75 @@
+package rpccontext
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+)
+
+type atomicSimulator interface {
+	SimulateAtomicTransaction(transaction *externalapi.DomainTransaction) (*atomicstate.Simulation, uint64, error)
+}
+
+// SimulateAtomicTransaction applies a populated transaction to a copy of the
+// virtual Atomic state and returns the resulting changes, along with the DAA
+// score the simulation ran at
+func (ctx *Context) SimulateAtomicTransaction(transaction *externalapi.DomainTransaction) (
+	*atomicstate.Simulation, uint64, error) {
+
+	simulator, ok := ctx.Domain.Consensus().(atomicSimulator)
+	if !ok {
+		return nil, 0, appmessage.RPCErrorf("Atomic simulation is not available from the current consensus")
+	}
+	return simulator.SimulateAtomicTransaction(transaction)
+}
+
+// PopulateSimulateAtomicTransactionResponse fills the changes of an Atomic
+// simulation into response
+func (ctx *Context) PopulateSimulateAtomicTransactionResponse(
+	response *appmessage.SimulateAtomicTransactionResponseMessage, simulation *atomicstate.Simulation) {
+
+	response.BalanceChanges = make([]*appmessage.RPCAtomicSimulatedBalanceChange, len(simulation.BalanceChanges))
+	for i, change := range simulation.BalanceChanges {
+		response.BalanceChanges[i] = &appmessage.RPCAtomicSimulatedBalanceChange{
+			AssetID: hex.EncodeToString(change.Key.AssetID[:]),
+			OwnerID: hex.EncodeToString(change.Key.OwnerID[:]),
+			Before:  change.Before.Big().String(),
+			After:   change.After.Big().String(),
+		}
+	}
+
+	response.NonceChanges = make([]*appmessage.RPCAtomicSimulatedNonceChange, len(simulation.NonceChanges))
+	for i, change := range simulation.NonceChanges {
+		rpcChange := &appmessage.RPCAtomicSimulatedNonceChange{
+			OwnerID: hex.EncodeToString(change.Key.OwnerID[:]),
+			Before:  change.Before,
+			After:   change.After,
+		}
+		if change.Key.ScopeKind == atomicstate.NonceScopeAsset {
+			rpcChange.AssetID = hex.EncodeToString(change.Key.ScopeID[:])
+		}
+		response.NonceChanges[i] = rpcChange
+	}
+
+	response.PoolChanges = make([]*appmessage.RPCAtomicSimulatedPoolChange, len(simulation.PoolChanges))
+	for i, change := range simulation.PoolChanges {
+		rpcChange := &appmessage.RPCAtomicSimulatedPoolChange{
+			AssetID: hex.EncodeToString(change.AssetID[:]),
+			After:   ctx.ConvertLiquidityPoolToRPCLiquidityPool(change.After),
+		}
+		if change.Before != nil {
+			rpcChange.Before = ctx.ConvertLiquidityPoolToRPCLiquidityPool(change.Before)
+		}
+		response.PoolChanges[i] = rpcChange
+	}
+
+	response.StateGrowth = &appmessage.RPCAtomicStateGrowth{
+		NewAssets:          simulation.Growth.NewAssets,
+		NewBalanceKeys:     simulation.Growth.NewBalanceKeys,
+		NewNonceKeys:       simulation.Growth.NewNonceKeys,
+		NewPools:           simulation.Growth.NewPools,
+		NewAnchorOwnerKeys: simulation.Growth.NewAnchorOwnerKeys,
+	}
+}
@@ -0,0 +1,160 @@
+package rpccontext
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/txscript"
+	"github.com/cryptix-network/cryptixd/util"
+)
+
+type atomicStateProvider interface {
+	GetVirtualSelectedParentAtomicState() (*atomicstate.State, *externalapi.DomainHash, error)
+}
+
+// VirtualSelectedParentAtomicState returns the Atomic state at the virtual
+// selected parent, along with the hash of that block
+func (ctx *Context) VirtualSelectedParentAtomicState() (*atomicstate.State, *externalapi.DomainHash, error) {
+	provider, ok := ctx.Domain.Consensus().(atomicStateProvider)
+	if !ok {
+		return nil, nil, appmessage.RPCErrorf("The Atomic state is not available from the current consensus")
+	}
+	return provider.GetVirtualSelectedParentAtomicState()
+}
+
+// ParseAtomicID parses a hex-encoded Atomic asset or owner ID. `name` is used
+// for error messages only.
+func ParseAtomicID(name string, idString string) ([externalapi.DomainHashSize]byte, error) {
+	var id [externalapi.DomainHashSize]byte
+	idBytes, err := hex.DecodeString(idString)
+	if err != nil {
+		return id, appmessage.RPCErrorf("Could not decode %s '%s': %s", name, idString, err)
+	}
+	if len(idBytes) != externalapi.DomainHashSize {
+		return id, appmessage.RPCErrorf("Invalid %s '%s': expected %d bytes but got %d",
+			name, idString, externalapi.DomainHashSize, len(idBytes))
+	}
+	copy(id[:], idBytes)
+	return id, nil
+}
+
+// AtomicOwnerID resolves an Atomic owner ID from either a hex-encoded owner ID
+// or an address. Exactly one of the two must be provided.
+func (ctx *Context) AtomicOwnerID(ownerIDString string, addressString string) ([externalapi.DomainHashSize]byte, error) {
+	if ownerIDString != "" && addressString != "" {
+		return [externalapi.DomainHashSize]byte{}, appmessage.RPCErrorf("Only one of ownerId and address may be provided")
+	}
+	if ownerIDString != "" {
+		return ParseAtomicID("owner ID", ownerIDString)
+	}
+	if addressString == "" {
+		return [externalapi.DomainHashSize]byte{}, appmessage.RPCErrorf("Either ownerId or address must be provided")
+	}
+
+	address, err := util.DecodeAddress(addressString, ctx.Config.ActiveNetParams.Prefix)
+	if err != nil {
+		return [externalapi.DomainHashSize]byte{}, appmessage.RPCErrorf("Could not decode address '%s': %s", addressString, err)
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return [externalapi.DomainHashSize]byte{}, appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s", addressString, err)
+	}
+	ownerID, ok := atomicstate.OwnerIDFromScript(scriptPublicKey)
+	if !ok {
+		return [externalapi.DomainHashSize]byte{}, appmessage.RPCErrorf("Address '%s' cannot own Atomic assets", addressString)
+	}
+	return ownerID, nil
+}
+
+// ConvertAtomicAssetToRPCAtomicAsset converts an Atomic asset state to its RPC representation
+func (ctx *Context) ConvertAtomicAssetToRPCAtomicAsset(assetID [externalapi.DomainHashSize]byte,
+	asset atomicstate.AssetState) *appmessage.RPCAtomicAsset {
+
+	rpcAsset := &appmessage.RPCAtomicAsset{
+		AssetID:              hex.EncodeToString(assetID[:]),
+		CreatorOwnerID:       hex.EncodeToString(asset.CreatorOwnerID[:]),
+		AssetClass:           uint32(asset.AssetClass),
+		TokenVersion:         uint32(asset.TokenVersion),
+		MintAuthorityOwnerID: hex.EncodeToString(asset.MintAuthorityOwnerID[:]),
+		Decimals:             uint32(asset.Decimals),
+		SupplyMode:           uint32(asset.SupplyMode),
+		MaxSupply:            asset.MaxSupply.Big().String(),
+		TotalSupply:          asset.TotalSupply.Big().String(),
+		Name:                 string(asset.Name),
+		Symbol:               string(asset.Symbol),
+		Metadata:             hex.EncodeToString(asset.Metadata),
+		PlatformTag:          string(asset.PlatformTag),
+	}
+	if asset.CreatedBlockHash != nil {
+		rpcAsset.CreatedBlockHash = hex.EncodeToString(asset.CreatedBlockHash[:])
+	}
+	if asset.CreatedDAAScore != nil {
+		rpcAsset.CreatedDAAScore = *asset.CreatedDAAScore
+	}
+	if asset.CreatedAt != nil {
+		rpcAsset.CreatedAt = *asset.CreatedAt
+	}
+	if asset.Liquidity != nil {
+		rpcAsset.Liquidity = ctx.ConvertLiquidityPoolToRPCLiquidityPool(asset.Liquidity)
+	}
+	return rpcAsset
+}
+
+// ConvertLiquidityPoolToRPCLiquidityPool converts an Atomic liquidity pool state to its RPC representation
+func (ctx *Context) ConvertLiquidityPoolToRPCLiquidityPool(pool *atomicstate.LiquidityPoolState) *appmessage.RPCLiquidityPool {
+	feeRecipients := make([]*appmessage.RPCLiquidityFeeRecipient, len(pool.FeeRecipients))
+	for i, recipient := range pool.FeeRecipients {
+		feeRecipients[i] = &appmessage.RPCLiquidityFeeRecipient{
+			OwnerID:        hex.EncodeToString(recipient.OwnerID[:]),
+			Address:        ctx.encodeAtomicRecipientAddress(recipient.AddressVersion, recipient.AddressPayload),
+			UnclaimedSompi: recipient.UnclaimedSompi,
+		}
+	}
+	return &appmessage.RPCLiquidityPool{
+		PoolNonce:                           pool.PoolNonce,
+		CurveVersion:                        uint32(pool.CurveVersion),
+		CurveMode:                           uint32(pool.CurveMode),
+		IndividualVirtualCPayReservesSompi:  pool.IndividualVirtualCPayReservesSompi,
+		IndividualVirtualTokenMultiplierBPS: uint32(pool.IndividualVirtualTokenMultiplierBPS),
+		RealCPayReservesSompi:               pool.RealCPayReservesSompi,
+		RealTokenReserves:                   pool.RealTokenReserves.Big().String(),
+		VirtualCPayReserves:                 pool.VirtualCPayReserves,
+		VirtualTokenReserves:                pool.VirtualTokenReserves.Big().String(),
+		UnclaimedFeeTotalSompi:              pool.UnclaimedFeeTotalSompi,
+		FeeBPS:                              uint32(pool.FeeBPS),
+		FeeRecipients:                       feeRecipients,
+		VaultOutpoint: &appmessage.RPCOutpoint{
+			TransactionID: pool.VaultOutpoint.TransactionID.String(),
+			Index:         pool.VaultOutpoint.Index,
+		},
+		VaultValueSompi:   pool.VaultValueSompi,
+		UnlockTargetSompi: pool.UnlockTargetSompi,
+		Unlocked:          pool.Unlocked,
+	}
+}
+
+// encodeAtomicRecipientAddress encodes the address components stored for a
+// liquidity fee recipient. Versions follow the cryptix address encoding: 0 for
+// Schnorr pubkeys, 1 for ECDSA pubkeys and 8 for script hashes. It returns an empty string for components that do
+// not form a valid address.
+func (ctx *Context) encodeAtomicRecipientAddress(addressVersion byte, addressPayload []byte) string {
+	prefix := ctx.Config.ActiveNetParams.Prefix
+	var address util.Address
+	var err error
+	switch addressVersion {
+	case 0:
+		address, err = util.NewAddressPublicKey(addressPayload, prefix)
+	case 1:
+		address, err = util.NewAddressPublicKeyECDSA(addressPayload, prefix)
+	case 8:
+		address, err = util.NewAddressScriptHashFromHash(addressPayload, prefix)
+	default:
+		return ""
+	}
+	if err != nil {
+		return ""
+	}
+	return address.EncodeAddress()
+}
@@ -0,0 +1,81 @@
+package rpccontext
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+)
+
+type atomicEventsProvider interface {
+	GetAtomicEvents(blockHash *externalapi.DomainHash) ([]*atomicstate.Event, error)
+}
+
+// ConvertVirtualSelectedParentChainChangesToRPCAtomicEvents collects the token events of
+// the given chain changes. Events of removed blocks come first, newest first and marked
+// as reversed, followed by the events of added blocks in acceptance order.
+func (ctx *Context) ConvertVirtualSelectedParentChainChangesToRPCAtomicEvents(
+	selectedParentChainChanges *externalapi.SelectedChainPath) ([]*appmessage.RPCAtomicEvent, error) {
+
+	provider, ok := ctx.Domain.Consensus().(atomicEventsProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	var rpcEvents []*appmessage.RPCAtomicEvent
+	for _, removed := range selectedParentChainChanges.Removed {
+		events, err := provider.GetAtomicEvents(removed)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(events) - 1; i >= 0; i-- {
+			rpcEvents = append(rpcEvents, ctx.ConvertAtomicEventToRPCAtomicEvent(events[i], removed, true))
+		}
+	}
+	for _, added := range selectedParentChainChanges.Added {
+		events, err := provider.GetAtomicEvents(added)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			rpcEvents = append(rpcEvents, ctx.ConvertAtomicEventToRPCAtomicEvent(event, added, false))
+		}
+	}
+	return rpcEvents, nil
+}
+
+// ConvertAtomicEventToRPCAtomicEvent converts a token event to its RPC representation
+func (ctx *Context) ConvertAtomicEventToRPCAtomicEvent(event *atomicstate.Event,
+	acceptingBlockHash *externalapi.DomainHash, reversed bool) *appmessage.RPCAtomicEvent {
+
+	recipients := make([]string, len(event.Recipients))
+	for i, recipient := range event.Recipients {
+		recipients[i] = hex.EncodeToString(recipient[:])
+	}
+	balanceChanges := make([]*appmessage.RPCAtomicBalanceChange, len(event.BalanceChanges))
+	for i, change := range event.BalanceChanges {
+		balanceChanges[i] = &appmessage.RPCAtomicBalanceChange{
+			OwnerID: hex.EncodeToString(change.OwnerID[:]),
+			Before:  change.Before.Big().String(),
+			After:   change.After.Big().String(),
+		}
+	}
+	var pool *appmessage.RPCLiquidityPool
+	if event.Pool != nil {
+		pool = ctx.ConvertLiquidityPoolToRPCLiquidityPool(event.Pool)
+	}
+	return &appmessage.RPCAtomicEvent{
+		Kind:               event.Kind.String(),
+		TransactionID:      event.TransactionID.String(),
+		AcceptingBlockHash: acceptingBlockHash.String(),
+		AssetID:            hex.EncodeToString(event.AssetID[:]),
+		OwnerID:            hex.EncodeToString(event.OwnerID[:]),
+		Amount:             event.Amount.Big().String(),
+		CPayAmountSompi:    event.CPayAmountSompi,
+		Recipients:         recipients,
+		BalanceChanges:     balanceChanges,
+		Pool:               pool,
+		Reversed:           reversed,
+	}
+}
@@ -3,6 +3,7 @@ package rpccontext
 import (
 	"github.com/cryptix-network/cryptixd/app/protocol"
 	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/atomicindex"
 	"github.com/cryptix-network/cryptixd/domain/utxoindex"
 	"github.com/cryptix-network/cryptixd/infrastructure/config"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/addressmanager"
@@ -19,6 +20,7 @@ type Context struct {
 	ConnectionManager *connmanager.ConnectionManager
 	AddressManager    *addressmanager.AddressManager
 	UTXOIndex         *utxoindex.UTXOIndex
+	AtomicIndex       *atomicindex.AtomicIndex
 	ShutDownChan      chan<- struct{}
 
 	NotificationManager *NotificationManager
@@ -32,6 +34,7 @@ func NewContext(cfg *config.Config,
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	atomicIndex *atomicindex.AtomicIndex,
 	shutDownChan chan<- struct{}) *Context {
 
 	context := &Context{
@@ -42,6 +45,7 @@ func NewContext(cfg *config.Config,
 		ConnectionManager: connectionManager,
 		AddressManager:    addressManager,
 		UTXOIndex:         utxoIndex,
+		AtomicIndex:       atomicIndex,
 		ShutDownChan:      shutDownChan,
 	}
 	context.NotificationManager = NewNotificationManager(cfg.ActiveNetParams)
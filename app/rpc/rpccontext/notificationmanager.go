@@ -41,9 +41,13 @@ type NotificationListener struct {
 	propagateVirtualDaaScoreChangedNotifications                bool
 	propagatePruningPointUTXOSetOverrideNotifications           bool
 	propagateNewBlockTemplateNotifications                      bool
+	propagateAtomicStateChangedNotifications                    bool
+	propagateBlockProducerClaimWinnerNotifications              bool
 
 	propagateUTXOsChangedNotificationAddresses                                    map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
 	includeAcceptedTransactionIDsInVirtualSelectedParentChainChangedNotifications bool
+	propagateAtomicStateChangedNotificationOwnerIDs                               map[string]struct{}
+	propagateAtomicStateChangedNotificationAssetIDs                               map[string]struct{}
 }
 
 // NewNotificationManager creates a new NotificationManager
@@ -227,6 +231,43 @@ func (nm *NotificationManager) NotifyUTXOsChanged(utxoChanges *utxoindex.UTXOCha
 	return nil
 }
 
+// HasAtomicStateChangedListeners indicates if the notification manager has any listeners for `AtomicStateChanged` events
+func (nm *NotificationManager) HasAtomicStateChangedListeners() bool {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for _, listener := range nm.listeners {
+		if listener.propagateAtomicStateChangedNotifications {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyAtomicStateChanged notifies the notification manager that token events were
+// accepted or reversed by selected parent chain changes
+func (nm *NotificationManager) NotifyAtomicStateChanged(events []*appmessage.RPCAtomicEvent) error {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for router, listener := range nm.listeners {
+		if listener.propagateAtomicStateChangedNotifications {
+			filteredEvents := listener.filterAtomicEvents(events)
+
+			// Don't send the notification if it's empty
+			if len(filteredEvents) == 0 {
+				continue
+			}
+
+			err := router.OutgoingRoute().MaybeEnqueue(appmessage.NewAtomicStateChangedNotificationMessage(filteredEvents))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // NotifyVirtualSelectedParentBlueScoreChanged notifies the notification manager that the DAG's
 // virtual selected parent blue score has changed
 func (nm *NotificationManager) NotifyVirtualSelectedParentBlueScoreChanged(
@@ -284,6 +325,38 @@ func (nm *NotificationManager) NotifyNewBlockTemplate(
 	return nil
 }
 
+// HasBlockProducerClaimWinnerListeners indicates if the notification manager has any listeners for `BlockProducerClaimWinner` events
+func (nm *NotificationManager) HasBlockProducerClaimWinnerListeners() bool {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for _, listener := range nm.listeners {
+		if listener.propagateBlockProducerClaimWinnerNotifications {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyBlockProducerClaimWinner notifies the notification manager that chain
+// blocks in the strong-node claim window got a new winning claim
+func (nm *NotificationManager) NotifyBlockProducerClaimWinner(
+	notification *appmessage.BlockProducerClaimWinnerNotificationMessage) error {
+
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for router, listener := range nm.listeners {
+		if listener.propagateBlockProducerClaimWinnerNotifications {
+			err := router.OutgoingRoute().MaybeEnqueue(notification)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // NotifyPruningPointUTXOSetOverride notifies the notification manager that the UTXO index
 // reset due to pruning point change via IBD.
 func (nm *NotificationManager) NotifyPruningPointUTXOSetOverride() error {
@@ -313,6 +386,8 @@ func newNotificationListener(params *dagconfig.Params) *NotificationListener {
 		propagateVirtualSelectedParentBlueScoreChangedNotifications: false,
 		propagateNewBlockTemplateNotifications:                      false,
 		propagatePruningPointUTXOSetOverrideNotifications:           false,
+		propagateAtomicStateChangedNotifications:                    false,
+		propagateBlockProducerClaimWinnerNotifications:              false,
 	}
 }
 
@@ -384,6 +459,75 @@ func (nm *NotificationManager) StopPropagatingUTXOsChangedNotifications(nl *Noti
 	}
 }
 
+// PropagateAtomicStateChangedNotifications instructs the listener to send Atomic state changed
+// notifications to the remote listener for events involving the given owner or asset IDs.
+// Subsequent calls add to the previously given IDs. If no IDs are ever given, all events are sent.
+func (nm *NotificationManager) PropagateAtomicStateChangedNotifications(nl *NotificationListener, ownerIDs []string, assetIDs []string) {
+	// Apply a write-lock since the internal listener ID maps are modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	if !nl.propagateAtomicStateChangedNotifications {
+		nl.propagateAtomicStateChangedNotifications = true
+		nl.propagateAtomicStateChangedNotificationOwnerIDs = make(map[string]struct{}, len(ownerIDs))
+		nl.propagateAtomicStateChangedNotificationAssetIDs = make(map[string]struct{}, len(assetIDs))
+	}
+
+	for _, ownerID := range ownerIDs {
+		nl.propagateAtomicStateChangedNotificationOwnerIDs[ownerID] = struct{}{}
+	}
+	for _, assetID := range assetIDs {
+		nl.propagateAtomicStateChangedNotificationAssetIDs[assetID] = struct{}{}
+	}
+}
+
+// StopPropagatingAtomicStateChangedNotifications instructs the listener to stop sending Atomic
+// state changed notifications to the remote listener
+func (nm *NotificationManager) StopPropagatingAtomicStateChangedNotifications(nl *NotificationListener) {
+	// Apply a write-lock since the internal listener ID maps are modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	nl.propagateAtomicStateChangedNotifications = false
+	nl.propagateAtomicStateChangedNotificationOwnerIDs = nil
+	nl.propagateAtomicStateChangedNotificationAssetIDs = nil
+}
+
+func (nl *NotificationListener) filterAtomicEvents(events []*appmessage.RPCAtomicEvent) []*appmessage.RPCAtomicEvent {
+	if len(nl.propagateAtomicStateChangedNotificationOwnerIDs) == 0 &&
+		len(nl.propagateAtomicStateChangedNotificationAssetIDs) == 0 {
+		return events
+	}
+
+	var filteredEvents []*appmessage.RPCAtomicEvent
+	for _, event := range events {
+		if nl.isAtomicEventRelevant(event) {
+			filteredEvents = append(filteredEvents, event)
+		}
+	}
+	return filteredEvents
+}
+
+func (nl *NotificationListener) isAtomicEventRelevant(event *appmessage.RPCAtomicEvent) bool {
+	if _, ok := nl.propagateAtomicStateChangedNotificationAssetIDs[event.AssetID]; ok {
+		return true
+	}
+	if _, ok := nl.propagateAtomicStateChangedNotificationOwnerIDs[event.OwnerID]; ok {
+		return true
+	}
+	for _, recipient := range event.Recipients {
+		if _, ok := nl.propagateAtomicStateChangedNotificationOwnerIDs[recipient]; ok {
+			return true
+		}
+	}
+	for _, change := range event.BalanceChanges {
+		if _, ok := nl.propagateAtomicStateChangedNotificationOwnerIDs[change.OwnerID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (nl *NotificationListener) convertUTXOChangesToUTXOsChangedNotification(
 	utxoChanges *utxoindex.UTXOChanges) (*appmessage.UTXOsChangedNotificationMessage, error) {
 
@@ -478,6 +622,12 @@ func (nl *NotificationListener) PropagateNewBlockTemplateNotifications() {
 	nl.propagateNewBlockTemplateNotifications = true
 }
 
+// PropagateBlockProducerClaimWinnerNotifications instructs the listener to send
+// block producer claim winner notifications to the remote listener
+func (nl *NotificationListener) PropagateBlockProducerClaimWinnerNotifications() {
+	nl.propagateBlockProducerClaimWinnerNotifications = true
+}
+
 // PropagatePruningPointUTXOSetOverrideNotifications instructs the listener to send pruning point UTXO set override notifications
 // to the remote listener.
 func (nl *NotificationListener) PropagatePruningPointUTXOSetOverrideNotifications() {
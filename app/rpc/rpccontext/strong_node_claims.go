@@ -0,0 +1,63 @@
+package rpccontext
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/protocol/strongnodeclaims"
+)
+
+// StrongNodeClaims builds a GetStrongNodeClaims response out of the current
+// state of the strong-node claims engine
+func (ctx *Context) StrongNodeClaims(includeWinningClaims bool, includePendingClaims bool) (
+	*appmessage.GetStrongNodeClaimsResponseMessage, error) {
+
+	flowContext := ctx.ProtocolManager.Context()
+	engine := flowContext.StrongNodeClaims()
+	if engine == nil {
+		return nil, appmessage.RPCErrorf("Strong-node claims are not available on this node")
+	}
+
+	snapshot := engine.Snapshot(flowContext.IsPayloadHfActive())
+	entries := make([]*appmessage.RPCStrongNodeClaimEntry, len(snapshot.Entries))
+	for i, entry := range snapshot.Entries {
+		entries[i] = &appmessage.RPCStrongNodeClaimEntry{
+			NodeID:             entry.NodeID,
+			PublicKeyXOnly:     entry.PublicKeyXOnly,
+			ClaimedBlocks:      entry.ClaimedBlocks,
+			ShareBPS:           entry.ShareBPS,
+			LastClaimBlockHash: entry.LastClaimBlockHash,
+			LastClaimTimeMs:    entry.LastClaimTimeMs,
+		}
+	}
+
+	response := &appmessage.GetStrongNodeClaimsResponseMessage{
+		Enabled:          snapshot.Enabled,
+		HardforkActive:   snapshot.HardforkActive,
+		RuntimeAvailable: snapshot.RuntimeAvailable,
+		WindowSize:       snapshot.WindowSize,
+		ConflictTotal:    snapshot.ConflictTotal,
+		Entries:          entries,
+	}
+	if includeWinningClaims {
+		response.WinningClaims = ConvertBlockProducerClaimsToRPC(engine.WinningClaims())
+	}
+	if includePendingClaims {
+		response.PendingClaims = ConvertBlockProducerClaimsToRPC(engine.PendingUnknownClaims())
+	}
+	return response, nil
+}
+
+// ConvertBlockProducerClaimsToRPC converts strong-node claim snapshots to
+// their RPC representation
+func ConvertBlockProducerClaimsToRPC(claims []strongnodeclaims.ClaimSnapshot) []*appmessage.RPCBlockProducerClaim {
+	rpcClaims := make([]*appmessage.RPCBlockProducerClaim, len(claims))
+	for i, claim := range claims {
+		rpcClaims[i] = &appmessage.RPCBlockProducerClaim{
+			BlockHash:      claim.BlockHash,
+			NodeID:         claim.NodeID,
+			PublicKeyXOnly: claim.PublicKeyXOnly,
+			ClaimID:        claim.ClaimID,
+			ReceivedAtMs:   claim.ReceivedAtMs,
+		}
+	}
+	return rpcClaims
+}
@@ -0,0 +1,108 @@
+package rpccontext
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+)
+
+// transactionAcceptanceLookupBatchSize is the number of chain blocks whose
+// acceptance data is read from consensus at once
+const transactionAcceptanceLookupBatchSize = 100
+
+// TransactionAcceptance returns the selected chain block that accepted the
+// given transaction, and its number of confirmations counting the accepting
+// block itself. It's read from the acceptance data of the most recent chain
+// blocks above the pruning point, so a transaction whose accepting block left
+// the selected chain in a reorg is not accepted anymore.
+//
+// Only the number of chain blocks set by the txstatus-acceptance-depth option,
+// counting back from the virtual selected parent, is searched. Each of them
+// costs a block info read and an acceptance data read, so the option bounds the
+// work a single getTransactionStatus call may cause.
+func (ctx *Context) TransactionAcceptance(transactionID *externalapi.DomainTransactionID) (
+	acceptingBlockHash *externalapi.DomainHash, confirmations uint64, isAccepted bool, err error) {
+
+	maxChainBlocks := ctx.Config.TxStatusAcceptanceDepth
+	if maxChainBlocks == 0 {
+		return nil, 0, false, nil
+	}
+
+	consensus := ctx.Domain.Consensus()
+	virtualSelectedParent, err := consensus.GetVirtualSelectedParent()
+	if err != nil {
+		return nil, 0, false, err
+	}
+	virtualSelectedParentInfo, err := consensus.GetBlockInfo(virtualSelectedParent)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	// The acceptance data of the pruning point and the blocks below it is pruned
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	chainBlockHashes := make([]*externalapi.DomainHash, 0, transactionAcceptanceLookupBatchSize)
+	chainBlockBlueScores := make([]uint64, 0, transactionAcceptanceLookupBatchSize)
+	current := virtualSelectedParent
+	for searched := uint64(0); searched < maxChainBlocks; {
+		chainBlockHashes = chainBlockHashes[:0]
+		chainBlockBlueScores = chainBlockBlueScores[:0]
+		for current != nil && !current.Equal(pruningPoint) &&
+			len(chainBlockHashes) < transactionAcceptanceLookupBatchSize &&
+			searched < maxChainBlocks {
+
+			blockInfo, err := consensus.GetBlockInfo(current)
+			if err != nil {
+				return nil, 0, false, err
+			}
+			chainBlockHashes = append(chainBlockHashes, current)
+			chainBlockBlueScores = append(chainBlockBlueScores, blockInfo.BlueScore)
+			searched++
+
+			if blockInfo.SelectedParent == nil || blockInfo.SelectedParent.Equal(current) {
+				current = nil
+			} else {
+				current = blockInfo.SelectedParent
+			}
+		}
+		if len(chainBlockHashes) == 0 {
+			break
+		}
+
+		blocksAcceptanceData, err := consensus.GetBlocksAcceptanceData(chainBlockHashes)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		for i, acceptanceData := range blocksAcceptanceData {
+			if !acceptanceDataAcceptsTransaction(acceptanceData, transactionID) {
+				continue
+			}
+			if virtualSelectedParentInfo.BlueScore >= chainBlockBlueScores[i] {
+				confirmations = virtualSelectedParentInfo.BlueScore - chainBlockBlueScores[i] + 1
+			}
+			return chainBlockHashes[i], confirmations, true, nil
+		}
+	}
+	return nil, 0, false, nil
+}
+
+func acceptanceDataAcceptsTransaction(acceptanceData externalapi.AcceptanceData,
+	transactionID *externalapi.DomainTransactionID) bool {
+
+	for _, blockAcceptanceData := range acceptanceData {
+		if blockAcceptanceData == nil {
+			continue
+		}
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			if transactionAcceptanceData == nil || !transactionAcceptanceData.IsAccepted ||
+				transactionAcceptanceData.Transaction == nil {
+				continue
+			}
+			if consensushashing.TransactionID(transactionAcceptanceData.Transaction).Equal(transactionID) {
+				return true
+			}
+		}
+	}
+	return false
+}
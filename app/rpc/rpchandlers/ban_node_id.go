@@ -0,0 +1,61 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+	"math"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+const maxNodeIDBanDurationSeconds = uint64(math.MaxInt64 / int64(time.Second))
+
+// HandleBanNodeID handles the respectively named RPC command
+func HandleBanNodeID(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("BanNodeID RPC command called while node in safe RPC mode -- ignoring.")
+		response := appmessage.NewBanNodeIDResponseMessage()
+		response.Error =
+			appmessage.RPCErrorf("BanNodeID RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	banNodeIDRequest := request.(*appmessage.BanNodeIDRequestMessage)
+	nodeID, err := parseUnifiedNodeID(banNodeIDRequest.NodeID)
+	if err != nil {
+		errorMessage := &appmessage.BanNodeIDResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse node ID: %s", err)
+		return errorMessage, nil
+	}
+	if banNodeIDRequest.DurationSeconds > maxNodeIDBanDurationSeconds {
+		errorMessage := &appmessage.BanNodeIDResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Ban duration may not exceed %d seconds", maxNodeIDBanDurationSeconds)
+		return errorMessage, nil
+	}
+
+	duration := time.Duration(banNodeIDRequest.DurationSeconds) * time.Second
+	err = context.ConnectionManager.BanByUnifiedNodeID(nodeID, banNodeIDRequest.Reason, duration)
+	if err != nil {
+		errorMessage := &appmessage.BanNodeIDResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not ban node ID: %s", err)
+		return errorMessage, nil
+	}
+	response := appmessage.NewBanNodeIDResponseMessage()
+	return response, nil
+}
+
+func parseUnifiedNodeID(nodeIDString string) ([32]byte, error) {
+	var nodeID [32]byte
+	nodeIDBytes, err := hex.DecodeString(nodeIDString)
+	if err != nil {
+		return nodeID, err
+	}
+	if len(nodeIDBytes) != len(nodeID) {
+		return nodeID, errors.Errorf("node ID must be %d bytes long, got %d", len(nodeID), len(nodeIDBytes))
+	}
+	copy(nodeID[:], nodeIDBytes)
+	return nodeID, nil
+}
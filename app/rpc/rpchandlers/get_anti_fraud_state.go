@@ -0,0 +1,69 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetAntiFraudState handles the respectively named RPC command
+func HandleGetAntiFraudState(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAntiFraudStateRequest := request.(*appmessage.GetAntiFraudStateRequestMessage)
+
+	state := context.ConnectionManager.AntiFraudState()
+	response := &appmessage.GetAntiFraudStateResponseMessage{
+		RuntimeEnabled: state.RuntimeEnabled,
+		PeerFallback:   state.PeerFallback,
+		SeedEnabled:    state.SeedEnabled,
+		HasSnapshot:    state.HasSnapshot,
+		HashWindow:     make([]string, len(state.HashWindow)),
+		PeerVotes:      make([]*appmessage.RPCAntiFraudPeerVote, len(state.PeerVotes)),
+		Matches:        make([]*appmessage.RPCAntiFraudEntryMatch, len(state.Matches)),
+	}
+	for i, hash := range state.HashWindow {
+		response.HashWindow[i] = hex.EncodeToString(hash[:])
+	}
+	for i, peerVote := range state.PeerVotes {
+		response.PeerVotes[i] = &appmessage.RPCAntiFraudPeerVote{
+			PeerID:       peerVote.PeerID,
+			SnapshotSeq:  peerVote.SnapshotSeq,
+			RootHash:     hex.EncodeToString(peerVote.RootHash[:]),
+			ReceivedAtMs: peerVote.ReceivedAt.UnixMilli(),
+		}
+	}
+	for i, match := range state.Matches {
+		response.Matches[i] = &appmessage.RPCAntiFraudEntryMatch{
+			Entry:    match.Entry,
+			IsNodeID: match.IsNodeID,
+			Peers:    match.Connections,
+		}
+	}
+	if !state.HasSnapshot {
+		return response, nil
+	}
+
+	response.Source = state.Source
+	response.SnapshotSeq = state.SnapshotSeq
+	response.GeneratedAtMs = state.GeneratedAtMs
+	response.SigningKeyID = uint32(state.SigningKeyID)
+	response.RootHash = hex.EncodeToString(state.RootHash[:])
+	response.BannedIPCount = uint32(len(state.BannedIPs))
+	response.BannedNodeIDCount = uint32(len(state.BannedNodeIDs))
+	if getAntiFraudStateRequest.IncludeEntries {
+		response.BannedIPs = state.BannedIPs
+		response.BannedNodeIDs = state.BannedNodeIDs
+	}
+	if getAntiFraudStateRequest.IncludeDiff && state.Diff != nil {
+		response.Diff = &appmessage.RPCAntiFraudSnapshotDiff{
+			PreviousSnapshotSeq: state.Diff.PreviousSnapshotSeq,
+			PreviousRootHash:    hex.EncodeToString(state.Diff.PreviousRootHash[:]),
+			AddedIPs:            state.Diff.AddedIPs,
+			RemovedIPs:          state.Diff.RemovedIPs,
+			AddedNodeIDs:        state.Diff.AddedNodeIDs,
+			RemovedNodeIDs:      state.Diff.RemovedNodeIDs,
+		}
+	}
+	return response, nil
+}
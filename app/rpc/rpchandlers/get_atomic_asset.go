@@ -0,0 +1,43 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetAtomicAsset handles the respectively named RPC command
+func HandleGetAtomicAsset(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAtomicAssetRequest := request.(*appmessage.GetAtomicAssetRequestMessage)
+
+	asset, blockHash, err := getAtomicAsset(context, getAtomicAssetRequest.AssetID)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetAtomicAssetResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+
+	return appmessage.NewGetAtomicAssetResponseMessage(asset, blockHash), nil
+}
+
+func getAtomicAsset(context *rpccontext.Context, assetIDString string) (*appmessage.RPCAtomicAsset, string, error) {
+	assetID, err := rpccontext.ParseAtomicID("asset ID", assetIDString)
+	if err != nil {
+		return nil, "", err
+	}
+
+	state, blockHash, err := context.VirtualSelectedParentAtomicState()
+	if err != nil {
+		return nil, "", err
+	}
+	asset, ok := state.Assets[assetID]
+	if !ok {
+		return nil, "", appmessage.RPCErrorf("Asset %s was not found", assetIDString)
+	}
+	return context.ConvertAtomicAssetToRPCAtomicAsset(assetID, asset), blockHash.String(), nil
+}
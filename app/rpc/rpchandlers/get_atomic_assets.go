@@ -0,0 +1,85 @@
+package rpchandlers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultGetAtomicAssetsLimit is the page size used when the request does not specify one
+	defaultGetAtomicAssetsLimit = 100
+
+	// maxGetAtomicAssetsLimit is the largest page size a single request may ask for
+	maxGetAtomicAssetsLimit = 1000
+)
+
+// HandleGetAtomicAssets handles the respectively named RPC command
+func HandleGetAtomicAssets(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAtomicAssetsRequest := request.(*appmessage.GetAtomicAssetsRequestMessage)
+
+	response, err := getAtomicAssets(context, getAtomicAssetsRequest.StartAssetID, getAtomicAssetsRequest.Limit)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetAtomicAssetsResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+	return response, nil
+}
+
+func getAtomicAssets(context *rpccontext.Context, startAssetIDString string,
+	limit uint32) (*appmessage.GetAtomicAssetsResponseMessage, error) {
+
+	if limit == 0 {
+		limit = defaultGetAtomicAssetsLimit
+	}
+	if limit > maxGetAtomicAssetsLimit {
+		return nil, appmessage.RPCErrorf("Limit %d exceeds the maximum of %d", limit, maxGetAtomicAssetsLimit)
+	}
+
+	var startAssetID [externalapi.DomainHashSize]byte
+	if startAssetIDString != "" {
+		var err error
+		startAssetID, err = rpccontext.ParseAtomicID("start asset ID", startAssetIDString)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	state, blockHash, err := context.VirtualSelectedParentAtomicState()
+	if err != nil {
+		return nil, err
+	}
+
+	assetIDs := make([][externalapi.DomainHashSize]byte, 0, len(state.Assets))
+	for assetID := range state.Assets {
+		if bytes.Compare(assetID[:], startAssetID[:]) >= 0 {
+			assetIDs = append(assetIDs, assetID)
+		}
+	}
+	sort.Slice(assetIDs, func(i, j int) bool {
+		return bytes.Compare(assetIDs[i][:], assetIDs[j][:]) < 0
+	})
+
+	nextAssetID := ""
+	if uint32(len(assetIDs)) > limit {
+		nextAssetID = hex.EncodeToString(assetIDs[limit][:])
+		assetIDs = assetIDs[:limit]
+	}
+
+	assets := make([]*appmessage.RPCAtomicAsset, len(assetIDs))
+	for i, assetID := range assetIDs {
+		assets[i] = context.ConvertAtomicAssetToRPCAtomicAsset(assetID, state.Assets[assetID])
+	}
+	return appmessage.NewGetAtomicAssetsResponseMessage(assets, nextAssetID, blockHash.String()), nil
+}
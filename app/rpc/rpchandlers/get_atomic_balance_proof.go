@@ -0,0 +1,69 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetAtomicBalanceProof handles the respectively named RPC command
+func HandleGetAtomicBalanceProof(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAtomicBalanceProofRequest := request.(*appmessage.GetAtomicBalanceProofRequestMessage)
+
+	response, err := getAtomicBalanceProof(context, getAtomicBalanceProofRequest)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetAtomicBalanceProofResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+	return response, nil
+}
+
+func getAtomicBalanceProof(context *rpccontext.Context,
+	request *appmessage.GetAtomicBalanceProofRequestMessage) (*appmessage.GetAtomicBalanceProofResponseMessage, error) {
+
+	assetID, err := rpccontext.ParseAtomicID("asset ID", request.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := context.AtomicOwnerID(request.OwnerID, request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	committedProof, err := context.AtomicBalanceProof(atomicstate.BalanceKey{AssetID: assetID, OwnerID: ownerID})
+	if err != nil {
+		return nil, err
+	}
+
+	proof := committedProof.Proof
+	siblings := make([]string, len(proof.Proof.Siblings))
+	for i, sibling := range proof.Proof.Siblings {
+		siblings[i] = hex.EncodeToString(sibling[:])
+	}
+	response := &appmessage.GetAtomicBalanceProofResponseMessage{
+		BlockHash:         committedProof.Block.String(),
+		DAAScore:          committedProof.Header.DAAScore(),
+		UTXOCommitment:    committedProof.Header.UTXOCommitment().String(),
+		RawUTXOCommitment: committedProof.RawUTXOCommitment.String(),
+		AtomicMerkleRoot:  hex.EncodeToString(committedProof.MerkleRoot[:]),
+		AssetID:           hex.EncodeToString(assetID[:]),
+		OwnerID:           hex.EncodeToString(ownerID[:]),
+		Balance:           proof.Amount.Big().String(),
+		Included:          proof.Included,
+		Siblings:          siblings,
+	}
+	if proof.Proof.OtherLeafPath != nil {
+		response.OtherLeafPath = hex.EncodeToString(proof.Proof.OtherLeafPath[:])
+		response.OtherLeafEntryHash = hex.EncodeToString(proof.Proof.OtherLeafEntryHash[:])
+	}
+	return response, nil
+}
@@ -0,0 +1,63 @@
+package rpchandlers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetAtomicBalancesByOwner handles the respectively named RPC command
+func HandleGetAtomicBalancesByOwner(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAtomicBalancesByOwnerRequest := request.(*appmessage.GetAtomicBalancesByOwnerRequestMessage)
+
+	response, err := getAtomicBalancesByOwner(context, getAtomicBalancesByOwnerRequest.OwnerID, getAtomicBalancesByOwnerRequest.Address)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetAtomicBalancesByOwnerResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+	return response, nil
+}
+
+func getAtomicBalancesByOwner(context *rpccontext.Context, ownerIDString string,
+	addressString string) (*appmessage.GetAtomicBalancesByOwnerResponseMessage, error) {
+
+	ownerID, err := context.AtomicOwnerID(ownerIDString, addressString)
+	if err != nil {
+		return nil, err
+	}
+
+	state, blockHash, err := context.VirtualSelectedParentAtomicState()
+	if err != nil {
+		return nil, err
+	}
+
+	balanceKeys := make([]atomicstate.BalanceKey, 0)
+	for balanceKey, balance := range state.Balances {
+		if balanceKey.OwnerID == ownerID && !balance.IsZero() {
+			balanceKeys = append(balanceKeys, balanceKey)
+		}
+	}
+	sort.Slice(balanceKeys, func(i, j int) bool {
+		return bytes.Compare(balanceKeys[i].AssetID[:], balanceKeys[j].AssetID[:]) < 0
+	})
+
+	balances := make([]*appmessage.RPCAtomicBalance, len(balanceKeys))
+	for i, balanceKey := range balanceKeys {
+		balances[i] = &appmessage.RPCAtomicBalance{
+			AssetID: hex.EncodeToString(balanceKey.AssetID[:]),
+			Balance: state.Balances[balanceKey].Big().String(),
+		}
+	}
+	return appmessage.NewGetAtomicBalancesByOwnerResponseMessage(hex.EncodeToString(ownerID[:]), balances, blockHash.String()), nil
+}
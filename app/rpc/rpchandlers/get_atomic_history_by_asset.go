@@ -0,0 +1,44 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/atomicindex"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetAtomicHistoryByAsset handles the respectively named RPC command
+func HandleGetAtomicHistoryByAsset(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAtomicHistoryByAssetRequest := request.(*appmessage.GetAtomicHistoryByAssetRequestMessage)
+
+	response, err := getAtomicHistoryByAsset(context, getAtomicHistoryByAssetRequest)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetAtomicHistoryByAssetResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+	return response, nil
+}
+
+func getAtomicHistoryByAsset(context *rpccontext.Context,
+	request *appmessage.GetAtomicHistoryByAssetRequestMessage) (*appmessage.GetAtomicHistoryByAssetResponseMessage, error) {
+
+	assetID, err := rpccontext.ParseAtomicID("asset ID", request.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, nextCursor, err := context.AtomicHistoryPage(request.Cursor, request.Limit,
+		func(cursor []byte, limit int) (*atomicindex.HistoryPage, error) {
+			return context.AtomicIndex.HistoryByAsset(assetID, cursor, limit)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return appmessage.NewGetAtomicHistoryByAssetResponseMessage(request.AssetID, entries, nextCursor), nil
+}
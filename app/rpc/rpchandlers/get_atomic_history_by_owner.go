@@ -0,0 +1,44 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/atomicindex"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetAtomicHistoryByOwner handles the respectively named RPC command
+func HandleGetAtomicHistoryByOwner(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAtomicHistoryByOwnerRequest := request.(*appmessage.GetAtomicHistoryByOwnerRequestMessage)
+
+	response, err := getAtomicHistoryByOwner(context, getAtomicHistoryByOwnerRequest)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetAtomicHistoryByOwnerResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+	return response, nil
+}
+
+func getAtomicHistoryByOwner(context *rpccontext.Context,
+	request *appmessage.GetAtomicHistoryByOwnerRequestMessage) (*appmessage.GetAtomicHistoryByOwnerResponseMessage, error) {
+
+	ownerID, err := rpccontext.ParseAtomicID("owner ID", request.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, nextCursor, err := context.AtomicHistoryPage(request.Cursor, request.Limit,
+		func(cursor []byte, limit int) (*atomicindex.HistoryPage, error) {
+			return context.AtomicIndex.HistoryByOwner(ownerID, cursor, limit)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return appmessage.NewGetAtomicHistoryByOwnerResponseMessage(request.OwnerID, entries, nextCursor), nil
+}
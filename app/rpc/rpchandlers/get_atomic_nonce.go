@@ -0,0 +1,55 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetAtomicNonce handles the respectively named RPC command
+func HandleGetAtomicNonce(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getAtomicNonceRequest := request.(*appmessage.GetAtomicNonceRequestMessage)
+
+	response, err := getAtomicNonce(context, getAtomicNonceRequest)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetAtomicNonceResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+	return response, nil
+}
+
+func getAtomicNonce(context *rpccontext.Context,
+	request *appmessage.GetAtomicNonceRequestMessage) (*appmessage.GetAtomicNonceResponseMessage, error) {
+
+	ownerID, err := context.AtomicOwnerID(request.OwnerID, request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Asset creation is sequenced by the owner-scoped nonce, while every other
+	// operation is sequenced per owner and asset
+	nonceKey := atomicstate.OwnerNonceKey(ownerID)
+	if request.AssetID != "" {
+		assetID, err := rpccontext.ParseAtomicID("asset ID", request.AssetID)
+		if err != nil {
+			return nil, err
+		}
+		nonceKey = atomicstate.AssetNonceKey(ownerID, assetID)
+	}
+
+	state, blockHash, err := context.VirtualSelectedParentAtomicState()
+	if err != nil {
+		return nil, err
+	}
+	return appmessage.NewGetAtomicNonceResponseMessage(hex.EncodeToString(ownerID[:]),
+		state.ExpectedNonce(nonceKey), blockHash.String()), nil
+}
@@ -0,0 +1,412 @@
+package rpchandlers_test
+
+import (
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpchandlers"
+	"github.com/cryptix-network/cryptixd/domain/atomicindex"
+	"github.com/cryptix-network/cryptixd/domain/consensus"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/testutils"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/txscript"
+	"github.com/cryptix-network/cryptixd/infrastructure/config"
+	"github.com/cryptix-network/cryptixd/util"
+)
+
+// atomicStateTestDomain serves a fixed Atomic state as the state at the
+// virtual selected parent
+type atomicStateTestDomain struct {
+	fakeDomain
+	state     *atomicstate.State
+	blockHash *externalapi.DomainHash
+}
+
+func (d atomicStateTestDomain) Consensus() externalapi.Consensus { return d }
+
+func (d atomicStateTestDomain) GetVirtualSelectedParentAtomicState() (
+	*atomicstate.State, *externalapi.DomainHash, error) {
+
+	return d.state, d.blockHash, nil
+}
+
+type atomicStateTestData struct {
+	context                       *rpccontext.Context
+	ownerID                       [externalapi.DomainHashSize]byte
+	address                       string
+	addressWithWrongPrefix        string
+	assetIDs                      [][externalapi.DomainHashSize]byte
+	liquidityAssetID              [externalapi.DomainHashSize]byte
+	blockHash                     *externalapi.DomainHash
+	ownerNonce                    uint64
+	ownerBalanceOfFirstAsset      uint64
+	otherOwnerBalanceOfFirstAsset uint64
+}
+
+func newAtomicStateTestData(t *testing.T, consensusConfig *consensus.Config) *atomicStateTestData {
+	params := &consensusConfig.Params
+
+	address, err := util.NewAddressPublicKey(make([]byte, 32), params.Prefix)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKey: %+v", err)
+	}
+	wrongPrefix := util.Bech32PrefixCryptixSim
+	if params.Prefix == wrongPrefix {
+		wrongPrefix = util.Bech32PrefixCryptixDev
+	}
+	addressWithWrongPrefix, err := util.NewAddressPublicKey(make([]byte, 32), wrongPrefix)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKey: %+v", err)
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %+v", err)
+	}
+	ownerID, ok := atomicstate.OwnerIDFromScript(scriptPublicKey)
+	if !ok {
+		t.Fatalf("expected address %s to be able to own Atomic assets", address)
+	}
+	otherOwnerID := [externalapi.DomainHashSize]byte{0xff}
+
+	data := &atomicStateTestData{
+		ownerID:                       ownerID,
+		address:                       address.EncodeAddress(),
+		addressWithWrongPrefix:        addressWithWrongPrefix.EncodeAddress(),
+		assetIDs:                      [][externalapi.DomainHashSize]byte{{1}, {2}, {3}},
+		liquidityAssetID:              [externalapi.DomainHashSize]byte{3},
+		blockHash:                     externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{0xaa}),
+		ownerNonce:                    4,
+		ownerBalanceOfFirstAsset:      5,
+		otherOwnerBalanceOfFirstAsset: 7,
+	}
+
+	state := atomicstate.NewState()
+	for _, assetID := range data.assetIDs {
+		state.Assets[assetID] = atomicstate.AssetState{
+			CreatorOwnerID: ownerID,
+			Name:           []byte("Test"),
+			Symbol:         []byte("TST"),
+		}
+	}
+	liquidityAsset := state.Assets[data.liquidityAssetID]
+	liquidityAsset.AssetClass = atomicstate.AssetClassLiquidity
+	liquidityAsset.Liquidity = &atomicstate.LiquidityPoolState{PoolNonce: 9}
+	state.Assets[data.liquidityAssetID] = liquidityAsset
+
+	state.Balances[atomicstate.BalanceKey{AssetID: data.assetIDs[0], OwnerID: ownerID}] =
+		atomicstate.Uint128FromUint64(data.ownerBalanceOfFirstAsset)
+	// Zero balances are not reported
+	state.Balances[atomicstate.BalanceKey{AssetID: data.assetIDs[1], OwnerID: ownerID}] =
+		atomicstate.Uint128FromUint64(0)
+	state.Balances[atomicstate.BalanceKey{AssetID: data.assetIDs[0], OwnerID: otherOwnerID}] =
+		atomicstate.Uint128FromUint64(data.otherOwnerBalanceOfFirstAsset)
+	state.NextNonces[atomicstate.OwnerNonceKey(ownerID)] = data.ownerNonce
+
+	data.context = &rpccontext.Context{
+		Config: &config.Config{Flags: &config.Flags{NetworkFlags: config.NetworkFlags{ActiveNetParams: params}}},
+		Domain: atomicStateTestDomain{state: state, blockHash: data.blockHash},
+	}
+	return data
+}
+
+func TestHandleGetAtomicAssets(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		data := newAtomicStateTestData(t, consensusConfig)
+
+		getAtomicAssets := func(startAssetID string, limit uint32) *appmessage.GetAtomicAssetsResponseMessage {
+			request := appmessage.NewGetAtomicAssetsRequestMessage(startAssetID, limit)
+			response, err := rpchandlers.HandleGetAtomicAssets(data.context, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetAtomicAssets: %+v", err)
+			}
+			return response.(*appmessage.GetAtomicAssetsResponseMessage)
+		}
+
+		response := getAtomicAssets("", 0)
+		if response.Error != nil {
+			t.Fatalf("unexpected error for the default limit: %s", response.Error.Message)
+		}
+		if len(response.Assets) != len(data.assetIDs) || response.NextAssetID != "" {
+			t.Fatalf("expected all %d assets in a single page, got %d with next asset ID '%s'",
+				len(data.assetIDs), len(response.Assets), response.NextAssetID)
+		}
+		if response.BlockHash != data.blockHash.String() {
+			t.Fatalf("expected block hash %s, got %s", data.blockHash, response.BlockHash)
+		}
+
+		response = getAtomicAssets("", 2)
+		if response.Error != nil {
+			t.Fatalf("unexpected error for a limit of 2: %s", response.Error.Message)
+		}
+		if len(response.Assets) != 2 {
+			t.Fatalf("expected 2 assets, got %d", len(response.Assets))
+		}
+		for i, asset := range response.Assets {
+			if asset.AssetID != hex.EncodeToString(data.assetIDs[i][:]) {
+				t.Fatalf("expected asset %d to be %x, got %s", i, data.assetIDs[i], asset.AssetID)
+			}
+		}
+		if response.NextAssetID != hex.EncodeToString(data.assetIDs[2][:]) {
+			t.Fatalf("expected next asset ID %x, got '%s'", data.assetIDs[2], response.NextAssetID)
+		}
+
+		response = getAtomicAssets(response.NextAssetID, 2)
+		if response.Error != nil {
+			t.Fatalf("unexpected error for the second page: %s", response.Error.Message)
+		}
+		if len(response.Assets) != 1 || response.NextAssetID != "" {
+			t.Fatalf("expected a last page of 1 asset, got %d with next asset ID '%s'",
+				len(response.Assets), response.NextAssetID)
+		}
+
+		if response := getAtomicAssets("", 1000); response.Error != nil {
+			t.Fatalf("unexpected error for the maximum limit: %s", response.Error.Message)
+		}
+		if response := getAtomicAssets("", 1001); response.Error == nil {
+			t.Fatalf("expected a limit above the maximum to fail")
+		}
+		if response := getAtomicAssets("not hex", 0); response.Error == nil {
+			t.Fatalf("expected a start asset ID that is not hex to fail")
+		}
+		if response := getAtomicAssets("0102", 0); response.Error == nil {
+			t.Fatalf("expected a start asset ID of the wrong length to fail")
+		}
+	})
+}
+
+func TestHandleGetAtomicAsset(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		data := newAtomicStateTestData(t, consensusConfig)
+
+		getAtomicAsset := func(assetID string) *appmessage.GetAtomicAssetResponseMessage {
+			request := appmessage.NewGetAtomicAssetRequestMessage(assetID)
+			response, err := rpchandlers.HandleGetAtomicAsset(data.context, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetAtomicAsset: %+v", err)
+			}
+			return response.(*appmessage.GetAtomicAssetResponseMessage)
+		}
+
+		assetID := hex.EncodeToString(data.assetIDs[0][:])
+		response := getAtomicAsset(assetID)
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %s", response.Error.Message)
+		}
+		if response.Asset.AssetID != assetID || response.Asset.Symbol != "TST" {
+			t.Fatalf("unexpected asset %s with symbol %s", response.Asset.AssetID, response.Asset.Symbol)
+		}
+
+		unknownAssetID := [externalapi.DomainHashSize]byte{0x42}
+		if response := getAtomicAsset(hex.EncodeToString(unknownAssetID[:])); response.Error == nil {
+			t.Fatalf("expected an unknown asset to fail")
+		}
+		if response := getAtomicAsset(""); response.Error == nil {
+			t.Fatalf("expected an empty asset ID to fail")
+		}
+	})
+}
+
+func TestHandleGetLiquidityPool(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		data := newAtomicStateTestData(t, consensusConfig)
+
+		getLiquidityPool := func(assetID [externalapi.DomainHashSize]byte) *appmessage.GetLiquidityPoolResponseMessage {
+			request := appmessage.NewGetLiquidityPoolRequestMessage(hex.EncodeToString(assetID[:]))
+			response, err := rpchandlers.HandleGetLiquidityPool(data.context, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetLiquidityPool: %+v", err)
+			}
+			return response.(*appmessage.GetLiquidityPoolResponseMessage)
+		}
+
+		response := getLiquidityPool(data.liquidityAssetID)
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %s", response.Error.Message)
+		}
+		if response.Pool.PoolNonce != 9 {
+			t.Fatalf("expected pool nonce 9, got %d", response.Pool.PoolNonce)
+		}
+		if response := getLiquidityPool(data.assetIDs[0]); response.Error == nil {
+			t.Fatalf("expected an asset without a liquidity pool to fail")
+		}
+		if response := getLiquidityPool([externalapi.DomainHashSize]byte{0x42}); response.Error == nil {
+			t.Fatalf("expected an unknown asset to fail")
+		}
+	})
+}
+
+func TestHandleGetAtomicBalancesByOwner(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		data := newAtomicStateTestData(t, consensusConfig)
+
+		getAtomicBalancesByOwner := func(ownerID string, address string) *appmessage.GetAtomicBalancesByOwnerResponseMessage {
+			request := appmessage.NewGetAtomicBalancesByOwnerRequestMessage(ownerID, address)
+			response, err := rpchandlers.HandleGetAtomicBalancesByOwner(data.context, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetAtomicBalancesByOwner: %+v", err)
+			}
+			return response.(*appmessage.GetAtomicBalancesByOwnerResponseMessage)
+		}
+
+		ownerID := hex.EncodeToString(data.ownerID[:])
+		for _, response := range []*appmessage.GetAtomicBalancesByOwnerResponseMessage{
+			getAtomicBalancesByOwner(ownerID, ""),
+			getAtomicBalancesByOwner("", data.address),
+		} {
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %s", response.Error.Message)
+			}
+			if response.OwnerID != ownerID {
+				t.Fatalf("expected owner ID %s, got %s", ownerID, response.OwnerID)
+			}
+			if len(response.Balances) != 1 {
+				t.Fatalf("expected only the non-zero balance of the owner, got %d balances", len(response.Balances))
+			}
+			if response.Balances[0].AssetID != hex.EncodeToString(data.assetIDs[0][:]) {
+				t.Fatalf("expected a balance of asset %x, got %s", data.assetIDs[0], response.Balances[0].AssetID)
+			}
+			if response.Balances[0].Balance != strconv.FormatUint(data.ownerBalanceOfFirstAsset, 10) {
+				t.Fatalf("expected a balance of %d, got %s", data.ownerBalanceOfFirstAsset, response.Balances[0].Balance)
+			}
+		}
+
+		invalidRequests := []struct {
+			name    string
+			ownerID string
+			address string
+		}{
+			{name: "neither owner ID nor address"},
+			{name: "both owner ID and address", ownerID: ownerID, address: data.address},
+			{name: "owner ID that is not hex", ownerID: "not hex"},
+			{name: "owner ID of the wrong length", ownerID: ownerID[:len(ownerID)-2]},
+			{name: "address that does not decode", address: "cryptix:invalid"},
+			{name: "address of another network", address: data.addressWithWrongPrefix},
+		}
+		for _, test := range invalidRequests {
+			if response := getAtomicBalancesByOwner(test.ownerID, test.address); response.Error == nil {
+				t.Fatalf("expected a request with %s to fail", test.name)
+			}
+		}
+	})
+}
+
+func TestHandleGetAtomicNonce(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		data := newAtomicStateTestData(t, consensusConfig)
+
+		getAtomicNonce := func(ownerID string, address string, assetID string) *appmessage.GetAtomicNonceResponseMessage {
+			request := appmessage.NewGetAtomicNonceRequestMessage(ownerID, address, assetID)
+			response, err := rpchandlers.HandleGetAtomicNonce(data.context, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetAtomicNonce: %+v", err)
+			}
+			return response.(*appmessage.GetAtomicNonceResponseMessage)
+		}
+
+		response := getAtomicNonce("", data.address, "")
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %s", response.Error.Message)
+		}
+		if response.NextNonce != data.ownerNonce {
+			t.Fatalf("expected owner nonce %d, got %d", data.ownerNonce, response.NextNonce)
+		}
+
+		response = getAtomicNonce(hex.EncodeToString(data.ownerID[:]), "", hex.EncodeToString(data.assetIDs[0][:]))
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %s", response.Error.Message)
+		}
+		if response.NextNonce != 1 {
+			t.Fatalf("expected an unused asset nonce to start at 1, got %d", response.NextNonce)
+		}
+
+		if response := getAtomicNonce("", data.address, "not hex"); response.Error == nil {
+			t.Fatalf("expected an asset ID that is not hex to fail")
+		}
+		if response := getAtomicNonce("", "", ""); response.Error == nil {
+			t.Fatalf("expected a request without an owner to fail")
+		}
+	})
+}
+
+func TestHandleGetAtomicHistory(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		data := newAtomicStateTestData(t, consensusConfig)
+		ownerID := hex.EncodeToString(data.ownerID[:])
+		assetID := hex.EncodeToString(data.assetIDs[0][:])
+
+		getHistoryByOwnerError := func(ownerID string, cursor string, limit uint32) *appmessage.RPCError {
+			request := appmessage.NewGetAtomicHistoryByOwnerRequestMessage(ownerID, cursor, limit)
+			response, err := rpchandlers.HandleGetAtomicHistoryByOwner(data.context, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetAtomicHistoryByOwner: %+v", err)
+			}
+			return response.(*appmessage.GetAtomicHistoryByOwnerResponseMessage).Error
+		}
+		getHistoryByAssetError := func(assetID string, cursor string, limit uint32) *appmessage.RPCError {
+			request := appmessage.NewGetAtomicHistoryByAssetRequestMessage(assetID, cursor, limit)
+			response, err := rpchandlers.HandleGetAtomicHistoryByAsset(data.context, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetAtomicHistoryByAsset: %+v", err)
+			}
+			return response.(*appmessage.GetAtomicHistoryByAssetResponseMessage).Error
+		}
+
+		if getHistoryByOwnerError(ownerID, "", 0) == nil || getHistoryByAssetError(assetID, "", 0) == nil {
+			t.Fatalf("expected the history to be unavailable while the Atomic index is disabled")
+		}
+
+		data.context.Config.AtomicIndex = true
+		if getHistoryByOwnerError(ownerID, "", atomicindex.MaxHistoryPageSize+1) == nil ||
+			getHistoryByAssetError(assetID, "", atomicindex.MaxHistoryPageSize+1) == nil {
+			t.Fatalf("expected a limit above the maximum to fail")
+		}
+		if getHistoryByOwnerError(ownerID, "not hex", 0) == nil || getHistoryByAssetError(assetID, "not hex", 0) == nil {
+			t.Fatalf("expected a cursor that is not hex to fail")
+		}
+		if getHistoryByOwnerError("not hex", "", 0) == nil {
+			t.Fatalf("expected an owner ID that is not hex to fail")
+		}
+		if getHistoryByAssetError(assetID[:len(assetID)-2], "", 0) == nil {
+			t.Fatalf("expected an asset ID of the wrong length to fail")
+		}
+	})
+}
+
+func TestHandleAtomicStateUnavailable(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		data := newAtomicStateTestData(t, consensusConfig)
+		// The Domain of the test context doesn't provide the Atomic state nor balance proofs
+		data.context.Domain = fakeDomain{}
+
+		response, err := rpchandlers.HandleGetAtomicAsset(data.context, nil,
+			appmessage.NewGetAtomicAssetRequestMessage(hex.EncodeToString(data.assetIDs[0][:])))
+		if err != nil {
+			t.Fatalf("HandleGetAtomicAsset: %+v", err)
+		}
+		if response.(*appmessage.GetAtomicAssetResponseMessage).Error == nil {
+			t.Fatalf("expected the request to fail while the Atomic state is unavailable")
+		}
+
+		response, err = rpchandlers.HandleGetAtomicBalanceProof(data.context, nil,
+			appmessage.NewGetAtomicBalanceProofRequestMessage(hex.EncodeToString(data.assetIDs[0][:]), "", data.address))
+		if err != nil {
+			t.Fatalf("HandleGetAtomicBalanceProof: %+v", err)
+		}
+		if response.(*appmessage.GetAtomicBalanceProofResponseMessage).Error == nil {
+			t.Fatalf("expected the request to fail while balance proofs are unavailable")
+		}
+
+		response, err = rpchandlers.HandleGetAtomicBalanceProof(data.context, nil,
+			appmessage.NewGetAtomicBalanceProofRequestMessage("not hex", "", data.address))
+		if err != nil {
+			t.Fatalf("HandleGetAtomicBalanceProof: %+v", err)
+		}
+		if response.(*appmessage.GetAtomicBalanceProofResponseMessage).Error == nil {
+			t.Fatalf("expected an asset ID that is not hex to fail")
+		}
+	})
+}
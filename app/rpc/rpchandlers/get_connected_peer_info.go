@@ -34,6 +34,7 @@ func HandleGetConnectedPeerInfo(context *rpccontext.Context, _ *router.Router, _
 			TimeConnected:             peer.TimeConnected().Milliseconds(),
 			IsIBDPeer:                 peer == ibdPeer,
 			UnifiedNodeID:             unifiedNodeID,
+			BanScore:                  context.ProtocolManager.Context().BanScore(peer.Connection()),
 		}
 		infos = append(infos, info)
 	}
@@ -0,0 +1,33 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetFastIntentStatus handles the respectively named RPC command
+func HandleGetFastIntentStatus(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getFastIntentStatusRequest := request.(*appmessage.GetFastIntentStatusRequestMessage)
+
+	intentID, err := externalapi.NewDomainHashFromString(getFastIntentStatusRequest.IntentID)
+	if err != nil {
+		errorMessage := &appmessage.GetFastIntentStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse intent ID %s: %s",
+			getFastIntentStatusRequest.IntentID, err)
+		return errorMessage, nil
+	}
+
+	intent, ok := context.ProtocolManager.Context().FastIntent(intentID)
+	if !ok {
+		errorMessage := &appmessage.GetFastIntentStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Fast intent %s was not found", intentID)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewGetFastIntentStatusResponseMessage(intent.ID.String(),
+		consensushashing.TransactionID(intent.Transaction).String(), intent.Status.String(), intent.MaxFee,
+		intent.ClientCreatedAtMs, intent.ReceivedAtMs, intent.MicroblockTimeMs), nil
+}
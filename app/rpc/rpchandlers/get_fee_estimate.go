@@ -0,0 +1,36 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetFeeEstimate handles the respectively named RPC command
+func HandleGetFeeEstimate(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	estimate := context.Domain.MiningManager().GetFeeEstimate()
+
+	response := appmessage.NewGetFeeEstimateResponseMessage()
+	response.Estimate = appmessage.RPCFeeEstimate{
+		PriorityBucket: feeRateBucketToRPC(estimate.PriorityBucket),
+		NormalBuckets:  feeRateBucketsToRPC(estimate.NormalBuckets),
+		LowBuckets:     feeRateBucketsToRPC(estimate.LowBuckets),
+	}
+	return response, nil
+}
+
+func feeRateBucketToRPC(bucket miningmanager.FeeRateBucket) appmessage.RPCFeeRateBucket {
+	return appmessage.RPCFeeRateBucket{
+		Feerate:          bucket.FeeRate,
+		EstimatedSeconds: bucket.EstimatedSeconds,
+	}
+}
+
+func feeRateBucketsToRPC(buckets []miningmanager.FeeRateBucket) []appmessage.RPCFeeRateBucket {
+	rpcBuckets := make([]appmessage.RPCFeeRateBucket, len(buckets))
+	for i, bucket := range buckets {
+		rpcBuckets[i] = feeRateBucketToRPC(bucket)
+	}
+	return rpcBuckets
+}
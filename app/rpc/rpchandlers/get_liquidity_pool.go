@@ -0,0 +1,46 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetLiquidityPool handles the respectively named RPC command
+func HandleGetLiquidityPool(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getLiquidityPoolRequest := request.(*appmessage.GetLiquidityPoolRequestMessage)
+
+	pool, blockHash, err := getLiquidityPool(context, getLiquidityPoolRequest.AssetID)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetLiquidityPoolResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+
+	return appmessage.NewGetLiquidityPoolResponseMessage(pool, blockHash), nil
+}
+
+func getLiquidityPool(context *rpccontext.Context, assetIDString string) (*appmessage.RPCLiquidityPool, string, error) {
+	assetID, err := rpccontext.ParseAtomicID("asset ID", assetIDString)
+	if err != nil {
+		return nil, "", err
+	}
+
+	state, blockHash, err := context.VirtualSelectedParentAtomicState()
+	if err != nil {
+		return nil, "", err
+	}
+	asset, ok := state.Assets[assetID]
+	if !ok {
+		return nil, "", appmessage.RPCErrorf("Asset %s was not found", assetIDString)
+	}
+	if asset.Liquidity == nil {
+		return nil, "", appmessage.RPCErrorf("Asset %s does not have a liquidity pool", assetIDString)
+	}
+	return context.ConvertLiquidityPoolToRPCLiquidityPool(asset.Liquidity), blockHash.String(), nil
+}
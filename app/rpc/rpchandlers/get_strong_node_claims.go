@@ -0,0 +1,26 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetStrongNodeClaims handles the respectively named RPC command
+func HandleGetStrongNodeClaims(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getStrongNodeClaimsRequest := request.(*appmessage.GetStrongNodeClaimsRequestMessage)
+
+	response, err := context.StrongNodeClaims(getStrongNodeClaimsRequest.IncludeWinningClaims,
+		getStrongNodeClaimsRequest.IncludePendingClaims)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if !errors.As(err, &rpcError) {
+			return nil, err
+		}
+		errorMessage := &appmessage.GetStrongNodeClaimsResponseMessage{}
+		errorMessage.Error = rpcError
+		return errorMessage, nil
+	}
+	return response, nil
+}
@@ -0,0 +1,69 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/transactionid"
+	miningmanagermodel "github.com/cryptix-network/cryptixd/domain/miningmanager/model"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetTransactionStatus handles the respectively named RPC command
+func HandleGetTransactionStatus(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getTransactionStatusRequest := request.(*appmessage.GetTransactionStatusRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionStatusRequest.TransactionID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	mempoolStatus, err := context.Domain.MiningManager().TransactionStatus(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	switch mempoolStatus.State {
+	case miningmanagermodel.TransactionStateInMempool:
+		response := appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusInMempool)
+		response.FeeRate = mempoolStatus.FeeRate
+		response.PackageFeeRate = mempoolStatus.PackageFeeRate
+		response.FeeRateRank = mempoolStatus.FeeRateRank
+		response.MempoolTransactionCount = mempoolStatus.TransactionCount
+		response.AtomicSlots = mempoolStatus.AtomicSlots
+		return response, nil
+	case miningmanagermodel.TransactionStateOrphan:
+		response := appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusOrphan)
+		response.MissingOutpoints = make([]*appmessage.RPCOutpoint, len(mempoolStatus.MissingOutpoints))
+		for i, outpoint := range mempoolStatus.MissingOutpoints {
+			response.MissingOutpoints[i] = &appmessage.RPCOutpoint{
+				TransactionID: outpoint.TransactionID.String(),
+				Index:         outpoint.Index,
+			}
+		}
+		return response, nil
+	}
+
+	// A transaction that was accepted by the DAG is removed from the mempool, and may also
+	// have been rejected by it when it was resubmitted, so acceptance is checked before
+	// the recent rejects
+	acceptingBlockHash, confirmations, isAccepted, err := context.TransactionAcceptance(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if isAccepted {
+		response := appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusAccepted)
+		response.AcceptingBlockHash = acceptingBlockHash.String()
+		response.Confirmations = confirmations
+		return response, nil
+	}
+
+	if mempoolStatus.State == miningmanagermodel.TransactionStateRejected {
+		response := appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusRejected)
+		response.RejectCode = mempoolStatus.RejectCode
+		response.RejectReason = mempoolStatus.RejectReason
+		response.RejectedAtMs = mempoolStatus.RejectedAtMs
+		return response, nil
+	}
+	return appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusUnknown), nil
+}
@@ -0,0 +1,123 @@
+package rpchandlers_test
+
+import (
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpchandlers"
+	"github.com/cryptix-network/cryptixd/domain/consensus"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/testutils"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/transactionhelper"
+	"github.com/cryptix-network/cryptixd/domain/consensusreference"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool"
+	"github.com/cryptix-network/cryptixd/infrastructure/config"
+)
+
+type transactionStatusTestDomain struct {
+	fakeDomain
+	miningManager miningmanager.MiningManager
+}
+
+func (d transactionStatusTestDomain) MiningManager() miningmanager.MiningManager {
+	return d.miningManager
+}
+
+func TestHandleGetTransactionStatusAcceptance(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestHandleGetTransactionStatusAcceptance")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningmanager.NewFactory().NewMiningManager(consensusReference, &consensusConfig.Params,
+			mempool.DefaultConfig(&consensusConfig.Params))
+
+		fakeContext := rpccontext.Context{
+			Config: &config.Config{Flags: &config.Flags{
+				NetworkFlags:            config.NetworkFlags{ActiveNetParams: &consensusConfig.Params},
+				TxStatusAcceptanceDepth: 100,
+			}},
+			Domain: transactionStatusTestDomain{fakeDomain: fakeDomain{tc}, miningManager: miningManager},
+		}
+
+		addBlock := func(parentHashes []*externalapi.DomainHash, transactions []*externalapi.DomainTransaction) *externalapi.DomainHash {
+			blockHash, _, err := tc.AddBlock(parentHashes, nil, transactions)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			return blockHash
+		}
+
+		// Build the following DAG:
+		// G <- A <- B <- C <- D
+		//             <- E <- F <- H
+		// Where block C has a transaction spending the coinbase of block B,
+		// which is accepted by block D until the chain of block H overtakes it
+		blockAHash := addBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash}, nil)
+		blockBHash := addBlock([]*externalapi.DomainHash{blockAHash}, nil)
+		blockB, _, err := tc.GetBlock(blockBHash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		transaction, err := testutils.CreateTransaction(blockB.Transactions[transactionhelper.CoinbaseTransactionIndex], 1)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		transactionID := consensushashing.TransactionID(transaction)
+
+		getTransactionStatus := func() *appmessage.GetTransactionStatusResponseMessage {
+			request := appmessage.NewGetTransactionStatusRequestMessage(transactionID.String())
+			response, err := rpchandlers.HandleGetTransactionStatus(&fakeContext, nil, request)
+			if err != nil {
+				t.Fatalf("HandleGetTransactionStatus: %+v", err)
+			}
+			return response.(*appmessage.GetTransactionStatusResponseMessage)
+		}
+
+		blockCHash := addBlock([]*externalapi.DomainHash{blockBHash}, []*externalapi.DomainTransaction{transaction})
+		if status := getTransactionStatus().Status; status != appmessage.TransactionStatusUnknown {
+			t.Fatalf("expected a transaction not yet accepted by a chain block to be %s, got %s",
+				appmessage.TransactionStatusUnknown, status)
+		}
+
+		blockDHash := addBlock([]*externalapi.DomainHash{blockCHash}, nil)
+		response := getTransactionStatus()
+		if response.Status != appmessage.TransactionStatusAccepted {
+			t.Fatalf("expected the transaction to be %s, got %s", appmessage.TransactionStatusAccepted, response.Status)
+		}
+		if response.AcceptingBlockHash != blockDHash.String() {
+			t.Fatalf("expected the transaction to be accepted by %s, got %s", blockDHash, response.AcceptingBlockHash)
+		}
+		if response.Confirmations != 1 {
+			t.Fatalf("expected 1 confirmation, got %d", response.Confirmations)
+		}
+
+		blockEHash := addBlock([]*externalapi.DomainHash{blockBHash}, nil)
+		blockFHash := addBlock([]*externalapi.DomainHash{blockEHash}, nil)
+		blockHHash := addBlock([]*externalapi.DomainHash{blockFHash}, nil)
+		virtualSelectedParent, err := tc.GetVirtualSelectedParent()
+		if err != nil {
+			t.Fatalf("GetVirtualSelectedParent: %+v", err)
+		}
+		if !virtualSelectedParent.Equal(blockHHash) {
+			t.Fatalf("expected the virtual selected parent to be %s, got %s", blockHHash, virtualSelectedParent)
+		}
+
+		response = getTransactionStatus()
+		if response.Status != appmessage.TransactionStatusUnknown {
+			t.Fatalf("expected a transaction whose accepting block was reorged out to be %s, got %s",
+				appmessage.TransactionStatusUnknown, response.Status)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/addressmanager"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/cryptix-network/cryptixd/util/mstime"
+)
+
+// HandleListBans handles the respectively named RPC command
+func HandleListBans(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	bannedAddresses := context.AddressManager.BannedAddresses()
+	bannedIPs := make([]*appmessage.RPCBannedIP, 0, len(bannedAddresses))
+	for _, bannedAddress := range bannedAddresses {
+		// Expired IP bans are only removed once the address is checked again
+		if mstime.Since(bannedAddress.Timestamp) > addressmanager.MaxBanTime {
+			continue
+		}
+		bannedIPs = append(bannedIPs, &appmessage.RPCBannedIP{
+			IP:          bannedAddress.IP.String(),
+			BannedAtMs:  bannedAddress.Timestamp.UnixMilliseconds(),
+			ExpiresAtMs: bannedAddress.Timestamp.Add(addressmanager.MaxBanTime).UnixMilliseconds(),
+		})
+	}
+
+	bannedNodeIDs, err := context.AddressManager.BannedNodeIDs()
+	if err != nil {
+		return nil, err
+	}
+	rpcBannedNodeIDs := make([]*appmessage.RPCBannedNodeID, len(bannedNodeIDs))
+	for i, bannedNodeID := range bannedNodeIDs {
+		rpcBannedNodeIDs[i] = &appmessage.RPCBannedNodeID{
+			NodeID:     hex.EncodeToString(bannedNodeID.NodeID[:]),
+			Reason:     bannedNodeID.Reason,
+			BannedAtMs: bannedNodeID.BannedAt.UnixMilliseconds(),
+		}
+		if !bannedNodeID.NeverExpires() {
+			rpcBannedNodeIDs[i].ExpiresAtMs = bannedNodeID.ExpiresAt.UnixMilliseconds()
+		}
+	}
+
+	return appmessage.NewListBansResponseMessage(bannedIPs, rpcBannedNodeIDs), nil
+}
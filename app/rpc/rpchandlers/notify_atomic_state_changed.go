@@ -0,0 +1,50 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleNotifyAtomicStateChanged handles the respectively named RPC command
+func HandleNotifyAtomicStateChanged(context *rpccontext.Context, router *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	notifyAtomicStateChangedRequest := request.(*appmessage.NotifyAtomicStateChangedRequestMessage)
+
+	ownerIDs, err := normalizeAtomicIDs("owner ID", notifyAtomicStateChangedRequest.OwnerIDs)
+	if err != nil {
+		errorMessage := appmessage.NewNotifyAtomicStateChangedResponseMessage()
+		errorMessage.Error = appmessage.RPCErrorf("Parsing error: %s", err)
+		return errorMessage, nil
+	}
+	assetIDs, err := normalizeAtomicIDs("asset ID", notifyAtomicStateChangedRequest.AssetIDs)
+	if err != nil {
+		errorMessage := appmessage.NewNotifyAtomicStateChangedResponseMessage()
+		errorMessage.Error = appmessage.RPCErrorf("Parsing error: %s", err)
+		return errorMessage, nil
+	}
+
+	listener, err := context.NotificationManager.Listener(router)
+	if err != nil {
+		return nil, err
+	}
+	context.NotificationManager.PropagateAtomicStateChangedNotifications(listener, ownerIDs, assetIDs)
+
+	response := appmessage.NewNotifyAtomicStateChangedResponseMessage()
+	return response, nil
+}
+
+// normalizeAtomicIDs validates the given hex-encoded Atomic IDs and returns
+// them in the lowercase form used by RPC events
+func normalizeAtomicIDs(name string, idStrings []string) ([]string, error) {
+	ids := make([]string, len(idStrings))
+	for i, idString := range idStrings {
+		id, err := rpccontext.ParseAtomicID(name, idString)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = hex.EncodeToString(id[:])
+	}
+	return ids, nil
+}
@@ -0,0 +1,60 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleSimulateAtomicTransaction handles the respectively named RPC command
+func HandleSimulateAtomicTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	simulateAtomicTransactionRequest := request.(*appmessage.SimulateAtomicTransactionRequestMessage)
+
+	domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(simulateAtomicTransactionRequest.Transaction)
+	if err != nil {
+		errorMessage := &appmessage.SimulateAtomicTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	transactionID := consensushashing.TransactionID(domainTransaction)
+	response := appmessage.NewSimulateAtomicTransactionResponseMessage(transactionID.String())
+
+	// Validation populates the transaction with its UTXO entries and fee before
+	// checking scripts, so unsigned transactions can still be simulated
+	err = context.Domain.Consensus().ValidateTransactionAndPopulateWithConsensusData(domainTransaction)
+	if err != nil {
+		if !errors.As(err, &ruleerrors.RuleError{}) {
+			return nil, err
+		}
+		response.ValidationError = err.Error()
+	}
+	response.Fee = domainTransaction.Fee
+	for _, input := range domainTransaction.Inputs {
+		if input.UTXOEntry == nil {
+			return response, nil
+		}
+	}
+
+	simulation, daaScore, err := context.SimulateAtomicTransaction(domainTransaction)
+	if err != nil {
+		rpcError := &appmessage.RPCError{}
+		if errors.As(err, &rpcError) {
+			response.Error = rpcError
+			return response, nil
+		}
+		if !errors.As(err, &ruleerrors.RuleError{}) {
+			return nil, err
+		}
+		response.DAAScore = daaScore
+		response.AtomicError = err.Error()
+		return response, nil
+	}
+
+	response.DAAScore = daaScore
+	context.PopulateSimulateAtomicTransactionResponse(response, simulation)
+	return response, nil
+}
@@ -0,0 +1,19 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleStopNotifyingAtomicStateChanged handles the respectively named RPC command
+func HandleStopNotifyingAtomicStateChanged(context *rpccontext.Context, router *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	listener, err := context.NotificationManager.Listener(router)
+	if err != nil {
+		return nil, err
+	}
+	context.NotificationManager.StopPropagatingAtomicStateChangedNotifications(listener)
+
+	response := appmessage.NewStopNotifyingAtomicStateChangedResponseMessage()
+	return response, nil
+}
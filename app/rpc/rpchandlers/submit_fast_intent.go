@@ -0,0 +1,45 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/protocol/fastintents"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleSubmitFastIntent handles the respectively named RPC command
+func HandleSubmitFastIntent(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	submitFastIntentRequest := request.(*appmessage.SubmitFastIntentRequestMessage)
+
+	domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(submitFastIntentRequest.Transaction)
+	if err != nil {
+		errorMessage := &appmessage.SubmitFastIntentResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	transactionID := consensushashing.TransactionID(domainTransaction)
+	intentID, err := context.ProtocolManager.Context().SubmitFastIntent(domainTransaction,
+		submitFastIntentRequest.IntentNonce, submitFastIntentRequest.ClientCreatedAtMs, submitFastIntentRequest.MaxFee)
+	if err != nil {
+		if !isFastIntentRejection(err) {
+			return nil, err
+		}
+
+		log.Debugf("Rejected fast intent for transaction %s: %s", transactionID, err)
+		errorMessage := appmessage.NewSubmitFastIntentResponseMessage("", transactionID.String())
+		errorMessage.Error = appmessage.RPCErrorf("Rejected fast intent for transaction %s: %s", transactionID, err)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewSubmitFastIntentResponseMessage(intentID.String(), transactionID.String()), nil
+}
+
+func isFastIntentRejection(err error) bool {
+	return errors.Is(err, fastintents.ErrInvalidIntent) || errors.Is(err, fastintents.ErrStaleIntent) ||
+		errors.Is(err, fastintents.ErrIntentAlreadyKnown) || errors.Is(err, fastintents.ErrIntentConflict) ||
+		errors.Is(err, fastintents.ErrPoolFull) || errors.As(err, &ruleerrors.RuleError{})
+}
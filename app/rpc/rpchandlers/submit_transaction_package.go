@@ -0,0 +1,51 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleSubmitTransactionPackage handles the respectively named RPC command
+func HandleSubmitTransactionPackage(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	submitTransactionPackageRequest := request.(*appmessage.SubmitTransactionPackageRequestMessage)
+
+	if len(submitTransactionPackageRequest.Transactions) > appmessage.MaxTransactionPackageTransactions {
+		errorMessage := &appmessage.SubmitTransactionPackageResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction package has %d transactions, which is more than the maximum of %d",
+			len(submitTransactionPackageRequest.Transactions), appmessage.MaxTransactionPackageTransactions)
+		return errorMessage, nil
+	}
+
+	domainTransactions := make([]*externalapi.DomainTransaction, len(submitTransactionPackageRequest.Transactions))
+	transactionIDs := make([]string, len(submitTransactionPackageRequest.Transactions))
+	for i, rpcTransaction := range submitTransactionPackageRequest.Transactions {
+		domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(rpcTransaction)
+		if err != nil {
+			errorMessage := &appmessage.SubmitTransactionPackageResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction #%d: %s", i, err)
+			return errorMessage, nil
+		}
+		domainTransactions[i] = domainTransaction
+		transactionIDs[i] = consensushashing.TransactionID(domainTransaction).String()
+	}
+
+	err := context.ProtocolManager.AddTransactionPackage(domainTransactions)
+	if err != nil {
+		if !errors.As(err, &mempool.RuleError{}) {
+			return nil, err
+		}
+
+		log.Debugf("Rejected transaction package %s: %s", transactionIDs, err)
+		// Return the IDs also in the case of error, so that clients can match the response to the correct package submit request
+		errorMessage := appmessage.NewSubmitTransactionPackageResponseMessage(transactionIDs)
+		errorMessage.Error = appmessage.RPCErrorf("Rejected transaction package: %s", err)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewSubmitTransactionPackageResponseMessage(transactionIDs), nil
+}
@@ -0,0 +1,34 @@
+package rpchandlers
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/app/rpc/rpccontext"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+)
+
+// HandleUnbanNodeID handles the respectively named RPC command
+func HandleUnbanNodeID(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("UnbanNodeID RPC command called while node in safe RPC mode -- ignoring.")
+		response := appmessage.NewUnbanNodeIDResponseMessage()
+		response.Error =
+			appmessage.RPCErrorf("UnbanNodeID RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	unbanNodeIDRequest := request.(*appmessage.UnbanNodeIDRequestMessage)
+	nodeID, err := parseUnifiedNodeID(unbanNodeIDRequest.NodeID)
+	if err != nil {
+		errorMessage := &appmessage.UnbanNodeIDResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse node ID: %s", err)
+		return errorMessage, nil
+	}
+	err = context.AddressManager.UnbanNodeID(nodeID)
+	if err != nil {
+		errorMessage := &appmessage.UnbanNodeIDResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not unban node ID: %s", err)
+		return errorMessage, nil
+	}
+	response := appmessage.NewUnbanNodeIDResponseMessage()
+	return response, nil
+}
@@ -0,0 +1,302 @@
+package snapshot
+
+import (
+	"io"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+const (
+	// atomicStateChunkSize is the size of the chunks the pruning point
+	// Atomic state is written in
+	atomicStateChunkSize = 4 * 1024 * 1024
+
+	// headersBatchSize is the number of pruning point future headers written
+	// per record. It must be >= MergeSetSizeLimit + 1, see GetHashesBetween.
+	headersBatchSize = 1 << 10
+
+	// utxoSetChunkSize is the number of pruning point UTXOs written per
+	// record
+	utxoSetChunkSize = 1000
+)
+
+// Export writes a snapshot of the pruning point of the given consensus to w:
+// the pruning point proof, the past pruning points, the pruning point and its
+// anticone with their trusted data, the pruning point Atomic state, the
+// headers in the future of the pruning point, and the pruning point UTXO set.
+// The consensus must not change while it's being exported.
+func Export(consensus externalapi.Consensus, params *dagconfig.Params, w io.Writer) error {
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return err
+	}
+	if pruningPoint.Equal(params.GenesisHash) {
+		return errors.New("the pruning point is still the genesis, so there's nothing to export")
+	}
+	log.Infof("Exporting a snapshot of pruning point %s", pruningPoint)
+
+	snapshotWriter, err := newWriter(w, params.GenesisHash)
+	if err != nil {
+		return err
+	}
+
+	pruningPointProof, err := consensus.BuildPruningPointProof()
+	if err != nil {
+		return err
+	}
+	err = snapshotWriter.writeMessage(appmessage.DomainPruningPointProofToMsgPruningPointProof(pruningPointProof))
+	if err != nil {
+		return err
+	}
+
+	pruningPointHeaders, err := consensus.PruningPointHeaders()
+	if err != nil {
+		return err
+	}
+	msgPruningPointHeaders := make([]*appmessage.MsgBlockHeader, len(pruningPointHeaders))
+	for i, header := range pruningPointHeaders {
+		msgPruningPointHeaders[i] = appmessage.DomainBlockHeaderToBlockHeader(header)
+	}
+	err = snapshotWriter.writeMessage(appmessage.NewMsgPruningPoints(msgPruningPointHeaders))
+	if err != nil {
+		return err
+	}
+
+	err = exportPruningPointAndItsAnticone(consensus, params, snapshotWriter)
+	if err != nil {
+		return err
+	}
+
+	err = exportPruningPointFutureHeaders(consensus, pruningPoint, snapshotWriter)
+	if err != nil {
+		return err
+	}
+
+	err = exportPruningPointUTXOSet(consensus, pruningPoint, snapshotWriter)
+	if err != nil {
+		return err
+	}
+
+	return snapshotWriter.flush()
+}
+
+func exportPruningPointAndItsAnticone(consensus externalapi.Consensus, params *dagconfig.Params,
+	snapshotWriter *writer) error {
+
+	pointAndItsAnticone, err := consensus.PruningPointAndItsAnticone()
+	if err != nil {
+		return err
+	}
+	if len(pointAndItsAnticone) == 0 {
+		return errors.New("pruning point and its anticone is empty")
+	}
+
+	windowSize := params.DifficultyAdjustmentWindowSize
+	daaWindowBlocks := make([]*externalapi.TrustedDataDataDAAHeader, 0, windowSize)
+	daaWindowHashesToIndex := make(map[externalapi.DomainHash]int, windowSize)
+	trustedDataDAABlockIndexes := make(map[externalapi.DomainHash][]uint64)
+
+	ghostdagData := make([]*externalapi.BlockGHOSTDAGDataHashPair, 0)
+	ghostdagDataHashToIndex := make(map[externalapi.DomainHash]int)
+	trustedDataGHOSTDAGDataIndexes := make(map[externalapi.DomainHash][]uint64)
+	for _, blockHash := range pointAndItsAnticone {
+		blockDAAWindowHashes, err := consensus.BlockDAAWindowHashes(blockHash)
+		if err != nil {
+			return err
+		}
+
+		trustedDataDAABlockIndexes[*blockHash] = make([]uint64, 0, windowSize)
+		for i, daaBlockHash := range blockDAAWindowHashes {
+			index, exists := daaWindowHashesToIndex[*daaBlockHash]
+			if !exists {
+				trustedDataDataDAAHeader, err := consensus.TrustedDataDataDAAHeader(blockHash, daaBlockHash, uint64(i))
+				if err != nil {
+					return err
+				}
+				daaWindowBlocks = append(daaWindowBlocks, trustedDataDataDAAHeader)
+				index = len(daaWindowBlocks) - 1
+				daaWindowHashesToIndex[*daaBlockHash] = index
+			}
+
+			trustedDataDAABlockIndexes[*blockHash] = append(trustedDataDAABlockIndexes[*blockHash], uint64(index))
+		}
+
+		ghostdagDataBlockHashes, err := consensus.TrustedBlockAssociatedGHOSTDAGDataBlockHashes(blockHash)
+		if err != nil {
+			return err
+		}
+
+		trustedDataGHOSTDAGDataIndexes[*blockHash] = make([]uint64, 0, params.K)
+		for _, ghostdagDataBlockHash := range ghostdagDataBlockHashes {
+			index, exists := ghostdagDataHashToIndex[*ghostdagDataBlockHash]
+			if !exists {
+				data, err := consensus.TrustedGHOSTDAGData(ghostdagDataBlockHash)
+				if err != nil {
+					return err
+				}
+				ghostdagData = append(ghostdagData, &externalapi.BlockGHOSTDAGDataHashPair{
+					Hash:         ghostdagDataBlockHash,
+					GHOSTDAGData: data,
+				})
+				index = len(ghostdagData) - 1
+				ghostdagDataHashToIndex[*ghostdagDataBlockHash] = index
+			}
+
+			trustedDataGHOSTDAGDataIndexes[*blockHash] = append(trustedDataGHOSTDAGDataIndexes[*blockHash], uint64(index))
+		}
+	}
+
+	msgTrustedData := appmessage.DomainTrustedDataToTrustedData(daaWindowBlocks, ghostdagData)
+	atomicStateBytes, atomicStateHash, hasAtomicState, err := pruningPointAtomicState(consensus, params, pointAndItsAnticone[0])
+	if err != nil {
+		return err
+	}
+	if hasAtomicState {
+		msgTrustedData.AtomicConsensusStateHash = append([]byte(nil), atomicStateHash[:]...)
+		msgTrustedData.AtomicConsensusStateByteLength = uint64(len(atomicStateBytes))
+		msgTrustedData.AtomicConsensusStateChunkCount = atomicStateChunkCount(uint64(len(atomicStateBytes)))
+	}
+	err = snapshotWriter.writeMessage(msgTrustedData)
+	if err != nil {
+		return err
+	}
+
+	for chunkIndex, offset := uint64(0), 0; offset < len(atomicStateBytes); chunkIndex++ {
+		chunkEnd := offset + atomicStateChunkSize
+		if chunkEnd > len(atomicStateBytes) {
+			chunkEnd = len(atomicStateBytes)
+		}
+		err = snapshotWriter.writeMessage(appmessage.NewMsgTrustedAtomicStateChunk(atomicStateHash[:], chunkIndex,
+			msgTrustedData.AtomicConsensusStateChunkCount, msgTrustedData.AtomicConsensusStateByteLength,
+			atomicStateBytes[offset:chunkEnd]))
+		if err != nil {
+			return err
+		}
+		offset = chunkEnd
+	}
+
+	for _, blockHash := range pointAndItsAnticone {
+		block, found, err := consensus.GetBlock(blockHash)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errors.Errorf("pruning point anticone block %s not found", blockHash)
+		}
+
+		err = snapshotWriter.writeMessage(appmessage.DomainBlockWithTrustedDataToBlockWithTrustedDataV4(
+			block, trustedDataDAABlockIndexes[*blockHash], trustedDataGHOSTDAGDataIndexes[*blockHash]))
+		if err != nil {
+			return err
+		}
+	}
+	log.Infof("Exported the pruning point and %d blocks of its anticone", len(pointAndItsAnticone)-1)
+
+	return snapshotWriter.writeMessage(appmessage.NewMsgDoneBlocksWithTrustedData())
+}
+
+// pruningPointAtomicState returns the Atomic state of the given pruning
+// point, which is only carried in trusted data past the payload hard fork.
+// Before it, the importer reconstructs the state out of the UTXO set.
+func pruningPointAtomicState(consensus externalapi.Consensus, params *dagconfig.Params,
+	pruningPoint *externalapi.DomainHash) ([]byte, [externalapi.DomainHashSize]byte, bool, error) {
+
+	header, err := consensus.GetBlockHeader(pruningPoint)
+	if err != nil {
+		return nil, [externalapi.DomainHashSize]byte{}, false, err
+	}
+	if header.DAAScore() < params.PayloadHfActivationDAAScore {
+		return nil, [externalapi.DomainHashSize]byte{}, false, nil
+	}
+
+	atomicStateBytes, err := consensus.GetPruningPointAtomicState(pruningPoint)
+	if err != nil {
+		return nil, [externalapi.DomainHashSize]byte{}, false, err
+	}
+	atomicState, err := atomicstate.FromCanonicalBytes(atomicStateBytes)
+	if err != nil {
+		return nil, [externalapi.DomainHashSize]byte{}, false, err
+	}
+	if atomicState.IsRootOnly() {
+		return nil, [externalapi.DomainHashSize]byte{}, false,
+			errors.Errorf("post-payload-HF pruning point %s has only an Atomic root; full Atomic state bytes are required", pruningPoint)
+	}
+
+	return atomicStateBytes, atomicState.CanonicalHash(), true, nil
+}
+
+func atomicStateChunkCount(totalBytes uint64) uint64 {
+	return (totalBytes + atomicStateChunkSize - 1) / atomicStateChunkSize
+}
+
+func exportPruningPointFutureHeaders(consensus externalapi.Consensus, pruningPoint *externalapi.DomainHash,
+	snapshotWriter *writer) error {
+
+	headersSelectedTip, err := consensus.GetHeadersSelectedTip()
+	if err != nil {
+		return err
+	}
+
+	headerCount := 0
+	for lowHash := pruningPoint; !lowHash.Equal(headersSelectedTip); {
+		blockHashes, _, err := consensus.GetHashesBetween(lowHash, headersSelectedTip, headersBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(blockHashes) == 0 {
+			return errors.Errorf("got no hashes between %s and %s", lowHash, headersSelectedTip)
+		}
+
+		blockHeaders := make([]*appmessage.MsgBlockHeader, len(blockHashes))
+		for i, blockHash := range blockHashes {
+			blockHeader, err := consensus.GetBlockHeader(blockHash)
+			if err != nil {
+				return err
+			}
+			blockHeaders[i] = appmessage.DomainBlockHeaderToBlockHeader(blockHeader)
+		}
+		err = snapshotWriter.writeMessage(appmessage.NewBlockHeadersMessage(blockHeaders))
+		if err != nil {
+			return err
+		}
+
+		headerCount += len(blockHashes)
+		lowHash = blockHashes[len(blockHashes)-1]
+	}
+	log.Infof("Exported %d headers up to the headers selected tip %s", headerCount, headersSelectedTip)
+
+	return snapshotWriter.writeMessage(appmessage.NewMsgDoneHeaders())
+}
+
+func exportPruningPointUTXOSet(consensus externalapi.Consensus, pruningPoint *externalapi.DomainHash,
+	snapshotWriter *writer) error {
+
+	var fromOutpoint *externalapi.DomainOutpoint
+	utxoCount := 0
+	for {
+		pruningPointUTXOs, err := consensus.GetPruningPointUTXOs(pruningPoint, fromOutpoint, utxoSetChunkSize)
+		if err != nil {
+			return err
+		}
+		if len(pruningPointUTXOs) > 0 {
+			err = snapshotWriter.writeMessage(appmessage.NewMsgPruningPointUTXOSetChunk(
+				appmessage.DomainOutpointAndUTXOEntryPairsToOutpointAndUTXOEntryPairs(pruningPointUTXOs)))
+			if err != nil {
+				return err
+			}
+			fromOutpoint = pruningPointUTXOs[len(pruningPointUTXOs)-1].Outpoint
+			utxoCount += len(pruningPointUTXOs)
+		}
+
+		if len(pruningPointUTXOs) < utxoSetChunkSize {
+			break
+		}
+	}
+	log.Infof("Exported %d pruning point UTXOs", utxoCount)
+
+	return snapshotWriter.writeMessage(appmessage.NewMsgDonePruningPointUTXOSetChunks())
+}
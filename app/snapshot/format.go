@@ -0,0 +1,146 @@
+// Package snapshot exports the pruning point of a node to a file, and imports
+// such a file into a fresh node, so that many nodes can be bootstrapped out of
+// a single synced one without going through IBD with a headers proof each.
+package snapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// A snapshot file starts with a header made of fileMagic, the file format
+// version and the genesis hash of the network it belongs to. It's followed by
+// a sequence of records, each a little-endian uint32 length followed by a
+// protowire.CryptixdMessage, in the same order a syncer sends them during IBD
+// with a headers proof.
+var fileMagic = [8]byte{'C', 'R', 'Y', 'X', 'S', 'N', 'A', 'P'}
+
+const (
+	fileVersion = uint32(1)
+
+	// maxRecordSize bounds the memory a corrupted record length can make the
+	// importer allocate
+	maxRecordSize = 256 << 20
+)
+
+type writer struct {
+	writer *bufio.Writer
+}
+
+func newWriter(w io.Writer, genesisHash *externalapi.DomainHash) (*writer, error) {
+	snapshotWriter := &writer{writer: bufio.NewWriter(w)}
+
+	_, err := snapshotWriter.writer.Write(fileMagic[:])
+	if err != nil {
+		return nil, err
+	}
+	err = binary.Write(snapshotWriter.writer, binary.LittleEndian, fileVersion)
+	if err != nil {
+		return nil, err
+	}
+	_, err = snapshotWriter.writer.Write(genesisHash.ByteSlice())
+	if err != nil {
+		return nil, err
+	}
+	return snapshotWriter, nil
+}
+
+func (w *writer) writeMessage(message appmessage.Message) error {
+	protoMessage, err := protowire.FromAppMessage(message)
+	if err != nil {
+		return err
+	}
+	messageBytes, err := proto.Marshal(protoMessage)
+	if err != nil {
+		return err
+	}
+	if len(messageBytes) > maxRecordSize {
+		return errors.Errorf("%s record of %d bytes is larger than the maximum of %d",
+			message.Command(), len(messageBytes), maxRecordSize)
+	}
+
+	err = binary.Write(w.writer, binary.LittleEndian, uint32(len(messageBytes)))
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(messageBytes)
+	return err
+}
+
+func (w *writer) flush() error {
+	return w.writer.Flush()
+}
+
+type reader struct {
+	reader *bufio.Reader
+}
+
+func newReader(r io.Reader, genesisHash *externalapi.DomainHash) (*reader, error) {
+	snapshotReader := &reader{reader: bufio.NewReader(r)}
+
+	var magic [len(fileMagic)]byte
+	_, err := io.ReadFull(snapshotReader.reader, magic[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the snapshot header")
+	}
+	if magic != fileMagic {
+		return nil, errors.New("not a snapshot file")
+	}
+
+	var version uint32
+	err = binary.Read(snapshotReader.reader, binary.LittleEndian, &version)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the snapshot header")
+	}
+	if version != fileVersion {
+		return nil, errors.Errorf("unsupported snapshot version %d. Expected version: %d", version, fileVersion)
+	}
+
+	var snapshotGenesisHash [externalapi.DomainHashSize]byte
+	_, err = io.ReadFull(snapshotReader.reader, snapshotGenesisHash[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the snapshot header")
+	}
+	if !externalapi.NewDomainHashFromByteArray(&snapshotGenesisHash).Equal(genesisHash) {
+		return nil, errors.Errorf("the snapshot belongs to a network with genesis %x, not %s",
+			snapshotGenesisHash, genesisHash)
+	}
+	return snapshotReader, nil
+}
+
+func (r *reader) readMessage() (appmessage.Message, error) {
+	var length uint32
+	err := binary.Read(r.reader, binary.LittleEndian, &length)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errors.New("the snapshot ended unexpectedly")
+		}
+		return nil, err
+	}
+	if length > maxRecordSize {
+		return nil, errors.Errorf("snapshot record of %d bytes is larger than the maximum of %d", length, maxRecordSize)
+	}
+
+	messageBytes := make([]byte, length)
+	_, err = io.ReadFull(r.reader, messageBytes)
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errors.New("the snapshot ended unexpectedly")
+		}
+		return nil, err
+	}
+
+	protoMessage := &protowire.CryptixdMessage{}
+	err = proto.Unmarshal(messageBytes, protoMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed snapshot record")
+	}
+	return protoMessage.ToAppMessage()
+}
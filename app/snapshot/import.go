@@ -0,0 +1,403 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+// maxAtomicStateBytes is the largest pruning point Atomic state a snapshot
+// may carry, the same as during IBD
+const maxAtomicStateBytes uint64 = 128 << 30
+
+// Import reads a snapshot written by Export out of r and imports it into a
+// staging consensus of the given domain, which then replaces its active
+// consensus. The snapshot is validated the same way IBD with a headers proof
+// validates the data it downloads: the pruning point proof is checked against
+// the active consensus, every header against its parents, and the pruning
+// point UTXO set and Atomic state against the commitments of the pruning
+// point header. Nothing is committed if any of it is invalid.
+//
+// The node then downloads the block bodies above the pruning point from its
+// peers, as it does after IBD with a headers proof.
+func Import(domainInstance domain.Domain, params *dagconfig.Params, r io.Reader) error {
+	snapshotReader, err := newReader(r, params.GenesisHash)
+	if err != nil {
+		return err
+	}
+
+	err = domainInstance.InitStagingConsensusWithoutGenesis()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := importSnapshot(domainInstance, params, snapshotReader)
+	if err != nil {
+		deleteStagingConsensusErr := domainInstance.DeleteStagingConsensus()
+		if deleteStagingConsensusErr != nil {
+			return deleteStagingConsensusErr
+		}
+		return err
+	}
+
+	err = domainInstance.CommitStagingConsensus()
+	if err != nil {
+		return err
+	}
+	log.Infof("Imported the snapshot of pruning point %s", pruningPoint)
+	return nil
+}
+
+func importSnapshot(domainInstance domain.Domain, params *dagconfig.Params,
+	snapshotReader *reader) (*externalapi.DomainHash, error) {
+
+	stagingConsensus := domainInstance.StagingConsensus()
+
+	proofPruningPoint, proofPruningPointDAAScore, err := importPruningPointProof(domainInstance, snapshotReader)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Validated the pruning point proof of pruning point %s", proofPruningPoint)
+
+	err = importPruningPoints(domainInstance, proofPruningPoint, snapshotReader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = importPruningPointAndItsAnticone(stagingConsensus, params, proofPruningPoint, proofPruningPointDAAScore, snapshotReader)
+	if err != nil {
+		return nil, err
+	}
+
+	if proofPruningPoint.Equal(params.GenesisHash) {
+		return nil, errors.New("the genesis pruning point violates finality")
+	}
+
+	err = importPruningPointFutureHeaders(stagingConsensus, snapshotReader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = importPruningPointUTXOSet(stagingConsensus, proofPruningPoint, snapshotReader)
+	if err != nil {
+		return nil, err
+	}
+
+	return proofPruningPoint, nil
+}
+
+func importPruningPointProof(domainInstance domain.Domain, snapshotReader *reader) (*externalapi.DomainHash, uint64, error) {
+	message, err := snapshotReader.readMessage()
+	if err != nil {
+		return nil, 0, err
+	}
+	pruningPointProofMessage, ok := message.(*appmessage.MsgPruningPointProof)
+	if !ok {
+		return nil, 0, unexpectedMessageError(appmessage.CmdPruningPointProof, message)
+	}
+	pruningPointProof := appmessage.MsgPruningPointProofToDomainPruningPointProof(pruningPointProofMessage)
+	if len(pruningPointProof.Headers) == 0 || len(pruningPointProof.Headers[0]) == 0 {
+		return nil, 0, errors.New("the snapshot pruning point proof is empty")
+	}
+
+	err = domainInstance.Consensus().ValidatePruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "pruning point proof validation failed")
+	}
+
+	proofPruningPointHeader := pruningPointProof.Headers[0][len(pruningPointProof.Headers[0])-1]
+	proofPruningPoint := consensushashing.HeaderHash(proofPruningPointHeader)
+	currentPruningPoint, err := domainInstance.Consensus().PruningPoint()
+	if err != nil {
+		return nil, 0, err
+	}
+	if currentPruningPoint.Equal(proofPruningPoint) {
+		return nil, 0, errors.Errorf("the node is already at the snapshot pruning point %s", proofPruningPoint)
+	}
+
+	err = domainInstance.StagingConsensus().ApplyPruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return proofPruningPoint, proofPruningPointHeader.DAAScore(), nil
+}
+
+func importPruningPoints(domainInstance domain.Domain, proofPruningPoint *externalapi.DomainHash,
+	snapshotReader *reader) error {
+
+	message, err := snapshotReader.readMessage()
+	if err != nil {
+		return err
+	}
+	msgPruningPoints, ok := message.(*appmessage.MsgPruningPoints)
+	if !ok {
+		return unexpectedMessageError(appmessage.CmdPruningPoints, message)
+	}
+	if len(msgPruningPoints.Headers) == 0 {
+		return errors.New("the snapshot has no pruning points")
+	}
+
+	headers := make([]externalapi.BlockHeader, len(msgPruningPoints.Headers))
+	for i, header := range msgPruningPoints.Headers {
+		headers[i] = appmessage.BlockHeaderToDomainBlockHeader(header)
+	}
+
+	arePruningPointsViolatingFinality, err := domainInstance.Consensus().ArePruningPointsViolatingFinality(headers)
+	if err != nil {
+		return err
+	}
+	if arePruningPointsViolatingFinality {
+		return errors.New("the snapshot pruning points are violating finality")
+	}
+
+	lastPruningPoint := consensushashing.HeaderHash(headers[len(headers)-1])
+	if !lastPruningPoint.Equal(proofPruningPoint) {
+		return errors.New("the proof pruning point is not equal to the last pruning point in the snapshot")
+	}
+
+	return domainInstance.StagingConsensus().ImportPruningPoints(headers)
+}
+
+func importPruningPointAndItsAnticone(consensus externalapi.Consensus, params *dagconfig.Params,
+	proofPruningPoint *externalapi.DomainHash, proofPruningPointDAAScore uint64, snapshotReader *reader) error {
+
+	message, err := snapshotReader.readMessage()
+	if err != nil {
+		return err
+	}
+	msgTrustedData, ok := message.(*appmessage.MsgTrustedData)
+	if !ok {
+		return unexpectedMessageError(appmessage.CmdTrustedData, message)
+	}
+
+	err = importPruningPointAtomicState(consensus, params, msgTrustedData, proofPruningPointDAAScore, snapshotReader)
+	if err != nil {
+		return err
+	}
+
+	blockCount := 0
+	for ; ; blockCount++ {
+		message, err := snapshotReader.readMessage()
+		if err != nil {
+			return err
+		}
+		if _, ok := message.(*appmessage.MsgDoneBlocksWithTrustedData); ok {
+			break
+		}
+		blockWithTrustedData, ok := message.(*appmessage.MsgBlockWithTrustedDataV4)
+		if !ok {
+			return unexpectedMessageError(appmessage.CmdBlockWithTrustedDataV4, message)
+		}
+
+		if blockCount == 0 && !blockWithTrustedData.Block.Header.BlockHash().Equal(proofPruningPoint) {
+			return errors.New("the first block with trusted data is not the pruning point")
+		}
+
+		err = processBlockWithTrustedData(consensus, blockWithTrustedData, msgTrustedData)
+		if err != nil {
+			return err
+		}
+	}
+	if blockCount == 0 {
+		return errors.New("the snapshot doesn't contain the pruning point")
+	}
+
+	log.Infof("Imported the pruning point and %d blocks of its anticone", blockCount-1)
+	return nil
+}
+
+func importPruningPointAtomicState(consensus externalapi.Consensus, params *dagconfig.Params,
+	msgTrustedData *appmessage.MsgTrustedData, proofPruningPointDAAScore uint64, snapshotReader *reader) error {
+
+	if proofPruningPointDAAScore < params.PayloadHfActivationDAAScore {
+		// Consensus reconstructs the Atomic state of pre-payload-HF pruning
+		// points out of the imported UTXO set
+		if msgTrustedData.AtomicConsensusStateChunkCount != 0 {
+			return errors.New("the snapshot carries an Atomic state for a pre-payload-HF pruning point")
+		}
+		return nil
+	}
+
+	if len(msgTrustedData.AtomicConsensusStateHash) != externalapi.DomainHashSize {
+		return errors.Errorf("invalid pruning point Atomic state hash length: expected %d, got %d",
+			externalapi.DomainHashSize, len(msgTrustedData.AtomicConsensusStateHash))
+	}
+	totalBytes := msgTrustedData.AtomicConsensusStateByteLength
+	totalChunks := msgTrustedData.AtomicConsensusStateChunkCount
+	if totalBytes == 0 || totalBytes > maxAtomicStateBytes || totalChunks != atomicStateChunkCount(totalBytes) {
+		return errors.Errorf("invalid pruning point Atomic state metadata: %d bytes in %d chunks", totalBytes, totalChunks)
+	}
+
+	stateBytes := make([]byte, 0, totalBytes)
+	for chunkIndex := uint64(0); chunkIndex < totalChunks; chunkIndex++ {
+		message, err := snapshotReader.readMessage()
+		if err != nil {
+			return err
+		}
+		chunk, ok := message.(*appmessage.MsgTrustedAtomicStateChunk)
+		if !ok {
+			return unexpectedMessageError(appmessage.CmdTrustedAtomicStateChunk, message)
+		}
+		if chunk.ChunkIndex != chunkIndex || chunk.TotalChunks != totalChunks || chunk.TotalBytes != totalBytes ||
+			!bytes.Equal(chunk.StateHash, msgTrustedData.AtomicConsensusStateHash) {
+			return errors.Errorf("pruning point Atomic state chunk %d doesn't match the trusted data", chunkIndex)
+		}
+		stateBytes = append(stateBytes, chunk.Chunk...)
+		if uint64(len(stateBytes)) > totalBytes {
+			return errors.New("the pruning point Atomic state is larger than declared")
+		}
+	}
+	if uint64(len(stateBytes)) != totalBytes {
+		return errors.New("the pruning point Atomic state is smaller than declared")
+	}
+
+	state, err := atomicstate.FromCanonicalBytes(stateBytes)
+	if err != nil {
+		return errors.Wrap(err, "invalid pruning point Atomic state")
+	}
+	if state.IsRootOnly() {
+		return errors.New("post-payload-HF pruning point Atomic state must be materialized, got root-only state")
+	}
+	stateHash := state.CanonicalHash()
+	if !bytes.Equal(stateHash[:], msgTrustedData.AtomicConsensusStateHash) {
+		return errors.New("pruning point Atomic state hash mismatch")
+	}
+
+	err = consensus.AppendImportedPruningPointAtomicState(stateBytes)
+	if err != nil {
+		return errors.Wrap(err, "invalid pruning point Atomic state")
+	}
+	return nil
+}
+
+func processBlockWithTrustedData(consensus externalapi.Consensus,
+	block *appmessage.MsgBlockWithTrustedDataV4, data *appmessage.MsgTrustedData) error {
+
+	if block.Block == nil {
+		return errors.New("block with trusted data is missing its block")
+	}
+
+	blockWithTrustedData := &externalapi.BlockWithTrustedData{
+		Block:        appmessage.MsgBlockToDomainBlock(block.Block),
+		DAAWindow:    make([]*externalapi.TrustedDataDataDAAHeader, 0, len(block.DAAWindowIndices)),
+		GHOSTDAGData: make([]*externalapi.BlockGHOSTDAGDataHashPair, 0, len(block.GHOSTDAGDataIndices)),
+	}
+
+	for _, index := range block.DAAWindowIndices {
+		if index >= uint64(len(data.DAAWindow)) {
+			return errors.Errorf("invalid DAA window index %d (trusted window size %d)", index, len(data.DAAWindow))
+		}
+		blockWithTrustedData.DAAWindow = append(blockWithTrustedData.DAAWindow,
+			appmessage.TrustedDataDataDAABlockV4ToTrustedDataDataDAAHeader(data.DAAWindow[index]))
+	}
+
+	for _, index := range block.GHOSTDAGDataIndices {
+		if index >= uint64(len(data.GHOSTDAGData)) {
+			return errors.Errorf("invalid GHOSTDAG index %d (trusted data size %d)", index, len(data.GHOSTDAGData))
+		}
+		blockWithTrustedData.GHOSTDAGData = append(blockWithTrustedData.GHOSTDAGData,
+			appmessage.GHOSTDAGHashPairToDomainGHOSTDAGHashPair(data.GHOSTDAGData[index]))
+	}
+	if len(blockWithTrustedData.GHOSTDAGData) == 0 {
+		return errors.New("block with trusted data has no GHOSTDAG indices")
+	}
+
+	err := consensus.ValidateAndInsertBlockWithTrustedData(blockWithTrustedData, false)
+	if err != nil {
+		return errors.Wrapf(err, "failed validating block with trusted data %s",
+			consensushashing.BlockHash(blockWithTrustedData.Block))
+	}
+	return nil
+}
+
+func importPruningPointFutureHeaders(consensus externalapi.Consensus, snapshotReader *reader) error {
+	headerCount := 0
+	for {
+		message, err := snapshotReader.readMessage()
+		if err != nil {
+			return err
+		}
+		if _, ok := message.(*appmessage.MsgDoneHeaders); ok {
+			break
+		}
+		blockHeadersMessage, ok := message.(*appmessage.BlockHeadersMessage)
+		if !ok {
+			return unexpectedMessageError(appmessage.CmdBlockHeaders, message)
+		}
+
+		for _, msgBlockHeader := range blockHeadersMessage.BlockHeaders {
+			block := &externalapi.DomainBlock{Header: appmessage.BlockHeaderToDomainBlockHeader(msgBlockHeader)}
+			err = consensus.ValidateAndInsertBlock(block, false)
+			if err != nil && !errors.Is(err, ruleerrors.ErrDuplicateBlock) {
+				return errors.Wrapf(err, "invalid block header %s", consensushashing.BlockHash(block))
+			}
+		}
+
+		headerCount += len(blockHeadersMessage.BlockHeaders)
+		log.Infof("Imported %d headers", headerCount)
+	}
+	return nil
+}
+
+func importPruningPointUTXOSet(consensus externalapi.Consensus, pruningPoint *externalapi.DomainHash,
+	snapshotReader *reader) (err error) {
+
+	isValid, err := consensus.IsValidPruningPoint(pruningPoint)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return errors.Errorf("invalid pruning point %s", pruningPoint)
+	}
+
+	defer func() {
+		clearErr := consensus.ClearImportedPruningPointData()
+		if clearErr != nil {
+			panic(fmt.Sprintf("failed to clear imported pruning point data: %s", clearErr))
+		}
+	}()
+
+	utxoCount := 0
+	for {
+		message, err := snapshotReader.readMessage()
+		if err != nil {
+			return err
+		}
+		if _, ok := message.(*appmessage.MsgDonePruningPointUTXOSetChunks); ok {
+			break
+		}
+		chunk, ok := message.(*appmessage.MsgPruningPointUTXOSetChunk)
+		if !ok {
+			return unexpectedMessageError(appmessage.CmdPruningPointUTXOSetChunk, message)
+		}
+
+		domainOutpointAndUTXOEntryPairs :=
+			appmessage.OutpointAndUTXOEntryPairsToDomainOutpointAndUTXOEntryPairs(chunk.OutpointAndUTXOEntryPairs)
+		err = consensus.AppendImportedPruningPointUTXOs(domainOutpointAndUTXOEntryPairs)
+		if err != nil {
+			return err
+		}
+		utxoCount += len(domainOutpointAndUTXOEntryPairs)
+	}
+	log.Infof("Imported %d pruning point UTXOs", utxoCount)
+
+	err = consensus.ValidateAndInsertImportedPruningPoint(pruningPoint)
+	if err != nil {
+		return errors.Wrap(err, "error with the pruning point UTXO set")
+	}
+	return nil
+}
+
+func unexpectedMessageError(expected appmessage.MessageCommand, message appmessage.Message) error {
+	return errors.Errorf("unexpected snapshot record. expected: %s, got: %s", expected, message.Command())
+}
@@ -0,0 +1,7 @@
+package snapshot
+
+import (
+	"github.com/cryptix-network/cryptixd/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("SNAP")
@@ -0,0 +1,194 @@
+package snapshot
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/consensus"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/dagconfig"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database/ldb"
+)
+
+func newTestDomain(t *testing.T, consensusConfig *consensus.Config) domain.Domain {
+	dataDir, err := os.MkdirTemp("", "TestSnapshot")
+	if err != nil {
+		t.Fatalf("MkdirTemp: %+v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dataDir) })
+
+	db, err := ldb.NewLevelDB(dataDir, 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %+v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	domainInstance, err := domain.New(consensusConfig, mempool.DefaultConfig(&consensusConfig.Params), db)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	return domainInstance
+}
+
+func testConsensusConfig(payloadHfActivationDAAScore uint64) *consensus.Config {
+	consensusConfig := &consensus.Config{Params: dagconfig.SimnetParams}
+	consensusConfig.SkipProofOfWork = true
+	consensusConfig.PayloadHfActivationDAAScore = payloadHfActivationDAAScore
+
+	// This is done to reduce the pruning depth to 12 blocks
+	consensusConfig.FinalityDuration = 5 * consensusConfig.TargetTimePerBlock
+	consensusConfig.K = 0
+	consensusConfig.PruningProofM = 1
+	return consensusConfig
+}
+
+func exportTestSnapshot(t *testing.T, consensusConfig *consensus.Config) []byte {
+	syncer := newTestDomain(t, consensusConfig)
+	coinbaseData := &externalapi.DomainCoinbaseData{ScriptPublicKey: &externalapi.ScriptPublicKey{}}
+	for i := 0; i < 40; i++ {
+		block, err := syncer.Consensus().BuildBlock(coinbaseData, nil)
+		if err != nil {
+			t.Fatalf("BuildBlock: %+v", err)
+		}
+		err = syncer.Consensus().ValidateAndInsertBlock(block, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertBlock: %+v", err)
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	err := Export(syncer.Consensus(), &consensusConfig.Params, buffer)
+	if err != nil {
+		t.Fatalf("Export: %+v", err)
+	}
+	return buffer.Bytes()
+}
+
+// rewriteTestSnapshot returns the given snapshot with every record passed
+// through modify
+func rewriteTestSnapshot(t *testing.T, consensusConfig *consensus.Config, snapshot []byte,
+	modify func(message appmessage.Message)) []byte {
+
+	snapshotReader, err := newReader(bytes.NewReader(snapshot), consensusConfig.GenesisHash)
+	if err != nil {
+		t.Fatalf("newReader: %+v", err)
+	}
+	buffer := &bytes.Buffer{}
+	snapshotWriter, err := newWriter(buffer, consensusConfig.GenesisHash)
+	if err != nil {
+		t.Fatalf("newWriter: %+v", err)
+	}
+	for {
+		message, err := snapshotReader.readMessage()
+		if err != nil {
+			t.Fatalf("readMessage: %+v", err)
+		}
+		modify(message)
+		err = snapshotWriter.writeMessage(message)
+		if err != nil {
+			t.Fatalf("writeMessage: %+v", err)
+		}
+		if _, ok := message.(*appmessage.MsgDonePruningPointUTXOSetChunks); ok {
+			break
+		}
+	}
+	err = snapshotWriter.flush()
+	if err != nil {
+		t.Fatalf("flush: %+v", err)
+	}
+	return buffer.Bytes()
+}
+
+func TestExportImport(t *testing.T) {
+	tests := []struct {
+		name                        string
+		payloadHfActivationDAAScore uint64
+	}{
+		{name: "pre-payload-HF", payloadHfActivationDAAScore: 1 << 20},
+		{name: "post-payload-HF", payloadHfActivationDAAScore: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			consensusConfig := testConsensusConfig(test.payloadHfActivationDAAScore)
+			snapshot := exportTestSnapshot(t, consensusConfig)
+
+			syncee := newTestDomain(t, consensusConfig)
+			err := Import(syncee, &consensusConfig.Params, bytes.NewReader(snapshot))
+			if err != nil {
+				t.Fatalf("Import: %+v", err)
+			}
+
+			pruningPoint, err := syncee.Consensus().PruningPoint()
+			if err != nil {
+				t.Fatalf("PruningPoint: %+v", err)
+			}
+			if pruningPoint.Equal(consensusConfig.GenesisHash) {
+				t.Fatalf("The imported pruning point is the genesis")
+			}
+			utxos, err := syncee.Consensus().GetPruningPointUTXOs(pruningPoint, nil, utxoSetChunkSize)
+			if err != nil {
+				t.Fatalf("GetPruningPointUTXOs: %+v", err)
+			}
+			if len(utxos) == 0 {
+				t.Fatalf("The imported pruning point UTXO set is empty")
+			}
+
+			err = Import(syncee, &consensusConfig.Params, bytes.NewReader(snapshot))
+			if err == nil {
+				t.Fatalf("Importing the same snapshot twice unexpectedly succeeded")
+			}
+		})
+	}
+}
+
+func TestImportInvalidSnapshot(t *testing.T) {
+	consensusConfig := testConsensusConfig(0)
+	snapshot := exportTestSnapshot(t, consensusConfig)
+
+	// A changed UTXO breaks the UTXO commitment of the pruning point, and a
+	// changed Atomic state no longer matches its hash
+	tamperedUTXOSet := rewriteTestSnapshot(t, consensusConfig, snapshot, func(message appmessage.Message) {
+		if chunk, ok := message.(*appmessage.MsgPruningPointUTXOSetChunk); ok {
+			chunk.OutpointAndUTXOEntryPairs[0].UTXOEntry.Amount++
+		}
+	})
+	tamperedAtomicState := rewriteTestSnapshot(t, consensusConfig, snapshot, func(message appmessage.Message) {
+		if chunk, ok := message.(*appmessage.MsgTrustedAtomicStateChunk); ok {
+			chunk.Chunk[len(chunk.Chunk)-1] ^= 1
+		}
+	})
+	for name, invalidSnapshot := range map[string][]byte{
+		"tampered UTXO set":     tamperedUTXOSet,
+		"tampered Atomic state": tamperedAtomicState,
+		"truncated":             snapshot[:len(snapshot)/2],
+	} {
+		t.Run(name, func(t *testing.T) {
+			syncee := newTestDomain(t, consensusConfig)
+			err := Import(syncee, &consensusConfig.Params, bytes.NewReader(invalidSnapshot))
+			if err == nil {
+				t.Fatalf("Import unexpectedly accepted a %s snapshot", name)
+			}
+
+			// Nothing of the invalid snapshot is committed
+			pruningPoint, err := syncee.Consensus().PruningPoint()
+			if err != nil {
+				t.Fatalf("PruningPoint: %+v", err)
+			}
+			if !pruningPoint.Equal(consensusConfig.GenesisHash) {
+				t.Fatalf("The pruning point of a rejected snapshot was committed")
+			}
+		})
+	}
+
+	otherNetworkConfig := testConsensusConfig(0)
+	otherNetworkConfig.Params = dagconfig.DevnetParams
+	syncee := newTestDomain(t, otherNetworkConfig)
+	err := Import(syncee, &otherNetworkConfig.Params, bytes.NewReader(snapshot))
+	if err == nil {
+		t.Fatalf("Import unexpectedly accepted a snapshot of another network")
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cryptix-network/cryptixd/domain/dagconfig"
+	"github.com/cryptix-network/cryptixd/infrastructure/config"
+	"github.com/cryptix-network/cryptixd/util"
+	"github.com/cryptix-network/cryptixd/version"
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLogFilename    = "antifraudseed.log"
+	defaultErrLogFilename = "antifraudseed_err.log"
+	defaultListen         = "127.0.0.1:19111"
+	defaultSigningKeyID   = 2
+)
+
+var (
+	// Default configuration options
+	defaultAppDir     = util.AppDir("antifraudseed", false)
+	defaultLogFile    = filepath.Join(defaultAppDir, defaultLogFilename)
+	defaultErrLogFile = filepath.Join(defaultAppDir, defaultErrLogFilename)
+	defaultKeyFile    = filepath.Join(defaultAppDir, "operator.key")
+	defaultSeqFile    = filepath.Join(defaultAppDir, "snapshot.seq")
+)
+
+type configFlags struct {
+	ShowVersion  bool   `short:"V" long:"version" description:"Display version information and exit"`
+	Listen       string `long:"listen" description:"Interface/port to serve the signed AntiFraud snapshot on"`
+	KeyFile      string `long:"keyfile" description:"File containing the hex encoded operator private key"`
+	GenerateKey  bool   `long:"generate-key" description:"Generate a new operator private key into --keyfile, print its public key and exit"`
+	SigningKeyID uint8  `long:"signing-key-id" description:"The key ID nodes pinned the operator public key under (see cryptixd --antifraud-operator-key). IDs 0 and 1 are reserved"`
+	BanlistFile  string `long:"banlist" description:"JSON file with the banned_ips and banned_node_ids arrays to serve. It is reloaded whenever it changes"`
+	SeqFile      string `long:"seqfile" description:"File the last snapshot sequence number is persisted in, so that it keeps increasing across restarts"`
+	Disabled     bool   `long:"disabled" description:"Serve a signed snapshot with antifraud_enabled=false"`
+	config.NetworkFlags
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		Listen:       defaultListen,
+		KeyFile:      defaultKeyFile,
+		SeqFile:      defaultSeqFile,
+		SigningKeyID: defaultSigningKeyID,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+
+	// Show the version and exit if the version flag was specified.
+	if cfg.ShowVersion {
+		appName := filepath.Base(os.Args[0])
+		appName = strings.TrimSuffix(appName, filepath.Ext(appName))
+		fmt.Println(appName, "version", version.Version())
+		os.Exit(0)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.GenerateKey {
+		return cfg, nil
+	}
+
+	// Nodes only accept operator keys on networks other than mainnet
+	if cfg.NetParams().Net == dagconfig.MainnetParams.Net {
+		return nil, errors.New("AntiFraud operator keys are not supported on mainnet -- use --testnet, --devnet or --simnet")
+	}
+
+	if cfg.SigningKeyID <= 1 {
+		return nil, errors.Errorf("signing key ID %d is reserved for the built-in AntiFraud keys", cfg.SigningKeyID)
+	}
+	if cfg.BanlistFile == "" {
+		return nil, errors.New("--banlist is required")
+	}
+
+	initLog(defaultLogFile, defaultErrLogFile)
+
+	return cfg, nil
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/os/signal"
+	"github.com/cryptix-network/cryptixd/util/panics"
+	"github.com/cryptix-network/cryptixd/version"
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
+	"github.com/pkg/errors"
+)
+
+func main() {
+	defer panics.HandlePanic(log, "MAIN", nil)
+	interrupt := signal.InterruptListener()
+
+	cfg, err := parseConfig()
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error parsing command-line arguments: %s", err))
+	}
+
+	if cfg.GenerateKey {
+		publicKey, err := generateKey(cfg.KeyFile)
+		if err != nil {
+			printErrorAndExit(errors.Wrap(err, "error generating the operator key"))
+		}
+		fmt.Printf("Operator key written to %s\n", cfg.KeyFile)
+		fmt.Printf("Public key: %s\n", publicKey)
+		return
+	}
+	defer backendLog.Close()
+
+	// Show version at startup.
+	log.Infof("Version %s", version.Version())
+
+	keyPair, publicKey, err := loadKey(cfg.KeyFile)
+	if err != nil {
+		printErrorAndExit(errors.Wrap(err, "error loading the operator key"))
+	}
+	log.Infof("Signing AntiFraud snapshots for %s. Pin the operator key on nodes with --antifraud-operator-key=%d:%s",
+		cfg.NetParams().Name, cfg.SigningKeyID, publicKey)
+
+	server := newSnapshotServer(cfg, keyPair)
+	// Sign the ban list once before serving, so that a broken one is reported right away
+	_, err = server.currentPayload()
+	if err != nil {
+		printErrorAndExit(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(snapshotPath, server)
+	httpServer := &http.Server{
+		Addr:              cfg.Listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	spawn("httpServer.ListenAndServe", func() {
+		err := httpServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(errors.Wrap(err, "error serving AntiFraud snapshots"))
+		}
+	})
+	log.Infof("Serving AntiFraud snapshots on http://%s%s", cfg.Listen, snapshotPath)
+
+	<-interrupt
+	err = httpServer.Close()
+	if err != nil {
+		log.Errorf("Error closing the HTTP server: %s", err)
+	}
+}
+
+func generateKey(keyFile string) (string, error) {
+	if _, err := os.Stat(keyFile); err == nil {
+		return "", errors.Errorf("%s already exists", keyFile)
+	}
+
+	keyPair, err := secp256k1.GenerateSchnorrKeyPair()
+	if err != nil {
+		return "", err
+	}
+	publicKey, err := serializedPublicKey(keyPair)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.MkdirAll(filepath.Dir(keyFile), 0700)
+	if err != nil {
+		return "", err
+	}
+	err = os.WriteFile(keyFile, []byte(hex.EncodeToString(keyPair.SerializePrivateKey()[:])+"\n"), 0600)
+	if err != nil {
+		return "", err
+	}
+	return publicKey, nil
+}
+
+func loadKey(keyFile string) (*secp256k1.SchnorrKeyPair, string, error) {
+	content, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, "", err
+	}
+	privateKeyBytes, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "%s does not contain a hex encoded private key", keyFile)
+	}
+	keyPair, err := secp256k1.DeserializeSchnorrPrivateKeyFromSlice(privateKeyBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	publicKey, err := serializedPublicKey(keyPair)
+	if err != nil {
+		return nil, "", err
+	}
+	return keyPair, publicKey, nil
+}
+
+func serializedPublicKey(keyPair *secp256k1.SchnorrKeyPair) (string, error) {
+	publicKey, err := keyPair.SchnorrPublicKey()
+	if err != nil {
+		return "", err
+	}
+	serialized, err := publicKey.Serialize()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(serialized[:]), nil
+}
+
+func printErrorAndExit(err error) {
+	fmt.Fprintf(os.Stderr, "%+v\n", err)
+	os.Exit(1)
+}
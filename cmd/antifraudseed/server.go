@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/network/connmanager"
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
+	"github.com/pkg/errors"
+)
+
+const snapshotPath = "/api/v1/antifraud/snapshot"
+
+type banlistFile struct {
+	BannedIPs     []string `json:"banned_ips"`
+	BannedNodeIDs []string `json:"banned_node_ids"`
+}
+
+// snapshotServer serves the ban list file as a signed AntiFraud snapshot,
+// re-signing it whenever the file changes
+type snapshotServer struct {
+	cfg     *configFlags
+	keyPair *secp256k1.SchnorrKeyPair
+
+	lock          sync.Mutex
+	loadedModTime time.Time
+	payload       []byte
+}
+
+func newSnapshotServer(cfg *configFlags, keyPair *secp256k1.SchnorrKeyPair) *snapshotServer {
+	return &snapshotServer{
+		cfg:     cfg,
+		keyPair: keyPair,
+	}
+}
+
+func (s *snapshotServer) currentPayload() ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stat, err := os.Stat(s.cfg.BanlistFile)
+	if err != nil {
+		return nil, err
+	}
+	if s.payload != nil && stat.ModTime().Equal(s.loadedModTime) {
+		return s.payload, nil
+	}
+
+	content, err := os.ReadFile(s.cfg.BanlistFile)
+	if err != nil {
+		return nil, err
+	}
+	banlist := &banlistFile{}
+	err = json.Unmarshal(content, banlist)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s", s.cfg.BanlistFile)
+	}
+
+	snapshotSeq, err := nextSnapshotSeq(s.cfg.SeqFile)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := connmanager.SignAntiFraudSnapshot(&connmanager.AntiFraudSnapshotContent{
+		NetworkName:      s.cfg.NetParams().Name,
+		SnapshotSeq:      snapshotSeq,
+		GeneratedAtMs:    uint64(time.Now().UnixMilli()),
+		SigningKeyID:     s.cfg.SigningKeyID,
+		AntiFraudEnabled: !s.cfg.Disabled,
+		BannedIPs:        banlist.BannedIPs,
+		BannedNodeIDs:    banlist.BannedNodeIDs,
+	}, s.keyPair)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error signing %s", s.cfg.BanlistFile)
+	}
+
+	log.Infof("Signed AntiFraud snapshot seq %d with %d banned IPs and %d banned node IDs",
+		snapshotSeq, len(banlist.BannedIPs), len(banlist.BannedNodeIDs))
+	s.loadedModTime = stat.ModTime()
+	s.payload = payload
+	return payload, nil
+}
+
+func (s *snapshotServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload, err := s.currentPayload()
+	if err != nil {
+		log.Errorf("Error building the AntiFraud snapshot: %s", err)
+		http.Error(writer, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	_, err = writer.Write(payload)
+	if err != nil {
+		log.Debugf("Error writing the AntiFraud snapshot to %s: %s", request.RemoteAddr, err)
+	}
+}
+
+// nextSnapshotSeq returns the sequence number of the next snapshot and persists it
+// in seqFile. Nodes reject snapshots whose sequence number isn't higher than the
+// last one they accepted, so it must keep increasing across restarts regardless of
+// file times or the clock. A fresh counter starts at the current time, so that it
+// doesn't go back from sequence numbers served before it was introduced.
+func nextSnapshotSeq(seqFile string) (uint64, error) {
+	snapshotSeq := uint64(time.Now().UnixMilli())
+	content, err := os.ReadFile(seqFile)
+	if err == nil {
+		lastSnapshotSeq, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "%s does not contain a snapshot sequence number", seqFile)
+		}
+		if lastSnapshotSeq == math.MaxUint64 {
+			return 0, errors.Errorf("the snapshot sequence number in %s is exhausted", seqFile)
+		}
+		snapshotSeq = max(snapshotSeq, lastSnapshotSeq+1)
+	} else if !os.IsNotExist(err) {
+		return 0, err
+	}
+
+	// Write to a temporary file first, so that a crash mid-write doesn't lose the counter
+	err = os.MkdirAll(filepath.Dir(seqFile), 0700)
+	if err != nil {
+		return 0, err
+	}
+	temporaryFile := seqFile + ".tmp"
+	err = os.WriteFile(temporaryFile, []byte(strconv.FormatUint(snapshotSeq, 10)+"\n"), 0600)
+	if err != nil {
+		return 0, err
+	}
+	err = os.Rename(temporaryFile, seqFile)
+	if err != nil {
+		return 0, err
+	}
+	return snapshotSeq, nil
+}
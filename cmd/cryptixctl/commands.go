@@ -32,15 +32,35 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.CryptixdMessage_GetMempoolEntryRequest{}),
 	reflect.TypeOf(protowire.CryptixdMessage_GetMempoolEntriesRequest{}),
 	reflect.TypeOf(protowire.CryptixdMessage_GetMempoolEntriesByAddressesRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetFeeEstimateRequest{}),
 
 	reflect.TypeOf(protowire.CryptixdMessage_SubmitTransactionRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_SubmitTransactionPackageRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetTransactionStatusRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_SubmitFastIntentRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetFastIntentStatusRequest{}),
 
 	reflect.TypeOf(protowire.CryptixdMessage_GetUtxosByAddressesRequest{}),
 	reflect.TypeOf(protowire.CryptixdMessage_GetBalanceByAddressRequest{}),
 	reflect.TypeOf(protowire.CryptixdMessage_GetCoinSupplyRequest{}),
 
+	reflect.TypeOf(protowire.CryptixdMessage_GetAtomicAssetRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetAtomicAssetsRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetAtomicBalancesByOwnerRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetAtomicNonceRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetLiquidityPoolRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetAtomicHistoryByOwnerRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetAtomicHistoryByAssetRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_SimulateAtomicTransactionRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetAtomicBalanceProofRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetStrongNodeClaimsRequest{}),
+
 	reflect.TypeOf(protowire.CryptixdMessage_BanRequest{}),
 	reflect.TypeOf(protowire.CryptixdMessage_UnbanRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_BanNodeIDRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_UnbanNodeIDRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_ListBansRequest{}),
+	reflect.TypeOf(protowire.CryptixdMessage_GetAntiFraudStateRequest{}),
 }
 
 type commandDescription struct {
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/config"
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+// dataDirname is the name of the database directory inside the cryptixd
+// appdir of every network
+const dataDirname = "datadir2"
+
+type configFlags struct {
+	AppDir string `short:"b" long:"appdir" description:"The appdir of the cryptixd node. The node must not be running"`
+	Export string `long:"export" description:"Export a snapshot of the node pruning point to the given file"`
+	Import string `long:"import" description:"Import the snapshot in the given file into the node"`
+
+	// The consensus settings below must match those the node runs with
+	IsArchivalNode                       bool    `long:"archival" description:"The node is an archival node"`
+	EnableSanityCheckPruningUTXOSet      bool    `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
+	PayloadHfActivationDAAScore          *uint64 `long:"payload-hf-activation-daa-score" description:"Override payload hardfork activation DAA score, as the node does"`
+	AtomicMerkleRootHfActivationDAAScore *uint64 `long:"atomic-merkle-root-hf-activation-daa-score" description:"Override Atomic Merkle root hardfork activation DAA score, as the node does"`
+	config.NetworkFlags
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		AppDir: config.DefaultAppDir,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.PayloadHfActivationDAAScore != nil {
+		cfg.NetParams().PayloadHfActivationDAAScore = *cfg.PayloadHfActivationDAAScore
+	}
+	if cfg.AtomicMerkleRootHfActivationDAAScore != nil {
+		cfg.NetParams().AtomicMerkleRootHfActivationDAAScore = *cfg.AtomicMerkleRootHfActivationDAAScore
+	}
+
+	if (cfg.Export == "") == (cfg.Import == "") {
+		return nil, errors.New("exactly one of --export and --import must be specified")
+	}
+
+	return cfg, nil
+}
+
+func (cfg *configFlags) databasePath() string {
+	return filepath.Join(cfg.AppDir, cfg.NetParams().Name, dataDirname)
+}
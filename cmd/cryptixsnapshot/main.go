@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cryptix-network/cryptixd/app/snapshot"
+	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/consensus"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database/ldb"
+	"github.com/cryptix-network/cryptixd/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+const leveldbCacheSizeMiB = 256
+
+func main() {
+	cfg, err := parseConfig()
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error parsing command-line arguments: %s", err))
+	}
+
+	logger.InitLogStdout(logger.LevelInfo)
+	defer logger.BackendLog.Close()
+
+	if cfg.Export != "" {
+		err = exportSnapshot(cfg)
+	} else {
+		err = importSnapshot(cfg)
+	}
+	if err != nil {
+		printErrorAndExit(err)
+	}
+}
+
+func exportSnapshot(cfg *configFlags) error {
+	// Don't let the exporter create an empty database out of a mistyped appdir
+	_, err := os.Stat(cfg.databasePath())
+	if err != nil {
+		return errors.Wrapf(err, "error opening the database of the node")
+	}
+
+	return withDomain(cfg, func(domainInstance domain.Domain) error {
+		file, err := os.Create(cfg.Export)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		err = snapshot.Export(domainInstance.Consensus(), cfg.NetParams(), file)
+		if err != nil {
+			os.Remove(cfg.Export)
+			return err
+		}
+		return file.Sync()
+	})
+}
+
+func importSnapshot(cfg *configFlags) error {
+	file, err := os.Open(cfg.Import)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return withDomain(cfg, func(domainInstance domain.Domain) error {
+		return snapshot.Import(domainInstance, cfg.NetParams(), file)
+	})
+}
+
+// withDomain opens the database of the node and calls f with its domain
+func withDomain(cfg *configFlags, f func(domainInstance domain.Domain) error) error {
+	db, err := ldb.NewLevelDB(cfg.databasePath(), leveldbCacheSizeMiB)
+	if err != nil {
+		return errors.Wrapf(err, "error opening the database of the node. Is it still running?")
+	}
+	defer db.Close()
+
+	// The consensus config is built the same way the node builds it, so that
+	// the snapshot is read and validated under the same rules
+	consensusConfig := &consensus.Config{
+		Params:                          *cfg.NetParams(),
+		IsArchival:                      cfg.IsArchivalNode,
+		EnableSanityCheckPruningUTXOSet: cfg.EnableSanityCheckPruningUTXOSet,
+	}
+	domainInstance, err := domain.New(consensusConfig, mempool.DefaultConfig(&consensusConfig.Params), db)
+	if err != nil {
+		return err
+	}
+
+	// Nothing listens to consensus events while the snapshot tool runs, so
+	// they're dropped rather than left to fill up the channel
+	go func() {
+		for range domainInstance.ConsensusEventsChannel() {
+		}
+	}()
+
+	return f(domainInstance)
+}
+
+func printErrorAndExit(err error) {
+	fmt.Fprintf(os.Stderr, "%+v\n", err)
+	os.Exit(1)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"time"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
 )
 
 const daemonTimeout = 2 * time.Minute
@@ -12,3 +14,30 @@ func printErrorAndExit(err error) {
 	fmt.Fprintf(os.Stderr, "%s\n", err)
 	os.Exit(1)
 }
+
+func feePolicyFromFlags(maxFeeRate float64, feeRate float64, maxFee uint64) *pb.FeePolicy {
+	if feeRate > 0 {
+		return &pb.FeePolicy{
+			FeePolicy: &pb.FeePolicy_ExactFeeRate{
+				ExactFeeRate: feeRate,
+			},
+		}
+	} else if maxFeeRate > 0 {
+		return &pb.FeePolicy{
+			FeePolicy: &pb.FeePolicy_MaxFeeRate{MaxFeeRate: maxFeeRate},
+		}
+	} else if maxFee > 0 {
+		return &pb.FeePolicy{
+			FeePolicy: &pb.FeePolicy_MaxFee{MaxFee: maxFee},
+		}
+	}
+	return nil
+}
+
+func printTokenTransaction(txID string, signedTransaction []byte, verbose bool) {
+	fmt.Printf("Broadcasted Transaction ID: \n\t%s\n", txID)
+	if verbose {
+		fmt.Println("Serialized Transaction (can be parsed via the `parse` command or resent via `broadcast`): ")
+		fmt.Printf("\t%x\n\n", signedTransaction)
+	}
+}
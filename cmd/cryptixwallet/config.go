@@ -27,6 +27,15 @@ const (
 	bumpFeeSubCmd                   = "bump-fee"
 	bumpFeeUnsignedSubCmd           = "bump-fee-unsigned"
 	broadcastReplacementSubCmd      = "broadcast-replacement"
+	tokenBalanceSubCmd              = "token-balance"
+	tokenSendSubCmd                 = "token-send"
+	tokenMintSubCmd                 = "token-mint"
+	tokenBurnSubCmd                 = "token-burn"
+	tokenCreateSubCmd               = "token-create"
+	tokenQuoteSubCmd                = "token-quote"
+	tokenBuySubCmd                  = "token-buy"
+	tokenSellSubCmd                 = "token-sell"
+	tokenClaimFeesSubCmd            = "token-claim-fees"
 )
 
 const (
@@ -174,6 +183,123 @@ type bumpFeeConfig struct {
 	config.NetworkFlags
 }
 
+type tokenBalanceConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	AssetID       string `long:"asset-id" short:"i" description:"Only show the balance of this asset"`
+	config.NetworkFlags
+}
+
+type tokenSendConfig struct {
+	DaemonAddress string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password      string  `long:"password" short:"p" description:"Wallet password"`
+	AssetID       string  `long:"asset-id" short:"i" description:"The ID of the token to send" required:"true"`
+	ToAddress     string  `long:"to-address" short:"t" description:"The public address to send the tokens to" required:"true"`
+	Amount        string  `long:"amount" short:"v" description:"An amount of tokens to send (e.g. 1234.5)" required:"true"`
+	FromAddress   string  `long:"from-address" short:"a" description:"Specific public address to send the tokens from. If not specified, an address holding enough tokens is selected"`
+	MaxFeeRate    float64 `long:"max-fee-rate" short:"m" description:"Maximum fee rate in Sompi/gram to use for the transaction. The wallet will take the minimum between the fee rate estimate from the connected node and this value."`
+	FeeRate       float64 `long:"fee-rate" short:"r" description:"Fee rate in Sompi/gram to use for the transaction. This option will override any fee estimate from the connected node."`
+	MaxFee        uint64  `long:"max-fee" short:"x" description:"Maximum fee in Sompi (not Sompi/gram) to use for the transaction. The wallet will take the minimum between the fee estimate from the connected node and this value. If no other fee policy is specified, it will set the max fee to 1 CPAY"`
+	Verbose       bool    `long:"show-serialized" short:"s" description:"Show the hex encoded sent transaction"`
+	config.NetworkFlags
+}
+
+type tokenMintConfig struct {
+	DaemonAddress string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password      string  `long:"password" short:"p" description:"Wallet password"`
+	AssetID       string  `long:"asset-id" short:"i" description:"The ID of the token to mint" required:"true"`
+	ToAddress     string  `long:"to-address" short:"t" description:"The public address to mint the tokens to" required:"true"`
+	Amount        string  `long:"amount" short:"v" description:"An amount of tokens to mint (e.g. 1234.5)" required:"true"`
+	FromAddress   string  `long:"from-address" short:"a" description:"The mint authority address. If not specified, it is looked up among the wallet addresses"`
+	MaxFeeRate    float64 `long:"max-fee-rate" short:"m" description:"Maximum fee rate in Sompi/gram to use for the transaction. The wallet will take the minimum between the fee rate estimate from the connected node and this value."`
+	FeeRate       float64 `long:"fee-rate" short:"r" description:"Fee rate in Sompi/gram to use for the transaction. This option will override any fee estimate from the connected node."`
+	MaxFee        uint64  `long:"max-fee" short:"x" description:"Maximum fee in Sompi (not Sompi/gram) to use for the transaction. The wallet will take the minimum between the fee estimate from the connected node and this value. If no other fee policy is specified, it will set the max fee to 1 CPAY"`
+	Verbose       bool    `long:"show-serialized" short:"s" description:"Show the hex encoded sent transaction"`
+	config.NetworkFlags
+}
+
+type tokenBurnConfig struct {
+	DaemonAddress string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password      string  `long:"password" short:"p" description:"Wallet password"`
+	AssetID       string  `long:"asset-id" short:"i" description:"The ID of the token to burn" required:"true"`
+	Amount        string  `long:"amount" short:"v" description:"An amount of tokens to burn (e.g. 1234.5)" required:"true"`
+	FromAddress   string  `long:"from-address" short:"a" description:"Specific public address to burn the tokens from. If not specified, an address holding enough tokens is selected"`
+	MaxFeeRate    float64 `long:"max-fee-rate" short:"m" description:"Maximum fee rate in Sompi/gram to use for the transaction. The wallet will take the minimum between the fee rate estimate from the connected node and this value."`
+	FeeRate       float64 `long:"fee-rate" short:"r" description:"Fee rate in Sompi/gram to use for the transaction. This option will override any fee estimate from the connected node."`
+	MaxFee        uint64  `long:"max-fee" short:"x" description:"Maximum fee in Sompi (not Sompi/gram) to use for the transaction. The wallet will take the minimum between the fee estimate from the connected node and this value. If no other fee policy is specified, it will set the max fee to 1 CPAY"`
+	Verbose       bool    `long:"show-serialized" short:"s" description:"Show the hex encoded sent transaction"`
+	config.NetworkFlags
+}
+
+type tokenCreateConfig struct {
+	DaemonAddress        string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password             string  `long:"password" short:"p" description:"Wallet password"`
+	Name                 string  `long:"name" short:"n" description:"The token name" required:"true"`
+	Symbol               string  `long:"symbol" short:"y" description:"The token symbol" required:"true"`
+	Decimals             uint32  `long:"decimals" short:"e" description:"The number of decimal places of the token" default:"0"`
+	MaxSupply            string  `long:"max-supply" description:"The maximum token supply. If not specified, the supply is uncapped"`
+	Metadata             string  `long:"metadata" description:"Token metadata (encoded in hex)"`
+	PlatformTag          string  `long:"platform-tag" description:"A tag identifying the platform that created the token"`
+	MintAuthorityAddress string  `long:"mint-authority" description:"The address allowed to mint the token. Defaults to the creating address"`
+	InitialMintAmount    string  `long:"initial-mint" short:"v" description:"An amount of tokens to mint on creation"`
+	InitialMintToAddress string  `long:"initial-mint-to" short:"t" description:"The address to mint the initial tokens to. Defaults to the creating address"`
+	FromAddress          string  `long:"from-address" short:"a" description:"Specific public address to create the token from"`
+	MaxFeeRate           float64 `long:"max-fee-rate" short:"m" description:"Maximum fee rate in Sompi/gram to use for the transaction. The wallet will take the minimum between the fee rate estimate from the connected node and this value."`
+	FeeRate              float64 `long:"fee-rate" short:"r" description:"Fee rate in Sompi/gram to use for the transaction. This option will override any fee estimate from the connected node."`
+	MaxFee               uint64  `long:"max-fee" short:"x" description:"Maximum fee in Sompi (not Sompi/gram) to use for the transaction. The wallet will take the minimum between the fee estimate from the connected node and this value. If no other fee policy is specified, it will set the max fee to 1 CPAY"`
+	Verbose              bool    `long:"show-serialized" short:"s" description:"Show the hex encoded sent transaction"`
+	config.NetworkFlags
+}
+
+type tokenQuoteConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	AssetID       string `long:"asset-id" short:"i" description:"The ID of the liquidity token to quote" required:"true"`
+	BuyAmount     string `long:"buy" short:"b" description:"Quote a buy spending this amount of CPAY (e.g. 1234.12345678)"`
+	SellAmount    string `long:"sell" short:"e" description:"Quote a sell of this amount of tokens (e.g. 1234.5)"`
+	SlippageBPS   uint32 `long:"slippage-bps" description:"The tolerated shortfall of the trade output, in basis points" default:"100"`
+	config.NetworkFlags
+}
+
+type tokenBuyConfig struct {
+	DaemonAddress string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password      string  `long:"password" short:"p" description:"Wallet password"`
+	AssetID       string  `long:"asset-id" short:"i" description:"The ID of the liquidity token to buy" required:"true"`
+	Amount        string  `long:"amount" short:"v" description:"An amount of CPAY to spend on the tokens (e.g. 1234.12345678)" required:"true"`
+	SlippageBPS   uint32  `long:"slippage-bps" description:"The tolerated shortfall of the token output, in basis points" default:"100"`
+	FromAddress   string  `long:"from-address" short:"a" description:"Specific public address to buy the tokens from. If not specified, an address with spendable funds is selected"`
+	MaxFeeRate    float64 `long:"max-fee-rate" short:"m" description:"Maximum fee rate in Sompi/gram to use for the transaction. The wallet will take the minimum between the fee rate estimate from the connected node and this value."`
+	FeeRate       float64 `long:"fee-rate" short:"r" description:"Fee rate in Sompi/gram to use for the transaction. This option will override any fee estimate from the connected node."`
+	MaxFee        uint64  `long:"max-fee" short:"x" description:"Maximum fee in Sompi (not Sompi/gram) to use for the transaction. The wallet will take the minimum between the fee estimate from the connected node and this value. If no other fee policy is specified, it will set the max fee to 1 CPAY"`
+	Verbose       bool    `long:"show-serialized" short:"s" description:"Show the hex encoded sent transaction"`
+	config.NetworkFlags
+}
+
+type tokenSellConfig struct {
+	DaemonAddress string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password      string  `long:"password" short:"p" description:"Wallet password"`
+	AssetID       string  `long:"asset-id" short:"i" description:"The ID of the liquidity token to sell" required:"true"`
+	Amount        string  `long:"amount" short:"v" description:"An amount of tokens to sell (e.g. 1234.5)" required:"true"`
+	SlippageBPS   uint32  `long:"slippage-bps" description:"The tolerated shortfall of the CPAY output, in basis points" default:"100"`
+	FromAddress   string  `long:"from-address" short:"a" description:"Specific public address to sell the tokens from. If not specified, an address holding enough tokens is selected"`
+	MaxFeeRate    float64 `long:"max-fee-rate" short:"m" description:"Maximum fee rate in Sompi/gram to use for the transaction. The wallet will take the minimum between the fee rate estimate from the connected node and this value."`
+	FeeRate       float64 `long:"fee-rate" short:"r" description:"Fee rate in Sompi/gram to use for the transaction. This option will override any fee estimate from the connected node."`
+	MaxFee        uint64  `long:"max-fee" short:"x" description:"Maximum fee in Sompi (not Sompi/gram) to use for the transaction. The wallet will take the minimum between the fee estimate from the connected node and this value. If no other fee policy is specified, it will set the max fee to 1 CPAY"`
+	Verbose       bool    `long:"show-serialized" short:"s" description:"Show the hex encoded sent transaction"`
+	config.NetworkFlags
+}
+
+type tokenClaimFeesConfig struct {
+	DaemonAddress string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password      string  `long:"password" short:"p" description:"Wallet password"`
+	AssetID       string  `long:"asset-id" short:"i" description:"The ID of the liquidity token to claim the fees of" required:"true"`
+	Amount        string  `long:"amount" short:"v" description:"An amount of CPAY to claim (e.g. 1234.12345678). If not specified, all unclaimed fees are claimed"`
+	FromAddress   string  `long:"from-address" short:"a" description:"The fee recipient address. If not specified, it is looked up among the wallet addresses"`
+	MaxFeeRate    float64 `long:"max-fee-rate" short:"m" description:"Maximum fee rate in Sompi/gram to use for the transaction. The wallet will take the minimum between the fee rate estimate from the connected node and this value."`
+	FeeRate       float64 `long:"fee-rate" short:"r" description:"Fee rate in Sompi/gram to use for the transaction. This option will override any fee estimate from the connected node."`
+	MaxFee        uint64  `long:"max-fee" short:"x" description:"Maximum fee in Sompi (not Sompi/gram) to use for the transaction. The wallet will take the minimum between the fee estimate from the connected node and this value. If no other fee policy is specified, it will set the max fee to 1 CPAY"`
+	Verbose       bool    `long:"show-serialized" short:"s" description:"Show the hex encoded sent transaction"`
+	config.NetworkFlags
+}
+
 type versionConfig struct {
 }
 
@@ -247,6 +373,34 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(broadcastReplacementSubCmd, "Broadcast the given transaction replacement",
 		"Broadcast the given transaction replacement", broadcastConf)
 
+	tokenBalanceConf := &tokenBalanceConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenBalanceSubCmd, "Shows the token balances of the wallet",
+		"Shows the CAT token balances of every address of the wallet", tokenBalanceConf)
+	tokenSendConf := &tokenSendConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenSendSubCmd, "Sends tokens to a public address",
+		"Sends CAT tokens to a public address", tokenSendConf)
+	tokenMintConf := &tokenMintConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenMintSubCmd, "Mints tokens to a public address",
+		"Mints CAT tokens to a public address. The wallet must control the mint authority of the token", tokenMintConf)
+	tokenBurnConf := &tokenBurnConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenBurnSubCmd, "Burns tokens held by the wallet",
+		"Burns CAT tokens held by the wallet", tokenBurnConf)
+	tokenCreateConf := &tokenCreateConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenCreateSubCmd, "Creates a new token",
+		"Creates a new CAT token, optionally minting an initial supply", tokenCreateConf)
+	tokenQuoteConf := &tokenQuoteConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenQuoteSubCmd, "Quotes a liquidity token trade",
+		"Quotes a buy or a sell of a CAT liquidity token against its current liquidity curve", tokenQuoteConf)
+	tokenBuyConf := &tokenBuyConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenBuySubCmd, "Buys liquidity tokens",
+		"Buys CAT liquidity tokens from their liquidity curve", tokenBuyConf)
+	tokenSellConf := &tokenSellConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenSellSubCmd, "Sells liquidity tokens",
+		"Sells CAT liquidity tokens held by the wallet to their liquidity curve", tokenSellConf)
+	tokenClaimFeesConf := &tokenClaimFeesConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(tokenClaimFeesSubCmd, "Claims liquidity trading fees",
+		"Claims the trading fees a CAT liquidity token owes a fee recipient address of the wallet", tokenClaimFeesConf)
+
 	_, err := parser.Parse()
 	if err != nil {
 		var flagsErr *flags.Error
@@ -387,6 +541,101 @@ func parseCommandLine() (subCommand string, config interface{}) {
 		}
 
 		config = bumpFeeUnsignedConf
+	case tokenBalanceSubCmd:
+		combineNetworkFlags(&tokenBalanceConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenBalanceConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenBalanceConf
+	case tokenSendSubCmd:
+		combineNetworkFlags(&tokenSendConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenSendConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateFeePolicyFlags(tokenSendConf.MaxFeeRate, tokenSendConf.FeeRate, tokenSendConf.MaxFee)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenSendConf
+	case tokenMintSubCmd:
+		combineNetworkFlags(&tokenMintConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenMintConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateFeePolicyFlags(tokenMintConf.MaxFeeRate, tokenMintConf.FeeRate, tokenMintConf.MaxFee)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenMintConf
+	case tokenBurnSubCmd:
+		combineNetworkFlags(&tokenBurnConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenBurnConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateFeePolicyFlags(tokenBurnConf.MaxFeeRate, tokenBurnConf.FeeRate, tokenBurnConf.MaxFee)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenBurnConf
+	case tokenCreateSubCmd:
+		combineNetworkFlags(&tokenCreateConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenCreateConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateFeePolicyFlags(tokenCreateConf.MaxFeeRate, tokenCreateConf.FeeRate, tokenCreateConf.MaxFee)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenCreateConf
+	case tokenQuoteSubCmd:
+		combineNetworkFlags(&tokenQuoteConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenQuoteConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateTokenQuoteConfig(tokenQuoteConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenQuoteConf
+	case tokenBuySubCmd:
+		combineNetworkFlags(&tokenBuyConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenBuyConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateFeePolicyFlags(tokenBuyConf.MaxFeeRate, tokenBuyConf.FeeRate, tokenBuyConf.MaxFee)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenBuyConf
+	case tokenSellSubCmd:
+		combineNetworkFlags(&tokenSellConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenSellConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateFeePolicyFlags(tokenSellConf.MaxFeeRate, tokenSellConf.FeeRate, tokenSellConf.MaxFee)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenSellConf
+	case tokenClaimFeesSubCmd:
+		combineNetworkFlags(&tokenClaimFeesConf.NetworkFlags, &cfg.NetworkFlags)
+		err := tokenClaimFeesConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateFeePolicyFlags(tokenClaimFeesConf.MaxFeeRate, tokenClaimFeesConf.FeeRate, tokenClaimFeesConf.MaxFee)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = tokenClaimFeesConf
 	}
 
 	return parser.Command.Active.Name, config
@@ -414,6 +663,13 @@ func validateCreateUnsignedTransactionConf(conf *createUnsignedTransactionConfig
 	return nil
 }
 
+func validateTokenQuoteConfig(conf *tokenQuoteConfig) error {
+	if (conf.BuyAmount == "") == (conf.SellAmount == "") {
+		return errors.New("exactly one of '--buy' or '--sell' must be specified")
+	}
+	return nil
+}
+
 func validateSendConfig(conf *sendConfig) error {
 	if (!conf.IsSendAll && conf.SendAmount == "") ||
 		(conf.IsSendAll && conf.SendAmount != "") {
@@ -468,6 +724,22 @@ func validateBumpFeeUnsignedConfig(conf *bumpFeeUnsignedConfig) error {
 	return nil
 }
 
+func validateFeePolicyFlags(maxFeeRate float64, feeRate float64, maxFee uint64) error {
+	if maxFeeRate < 0 {
+		return errors.New("--max-fee-rate must be a positive number")
+	}
+
+	if feeRate < 0 {
+		return errors.New("--fee-rate must be a positive number")
+	}
+
+	if boolToUint8(maxFeeRate > 0)+boolToUint8(feeRate > 0)+boolToUint8(maxFee > 0) > 1 {
+		return errors.New("at most one of '--max-fee-rate', '--fee-rate' or '--max-fee' can be specified")
+	}
+
+	return nil
+}
+
 func boolToUint8(b bool) uint8 {
 	if b {
 		return 1
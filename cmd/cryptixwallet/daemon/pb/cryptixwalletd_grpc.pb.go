@@ -39,6 +39,17 @@ type CryptixwalletdClient interface {
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error)
+	// Since the token requests below contain a password - these commands should
+	// only be used on a trusted or secure connection
+	SendToken(ctx context.Context, in *SendTokenRequest, opts ...grpc.CallOption) (*SendTokenResponse, error)
+	MintToken(ctx context.Context, in *MintTokenRequest, opts ...grpc.CallOption) (*MintTokenResponse, error)
+	BurnToken(ctx context.Context, in *BurnTokenRequest, opts ...grpc.CallOption) (*BurnTokenResponse, error)
+	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	QuoteLiquidityTrade(ctx context.Context, in *QuoteLiquidityTradeRequest, opts ...grpc.CallOption) (*QuoteLiquidityTradeResponse, error)
+	BuyLiquidityToken(ctx context.Context, in *BuyLiquidityTokenRequest, opts ...grpc.CallOption) (*BuyLiquidityTokenResponse, error)
+	SellLiquidityToken(ctx context.Context, in *SellLiquidityTokenRequest, opts ...grpc.CallOption) (*SellLiquidityTokenResponse, error)
+	ClaimLiquidityFees(ctx context.Context, in *ClaimLiquidityFeesRequest, opts ...grpc.CallOption) (*ClaimLiquidityFeesResponse, error)
 }
 
 type cryptixwalletdClient struct {
@@ -157,6 +168,87 @@ func (c *cryptixwalletdClient) BumpFee(ctx context.Context, in *BumpFeeRequest,
 	return out, nil
 }
 
+func (c *cryptixwalletdClient) GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error) {
+	out := new(GetTokenBalanceResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/GetTokenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) SendToken(ctx context.Context, in *SendTokenRequest, opts ...grpc.CallOption) (*SendTokenResponse, error) {
+	out := new(SendTokenResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/SendToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) MintToken(ctx context.Context, in *MintTokenRequest, opts ...grpc.CallOption) (*MintTokenResponse, error) {
+	out := new(MintTokenResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/MintToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) BurnToken(ctx context.Context, in *BurnTokenRequest, opts ...grpc.CallOption) (*BurnTokenResponse, error) {
+	out := new(BurnTokenResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/BurnToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
+	out := new(CreateTokenResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/CreateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) QuoteLiquidityTrade(ctx context.Context, in *QuoteLiquidityTradeRequest, opts ...grpc.CallOption) (*QuoteLiquidityTradeResponse, error) {
+	out := new(QuoteLiquidityTradeResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/QuoteLiquidityTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) BuyLiquidityToken(ctx context.Context, in *BuyLiquidityTokenRequest, opts ...grpc.CallOption) (*BuyLiquidityTokenResponse, error) {
+	out := new(BuyLiquidityTokenResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/BuyLiquidityToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) SellLiquidityToken(ctx context.Context, in *SellLiquidityTokenRequest, opts ...grpc.CallOption) (*SellLiquidityTokenResponse, error) {
+	out := new(SellLiquidityTokenResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/SellLiquidityToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptixwalletdClient) ClaimLiquidityFees(ctx context.Context, in *ClaimLiquidityFeesRequest, opts ...grpc.CallOption) (*ClaimLiquidityFeesResponse, error) {
+	out := new(ClaimLiquidityFeesResponse)
+	err := c.cc.Invoke(ctx, "/cryptixwalletd.cryptixwalletd/ClaimLiquidityFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CryptixwalletdServer is the server API for Cryptixwalletd service.
 // All implementations must embed UnimplementedCryptixwalletdServer
 // for forward compatibility
@@ -178,6 +270,17 @@ type CryptixwalletdServer interface {
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error)
+	// Since the token requests below contain a password - these commands should
+	// only be used on a trusted or secure connection
+	SendToken(context.Context, *SendTokenRequest) (*SendTokenResponse, error)
+	MintToken(context.Context, *MintTokenRequest) (*MintTokenResponse, error)
+	BurnToken(context.Context, *BurnTokenRequest) (*BurnTokenResponse, error)
+	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
+	QuoteLiquidityTrade(context.Context, *QuoteLiquidityTradeRequest) (*QuoteLiquidityTradeResponse, error)
+	BuyLiquidityToken(context.Context, *BuyLiquidityTokenRequest) (*BuyLiquidityTokenResponse, error)
+	SellLiquidityToken(context.Context, *SellLiquidityTokenRequest) (*SellLiquidityTokenResponse, error)
+	ClaimLiquidityFees(context.Context, *ClaimLiquidityFeesRequest) (*ClaimLiquidityFeesResponse, error)
 	mustEmbedUnimplementedCryptixwalletdServer()
 }
 
@@ -221,6 +324,33 @@ func (UnimplementedCryptixwalletdServer) GetVersion(context.Context, *GetVersion
 func (UnimplementedCryptixwalletdServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
 }
+func (UnimplementedCryptixwalletdServer) GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenBalance not implemented")
+}
+func (UnimplementedCryptixwalletdServer) SendToken(context.Context, *SendTokenRequest) (*SendTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToken not implemented")
+}
+func (UnimplementedCryptixwalletdServer) MintToken(context.Context, *MintTokenRequest) (*MintTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintToken not implemented")
+}
+func (UnimplementedCryptixwalletdServer) BurnToken(context.Context, *BurnTokenRequest) (*BurnTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnToken not implemented")
+}
+func (UnimplementedCryptixwalletdServer) CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
+}
+func (UnimplementedCryptixwalletdServer) QuoteLiquidityTrade(context.Context, *QuoteLiquidityTradeRequest) (*QuoteLiquidityTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteLiquidityTrade not implemented")
+}
+func (UnimplementedCryptixwalletdServer) BuyLiquidityToken(context.Context, *BuyLiquidityTokenRequest) (*BuyLiquidityTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyLiquidityToken not implemented")
+}
+func (UnimplementedCryptixwalletdServer) SellLiquidityToken(context.Context, *SellLiquidityTokenRequest) (*SellLiquidityTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellLiquidityToken not implemented")
+}
+func (UnimplementedCryptixwalletdServer) ClaimLiquidityFees(context.Context, *ClaimLiquidityFeesRequest) (*ClaimLiquidityFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimLiquidityFees not implemented")
+}
 func (UnimplementedCryptixwalletdServer) mustEmbedUnimplementedCryptixwalletdServer() {}
 
 // UnsafeCryptixwalletdServer may be embedded to opt out of forward compatibility for this service.
@@ -450,6 +580,168 @@ func _Cryptixwalletd_BumpFee_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cryptixwalletd_GetTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).GetTokenBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/GetTokenBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).GetTokenBalance(ctx, req.(*GetTokenBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_SendToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).SendToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/SendToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).SendToken(ctx, req.(*SendTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_MintToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).MintToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/MintToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).MintToken(ctx, req.(*MintTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_BurnToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).BurnToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/BurnToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).BurnToken(ctx, req.(*BurnTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/CreateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).CreateToken(ctx, req.(*CreateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_QuoteLiquidityTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteLiquidityTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).QuoteLiquidityTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/QuoteLiquidityTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).QuoteLiquidityTrade(ctx, req.(*QuoteLiquidityTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_BuyLiquidityToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyLiquidityTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).BuyLiquidityToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/BuyLiquidityToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).BuyLiquidityToken(ctx, req.(*BuyLiquidityTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_SellLiquidityToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellLiquidityTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).SellLiquidityToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/SellLiquidityToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).SellLiquidityToken(ctx, req.(*SellLiquidityTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cryptixwalletd_ClaimLiquidityFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimLiquidityFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptixwalletdServer).ClaimLiquidityFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cryptixwalletd.cryptixwalletd/ClaimLiquidityFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptixwalletdServer).ClaimLiquidityFees(ctx, req.(*ClaimLiquidityFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cryptixwalletd_ServiceDesc is the grpc.ServiceDesc for Cryptixwalletd service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -505,6 +797,42 @@ var Cryptixwalletd_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BumpFee",
 			Handler:    _Cryptixwalletd_BumpFee_Handler,
 		},
+		{
+			MethodName: "GetTokenBalance",
+			Handler:    _Cryptixwalletd_GetTokenBalance_Handler,
+		},
+		{
+			MethodName: "SendToken",
+			Handler:    _Cryptixwalletd_SendToken_Handler,
+		},
+		{
+			MethodName: "MintToken",
+			Handler:    _Cryptixwalletd_MintToken_Handler,
+		},
+		{
+			MethodName: "BurnToken",
+			Handler:    _Cryptixwalletd_BurnToken_Handler,
+		},
+		{
+			MethodName: "CreateToken",
+			Handler:    _Cryptixwalletd_CreateToken_Handler,
+		},
+		{
+			MethodName: "QuoteLiquidityTrade",
+			Handler:    _Cryptixwalletd_QuoteLiquidityTrade_Handler,
+		},
+		{
+			MethodName: "BuyLiquidityToken",
+			Handler:    _Cryptixwalletd_BuyLiquidityToken_Handler,
+		},
+		{
+			MethodName: "SellLiquidityToken",
+			Handler:    _Cryptixwalletd_SellLiquidityToken_Handler,
+		},
+		{
+			MethodName: "ClaimLiquidityFees",
+			Handler:    _Cryptixwalletd_ClaimLiquidityFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cryptixwalletd.proto",
@@ -0,0 +1,422 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/libcryptixwallet"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/transactionid"
+	"github.com/pkg/errors"
+)
+
+// maxSlippageBPS is the largest slippage bound a trade may specify, which
+// accepts any output
+const maxSlippageBPS = 10_000
+
+// liquidityTransition is the vault transition of a liquidity transaction,
+// along with the payout it owes the owner
+type liquidityTransition struct {
+	vault  *libcryptixwallet.LiquidityVault
+	payout uint64
+}
+
+// ownerChange returns the change owed to the owner when its selected UTXOs sum
+// up to totalValue. It returns false if they don't cover the fee and, for
+// buys, the growth of the vault.
+func (transition *liquidityTransition) ownerChange(totalValue uint64, fee uint64) (uint64, bool) {
+	available := totalValue
+	required := fee
+	if transition != nil {
+		available += transition.vault.Value
+		required += transition.vault.NewValue + transition.payout
+	}
+	if available <= required {
+		return 0, false
+	}
+	return available - required, true
+}
+
+// payments returns the outputs paid to the owner. The payout, if any, is always
+// the first output, so liquidity ops can refer to it by index 0.
+func (transition *liquidityTransition) payments(owner *tokenOwner, change uint64) []*libcryptixwallet.Payment {
+	payments := make([]*libcryptixwallet.Payment, 0, 2)
+	if transition != nil && transition.payout > 0 {
+		payments = append(payments, &libcryptixwallet.Payment{Address: owner.address, Amount: transition.payout})
+	}
+	return append(payments, &libcryptixwallet.Payment{Address: owner.address, Amount: change})
+}
+
+func (s *server) QuoteLiquidityTrade(_ context.Context, request *pb.QuoteLiquidityTradeRequest) (
+	*pb.QuoteLiquidityTradeResponse, error) {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	asset, _, err := s.parseTokenAsset(request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.liquidityPool(asset, request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.QuoteLiquidityTradeResponse{Decimals: asset.Decimals, PoolNonce: pool.PoolNonce}
+	switch {
+	case request.BuyCPayInSompi > 0 && request.SellAmount == "":
+		quote, minTokenOut, err := quoteLiquidityBuy(pool, request.BuyCPayInSompi, request.SlippageBps, nil)
+		if err != nil {
+			return nil, err
+		}
+		response.CpayInSompi = quote.CPayInSompi
+		response.TokenOut = quote.TokenOut.Big().String()
+		response.MinTokenOut = minTokenOut.Big().String()
+		response.FeeSompi = quote.FeeSompi
+	case request.BuyCPayInSompi == 0 && request.SellAmount != "":
+		tokenIn, err := tokenAmount(request.SellAmount, asset.Decimals)
+		if err != nil {
+			return nil, err
+		}
+		quote, minCPayOut, err := quoteLiquiditySell(pool, tokenIn, request.SlippageBps, 0)
+		if err != nil {
+			return nil, err
+		}
+		response.TokenIn = quote.TokenIn.Big().String()
+		response.CpayOutSompi = quote.CPayOutSompi
+		response.MinCPayOutSompi = minCPayOut
+		response.FeeSompi = quote.FeeSompi
+	default:
+		return nil, errors.New("exactly one of buyCPayInSompi and sellAmount must be set")
+	}
+	return response, nil
+}
+
+func (s *server) BuyLiquidityToken(_ context.Context, request *pb.BuyLiquidityTokenRequest) (
+	*pb.BuyLiquidityTokenResponse, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	asset, assetID, err := s.parseTokenAsset(request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.liquidityPool(asset, request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+
+	var expectedTokenOut *big.Int
+	if request.ExpectedTokenOut != "" {
+		var ok bool
+		expectedTokenOut, ok = new(big.Int).SetString(request.ExpectedTokenOut, 10)
+		if !ok || expectedTokenOut.Sign() <= 0 {
+			return nil, errors.Errorf("invalid expected token output %s", request.ExpectedTokenOut)
+		}
+	}
+	quote, minTokenOut, err := quoteLiquidityBuy(pool, request.CpayInSompi, request.SlippageBps, expectedTokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.selectTokenOwner(request.FromAddress, func(atomicID) error { return nil })
+	if err != nil {
+		return nil, err
+	}
+
+	op := atomicstate.BuyLiquidityExactInOp{
+		AssetID:           assetID,
+		ExpectedPoolNonce: pool.PoolNonce,
+		CPayInSompi:       quote.CPayInSompi,
+		MinTokenOut:       minTokenOut,
+	}
+	transition := &liquidityTransition{
+		vault: &libcryptixwallet.LiquidityVault{
+			Outpoint: &pool.VaultOutpoint,
+			Value:    pool.VaultValueSompi,
+			NewValue: pool.VaultValueSompi + quote.CPayInSompi,
+		},
+	}
+	txID, signedTransaction, err := s.sendTokenTransaction(owner, atomicstate.AssetNonceKey(owner.ownerID, assetID),
+		op, transition, request.Password, request.FeePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BuyLiquidityTokenResponse{
+		TxID:              txID,
+		SignedTransaction: signedTransaction,
+		CpayInSompi:       quote.CPayInSompi,
+		TokenOut:          quote.TokenOut.Big().String(),
+		Decimals:          asset.Decimals,
+	}, nil
+}
+
+func (s *server) SellLiquidityToken(_ context.Context, request *pb.SellLiquidityTokenRequest) (
+	*pb.SellLiquidityTokenResponse, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	asset, assetID, err := s.parseTokenAsset(request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.liquidityPool(asset, request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	tokenIn, err := tokenAmount(request.Amount, asset.Decimals)
+	if err != nil {
+		return nil, err
+	}
+	quote, minCPayOut, err := quoteLiquiditySell(pool, tokenIn, request.SlippageBps, request.ExpectedCPayOutSompi)
+	if err != nil {
+		return nil, err
+	}
+	if quote.CPayOutSompi > pool.VaultValueSompi {
+		return nil, errors.Errorf("liquidity vault of asset %s holds only %d sompi", request.AssetId, pool.VaultValueSompi)
+	}
+
+	owner, err := s.selectTokenOwner(request.FromAddress, func(ownerID atomicID) error {
+		return s.checkTokenBalance(ownerID, request.AssetId, tokenIn)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	op := atomicstate.SellLiquidityExactInOp{
+		AssetID:                assetID,
+		ExpectedPoolNonce:      pool.PoolNonce,
+		TokenIn:                tokenIn,
+		MinCPayOutSompi:        minCPayOut,
+		CPayReceiveOutputIndex: 0,
+	}
+	transition := &liquidityTransition{
+		vault: &libcryptixwallet.LiquidityVault{
+			Outpoint: &pool.VaultOutpoint,
+			Value:    pool.VaultValueSompi,
+			NewValue: pool.VaultValueSompi - quote.CPayOutSompi,
+		},
+		payout: quote.CPayOutSompi,
+	}
+	txID, signedTransaction, err := s.sendTokenTransaction(owner, atomicstate.AssetNonceKey(owner.ownerID, assetID),
+		op, transition, request.Password, request.FeePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SellLiquidityTokenResponse{
+		TxID:              txID,
+		SignedTransaction: signedTransaction,
+		CpayOutSompi:      quote.CPayOutSompi,
+	}, nil
+}
+
+func (s *server) ClaimLiquidityFees(_ context.Context, request *pb.ClaimLiquidityFeesRequest) (
+	*pb.ClaimLiquidityFeesResponse, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	asset, assetID, err := s.parseTokenAsset(request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.liquidityPool(asset, request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	if atomicstate.LiquiditySellLocked(pool) {
+		return nil, errors.Errorf("fee claims of asset %s are locked until its real CPAY reserve reaches %d sompi",
+			request.AssetId, pool.UnlockTargetSompi)
+	}
+
+	recipientIndex := -1
+	amount := request.AmountSompi
+	owner, err := s.selectTokenOwner(request.FromAddress, func(ownerID atomicID) error {
+		for i, recipient := range pool.FeeRecipients {
+			if recipient.OwnerID != ownerID {
+				continue
+			}
+			if request.AmountSompi == 0 {
+				amount = recipient.UnclaimedSompi
+			}
+			if amount < atomicstate.LiquidityMinPayoutSompi || amount > recipient.UnclaimedSompi {
+				return errors.Errorf("owner %x can't claim %d sompi out of its %d unclaimed sompi",
+					ownerID, amount, recipient.UnclaimedSompi)
+			}
+			recipientIndex = i
+			return nil
+		}
+		return errors.Errorf("owner %x is not a fee recipient of asset %s", ownerID, request.AssetId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	op := atomicstate.ClaimLiquidityFeesOp{
+		AssetID:                 assetID,
+		ExpectedPoolNonce:       pool.PoolNonce,
+		RecipientIndex:          byte(recipientIndex),
+		ClaimAmountSompi:        amount,
+		ClaimReceiveOutputIndex: 0,
+	}
+	transition := &liquidityTransition{
+		vault: &libcryptixwallet.LiquidityVault{
+			Outpoint: &pool.VaultOutpoint,
+			Value:    pool.VaultValueSompi,
+			NewValue: pool.VaultValueSompi - amount,
+		},
+		payout: amount,
+	}
+	txID, signedTransaction, err := s.sendTokenTransaction(owner, atomicstate.AssetNonceKey(owner.ownerID, assetID),
+		op, transition, request.Password, request.FeePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClaimLiquidityFeesResponse{TxID: txID, SignedTransaction: signedTransaction, AmountSompi: amount}, nil
+}
+
+// quoteLiquidityBuy quotes a buy of at most cpayInSompi, and returns the
+// minimum token output allowed by the slippage bound. If expectedTokenOut is
+// nil, the quote itself is the reference for the slippage bound.
+func quoteLiquidityBuy(pool atomicstate.LiquidityPoolState, cpayInSompi uint64, slippageBPS uint32,
+	expectedTokenOut *big.Int) (atomicstate.LiquidityBuyQuote, atomicstate.Uint128, error) {
+
+	if cpayInSompi == 0 {
+		return atomicstate.LiquidityBuyQuote{}, atomicstate.Uint128{}, errors.New("CPAY input must be positive")
+	}
+	quote, err := atomicstate.QuoteLiquidityBuy(pool, cpayInSompi)
+	if err != nil {
+		return atomicstate.LiquidityBuyQuote{}, atomicstate.Uint128{}, errors.Wrap(err, "error quoting the buy")
+	}
+
+	reference := expectedTokenOut
+	if reference == nil {
+		reference = quote.TokenOut.Big()
+	}
+	minTokenOutBig, err := minTradeOutput(quote.TokenOut.Big(), reference, slippageBPS)
+	if err != nil {
+		return atomicstate.LiquidityBuyQuote{}, atomicstate.Uint128{}, err
+	}
+	minTokenOut, ok := atomicstate.Uint128FromBig(minTokenOutBig)
+	if !ok {
+		return atomicstate.LiquidityBuyQuote{}, atomicstate.Uint128{}, errors.Errorf(
+			"minimum token output %s is out of range", minTokenOutBig)
+	}
+	return quote, minTokenOut, nil
+}
+
+// quoteLiquiditySell quotes a sell of tokenIn, and returns the minimum CPAY
+// output allowed by the slippage bound. If expectedCPayOutSompi is zero, the
+// quote itself is the reference for the slippage bound.
+func quoteLiquiditySell(pool atomicstate.LiquidityPoolState, tokenIn atomicstate.Uint128, slippageBPS uint32,
+	expectedCPayOutSompi uint64) (atomicstate.LiquiditySellQuote, uint64, error) {
+
+	if tokenIn.IsZero() {
+		return atomicstate.LiquiditySellQuote{}, 0, errors.New("token input must be positive")
+	}
+	quote, err := atomicstate.QuoteLiquiditySell(pool, tokenIn)
+	if err != nil {
+		return atomicstate.LiquiditySellQuote{}, 0, errors.Wrap(err, "error quoting the sell")
+	}
+
+	reference := quote.CPayOutSompi
+	if expectedCPayOutSompi > 0 {
+		reference = expectedCPayOutSompi
+	}
+	minCPayOut, err := minTradeOutput(new(big.Int).SetUint64(quote.CPayOutSompi), new(big.Int).SetUint64(reference),
+		slippageBPS)
+	if err != nil {
+		return atomicstate.LiquiditySellQuote{}, 0, err
+	}
+	return quote, minCPayOut.Uint64(), nil
+}
+
+// minTradeOutput returns the smallest trade output within slippageBPS of the
+// reference output, and makes sure the quoted output is not below it
+func minTradeOutput(quoted *big.Int, reference *big.Int, slippageBPS uint32) (*big.Int, error) {
+	if slippageBPS > maxSlippageBPS {
+		return nil, errors.Errorf("slippage of %d basis points is above the maximum of %d", slippageBPS, maxSlippageBPS)
+	}
+	minOutput := new(big.Int).Mul(reference, big.NewInt(int64(maxSlippageBPS-slippageBPS)))
+	minOutput.Div(minOutput, big.NewInt(maxSlippageBPS))
+	if minOutput.Sign() == 0 {
+		minOutput.SetInt64(1)
+	}
+	if quoted.Cmp(minOutput) < 0 {
+		return nil, errors.Errorf("the quoted output %s is below the slippage bound of %s", quoted, minOutput)
+	}
+	return minOutput, nil
+}
+
+// liquidityPool returns the pool of the given liquidity asset in the form
+// consensus quotes trades against. It fails if the wallet already spent the
+// current vault in a transaction the node did not accept yet, since any
+// transaction built on top of it would be rejected.
+func (s *server) liquidityPool(asset *appmessage.RPCAtomicAsset, assetID string) (atomicstate.LiquidityPoolState, error) {
+	rpcPool := asset.Liquidity
+	if rpcPool == nil || rpcPool.VaultOutpoint == nil {
+		return atomicstate.LiquidityPoolState{}, errors.Errorf("asset %s is not a liquidity asset", assetID)
+	}
+
+	realTokenReserves, err := parseRPCUint128(rpcPool.RealTokenReserves)
+	if err != nil {
+		return atomicstate.LiquidityPoolState{}, err
+	}
+	virtualTokenReserves, err := parseRPCUint128(rpcPool.VirtualTokenReserves)
+	if err != nil {
+		return atomicstate.LiquidityPoolState{}, err
+	}
+	vaultTransactionID, err := transactionid.FromString(rpcPool.VaultOutpoint.TransactionID)
+	if err != nil {
+		return atomicstate.LiquidityPoolState{}, err
+	}
+	feeRecipients := make([]atomicstate.LiquidityFeeRecipientState, len(rpcPool.FeeRecipients))
+	for i, recipient := range rpcPool.FeeRecipients {
+		ownerIDBytes, err := hex.DecodeString(recipient.OwnerID)
+		if err != nil || len(ownerIDBytes) != externalapi.DomainHashSize {
+			return atomicstate.LiquidityPoolState{}, errors.Errorf("got an invalid fee recipient %s from the node",
+				recipient.OwnerID)
+		}
+		copy(feeRecipients[i].OwnerID[:], ownerIDBytes)
+		feeRecipients[i].UnclaimedSompi = recipient.UnclaimedSompi
+	}
+
+	pool := atomicstate.LiquidityPoolState{
+		PoolNonce:              rpcPool.PoolNonce,
+		RealCPayReservesSompi:  rpcPool.RealCPayReservesSompi,
+		RealTokenReserves:      realTokenReserves,
+		VirtualCPayReserves:    rpcPool.VirtualCPayReserves,
+		VirtualTokenReserves:   virtualTokenReserves,
+		UnclaimedFeeTotalSompi: rpcPool.UnclaimedFeeTotalSompi,
+		FeeBPS:                 uint16(rpcPool.FeeBPS),
+		FeeRecipients:          feeRecipients,
+		VaultOutpoint:          externalapi.DomainOutpoint{TransactionID: *vaultTransactionID, Index: rpcPool.VaultOutpoint.Index},
+		VaultValueSompi:        rpcPool.VaultValueSompi,
+		UnlockTargetSompi:      rpcPool.UnlockTargetSompi,
+		Unlocked:               rpcPool.Unlocked,
+	}
+
+	if broadcastTime, ok := s.usedOutpoints[pool.VaultOutpoint]; ok && !s.usedOutpointHasExpired(broadcastTime) {
+		return atomicstate.LiquidityPoolState{}, errors.Errorf(
+			"a previous transaction of the liquidity pool of asset %s was not accepted yet", assetID)
+	}
+	return pool, nil
+}
+
+func parseRPCUint128(value string) (atomicstate.Uint128, error) {
+	valueBig, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return atomicstate.Uint128{}, errors.Errorf("got an invalid amount %s from the node", value)
+	}
+	result, ok := atomicstate.Uint128FromBig(valueBig)
+	if !ok {
+		return atomicstate.Uint128{}, errors.Errorf("got an out of range amount %s from the node", value)
+	}
+	return result, nil
+}
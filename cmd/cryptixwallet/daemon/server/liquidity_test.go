@@ -0,0 +1,64 @@
+package server
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/libcryptixwallet"
+)
+
+func TestMinTradeOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		quoted      int64
+		reference   int64
+		slippageBPS uint32
+		expected    int64
+		expectError bool
+	}{
+		{name: "no slippage", quoted: 1000, reference: 1000, slippageBPS: 0, expected: 1000},
+		{name: "one percent", quoted: 1000, reference: 1000, slippageBPS: 100, expected: 990},
+		{name: "rounds down", quoted: 999, reference: 999, slippageBPS: 100, expected: 989},
+		{name: "never zero", quoted: 1, reference: 1, slippageBPS: maxSlippageBPS, expected: 1},
+		{name: "pool moved within bound", quoted: 995, reference: 1000, slippageBPS: 100, expected: 990},
+		{name: "pool moved beyond bound", quoted: 980, reference: 1000, slippageBPS: 100, expectError: true},
+		{name: "slippage above max", quoted: 1000, reference: 1000, slippageBPS: maxSlippageBPS + 1, expectError: true},
+	}
+
+	for _, test := range tests {
+		minOutput, err := minTradeOutput(big.NewInt(test.quoted), big.NewInt(test.reference), test.slippageBPS)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", test.name, minOutput)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if minOutput.Int64() != test.expected {
+			t.Errorf("%s: expected %d, got %s", test.name, test.expected, minOutput)
+		}
+	}
+}
+
+func TestLiquidityTransitionOwnerChange(t *testing.T) {
+	var noTransition *liquidityTransition
+	if change, ok := noTransition.ownerChange(1000, 10); !ok || change != 990 {
+		t.Fatalf("unexpected change without a transition: %d, %t", change, ok)
+	}
+
+	buy := &liquidityTransition{vault: &libcryptixwallet.LiquidityVault{Value: 5000, NewValue: 5600}}
+	if change, ok := buy.ownerChange(1000, 10); !ok || change != 390 {
+		t.Fatalf("unexpected buy change: %d, %t", change, ok)
+	}
+	if _, ok := buy.ownerChange(610, 10); ok {
+		t.Fatalf("expected a buy that exhausts the owner funds to be rejected")
+	}
+
+	sell := &liquidityTransition{vault: &libcryptixwallet.LiquidityVault{Value: 5000, NewValue: 4400}, payout: 600}
+	if change, ok := sell.ownerChange(1000, 10); !ok || change != 990 {
+		t.Fatalf("unexpected sell change: %d, %t", change, ok)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cryptix-network/cryptixd/version"
 
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
 
 	"github.com/cryptix-network/cryptixd/util/txmass"
 
@@ -46,6 +47,7 @@ type server struct {
 	addressSet                      walletAddressSet
 	txMassCalculator                *txmass.Calculator
 	usedOutpoints                   map[externalapi.DomainOutpoint]time.Time
+	tokenNonces                     map[atomicstate.NonceKey]*pendingTokenNonce
 	firstSyncDone                   atomic.Bool
 
 	isLogFinalProgressLineShown bool
@@ -119,6 +121,7 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 			params.PayloadWeightMultiplier,
 		),
 		usedOutpoints:               map[externalapi.DomainOutpoint]time.Time{},
+		tokenNonces:                 map[atomicstate.NonceKey]*pendingTokenNonce{},
 		isLogFinalProgressLineShown: false,
 		maxUsedAddressesForLog:      0,
 		maxProcessedAddressesForLog: 0,
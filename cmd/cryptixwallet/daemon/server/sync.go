@@ -289,6 +289,11 @@ func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, memp
 			delete(s.usedOutpoints, outpoint)
 		}
 	}
+	for nonceKey, pending := range s.tokenNonces {
+		if s.usedOutpointHasExpired(pending.broadcastTime) {
+			delete(s.tokenNonces, nonceKey)
+		}
+	}
 	s.lock.Unlock()
 
 	return nil
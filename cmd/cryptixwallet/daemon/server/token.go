@@ -0,0 +1,558 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/libcryptixwallet"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/libcryptixwallet/serialization"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/utils"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/txscript"
+	"github.com/cryptix-network/cryptixd/util"
+	"github.com/pkg/errors"
+)
+
+type atomicID = [externalapi.DomainHashSize]byte
+
+// pendingTokenNonce is the last nonce the wallet broadcasted for a nonce scope.
+// It lets consecutive token transactions be sent before the previous ones are
+// accepted by consensus, since the node only reports nonces of accepted ones.
+type pendingTokenNonce struct {
+	nonce         uint64
+	broadcastTime time.Time
+}
+
+// tokenOwner is a wallet address that acts as the owner in a token transaction
+type tokenOwner struct {
+	walletAddress *walletAddress
+	address       util.Address
+	ownerID       atomicID
+}
+
+func (s *server) GetTokenBalance(_ context.Context, request *pb.GetTokenBalanceRequest) (*pb.GetTokenBalanceResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	addresses := s.addressSet.strings()
+	sort.Strings(addresses)
+
+	assets := make(map[string]*appmessage.RPCAtomicAsset)
+	var balances []*pb.TokenBalance
+	for _, address := range addresses {
+		response, err := s.rpcClient.GetAtomicBalancesByOwner("", address)
+		if err != nil {
+			return nil, err
+		}
+		for _, balance := range response.Balances {
+			if request.AssetId != "" && balance.AssetID != request.AssetId {
+				continue
+			}
+			asset, ok := assets[balance.AssetID]
+			if !ok {
+				asset, err = s.getAtomicAsset(balance.AssetID)
+				if err != nil {
+					return nil, err
+				}
+				assets[balance.AssetID] = asset
+			}
+			balances = append(balances, &pb.TokenBalance{
+				Address:  address,
+				OwnerId:  response.OwnerID,
+				AssetId:  balance.AssetID,
+				Name:     asset.Name,
+				Symbol:   asset.Symbol,
+				Decimals: asset.Decimals,
+				Amount:   balance.Balance,
+			})
+		}
+	}
+
+	return &pb.GetTokenBalanceResponse{Balances: balances}, nil
+}
+
+func (s *server) SendToken(_ context.Context, request *pb.SendTokenRequest) (*pb.SendTokenResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	asset, assetID, err := s.parseTokenAsset(request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := tokenAmount(request.Amount, asset.Decimals)
+	if err != nil {
+		return nil, err
+	}
+	toOwnerID, err := s.tokenOwnerIDFromAddressString(request.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.selectTokenOwner(request.FromAddress, func(ownerID atomicID) error {
+		return s.checkTokenBalance(ownerID, request.AssetId, amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	op := atomicstate.TransferOp{AssetID: assetID, ToOwnerID: toOwnerID, Amount: amount}
+	txID, signedTransaction, err := s.sendTokenTransaction(owner, atomicstate.AssetNonceKey(owner.ownerID, assetID),
+		op, nil, request.Password, request.FeePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SendTokenResponse{TxID: txID, SignedTransaction: signedTransaction}, nil
+}
+
+func (s *server) MintToken(_ context.Context, request *pb.MintTokenRequest) (*pb.MintTokenResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	asset, assetID, err := s.parseTokenAsset(request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := tokenAmount(request.Amount, asset.Decimals)
+	if err != nil {
+		return nil, err
+	}
+	toOwnerID, err := s.tokenOwnerIDFromAddressString(request.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.selectTokenOwner(request.FromAddress, func(ownerID atomicID) error {
+		if hex.EncodeToString(ownerID[:]) != asset.MintAuthorityOwnerID {
+			return errors.Errorf("owner %x is not the mint authority of asset %s", ownerID, request.AssetId)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	op := atomicstate.MintOp{AssetID: assetID, ToOwnerID: toOwnerID, Amount: amount}
+	txID, signedTransaction, err := s.sendTokenTransaction(owner, atomicstate.AssetNonceKey(owner.ownerID, assetID),
+		op, nil, request.Password, request.FeePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MintTokenResponse{TxID: txID, SignedTransaction: signedTransaction}, nil
+}
+
+func (s *server) BurnToken(_ context.Context, request *pb.BurnTokenRequest) (*pb.BurnTokenResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	asset, assetID, err := s.parseTokenAsset(request.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := tokenAmount(request.Amount, asset.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.selectTokenOwner(request.FromAddress, func(ownerID atomicID) error {
+		return s.checkTokenBalance(ownerID, request.AssetId, amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	op := atomicstate.BurnOp{AssetID: assetID, Amount: amount}
+	txID, signedTransaction, err := s.sendTokenTransaction(owner, atomicstate.AssetNonceKey(owner.ownerID, assetID),
+		op, nil, request.Password, request.FeePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BurnTokenResponse{TxID: txID, SignedTransaction: signedTransaction}, nil
+}
+
+func (s *server) CreateToken(_ context.Context, request *pb.CreateTokenRequest) (*pb.CreateTokenResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if request.Decimals > math.MaxUint8 {
+		return nil, errors.Errorf("decimals %d is out of range", request.Decimals)
+	}
+
+	supplyMode := atomicstate.PayloadSupplyModeUncapped
+	maxSupply := atomicstate.Uint128{}
+	if request.MaxSupply != "" {
+		var err error
+		maxSupply, err = tokenAmount(request.MaxSupply, request.Decimals)
+		if err != nil {
+			return nil, err
+		}
+		if !maxSupply.IsZero() {
+			supplyMode = atomicstate.PayloadSupplyModeCapped
+		}
+	}
+	initialMintAmount := atomicstate.Uint128{}
+	if request.InitialMintAmount != "" {
+		var err error
+		initialMintAmount, err = tokenAmount(request.InitialMintAmount, request.Decimals)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	owner, err := s.selectTokenOwner(request.FromAddress, func(atomicID) error { return nil })
+	if err != nil {
+		return nil, err
+	}
+
+	mintAuthorityOwnerID := owner.ownerID
+	if request.MintAuthorityAddress != "" {
+		mintAuthorityOwnerID, err = s.tokenOwnerIDFromAddressString(request.MintAuthorityAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var op atomicstate.PayloadOp
+	if initialMintAmount.IsZero() {
+		op = atomicstate.CreateAssetOp{
+			TokenVersion:         atomicstate.CurrentTokenVersion,
+			Decimals:             byte(request.Decimals),
+			SupplyMode:           supplyMode,
+			MaxSupply:            maxSupply,
+			MintAuthorityOwnerID: mintAuthorityOwnerID,
+			Name:                 []byte(request.Name),
+			Symbol:               []byte(request.Symbol),
+			Metadata:             request.Metadata,
+			PlatformTag:          []byte(request.PlatformTag),
+		}
+	} else {
+		initialMintToOwnerID := owner.ownerID
+		if request.InitialMintToAddress != "" {
+			initialMintToOwnerID, err = s.tokenOwnerIDFromAddressString(request.InitialMintToAddress)
+			if err != nil {
+				return nil, err
+			}
+		}
+		op = atomicstate.CreateAssetWithMintOp{
+			TokenVersion:         atomicstate.CurrentTokenVersion,
+			Decimals:             byte(request.Decimals),
+			SupplyMode:           supplyMode,
+			MaxSupply:            maxSupply,
+			MintAuthorityOwnerID: mintAuthorityOwnerID,
+			Name:                 []byte(request.Name),
+			Symbol:               []byte(request.Symbol),
+			Metadata:             request.Metadata,
+			InitialMintAmount:    initialMintAmount,
+			InitialMintToOwnerID: initialMintToOwnerID,
+			PlatformTag:          []byte(request.PlatformTag),
+		}
+	}
+	txID, signedTransaction, err := s.sendTokenTransaction(owner, atomicstate.OwnerNonceKey(owner.ownerID),
+		op, nil, request.Password, request.FeePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateTokenResponse{TxID: txID, SignedTransaction: signedTransaction, AssetId: txID}, nil
+}
+
+// sendTokenTransaction builds a transaction carrying the given CAT op, signs it
+// with the wallet keys and broadcasts it. The auth input is the first input, and
+// the change is paid back to the owner address, so that the owner always keeps
+// an anchor UTXO. transition must be set for liquidity ops, and nil otherwise.
+func (s *server) sendTokenTransaction(owner *tokenOwner, nonceKey atomicstate.NonceKey, op atomicstate.PayloadOp,
+	transition *liquidityTransition, password string, feePolicy *pb.FeePolicy) (string, []byte, error) {
+
+	if !s.isSynced() {
+		return "", nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	feeRate, maxFee, err := s.calculateFeeLimits(feePolicy)
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce, err := s.nextTokenNonce(nonceKey)
+	if err != nil {
+		return "", nil, err
+	}
+	payload, err := atomicstate.EncodePayload(&atomicstate.ParsedPayload{AuthInputIndex: 0, Nonce: nonce, Op: op})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error encoding the token payload")
+	}
+
+	unsignedTransaction, err := s.createUnsignedTokenTransaction(owner, payload, transition, feeRate, maxFee)
+	if err != nil {
+		return "", nil, err
+	}
+
+	signedTransactions, err := s.signTransactions([][]byte{unsignedTransaction}, password)
+	if err != nil {
+		return "", nil, err
+	}
+
+	txIDs, err := s.broadcast(signedTransactions, false)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error broadcasting transactions %s", EncodeTransactionsToHex(signedTransactions))
+	}
+
+	s.tokenNonces[nonceKey] = &pendingTokenNonce{nonce: nonce, broadcastTime: time.Now()}
+	return txIDs[0], signedTransactions[0], nil
+}
+
+// createUnsignedTokenTransaction selects spendable UTXOs of the owner address
+// until they cover the fee, and returns a serialized unsigned transaction that
+// pays the rest back to the owner address
+func (s *server) createUnsignedTokenTransaction(owner *tokenOwner, payload []byte, transition *liquidityTransition,
+	feeRate float64, maxFee uint64) ([]byte, error) {
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	var selectedUTXOs []*libcryptixwallet.UTXO
+	totalValue := uint64(0)
+	for _, utxo := range s.utxosSortedByAmount {
+		if *utxo.address != *owner.walletAddress || !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+			continue
+		}
+		if broadcastTime, ok := s.usedOutpoints[*utxo.Outpoint]; ok {
+			if !s.usedOutpointHasExpired(broadcastTime) {
+				continue
+			}
+			delete(s.usedOutpoints, *utxo.Outpoint)
+		}
+
+		selectedUTXOs = append(selectedUTXOs, &libcryptixwallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.walletAddressPath(utxo.address),
+		})
+		totalValue += utxo.UTXOEntry.Amount()
+
+		fee, err := s.estimateTokenTransactionFee(owner, selectedUTXOs, totalValue, payload, transition, feeRate, maxFee)
+		if err != nil {
+			return nil, err
+		}
+		change, ok := transition.ownerChange(totalValue, fee)
+		if !ok {
+			continue
+		}
+
+		payments := transition.payments(owner, change)
+		unsignedTransaction, err := s.createUnsignedPayloadTransaction(payments, selectedUTXOs, payload, transition)
+		if err != nil {
+			return nil, err
+		}
+		return serialization.SerializePartiallySignedTransaction(unsignedTransaction)
+	}
+
+	return nil, errors.Errorf("address %s doesn't have enough spendable funds to pay for the token transaction fee",
+		owner.address)
+}
+
+func (s *server) createUnsignedPayloadTransaction(payments []*libcryptixwallet.Payment,
+	selectedUTXOs []*libcryptixwallet.UTXO, payload []byte, transition *liquidityTransition) (
+	*serialization.PartiallySignedTransaction, error) {
+
+	if transition == nil {
+		return libcryptixwallet.CreateUnsignedPayloadTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures, payments, selectedUTXOs, payload)
+	}
+	return libcryptixwallet.CreateUnsignedLiquidityTransaction(s.keysFile.ExtendedPublicKeys,
+		s.keysFile.MinimumSignatures, payments, selectedUTXOs, transition.vault, payload)
+}
+
+func (s *server) estimateTokenTransactionFee(owner *tokenOwner, selectedUTXOs []*libcryptixwallet.UTXO,
+	totalValue uint64, payload []byte, transition *liquidityTransition, feeRate float64, maxFee uint64) (uint64, error) {
+
+	// We ignore the fee in the change value since we expect it to be insignificant in mass calculation.
+	mockPayments := transition.payments(owner, totalValue)
+	mockTx, err := s.createUnsignedPayloadTransaction(mockPayments, selectedUTXOs, payload, transition)
+	if err != nil {
+		return 0, err
+	}
+
+	mass, err := s.estimateMassAfterSignatures(mockTx)
+	if err != nil {
+		return 0, err
+	}
+
+	return min(uint64(math.Ceil(float64(mass)*feeRate)), maxFee), nil
+}
+
+// nextTokenNonce returns the nonce the next token transaction in the given
+// scope should use, taking into account transactions this wallet already
+// broadcasted but the node did not accept yet
+func (s *server) nextTokenNonce(nonceKey atomicstate.NonceKey) (uint64, error) {
+	assetID := ""
+	if nonceKey.ScopeKind == atomicstate.NonceScopeAsset {
+		assetID = hex.EncodeToString(nonceKey.ScopeID[:])
+	}
+	response, err := s.rpcClient.GetAtomicNonce(hex.EncodeToString(nonceKey.OwnerID[:]), "", assetID)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce := response.NextNonce
+	if pending, ok := s.tokenNonces[nonceKey]; ok {
+		if s.usedOutpointHasExpired(pending.broadcastTime) {
+			delete(s.tokenNonces, nonceKey)
+		} else if pending.nonce >= nonce {
+			nonce = pending.nonce + 1
+		}
+	}
+	return nonce, nil
+}
+
+// selectTokenOwner returns the wallet address that should authorize a token
+// transaction. If fromAddress is empty, the first wallet address with
+// spendable funds that passes `check` is selected.
+func (s *server) selectTokenOwner(fromAddress string, check func(ownerID atomicID) error) (*tokenOwner, error) {
+	if fromAddress != "" {
+		walletAddress, exists := s.addressSet[fromAddress]
+		if !exists {
+			return nil, errors.Errorf("specified from address %s does not exists", fromAddress)
+		}
+		owner, err := s.tokenOwner(walletAddress)
+		if err != nil {
+			return nil, err
+		}
+		err = check(owner.ownerID)
+		if err != nil {
+			return nil, err
+		}
+		return owner, nil
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	checked := make(map[walletAddress]struct{})
+	for _, utxo := range s.utxosSortedByAmount {
+		if _, ok := checked[*utxo.address]; ok || !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+			continue
+		}
+		checked[*utxo.address] = struct{}{}
+
+		owner, err := s.tokenOwner(utxo.address)
+		if err != nil {
+			return nil, err
+		}
+		lastErr = check(owner.ownerID)
+		if lastErr == nil {
+			return owner, nil
+		}
+	}
+	if lastErr != nil {
+		return nil, errors.Wrap(lastErr, "couldn't find a wallet address with spendable funds that can perform the operation")
+	}
+	return nil, errors.Errorf("couldn't find a wallet address with spendable funds")
+}
+
+func (s *server) tokenOwner(walletAddress *walletAddress) (*tokenOwner, error) {
+	address, err := libcryptixwallet.Address(s.params, s.keysFile.ExtendedPublicKeys, s.keysFile.MinimumSignatures,
+		s.walletAddressPath(walletAddress), s.keysFile.ECDSA)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := tokenOwnerIDFromAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenOwner{walletAddress: walletAddress, address: address, ownerID: ownerID}, nil
+}
+
+func (s *server) tokenOwnerIDFromAddressString(addressString string) (atomicID, error) {
+	address, err := util.DecodeAddress(addressString, s.params.Prefix)
+	if err != nil {
+		return atomicID{}, err
+	}
+	return tokenOwnerIDFromAddress(address)
+}
+
+func tokenOwnerIDFromAddress(address util.Address) (atomicID, error) {
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return atomicID{}, err
+	}
+	ownerID, ok := atomicstate.OwnerIDFromScript(scriptPublicKey)
+	if !ok {
+		return atomicID{}, errors.Errorf("address %s cannot own tokens", address)
+	}
+	return ownerID, nil
+}
+
+func (s *server) checkTokenBalance(ownerID atomicID, assetID string, amount atomicstate.Uint128) error {
+	response, err := s.rpcClient.GetAtomicBalancesByOwner(hex.EncodeToString(ownerID[:]), "")
+	if err != nil {
+		return err
+	}
+	balance := big.NewInt(0)
+	for _, atomicBalance := range response.Balances {
+		if atomicBalance.AssetID == assetID {
+			var ok bool
+			balance, ok = new(big.Int).SetString(atomicBalance.Balance, 10)
+			if !ok {
+				return errors.Errorf("got an invalid balance %s from the node", atomicBalance.Balance)
+			}
+			break
+		}
+	}
+	if balance.Cmp(amount.Big()) < 0 {
+		return errors.Errorf("insufficient token balance for owner %x: %s required, while only %s available",
+			ownerID, amount.Big(), balance)
+	}
+	return nil
+}
+
+func (s *server) parseTokenAsset(assetIDString string) (*appmessage.RPCAtomicAsset, atomicID, error) {
+	assetIDBytes, err := hex.DecodeString(assetIDString)
+	if err != nil || len(assetIDBytes) != externalapi.DomainHashSize {
+		return nil, atomicID{}, errors.Errorf("invalid asset ID %s", assetIDString)
+	}
+	var assetID atomicID
+	copy(assetID[:], assetIDBytes)
+
+	asset, err := s.getAtomicAsset(assetIDString)
+	if err != nil {
+		return nil, atomicID{}, err
+	}
+	return asset, assetID, nil
+}
+
+func (s *server) getAtomicAsset(assetID string) (*appmessage.RPCAtomicAsset, error) {
+	response, err := s.rpcClient.GetAtomicAsset(assetID)
+	if err != nil {
+		return nil, err
+	}
+	if response.Asset == nil {
+		return nil, errors.Errorf("asset %s was not found", assetID)
+	}
+	return response.Asset, nil
+}
+
+func tokenAmount(amount string, decimals uint32) (atomicstate.Uint128, error) {
+	raw, err := utils.TokenAmountToRaw(amount, decimals)
+	if err != nil {
+		return atomicstate.Uint128{}, err
+	}
+	value, ok := atomicstate.Uint128FromBig(raw)
+	if !ok {
+		return atomicstate.Uint128{}, errors.Errorf("amount %s is out of range", amount)
+	}
+	return value, nil
+}
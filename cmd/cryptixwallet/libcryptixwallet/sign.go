@@ -62,6 +62,9 @@ func sign(params *dagconfig.Params, mnemonic string, partiallySignedTransaction
 
 	signed := false
 	for i, partiallySignedInput := range partiallySignedTransaction.PartiallySignedInputs {
+		if len(partiallySignedInput.PubKeySignaturePairs) == 0 {
+			continue
+		}
 		isMultisig := len(partiallySignedInput.PubKeySignaturePairs) > 1
 		path := defaultPath(isMultisig)
 		extendedKey, err := extendedKeyFromMnemonicAndPath(mnemonic, path, params)
@@ -4,6 +4,7 @@ import (
 	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/libcryptixwallet/bip32"
 	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/libcryptixwallet/serialization"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/constants"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/subnetworks"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/txscript"
@@ -18,6 +19,14 @@ type Payment struct {
 	Amount  uint64
 }
 
+// LiquidityVault is a CAT liquidity vault UTXO spent by a liquidity transaction,
+// along with the value of the vault output that replaces it
+type LiquidityVault struct {
+	Outpoint *externalapi.DomainOutpoint
+	Value    uint64
+	NewValue uint64
+}
+
 // UTXO is a type that stores a UTXO and meta data
 // that is needed in order to sign it and create
 // transactions with it.
@@ -35,7 +44,60 @@ func CreateUnsignedTransaction(
 	selectedUTXOs []*UTXO) (*serialization.PartiallySignedTransaction, error) {
 
 	sortPublicKeys(extendedPublicKeys)
-	return createUnsignedTransaction(extendedPublicKeys, minimumSignatures, payments, selectedUTXOs)
+	return createUnsignedTransaction(extendedPublicKeys, minimumSignatures, payments, selectedUTXOs,
+		subnetworks.SubnetworkIDNative, nil)
+}
+
+// CreateUnsignedPayloadTransaction creates an unsigned transaction on the payload
+// subnetwork that carries the given payload, such as a CAT operation
+func CreateUnsignedPayloadTransaction(
+	extendedPublicKeys []string,
+	minimumSignatures uint32,
+	payments []*Payment,
+	selectedUTXOs []*UTXO,
+	payload []byte) (*serialization.PartiallySignedTransaction, error) {
+
+	sortPublicKeys(extendedPublicKeys)
+	return createUnsignedTransaction(extendedPublicKeys, minimumSignatures, payments, selectedUTXOs,
+		subnetworks.SubnetworkIDPayload, payload)
+}
+
+// CreateUnsignedLiquidityTransaction creates an unsigned payload transaction that
+// also spends the given liquidity vault and recreates it as its last output. The
+// vault input is anyone-can-spend, so it requires no signatures.
+func CreateUnsignedLiquidityTransaction(
+	extendedPublicKeys []string,
+	minimumSignatures uint32,
+	payments []*Payment,
+	selectedUTXOs []*UTXO,
+	vault *LiquidityVault,
+	payload []byte) (*serialization.PartiallySignedTransaction, error) {
+
+	sortPublicKeys(extendedPublicKeys)
+	partiallySignedTransaction, err := createUnsignedTransaction(extendedPublicKeys, minimumSignatures, payments,
+		selectedUTXOs, subnetworks.SubnetworkIDPayload, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	vaultScriptPublicKey := atomicstate.LiquidityVaultScriptPublicKey()
+	partiallySignedTransaction.Tx.Inputs = append(partiallySignedTransaction.Tx.Inputs,
+		&externalapi.DomainTransactionInput{PreviousOutpoint: *vault.Outpoint})
+	partiallySignedTransaction.PartiallySignedInputs = append(partiallySignedTransaction.PartiallySignedInputs,
+		&serialization.PartiallySignedInput{
+			PrevOutput: &externalapi.DomainTransactionOutput{
+				Value:           vault.Value,
+				ScriptPublicKey: vaultScriptPublicKey,
+			},
+			MinimumSignatures:    0,
+			PubKeySignaturePairs: []*serialization.PubKeySignaturePair{},
+		})
+	partiallySignedTransaction.Tx.Outputs = append(partiallySignedTransaction.Tx.Outputs,
+		&externalapi.DomainTransactionOutput{
+			Value:           vault.NewValue,
+			ScriptPublicKey: vaultScriptPublicKey,
+		})
+	return partiallySignedTransaction, nil
 }
 
 func multiSigRedeemScript(extendedPublicKeys []string, minimumSignatures uint32, path string, ecdsa bool) ([]byte, error) {
@@ -94,7 +156,9 @@ func createUnsignedTransaction(
 	extendedPublicKeys []string,
 	minimumSignatures uint32,
 	payments []*Payment,
-	selectedUTXOs []*UTXO) (*serialization.PartiallySignedTransaction, error) {
+	selectedUTXOs []*UTXO,
+	subnetworkID externalapi.DomainSubnetworkID,
+	payload []byte) (*serialization.PartiallySignedTransaction, error) {
 
 	inputs := make([]*externalapi.DomainTransactionInput, len(selectedUTXOs))
 	partiallySignedInputs := make([]*serialization.PartiallySignedInput, len(selectedUTXOs))
@@ -146,9 +210,9 @@ func createUnsignedTransaction(
 		Inputs:       inputs,
 		Outputs:      outputs,
 		LockTime:     0,
-		SubnetworkID: subnetworks.SubnetworkIDNative,
+		SubnetworkID: subnetworkID,
 		Gas:          0,
-		Payload:      nil,
+		Payload:      payload,
 	}
 
 	return &serialization.PartiallySignedTransaction{
@@ -202,7 +266,13 @@ func ExtractTransactionDeserialized(partiallySignedTransaction *serialization.Pa
 	for i, input := range partiallySignedTransaction.PartiallySignedInputs {
 		isMultisig := len(input.PubKeySignaturePairs) > 1
 		scriptBuilder := txscript.NewScriptBuilder()
-		if isMultisig {
+		if len(input.PubKeySignaturePairs) == 0 {
+			// Inputs without keys, such as liquidity vaults, are spent with an empty signature script
+			if input.MinimumSignatures > 0 {
+				return nil, errors.Errorf("input %d requires signatures but has no public keys", i)
+			}
+			partiallySignedTransaction.Tx.Inputs[i].SignatureScript = []byte{}
+		} else if isMultisig {
 			signatureCount := 0
 			for _, pair := range input.PubKeySignaturePairs {
 				if pair.Signature != nil {
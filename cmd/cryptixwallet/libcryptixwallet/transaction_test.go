@@ -11,10 +11,12 @@ import (
 	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/libcryptixwallet"
 	"github.com/cryptix-network/cryptixd/domain/consensus"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/testutils"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/txscript"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/utxo"
+	"github.com/cryptix-network/cryptixd/domain/dagconfig"
 	"github.com/cryptix-network/cryptixd/util"
 )
 
@@ -532,3 +534,79 @@ func TestMaxSompi(t *testing.T) {
 		}
 	})
 }
+
+func TestLiquidityVaultInput(t *testing.T) {
+	forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+		params := &dagconfig.MainnetParams
+		mnemonic, err := libcryptixwallet.CreateMnemonic()
+		if err != nil {
+			t.Fatalf("CreateMnemonic: %+v", err)
+		}
+		publicKey, err := libcryptixwallet.MasterPublicKeyFromMnemonic(params, mnemonic, false)
+		if err != nil {
+			t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+		}
+
+		path := "m/1/2/3"
+		address, err := libcryptixwallet.Address(params, []string{publicKey}, 1, path, ecdsa)
+		if err != nil {
+			t.Fatalf("Address: %+v", err)
+		}
+		scriptPublicKey, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			t.Fatalf("PayToAddrScript: %+v", err)
+		}
+
+		selectedUTXOs := []*libcryptixwallet.UTXO{{
+			Outpoint:       &externalapi.DomainOutpoint{Index: 0},
+			UTXOEntry:      utxo.NewUTXOEntry(1000, scriptPublicKey, false, 0),
+			DerivationPath: path,
+		}}
+		vault := &libcryptixwallet.LiquidityVault{
+			Outpoint: &externalapi.DomainOutpoint{Index: 1},
+			Value:    5000,
+			NewValue: 5500,
+		}
+		unsignedTransaction, err := libcryptixwallet.CreateUnsignedLiquidityTransaction([]string{publicKey}, 1,
+			[]*libcryptixwallet.Payment{{Address: address, Amount: 400}}, selectedUTXOs, vault, []byte{1})
+		if err != nil {
+			t.Fatalf("CreateUnsignedLiquidityTransaction: %+v", err)
+		}
+		serializedTransaction, err := serialization.SerializePartiallySignedTransaction(unsignedTransaction)
+		if err != nil {
+			t.Fatalf("SerializePartiallySignedTransaction: %+v", err)
+		}
+
+		signedTransaction, err := libcryptixwallet.Sign(params, []string{mnemonic}, serializedTransaction, ecdsa)
+		if err != nil {
+			t.Fatalf("Sign: %+v", err)
+		}
+		tx, err := libcryptixwallet.ExtractTransaction(signedTransaction, ecdsa)
+		if err != nil {
+			t.Fatalf("ExtractTransaction: %+v", err)
+		}
+
+		if len(tx.Inputs) != 2 || len(tx.Outputs) != 2 {
+			t.Fatalf("unexpected transaction shape: %d inputs, %d outputs", len(tx.Inputs), len(tx.Outputs))
+		}
+		vaultOutput := tx.Outputs[1]
+		if vaultOutput.Value != vault.NewValue || !atomicstate.IsLiquidityVaultScript(vaultOutput.ScriptPublicKey.Script) {
+			t.Fatalf("unexpected vault output %+v", vaultOutput)
+		}
+		if len(tx.Inputs[1].SignatureScript) != 0 {
+			t.Fatalf("expected an empty vault signature script, got %x", tx.Inputs[1].SignatureScript)
+		}
+
+		for i, input := range tx.Inputs {
+			engine, err := txscript.NewEngine(input.UTXOEntry.ScriptPublicKey(), tx, i, txscript.ScriptNoFlags,
+				txscript.NewSigCache(10), txscript.NewSigCacheECDSA(10), &consensushashing.SighashReusedValues{})
+			if err != nil {
+				t.Fatalf("NewEngine for input %d: %+v", i, err)
+			}
+			err = engine.Execute()
+			if err != nil {
+				t.Fatalf("Execute for input %d: %+v", i, err)
+			}
+		}
+	})
+}
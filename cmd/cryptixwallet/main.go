@@ -42,6 +42,24 @@ func main() {
 		err = bumpFee(config.(*bumpFeeConfig))
 	case bumpFeeUnsignedSubCmd:
 		err = bumpFeeUnsigned(config.(*bumpFeeUnsignedConfig))
+	case tokenBalanceSubCmd:
+		err = tokenBalance(config.(*tokenBalanceConfig))
+	case tokenSendSubCmd:
+		err = tokenSend(config.(*tokenSendConfig))
+	case tokenMintSubCmd:
+		err = tokenMint(config.(*tokenMintConfig))
+	case tokenBurnSubCmd:
+		err = tokenBurn(config.(*tokenBurnConfig))
+	case tokenCreateSubCmd:
+		err = tokenCreate(config.(*tokenCreateConfig))
+	case tokenQuoteSubCmd:
+		err = tokenQuote(config.(*tokenQuoteConfig))
+	case tokenBuySubCmd:
+		err = tokenBuy(config.(*tokenBuyConfig))
+	case tokenSellSubCmd:
+		err = tokenSell(config.(*tokenSellConfig))
+	case tokenClaimFeesSubCmd:
+		err = tokenClaimFees(config.(*tokenClaimFeesConfig))
 	default:
 		err = errors.Errorf("Unknown sub-command '%s'\n", subCmd)
 	}
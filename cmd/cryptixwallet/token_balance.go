@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/client"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/utils"
+	"github.com/pkg/errors"
+)
+
+func tokenBalance(conf *tokenBalanceConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.GetTokenBalance(ctx, &pb.GetTokenBalanceRequest{AssetId: conf.AssetID})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Balances) == 0 {
+		fmt.Println("The wallet doesn't hold any tokens")
+		return nil
+	}
+
+	type assetTotal struct {
+		balance *pb.TokenBalance
+		total   *big.Int
+	}
+	var assetIDs []string
+	totals := make(map[string]*assetTotal)
+	for _, balance := range response.Balances {
+		amount, ok := new(big.Int).SetString(balance.Amount, 10)
+		if !ok {
+			return errors.Errorf("Got an invalid token amount %s from the daemon", balance.Amount)
+		}
+		fmt.Printf("%s %s %s %s\n", balance.Address, balance.AssetId,
+			utils.FormatTokenAmount(amount, balance.Decimals), balance.Symbol)
+
+		total, ok := totals[balance.AssetId]
+		if !ok {
+			total = &assetTotal{balance: balance, total: big.NewInt(0)}
+			totals[balance.AssetId] = total
+			assetIDs = append(assetIDs, balance.AssetId)
+		}
+		total.total.Add(total.total, amount)
+	}
+
+	fmt.Println()
+	for _, assetID := range assetIDs {
+		total := totals[assetID]
+		fmt.Printf("Total balance of %s (%s): %s %s\n", total.balance.Name, assetID,
+			utils.FormatTokenAmount(total.total, total.balance.Decimals), total.balance.Symbol)
+	}
+
+	return nil
+}
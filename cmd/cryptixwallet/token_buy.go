@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/client"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/keys"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/utils"
+)
+
+func tokenBuy(conf *tokenBuyConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	cpayIn, err := utils.CpayToSompi(conf.Amount)
+	if err != nil {
+		return err
+	}
+
+	if len(conf.Password) == 0 {
+		conf.Password = keys.GetPassword("Password:")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.BuyLiquidityToken(ctx, &pb.BuyLiquidityTokenRequest{
+		AssetId:     conf.AssetID,
+		CpayInSompi: cpayIn,
+		SlippageBps: conf.SlippageBPS,
+		FromAddress: conf.FromAddress,
+		Password:    conf.Password,
+		FeePolicy:   feePolicyFromFlags(conf.MaxFeeRate, conf.FeeRate, conf.MaxFee),
+	})
+	if err != nil {
+		return err
+	}
+
+	tokenOut, err := formatRawTokenAmount(response.TokenOut, response.Decimals)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Spent %s CPAY for %s tokens\n", utils.FormatCpay(response.CpayInSompi), tokenOut)
+	printTokenTransaction(response.TxID, response.SignedTransaction, conf.Verbose)
+	return nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/client"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/keys"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/utils"
+)
+
+func tokenClaimFees(conf *tokenClaimFeesConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	var amount uint64
+	if conf.Amount != "" {
+		amount, err = utils.CpayToSompi(conf.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(conf.Password) == 0 {
+		conf.Password = keys.GetPassword("Password:")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.ClaimLiquidityFees(ctx, &pb.ClaimLiquidityFeesRequest{
+		AssetId:     conf.AssetID,
+		AmountSompi: amount,
+		FromAddress: conf.FromAddress,
+		Password:    conf.Password,
+		FeePolicy:   feePolicyFromFlags(conf.MaxFeeRate, conf.FeeRate, conf.MaxFee),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Claimed %s CPAY of trading fees\n", utils.FormatCpay(response.AmountSompi))
+	printTokenTransaction(response.TxID, response.SignedTransaction, conf.Verbose)
+	return nil
+}
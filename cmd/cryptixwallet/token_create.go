@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/client"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/keys"
+	"github.com/pkg/errors"
+)
+
+func tokenCreate(conf *tokenCreateConfig) error {
+	metadata, err := hex.DecodeString(conf.Metadata)
+	if err != nil {
+		return errors.Wrap(err, "Error decoding the token metadata")
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	if len(conf.Password) == 0 {
+		conf.Password = keys.GetPassword("Password:")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.CreateToken(ctx, &pb.CreateTokenRequest{
+		Name:                 conf.Name,
+		Symbol:               conf.Symbol,
+		Decimals:             conf.Decimals,
+		MaxSupply:            conf.MaxSupply,
+		Metadata:             metadata,
+		PlatformTag:          conf.PlatformTag,
+		MintAuthorityAddress: conf.MintAuthorityAddress,
+		InitialMintAmount:    conf.InitialMintAmount,
+		InitialMintToAddress: conf.InitialMintToAddress,
+		FromAddress:          conf.FromAddress,
+		Password:             conf.Password,
+		FeePolicy:            feePolicyFromFlags(conf.MaxFeeRate, conf.FeeRate, conf.MaxFee),
+	})
+	if err != nil {
+		return err
+	}
+
+	printTokenTransaction(response.TxID, response.SignedTransaction, conf.Verbose)
+	fmt.Printf("Asset ID: %s\n", response.AssetId)
+	return nil
+}
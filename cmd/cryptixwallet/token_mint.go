@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/client"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/keys"
+)
+
+func tokenMint(conf *tokenMintConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	if len(conf.Password) == 0 {
+		conf.Password = keys.GetPassword("Password:")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.MintToken(ctx, &pb.MintTokenRequest{
+		AssetId:     conf.AssetID,
+		ToAddress:   conf.ToAddress,
+		Amount:      conf.Amount,
+		FromAddress: conf.FromAddress,
+		Password:    conf.Password,
+		FeePolicy:   feePolicyFromFlags(conf.MaxFeeRate, conf.FeeRate, conf.MaxFee),
+	})
+	if err != nil {
+		return err
+	}
+
+	printTokenTransaction(response.TxID, response.SignedTransaction, conf.Verbose)
+	return nil
+}
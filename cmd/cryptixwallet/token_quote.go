@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/client"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/utils"
+	"github.com/pkg/errors"
+)
+
+func tokenQuote(conf *tokenQuoteConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	request := &pb.QuoteLiquidityTradeRequest{
+		AssetId:     conf.AssetID,
+		SellAmount:  conf.SellAmount,
+		SlippageBps: conf.SlippageBPS,
+	}
+	if conf.BuyAmount != "" {
+		request.BuyCPayInSompi, err = utils.CpayToSompi(conf.BuyAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.QuoteLiquidityTrade(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if conf.BuyAmount != "" {
+		tokenOut, err := formatRawTokenAmount(response.TokenOut, response.Decimals)
+		if err != nil {
+			return err
+		}
+		minTokenOut, err := formatRawTokenAmount(response.MinTokenOut, response.Decimals)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Spends %s CPAY (including a %s CPAY trading fee)\n",
+			utils.FormatCpay(response.CpayInSompi), utils.FormatCpay(response.FeeSompi))
+		fmt.Printf("Buys %s tokens, and at least %s tokens with the given slippage\n", tokenOut, minTokenOut)
+	} else {
+		tokenIn, err := formatRawTokenAmount(response.TokenIn, response.Decimals)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Sells %s tokens\n", tokenIn)
+		fmt.Printf("Receives %s CPAY (after a %s CPAY trading fee), and at least %s CPAY with the given slippage\n",
+			utils.FormatCpay(response.CpayOutSompi), utils.FormatCpay(response.FeeSompi),
+			utils.FormatCpay(response.MinCPayOutSompi))
+	}
+	fmt.Printf("Pool nonce: %d\n", response.PoolNonce)
+	return nil
+}
+
+func formatRawTokenAmount(amount string, decimals uint32) (string, error) {
+	raw, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return "", errors.Errorf("Got an invalid token amount %s from the daemon", amount)
+	}
+	return utils.FormatTokenAmount(raw, decimals), nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/client"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/daemon/pb"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/keys"
+	"github.com/cryptix-network/cryptixd/cmd/cryptixwallet/utils"
+)
+
+func tokenSell(conf *tokenSellConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	if len(conf.Password) == 0 {
+		conf.Password = keys.GetPassword("Password:")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.SellLiquidityToken(ctx, &pb.SellLiquidityTokenRequest{
+		AssetId:     conf.AssetID,
+		Amount:      conf.Amount,
+		SlippageBps: conf.SlippageBPS,
+		FromAddress: conf.FromAddress,
+		Password:    conf.Password,
+		FeePolicy:   feePolicyFromFlags(conf.MaxFeeRate, conf.FeeRate, conf.MaxFee),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sold %s tokens for %s CPAY\n", conf.Amount, utils.FormatCpay(response.CpayOutSompi))
+	printTokenTransaction(response.TxID, response.SignedTransaction, conf.Verbose)
+	return nil
+}
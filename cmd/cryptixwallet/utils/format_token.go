@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var tokenAmountFormat = regexp.MustCompile(`^([1-9]\d*|0)(\.\d+)?$`)
+
+// TokenAmountToRaw takes in a string representation of a token amount in whole
+// token units, and converts it to the asset's smallest unit given its decimals
+func TokenAmountToRaw(amount string, decimals uint32) (*big.Int, error) {
+	if !tokenAmountFormat.MatchString(amount) {
+		return nil, errors.Errorf("Invalid amount")
+	}
+
+	parts := strings.Split(amount, ".")
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = parts[1]
+	}
+	if uint32(len(fraction)) > decimals {
+		return nil, errors.Errorf("Amount %s has more than %d decimal places", amount, decimals)
+	}
+	fraction += strings.Repeat("0", int(decimals)-len(fraction))
+
+	raw, ok := new(big.Int).SetString(parts[0]+fraction, 10)
+	if !ok {
+		return nil, errors.Errorf("Invalid amount")
+	}
+	return raw, nil
+}
+
+// FormatTokenAmount takes an amount in the asset's smallest unit, and returns
+// it in whole token units with the given amount of decimal places
+func FormatTokenAmount(raw *big.Int, decimals uint32) string {
+	if decimals == 0 {
+		return raw.String()
+	}
+
+	digits := raw.String()
+	if uint32(len(digits)) <= decimals {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	split := len(digits) - int(decimals)
+	return digits[:split] + "." + digits[split:]
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestTokenAmountToRaw(t *testing.T) {
+	type testVector struct {
+		amount    string
+		decimals  uint32
+		rawAmount string
+	}
+
+	validCases := []testVector{
+		{amount: "0", decimals: 0, rawAmount: "0"},
+		{amount: "12", decimals: 0, rawAmount: "12"},
+		{amount: "1", decimals: 8, rawAmount: "100000000"},
+		{amount: "1.5", decimals: 2, rawAmount: "150"},
+		{amount: "0.000000000000000001", decimals: 18, rawAmount: "1"},
+		{amount: "340282366920938463463.374607431768211455", decimals: 18, rawAmount: "340282366920938463463374607431768211455"},
+	}
+
+	for _, currentTestVector := range validCases {
+		raw, err := TokenAmountToRaw(currentTestVector.amount, currentTestVector.decimals)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %s", currentTestVector.amount, err)
+			continue
+		}
+		if raw.String() != currentTestVector.rawAmount {
+			t.Errorf("Expected %s with %d decimals to convert to %s. Got: %s", currentTestVector.amount,
+				currentTestVector.decimals, currentTestVector.rawAmount, raw)
+		}
+		formatted := FormatTokenAmount(raw, currentTestVector.decimals)
+		reparsed, err := TokenAmountToRaw(formatted, currentTestVector.decimals)
+		if err != nil || reparsed.Cmp(raw) != 0 {
+			t.Errorf("Formatting %s with %d decimals did not round trip: got %s", raw,
+				currentTestVector.decimals, formatted)
+		}
+	}
+
+	invalidCases := []testVector{
+		{amount: "1.5", decimals: 0},
+		{amount: "0.001", decimals: 2},
+		{amount: "-1", decimals: 8},
+		{amount: "01", decimals: 8},
+		{amount: "1.", decimals: 8},
+		{amount: "a", decimals: 8},
+		{amount: "", decimals: 8},
+	}
+
+	for _, currentTestVector := range invalidCases {
+		_, err := TokenAmountToRaw(currentTestVector.amount, currentTestVector.decimals)
+		if err == nil {
+			t.Errorf("Expected an error but succeeded validation for test case %s", currentTestVector.amount)
+		}
+	}
+}
+
+func TestFormatTokenAmount(t *testing.T) {
+	if formatted := FormatTokenAmount(big.NewInt(5), 3); formatted != "0.005" {
+		t.Errorf("Expected 0.005, got %s", formatted)
+	}
+	if formatted := FormatTokenAmount(big.NewInt(12345), 2); formatted != "123.45" {
+		t.Errorf("Expected 123.45, got %s", formatted)
+	}
+}
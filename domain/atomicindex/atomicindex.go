@@ -0,0 +1,282 @@
+package atomicindex
+
+import (
+	"sync"
+
+	"github.com/cryptix-network/cryptixd/domain"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database"
+	"github.com/cryptix-network/cryptixd/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxHistoryPageSize is the maximal number of entries in a history page
+	MaxHistoryPageSize = 1000
+
+	// blocksPerTransaction is the number of chain blocks indexed in a single
+	// database transaction while catching up with consensus
+	blocksPerTransaction = 100
+)
+
+type atomicEventsProvider interface {
+	GetAtomicEvents(blockHash *externalapi.DomainHash) ([]*atomicstate.Event, error)
+}
+
+// AtomicIndex maintains an index of the token events of accepted payload
+// transactions by owner ID and by asset ID, ordered by accepting DAA score.
+// Unlike acceptance data, the index is not pruned.
+type AtomicIndex struct {
+	domain domain.Domain
+	store  *atomicIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new Atomic index. A new index is built from the pruning point,
+// and an existing one is caught up with the virtual selected parent chain. An
+// index whose selected tip was pruned while the node was down resumes from the
+// pruning point, keeping the history it already has.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*AtomicIndex, error) {
+	atomicIndex := &AtomicIndex{
+		domain: domain,
+		store:  newAtomicIndexStore(database),
+	}
+
+	err := atomicIndex.catchUp()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not catch the Atomic index up with consensus")
+	}
+
+	return atomicIndex, nil
+}
+
+func (ai *AtomicIndex) catchUp() error {
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	selectedTip, err := ai.store.getSelectedTip()
+	if database.IsNotFoundError(err) {
+		log.Infof("Building the Atomic index from the pruning point")
+		return ai.resumeFromPruningPoint()
+	}
+	if err != nil {
+		return err
+	}
+
+	isPruned, err := ai.isBelowPruningPoint(selectedTip)
+	if err != nil {
+		return err
+	}
+	if isPruned {
+		log.Warnf("The selected tip %s of the Atomic index was pruned, resuming from the pruning point. "+
+			"The entries of the chain blocks between them are missing from the Atomic index", selectedTip)
+		return ai.resumeFromPruningPoint()
+	}
+
+	chainPath, err := ai.domain.Consensus().GetVirtualSelectedParentChainFromBlock(selectedTip)
+	if err != nil {
+		return err
+	}
+	return ai.applyChainPath(chainPath)
+}
+
+// isBelowPruningPoint returns whether the given block is unknown to consensus
+// or has a lower blue score than the pruning point
+func (ai *AtomicIndex) isBelowPruningPoint(blockHash *externalapi.DomainHash) (bool, error) {
+	consensus := ai.domain.Consensus()
+	blockInfo, err := consensus.GetBlockInfo(blockHash)
+	if err != nil {
+		return false, err
+	}
+	if !blockInfo.Exists || !blockInfo.HasHeader() {
+		return true, nil
+	}
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return false, err
+	}
+	pruningPointInfo, err := consensus.GetBlockInfo(pruningPoint)
+	if err != nil {
+		return false, err
+	}
+	return blockInfo.BlueScore < pruningPointInfo.BlueScore, nil
+}
+
+// Reset deletes the whole Atomic index and rebuilds it from the chain blocks
+// above the pruning point. History below the pruning point is lost.
+func (ai *AtomicIndex) Reset() error {
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	log.Infof("Starting Atomic index reset")
+
+	err := ai.store.deleteAll()
+	if err != nil {
+		return err
+	}
+	err = ai.resumeFromPruningPoint()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Finished Atomic index reset")
+	return nil
+}
+
+// resumeFromPruningPoint moves the selected tip of the index to the pruning
+// point and indexes the chain blocks above it. Existing entries are kept.
+func (ai *AtomicIndex) resumeFromPruningPoint() error {
+	pruningPoint, err := ai.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+	chainPath, err := ai.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	dbTransaction, err := ai.store.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+	err = ai.store.updateSelectedTip(dbTransaction, pruningPoint)
+	if err != nil {
+		return err
+	}
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	return ai.applyChainPath(chainPath)
+}
+
+// Update updates the Atomic index with the given DAG selected parent chain changes
+func (ai *AtomicIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AtomicIndex.Update")
+	defer onEnd()
+
+	if virtualChangeSet.VirtualSelectedParentChainChanges == nil {
+		return nil
+	}
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	return ai.applyChainPath(virtualChangeSet.VirtualSelectedParentChainChanges)
+}
+
+// applyChainPath removes the entries of the removed chain blocks and adds
+// the entries of the added ones. Every database transaction also moves the
+// selected tip, so an interrupted update is resumed by catchUp.
+func (ai *AtomicIndex) applyChainPath(chainPath *externalapi.SelectedChainPath) error {
+	if len(chainPath.Added) == 0 {
+		if len(chainPath.Removed) > 0 {
+			return errors.Errorf("a selected chain path cannot remove blocks without adding any")
+		}
+		return nil
+	}
+
+	// The removals are committed together with the first batch of additions
+	removed := chainPath.Removed
+	for start := 0; start < len(chainPath.Added); start += blocksPerTransaction {
+		end := start + blocksPerTransaction
+		if end > len(chainPath.Added) {
+			end = len(chainPath.Added)
+		}
+		err := ai.applyChainPathBatch(removed, chainPath.Added[start:end])
+		if err != nil {
+			return err
+		}
+		removed = nil
+	}
+	return nil
+}
+
+func (ai *AtomicIndex) applyChainPathBatch(removed []*externalapi.DomainHash, added []*externalapi.DomainHash) error {
+	dbTransaction, err := ai.store.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for _, blockHash := range removed {
+		log.Tracef("Removing the entries of chain block %s from the Atomic index", blockHash)
+		err := ai.store.removeBlock(dbTransaction, blockHash)
+		if err != nil {
+			return err
+		}
+	}
+	for _, blockHash := range added {
+		err := ai.addBlock(dbTransaction, blockHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = ai.store.updateSelectedTip(dbTransaction, added[len(added)-1])
+	if err != nil {
+		return err
+	}
+	return dbTransaction.Commit()
+}
+
+func (ai *AtomicIndex) addBlock(dbTransaction database.Transaction, blockHash *externalapi.DomainHash) error {
+	provider, ok := ai.domain.Consensus().(atomicEventsProvider)
+	if !ok {
+		return errors.Errorf("consensus does not provide token events")
+	}
+
+	header, err := ai.domain.Consensus().GetBlockHeader(blockHash)
+	if err != nil {
+		return err
+	}
+	events, err := provider.GetAtomicEvents(blockHash)
+	if err != nil {
+		// Token events cannot be computed for blocks whose selected parent
+		// only has an Atomic root, e.g. right after a pruning point sync.
+		// Any other error rolls the batch back, so the block is retried.
+		if !errors.Is(err, atomicstate.ErrEventsUnavailable) {
+			return err
+		}
+		log.Warnf("Could not compute the token events of chain block %s, "+
+			"they are missing from the Atomic index: %s", blockHash, err)
+		return nil
+	}
+
+	log.Tracef("Adding %d entries of chain block %s to the Atomic index", len(events), blockHash)
+	return ai.store.addBlock(dbTransaction, blockHash, header.DAAScore(), events)
+}
+
+// HistoryByOwner returns a page of the entries of the given owner, starting
+// after the given cursor. An empty cursor starts from the oldest entry.
+func (ai *AtomicIndex) HistoryByOwner(ownerID [externalapi.DomainHashSize]byte, cursor []byte, limit int) (
+	*HistoryPage, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AtomicIndex.HistoryByOwner")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	return ai.store.getHistory(bucketForOwner(ownerID), cursor, limit)
+}
+
+// HistoryByAsset returns a page of the entries of the given asset, starting
+// after the given cursor. An empty cursor starts from the oldest entry.
+func (ai *AtomicIndex) HistoryByAsset(assetID [externalapi.DomainHashSize]byte, cursor []byte, limit int) (
+	*HistoryPage, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AtomicIndex.HistoryByAsset")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	return ai.store.getHistory(bucketForAsset(assetID), cursor, limit)
+}
@@ -0,0 +1,23 @@
+package atomicindex
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+)
+
+// HistoryEntry is a token event recorded by the index, together with the
+// chain block that accepted its transaction
+type HistoryEntry struct {
+	AcceptingBlockHash *externalapi.DomainHash
+	AcceptingDAAScore  uint64
+	// Event is the recorded token event. Its Pool is not stored and is always nil.
+	Event *atomicstate.Event
+}
+
+// HistoryPage is a page of history entries ordered by DAA score
+type HistoryPage struct {
+	Entries []*HistoryEntry
+	// NextCursor is the cursor to pass to get the next page, or nil if
+	// this is the last page
+	NextCursor []byte
+}
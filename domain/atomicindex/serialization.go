@@ -0,0 +1,264 @@
+package atomicindex
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/pkg/errors"
+)
+
+const (
+	uint128Size       = 16
+	eventFixedSize    = 1 + externalapi.DomainHashSize*3 + uint128Size + 8
+	balanceChangeSize = externalapi.DomainHashSize + uint128Size*2
+
+	// cursorSize is the size of the key suffix of a history entry:
+	// the accepting DAA score followed by the index of the event in the
+	// accepting block, both big-endian so that keys sort by acceptance order
+	cursorSize = 8 + 4
+)
+
+func historyKeySuffix(acceptingDAAScore uint64, eventIndex uint32) []byte {
+	suffix := make([]byte, cursorSize)
+	binary.BigEndian.PutUint64(suffix[:8], acceptingDAAScore)
+	binary.BigEndian.PutUint32(suffix[8:], eventIndex)
+	return suffix
+}
+
+func serializeEvent(event *atomicstate.Event) []byte {
+	serialized := make([]byte, 0, eventFixedSize+8+
+		externalapi.DomainHashSize*len(event.Recipients)+balanceChangeSize*len(event.BalanceChanges))
+
+	serialized = append(serialized, byte(event.Kind))
+	serialized = append(serialized, event.TransactionID.ByteSlice()...)
+	serialized = append(serialized, event.AssetID[:]...)
+	serialized = append(serialized, event.OwnerID[:]...)
+	amount := event.Amount.ToLE()
+	serialized = append(serialized, amount[:]...)
+	serialized = binary.LittleEndian.AppendUint64(serialized, event.CPayAmountSompi)
+
+	serialized = binary.LittleEndian.AppendUint32(serialized, uint32(len(event.Recipients)))
+	for _, recipient := range event.Recipients {
+		serialized = append(serialized, recipient[:]...)
+	}
+	serialized = binary.LittleEndian.AppendUint32(serialized, uint32(len(event.BalanceChanges)))
+	for _, change := range event.BalanceChanges {
+		serialized = append(serialized, change.OwnerID[:]...)
+		before := change.Before.ToLE()
+		serialized = append(serialized, before[:]...)
+		after := change.After.ToLE()
+		serialized = append(serialized, after[:]...)
+	}
+	return serialized
+}
+
+type eventReader struct {
+	serialized []byte
+	offset     int
+}
+
+func (r *eventReader) read(size int) ([]byte, error) {
+	if r.offset+size > len(r.serialized) {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing a token event")
+	}
+	bytes := r.serialized[r.offset : r.offset+size]
+	r.offset += size
+	return bytes, nil
+}
+
+func (r *eventReader) readHash() ([externalapi.DomainHashSize]byte, error) {
+	var hash [externalapi.DomainHashSize]byte
+	bytes, err := r.read(externalapi.DomainHashSize)
+	if err != nil {
+		return hash, err
+	}
+	copy(hash[:], bytes)
+	return hash, nil
+}
+
+func (r *eventReader) readUint128() (atomicstate.Uint128, error) {
+	bytes, err := r.read(uint128Size)
+	if err != nil {
+		return atomicstate.Uint128{}, err
+	}
+	value, _ := atomicstate.Uint128FromLE(bytes)
+	return value, nil
+}
+
+func (r *eventReader) readUint32() (uint32, error) {
+	bytes, err := r.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(bytes), nil
+}
+
+func (r *eventReader) readUint64() (uint64, error) {
+	bytes, err := r.read(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(bytes), nil
+}
+
+func (r *eventReader) readEvent() (*atomicstate.Event, error) {
+	kind, err := r.read(1)
+	if err != nil {
+		return nil, err
+	}
+	event := &atomicstate.Event{Kind: atomicstate.EventKind(kind[0])}
+
+	transactionID, err := r.readHash()
+	if err != nil {
+		return nil, err
+	}
+	event.TransactionID = *externalapi.NewDomainTransactionIDFromByteArray(&transactionID)
+	event.AssetID, err = r.readHash()
+	if err != nil {
+		return nil, err
+	}
+	event.OwnerID, err = r.readHash()
+	if err != nil {
+		return nil, err
+	}
+	event.Amount, err = r.readUint128()
+	if err != nil {
+		return nil, err
+	}
+	event.CPayAmountSompi, err = r.readUint64()
+	if err != nil {
+		return nil, err
+	}
+
+	recipientCount, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if int(recipientCount) > (len(r.serialized)-r.offset)/externalapi.DomainHashSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing event recipients")
+	}
+	if recipientCount > 0 {
+		event.Recipients = make([][externalapi.DomainHashSize]byte, recipientCount)
+		for i := range event.Recipients {
+			event.Recipients[i], err = r.readHash()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	balanceChangeCount, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if int(balanceChangeCount) > (len(r.serialized)-r.offset)/balanceChangeSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing event balance changes")
+	}
+	if balanceChangeCount > 0 {
+		event.BalanceChanges = make([]atomicstate.BalanceChange, balanceChangeCount)
+		for i := range event.BalanceChanges {
+			change := &event.BalanceChanges[i]
+			change.OwnerID, err = r.readHash()
+			if err != nil {
+				return nil, err
+			}
+			change.Before, err = r.readUint128()
+			if err != nil {
+				return nil, err
+			}
+			change.After, err = r.readUint128()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return event, nil
+}
+
+func deserializeEvent(serialized []byte) (*atomicstate.Event, error) {
+	reader := &eventReader{serialized: serialized}
+	event, err := reader.readEvent()
+	if err != nil {
+		return nil, err
+	}
+	if reader.offset != len(serialized) {
+		return nil, errors.Errorf("%d unexpected trailing bytes after a token event", len(serialized)-reader.offset)
+	}
+	return event, nil
+}
+
+// serializeHistoryEntry serializes the value stored under the owner and asset keys
+func serializeHistoryEntry(entry *HistoryEntry) []byte {
+	serialized := make([]byte, 0, externalapi.DomainHashSize+8+eventFixedSize)
+	serialized = append(serialized, entry.AcceptingBlockHash.ByteSlice()...)
+	serialized = binary.LittleEndian.AppendUint64(serialized, entry.AcceptingDAAScore)
+	return append(serialized, serializeEvent(entry.Event)...)
+}
+
+func deserializeHistoryEntry(serialized []byte) (*HistoryEntry, error) {
+	reader := &eventReader{serialized: serialized}
+	blockHash, err := reader.readHash()
+	if err != nil {
+		return nil, err
+	}
+	acceptingDAAScore, err := reader.readUint64()
+	if err != nil {
+		return nil, err
+	}
+	event, err := deserializeEvent(serialized[reader.offset:])
+	if err != nil {
+		return nil, err
+	}
+	return &HistoryEntry{
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&blockHash),
+		AcceptingDAAScore:  acceptingDAAScore,
+		Event:              event,
+	}, nil
+}
+
+// serializeBlockEvents serializes the events recorded for a chain block, so
+// that they can be removed once the block leaves the selected chain
+func serializeBlockEvents(acceptingDAAScore uint64, events []*atomicstate.Event) []byte {
+	serialized := make([]byte, 0, 12)
+	serialized = binary.LittleEndian.AppendUint64(serialized, acceptingDAAScore)
+	serialized = binary.LittleEndian.AppendUint32(serialized, uint32(len(events)))
+	for _, event := range events {
+		serializedEvent := serializeEvent(event)
+		serialized = binary.LittleEndian.AppendUint32(serialized, uint32(len(serializedEvent)))
+		serialized = append(serialized, serializedEvent...)
+	}
+	return serialized
+}
+
+func deserializeBlockEvents(serialized []byte) (uint64, []*atomicstate.Event, error) {
+	reader := &eventReader{serialized: serialized}
+	acceptingDAAScore, err := reader.readUint64()
+	if err != nil {
+		return 0, nil, err
+	}
+	eventCount, err := reader.readUint32()
+	if err != nil {
+		return 0, nil, err
+	}
+	if int(eventCount) > (len(serialized)-reader.offset)/eventFixedSize {
+		return 0, nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing block events")
+	}
+	events := make([]*atomicstate.Event, eventCount)
+	for i := range events {
+		length, err := reader.readUint32()
+		if err != nil {
+			return 0, nil, err
+		}
+		serializedEvent, err := reader.read(int(length))
+		if err != nil {
+			return 0, nil, err
+		}
+		events[i], err = deserializeEvent(serializedEvent)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return acceptingDAAScore, events, nil
+}
@@ -0,0 +1,71 @@
+package atomicindex
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/pkg/errors"
+)
+
+func testID(b byte) [externalapi.DomainHashSize]byte {
+	var id [externalapi.DomainHashSize]byte
+	id[0] = b
+	id[31] = 0xEE
+	return id
+}
+
+func testEvent(kind atomicstate.EventKind, transactionTag byte, assetID, ownerID byte, recipients ...byte) *atomicstate.Event {
+	transactionID := testID(transactionTag)
+	event := &atomicstate.Event{
+		Kind:            kind,
+		TransactionID:   *externalapi.NewDomainTransactionIDFromByteArray(&transactionID),
+		AssetID:         testID(assetID),
+		OwnerID:         testID(ownerID),
+		Amount:          atomicstate.Uint128{Lo: 7, Hi: 1},
+		CPayAmountSompi: 12345,
+	}
+	for _, recipient := range recipients {
+		event.Recipients = append(event.Recipients, testID(recipient))
+		event.BalanceChanges = append(event.BalanceChanges, atomicstate.BalanceChange{
+			OwnerID: testID(recipient),
+			Before:  atomicstate.Uint128FromUint64(1),
+			After:   atomicstate.Uint128FromUint64(8),
+		})
+	}
+	return event
+}
+
+func TestEventSerialization(t *testing.T) {
+	events := []*atomicstate.Event{
+		testEvent(atomicstate.EventAssetCreated, 1, 2, 3),
+		testEvent(atomicstate.EventTransfer, 4, 2, 3, 5, 6),
+	}
+	for _, event := range events {
+		deserialized, err := deserializeEvent(serializeEvent(event))
+		if err != nil {
+			t.Fatalf("deserializeEvent: %s", err)
+		}
+		if !reflect.DeepEqual(event, deserialized) {
+			t.Fatalf("expected %+v, got %+v", event, deserialized)
+		}
+	}
+
+	daaScore, deserializedEvents, err := deserializeBlockEvents(serializeBlockEvents(42, events))
+	if err != nil {
+		t.Fatalf("deserializeBlockEvents: %s", err)
+	}
+	if daaScore != 42 || !reflect.DeepEqual(events, deserializedEvents) {
+		t.Fatalf("unexpected block events %d %+v", daaScore, deserializedEvents)
+	}
+}
+
+func TestEventDeserializationFailure(t *testing.T) {
+	serialized := serializeEvent(testEvent(atomicstate.EventTransfer, 4, 2, 3, 5, 6))
+	_, err := deserializeEvent(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package atomicindex
+
+import (
+	"bytes"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+var atomicIndexBucket = database.MakeBucket([]byte("atomic-index"))
+var ownerHistoryBucket = atomicIndexBucket.Bucket([]byte("owner"))
+var assetHistoryBucket = atomicIndexBucket.Bucket([]byte("asset"))
+var blockEventsBucket = atomicIndexBucket.Bucket([]byte("block"))
+var selectedTipKey = database.MakeBucket([]byte("")).Key([]byte("atomic-index-selected-tip"))
+
+type atomicIndexStore struct {
+	database database.Database
+}
+
+func newAtomicIndexStore(database database.Database) *atomicIndexStore {
+	return &atomicIndexStore{
+		database: database,
+	}
+}
+
+func bucketForOwner(ownerID [externalapi.DomainHashSize]byte) *database.Bucket {
+	return ownerHistoryBucket.Bucket(ownerID[:])
+}
+
+func bucketForAsset(assetID [externalapi.DomainHashSize]byte) *database.Bucket {
+	return assetHistoryBucket.Bucket(assetID[:])
+}
+
+// eventOwners returns the distinct owners an event touches: the authorizing
+// owner, the recipients and every owner whose balance changed
+func eventOwners(event *atomicstate.Event) [][externalapi.DomainHashSize]byte {
+	var zeroID [externalapi.DomainHashSize]byte
+	owners := make([][externalapi.DomainHashSize]byte, 0, 1+len(event.Recipients)+len(event.BalanceChanges))
+	seen := make(map[[externalapi.DomainHashSize]byte]struct{}, cap(owners))
+	add := func(ownerID [externalapi.DomainHashSize]byte) {
+		if ownerID == zeroID {
+			return
+		}
+		if _, ok := seen[ownerID]; ok {
+			return
+		}
+		seen[ownerID] = struct{}{}
+		owners = append(owners, ownerID)
+	}
+
+	add(event.OwnerID)
+	for _, recipient := range event.Recipients {
+		add(recipient)
+	}
+	for _, change := range event.BalanceChanges {
+		add(change.OwnerID)
+	}
+	return owners
+}
+
+func (ais *atomicIndexStore) addBlock(dbTransaction database.Transaction, blockHash *externalapi.DomainHash,
+	acceptingDAAScore uint64, events []*atomicstate.Event) error {
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	for i, event := range events {
+		suffix := historyKeySuffix(acceptingDAAScore, uint32(i))
+		serializedEntry := serializeHistoryEntry(&HistoryEntry{
+			AcceptingBlockHash: blockHash,
+			AcceptingDAAScore:  acceptingDAAScore,
+			Event:              event,
+		})
+		for _, ownerID := range eventOwners(event) {
+			err := dbTransaction.Put(bucketForOwner(ownerID).Key(suffix), serializedEntry)
+			if err != nil {
+				return err
+			}
+		}
+		err := dbTransaction.Put(bucketForAsset(event.AssetID).Key(suffix), serializedEntry)
+		if err != nil {
+			return err
+		}
+	}
+	return dbTransaction.Put(blockEventsBucket.Key(blockHash.ByteSlice()),
+		serializeBlockEvents(acceptingDAAScore, events))
+}
+
+func (ais *atomicIndexStore) removeBlock(dbTransaction database.Transaction, blockHash *externalapi.DomainHash) error {
+	blockKey := blockEventsBucket.Key(blockHash.ByteSlice())
+	serializedBlockEvents, err := dbTransaction.Get(blockKey)
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	acceptingDAAScore, events, err := deserializeBlockEvents(serializedBlockEvents)
+	if err != nil {
+		return err
+	}
+
+	for i, event := range events {
+		suffix := historyKeySuffix(acceptingDAAScore, uint32(i))
+		for _, ownerID := range eventOwners(event) {
+			err := dbTransaction.Delete(bucketForOwner(ownerID).Key(suffix))
+			if err != nil {
+				return err
+			}
+		}
+		err := dbTransaction.Delete(bucketForAsset(event.AssetID).Key(suffix))
+		if err != nil {
+			return err
+		}
+	}
+	return dbTransaction.Delete(blockKey)
+}
+
+func (ais *atomicIndexStore) updateSelectedTip(dbTransaction database.Transaction, selectedTip *externalapi.DomainHash) error {
+	return dbTransaction.Put(selectedTipKey, selectedTip.ByteSlice())
+}
+
+func (ais *atomicIndexStore) getSelectedTip() (*externalapi.DomainHash, error) {
+	serializedSelectedTip, err := ais.database.Get(selectedTipKey)
+	if err != nil {
+		return nil, err
+	}
+	return externalapi.NewDomainHashFromByteSlice(serializedSelectedTip)
+}
+
+func (ais *atomicIndexStore) getHistory(bucket *database.Bucket, startAfter []byte, limit int) (*HistoryPage, error) {
+	cursor, err := ais.database.Cursor(bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	if len(startAfter) > 0 && len(startAfter) != cursorSize {
+		return nil, errors.Errorf("invalid cursor length %d, expected %d", len(startAfter), cursorSize)
+	}
+
+	page := &HistoryPage{Entries: make([]*HistoryEntry, 0, limit)}
+	var lastSuffix []byte
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		// The entry of the cursor itself may have been removed by a reorg
+		// since the previous page, so the page starts at the first entry
+		// after it rather than at the cursor's key
+		if len(startAfter) > 0 && bytes.Compare(key.Suffix(), startAfter) <= 0 {
+			continue
+		}
+		if len(page.Entries) == limit {
+			// There is at least one more entry, so the next page starts
+			// after the last returned one
+			page.NextCursor = lastSuffix
+			break
+		}
+		lastSuffix = append([]byte(nil), key.Suffix()...)
+		serializedEntry, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		entry, err := deserializeHistoryEntry(serializedEntry)
+		if err != nil {
+			return nil, err
+		}
+		page.Entries = append(page.Entries, entry)
+	}
+	return page, nil
+}
+
+func (ais *atomicIndexStore) deleteAll() error {
+	// First we delete the selected tip, so if anything goes wrong, the index
+	// will be marked as "not synced" and will be reset.
+	err := ais.database.Delete(selectedTipKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := ais.database.Cursor(atomicIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = ais.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package atomicindex
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database/ldb"
+)
+
+func newTestStore(t *testing.T) *atomicIndexStore {
+	t.Helper()
+
+	db, err := ldb.NewLevelDB(t.TempDir(), 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %+v", err)
+	}
+	t.Cleanup(func() {
+		err := db.Close()
+		if err != nil {
+			t.Fatalf("Close: %+v", err)
+		}
+	})
+	return newAtomicIndexStore(db)
+}
+
+func addTestBlock(t *testing.T, store *atomicIndexStore, blockTag byte, daaScore uint64, events ...*atomicstate.Event) {
+	t.Helper()
+
+	blockID := testID(blockTag)
+	dbTransaction, err := store.database.Begin()
+	if err != nil {
+		t.Fatalf("Begin: %+v", err)
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+	err = store.addBlock(dbTransaction, externalapi.NewDomainHashFromByteArray(&blockID), daaScore, events)
+	if err != nil {
+		t.Fatalf("addBlock: %+v", err)
+	}
+	err = dbTransaction.Commit()
+	if err != nil {
+		t.Fatalf("Commit: %+v", err)
+	}
+}
+
+func removeTestBlock(t *testing.T, store *atomicIndexStore, blockTag byte) {
+	t.Helper()
+
+	blockID := testID(blockTag)
+	dbTransaction, err := store.database.Begin()
+	if err != nil {
+		t.Fatalf("Begin: %+v", err)
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+	err = store.removeBlock(dbTransaction, externalapi.NewDomainHashFromByteArray(&blockID))
+	if err != nil {
+		t.Fatalf("removeBlock: %+v", err)
+	}
+	err = dbTransaction.Commit()
+	if err != nil {
+		t.Fatalf("Commit: %+v", err)
+	}
+}
+
+func TestStoreHistoryPaging(t *testing.T) {
+	store := newTestStore(t)
+	const asset, owner, receiver = 0x10, 0x20, 0x30
+
+	addTestBlock(t, store, 1, 100, testEvent(atomicstate.EventAssetCreated, 1, asset, owner))
+	addTestBlock(t, store, 2, 200,
+		testEvent(atomicstate.EventTransfer, 2, asset, owner, receiver),
+		testEvent(atomicstate.EventTransfer, 3, asset, owner, receiver))
+
+	page, err := store.getHistory(bucketForOwner(testID(owner)), nil, 2)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(page.Entries) != 2 || page.Entries[0].AcceptingDAAScore != 100 || page.Entries[1].AcceptingDAAScore != 200 {
+		t.Fatalf("unexpected first page %+v", page.Entries)
+	}
+	if !bytes.Equal(page.NextCursor, historyKeySuffix(200, 0)) {
+		t.Fatalf("unexpected next cursor %x", page.NextCursor)
+	}
+
+	page, err = store.getHistory(bucketForOwner(testID(owner)), page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(page.Entries) != 1 || page.NextCursor != nil {
+		t.Fatalf("unexpected last page %+v", page)
+	}
+	transactionID := testID(3)
+	if !page.Entries[0].Event.TransactionID.Equal(externalapi.NewDomainTransactionIDFromByteArray(&transactionID)) {
+		t.Fatalf("unexpected last entry %+v", page.Entries[0].Event)
+	}
+
+	// The receiver only appears in the transfers, and the asset in every event
+	page, err = store.getHistory(bucketForOwner(testID(receiver)), nil, MaxHistoryPageSize)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(page.Entries) != 2 {
+		t.Fatalf("expected 2 receiver entries, got %d", len(page.Entries))
+	}
+	page, err = store.getHistory(bucketForAsset(testID(asset)), nil, MaxHistoryPageSize)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(page.Entries) != 3 {
+		t.Fatalf("expected 3 asset entries, got %d", len(page.Entries))
+	}
+}
+
+func TestStoreRemoveBlock(t *testing.T) {
+	store := newTestStore(t)
+	const asset, owner, receiver = 0x11, 0x21, 0x31
+
+	addTestBlock(t, store, 1, 100, testEvent(atomicstate.EventAssetCreated, 1, asset, owner))
+	addTestBlock(t, store, 2, 200, testEvent(atomicstate.EventTransfer, 2, asset, owner, receiver))
+
+	removeTestBlock(t, store, 2)
+
+	page, err := store.getHistory(bucketForOwner(testID(receiver)), nil, MaxHistoryPageSize)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(page.Entries) != 0 {
+		t.Fatalf("expected the receiver entries to be removed, got %d", len(page.Entries))
+	}
+	page, err = store.getHistory(bucketForAsset(testID(asset)), nil, MaxHistoryPageSize)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(page.Entries) != 1 || page.Entries[0].AcceptingDAAScore != 100 {
+		t.Fatalf("unexpected asset entries after removal %+v", page.Entries)
+	}
+}
+
+func TestStoreHistoryPagingAfterCursorEntryRemoval(t *testing.T) {
+	store := newTestStore(t)
+	const asset, owner = 0x12, 0x22
+
+	addTestBlock(t, store, 1, 100, testEvent(atomicstate.EventAssetCreated, 1, asset, owner))
+	addTestBlock(t, store, 2, 200, testEvent(atomicstate.EventMint, 2, asset, owner))
+	addTestBlock(t, store, 3, 300, testEvent(atomicstate.EventMint, 3, asset, owner))
+
+	page, err := store.getHistory(bucketForOwner(testID(owner)), nil, 2)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if !bytes.Equal(page.NextCursor, historyKeySuffix(200, 0)) {
+		t.Fatalf("unexpected next cursor %x", page.NextCursor)
+	}
+
+	// A reorg removes the entry the cursor points to
+	removeTestBlock(t, store, 2)
+
+	page, err = store.getHistory(bucketForOwner(testID(owner)), page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(page.Entries) != 1 || page.Entries[0].AcceptingDAAScore != 300 || page.NextCursor != nil {
+		t.Fatalf("unexpected page after the cursor entry was removed %+v", page)
+	}
+}
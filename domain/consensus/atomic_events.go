@@ -0,0 +1,115 @@
+package consensus
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/subnetworks"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/transactionhelper"
+	"github.com/pkg/errors"
+)
+
+// GetAtomicEvents returns the token events of the transactions accepted by
+// the given chain block, in acceptance order. The events are computed by
+// replaying the block's acceptance data over the Atomic state of its selected
+// parent, the same way consensus applies it: each transaction is applied to a
+// copy of the state under the state growth limits of its merge set block.
+func (s *consensus) GetAtomicEvents(blockHash *externalapi.DomainHash) ([]*atomicstate.Event, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	header, err := s.blockHeaderStore.BlockHeader(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if header.DAAScore() < s.payloadHfActivationDAAScore {
+		return nil, nil
+	}
+
+	acceptanceData, err := s.acceptanceDataStore.Get(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !acceptsPayloadTransactions(acceptanceData) {
+		return nil, nil
+	}
+
+	ghostdagData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, blockHash, false)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.atomicStateStore.Get(s.databaseContext, stagingArea, ghostdagData.SelectedParent())
+	if err != nil {
+		return nil, err
+	}
+	if state.IsRootOnly() {
+		return nil, errors.Wrapf(atomicstate.ErrEventsUnavailable, "selected parent %s of block %s "+
+			"has only an Atomic root", ghostdagData.SelectedParent(), blockHash)
+	}
+
+	refs, err := s.atomicTokenReplayRefs(stagingArea, acceptanceData)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*atomicstate.Event
+	var growth *atomicstate.BlockStateGrowth
+	var growthBlockHash *externalapi.DomainHash
+	for _, ref := range refs {
+		tx := ref.transaction
+		if transactionhelper.IsCoinBase(tx) {
+			continue
+		}
+		// State growth is limited per merge set block
+		if growthBlockHash == nil || !growthBlockHash.Equal(ref.sourceBlockHash) {
+			growth = &atomicstate.BlockStateGrowth{}
+			growthBlockHash = ref.sourceBlockHash
+		}
+		creationContext := atomicstate.NewCreationContext(ref.sourceBlockHash, ref.sourceDAAScore, ref.sourceTime)
+		transactionState := state.Clone()
+		txEvents, err := atomicstate.ValidateAndApplyTransactionWithGrowthAndEvents(
+			tx,
+			header.DAAScore(),
+			s.payloadHfActivationDAAScore,
+			creationContext,
+			transactionState,
+			growth,
+			s.atomicStateGrowthLimits,
+		)
+		if err != nil {
+			// Accepted CAT payloads that fail to apply are no-ops that only
+			// move owner anchors, the same way token replay treats them.
+			if subnetworks.IsPayload(tx.SubnetworkID) && len(tx.Payload) > 0 {
+				anchorErr := atomicstate.ApplyAnchorDeltasForTransaction(tx, state)
+				if anchorErr != nil {
+					return nil, anchorErr
+				}
+				continue
+			}
+			return nil, err
+		}
+		state = transactionState
+		events = append(events, txEvents...)
+	}
+	return events, nil
+}
+
+func acceptsPayloadTransactions(acceptanceData externalapi.AcceptanceData) bool {
+	for _, blockAcceptanceData := range acceptanceData {
+		if blockAcceptanceData == nil {
+			continue
+		}
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			if transactionAcceptanceData == nil || !transactionAcceptanceData.IsAccepted ||
+				transactionAcceptanceData.Transaction == nil {
+				continue
+			}
+			if subnetworks.IsPayload(transactionAcceptanceData.Transaction.SubnetworkID) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package consensus
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/pkg/errors"
+)
+
+// GetAtomicBalanceProof returns a proof of the balance of key in the Atomic
+// state of the virtual selected parent
+func (s *consensus) GetAtomicBalanceProof(key atomicstate.BalanceKey) (*atomicstate.CommittedBalanceProof, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	virtualGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, model.VirtualBlockHash, false)
+	if err != nil {
+		return nil, err
+	}
+	selectedParent := virtualGHOSTDAGData.SelectedParent()
+
+	header, err := s.blockHeaderStore.BlockHeader(s.databaseContext, stagingArea, selectedParent)
+	if err != nil {
+		return nil, err
+	}
+	if header.DAAScore() < s.payloadHfActivationDAAScore || header.DAAScore() < s.atomicMerkleRootHfActivationDAAScore {
+		return nil, errors.Wrapf(atomicstate.ErrMerkleRootHFNotActive, "virtual selected parent %s has DAA score %d, "+
+			"the hardfork activates at %d", selectedParent, header.DAAScore(), s.atomicMerkleRootHfActivationDAAScore)
+	}
+
+	atomicState, err := s.atomicStateStore.Get(s.databaseContext, stagingArea, selectedParent)
+	if err != nil {
+		return nil, err
+	}
+	multiset, err := s.multisetStore.Get(s.databaseContext, stagingArea, selectedParent)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, merkleRoot, err := atomicState.ProveBalance(key)
+	if err != nil {
+		return nil, err
+	}
+	return &atomicstate.CommittedBalanceProof{
+		Block:             selectedParent,
+		Header:            header,
+		RawUTXOCommitment: multiset.Hash(),
+		MerkleRoot:        merkleRoot,
+		Proof:             proof,
+	}, nil
+}
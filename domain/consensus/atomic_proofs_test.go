@@ -0,0 +1,73 @@
+package consensus_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/testutils"
+)
+
+type atomicBalanceProver interface {
+	GetAtomicBalanceProof(key atomicstate.BalanceKey) (*atomicstate.CommittedBalanceProof, error)
+}
+
+func TestAtomicBalanceProofAgainstHeader(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.PayloadHfActivationDAAScore = 0
+		consensusConfig.AtomicMerkleRootHfActivationDAAScore = 2
+
+		tc, teardown, err := consensus.NewFactory().NewTestConsensus(consensusConfig, "TestAtomicBalanceProofAgainstHeader")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		prover, ok := tc.(atomicBalanceProver)
+		if !ok {
+			t.Fatalf("test consensus does not provide Atomic balance proofs")
+		}
+		key := atomicstate.BalanceKey{AssetID: [externalapi.DomainHashSize]byte{1}, OwnerID: [externalapi.DomainHashSize]byte{2}}
+
+		tipHash := consensusConfig.GenesisHash
+		for i := 0; i < 5; i++ {
+			tipHash, _, err = tc.AddBlock([]*externalapi.DomainHash{tipHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+
+			header, err := tc.GetBlockHeader(tipHash)
+			if err != nil {
+				t.Fatalf("GetBlockHeader: %+v", err)
+			}
+			committedProof, err := prover.GetAtomicBalanceProof(key)
+			if header.DAAScore() < consensusConfig.AtomicMerkleRootHfActivationDAAScore {
+				if !errors.Is(err, atomicstate.ErrMerkleRootHFNotActive) {
+					t.Fatalf("expected ErrMerkleRootHFNotActive at DAA score %d, got %v", header.DAAScore(), err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("GetAtomicBalanceProof: %+v", err)
+			}
+
+			if !committedProof.Block.Equal(tipHash) {
+				t.Fatalf("expected a proof at %s, got %s", tipHash, committedProof.Block)
+			}
+			expectedCommitment := atomicstate.HeaderCommitment(committedProof.RawUTXOCommitment, committedProof.MerkleRoot, true)
+			if !header.UTXOCommitment().Equal(expectedCommitment) {
+				t.Fatalf("header UTXO commitment %s does not commit to the Merkle root, expected %s",
+					header.UTXOCommitment(), expectedCommitment)
+			}
+			if committedProof.Proof.Included {
+				t.Fatalf("expected a proof of absence")
+			}
+			err = atomicstate.VerifyBalanceProof(committedProof.MerkleRoot, committedProof.Proof)
+			if err != nil {
+				t.Fatalf("VerifyBalanceProof: %+v", err)
+			}
+		}
+	})
+}
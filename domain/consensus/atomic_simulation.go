@@ -0,0 +1,48 @@
+package consensus
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/pkg/errors"
+)
+
+// SimulateAtomicTransaction applies a transaction that is already populated
+// with its UTXO entries to a clone of the virtual Atomic state, the same way
+// the block builder does, and returns the resulting changes along with the
+// virtual DAA score it was simulated at. Atomic rejections are returned as
+// ruleerrors.ErrInvalidPayload.
+func (s *consensus) SimulateAtomicTransaction(transaction *externalapi.DomainTransaction) (
+	*atomicstate.Simulation, uint64, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	virtualDAAScore, err := s.daaBlocksStore.DAAScore(s.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return nil, 0, err
+	}
+	virtualAtomicState, err := s.atomicStateStore.Get(s.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return nil, 0, err
+	}
+	if virtualAtomicState.IsRootOnly() {
+		return nil, 0, errors.Errorf("the virtual has only an Atomic root; " +
+			"the full Atomic state is not available yet")
+	}
+	for i, input := range transaction.Inputs {
+		if input.UTXOEntry == nil {
+			return nil, 0, errors.Errorf("input %d of transaction is not populated with its UTXO entry", i)
+		}
+	}
+
+	simulation, err := atomicstate.SimulateTransaction(transaction, virtualDAAScore, s.payloadHfActivationDAAScore,
+		virtualAtomicState, s.atomicStateGrowthLimits)
+	if err != nil {
+		return nil, virtualDAAScore, errors.Wrapf(ruleerrors.ErrInvalidPayload, "atomic validation failed: %s", err)
+	}
+	return simulation, virtualDAAScore, nil
+}
@@ -21,10 +21,12 @@ type consensus struct {
 	lock            *sync.Mutex
 	databaseContext model.DBManager
 
-	genesisBlock                *externalapi.DomainBlock
-	genesisHash                 *externalapi.DomainHash
-	payloadHfActivationDAAScore uint64
-	startupRepairPlanPath       string
+	genesisBlock                         *externalapi.DomainBlock
+	genesisHash                          *externalapi.DomainHash
+	payloadHfActivationDAAScore          uint64
+	atomicMerkleRootHfActivationDAAScore uint64
+	atomicStateGrowthLimits              atomicstate.StateGrowthLimits
+	startupRepairPlanPath                string
 
 	expectedDAAWindowDurationInMilliseconds int64
 
@@ -123,7 +125,7 @@ func (s *consensus) Init(skipAddingGenesis bool) error {
 		}
 
 		s.consensusStateStore.StageTips(stagingArea, []*externalapi.DomainHash{model.VirtualGenesisBlockHash})
-		s.atomicStateStore.Stage(stagingArea, model.VirtualGenesisBlockHash, atomicstate.NewState())
+		s.atomicStateStore.Stage(stagingArea, model.VirtualGenesisBlockHash, atomicstate.NewState(), nil)
 		for _, ghostdagDataStore := range s.ghostdagDataStores {
 			ghostdagDataStore.Stage(stagingArea, model.VirtualGenesisBlockHash, externalapi.NewBlockGHOSTDAGData(
 				0,
@@ -763,7 +765,10 @@ func (s *consensus) IsStoredBlockUTXOCommitmentValid(blockHash *externalapi.Doma
 			}
 			return false, "", err
 		}
-		atomicStateHash = atomicState.CanonicalHash()
+		atomicStateHash, err = atomicState.CommitmentHash(header.DAAScore() >= s.atomicMerkleRootHfActivationDAAScore)
+		if err != nil {
+			return false, "", err
+		}
 	}
 
 	rawUTXOCommitment := storedMultiset.Hash()
@@ -813,6 +818,32 @@ func (s *consensus) GetAtomicTokenStateDebugReport(blockHash *externalapi.Domain
 	return s.atomicTokenReplayCache.state.TokenIndexDebugReport(nil, maxEntries), true, nil
 }
 
+// GetVirtualSelectedParentAtomicState returns the materialized Atomic state of
+// the virtual selected parent along with that block's hash. The returned state
+// is owned by the caller.
+func (s *consensus) GetVirtualSelectedParentAtomicState() (*atomicstate.State, *externalapi.DomainHash, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	virtualGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, model.VirtualBlockHash, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	selectedParent := virtualGHOSTDAGData.SelectedParent()
+
+	atomicState, err := s.atomicStateStore.Get(s.databaseContext, stagingArea, selectedParent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if atomicState.IsRootOnly() {
+		return nil, nil, errors.Errorf("virtual selected parent %s has only an Atomic root; "+
+			"the full Atomic state is not available yet", selectedParent)
+	}
+	return atomicState, selectedParent, nil
+}
+
 func (s *consensus) GetVirtualUTXOs(expectedVirtualParents []*externalapi.DomainHash,
 	fromOutpoint *externalapi.DomainOutpoint, limit int) ([]*externalapi.OutpointAndUTXOEntryPair, error) {
 
@@ -1,14 +1,28 @@
 package atomicstatestore
 
 import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/database"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
 )
 
+type stagedAtomicState struct {
+	state *atomicstate.State
+	base  *externalapi.DomainHash
+}
+
+// atomicStateRecord is the serialized form of a staged state. base is nil
+// for full records.
+type atomicStateRecord struct {
+	recordBytes []byte
+	base        *externalapi.DomainHash
+	depth       uint64
+}
+
 type atomicStateStagingShard struct {
 	store    *atomicStateStore
-	toAdd    map[externalapi.DomainHash]*atomicstate.State
+	toAdd    map[externalapi.DomainHash]*stagedAtomicState
 	toDelete map[externalapi.DomainHash]struct{}
 }
 
@@ -16,19 +30,68 @@ func (ass *atomicStateStore) stagingShard(stagingArea *model.StagingArea) *atomi
 	return stagingArea.GetOrCreateShard(ass.shardID, func() model.StagingShard {
 		return &atomicStateStagingShard{
 			store:    ass,
-			toAdd:    make(map[externalapi.DomainHash]*atomicstate.State),
+			toAdd:    make(map[externalapi.DomainHash]*stagedAtomicState),
 			toDelete: make(map[externalapi.DomainHash]struct{}),
 		}
 	}).(*atomicStateStagingShard)
 }
 
 func (asss *atomicStateStagingShard) Commit(dbTx model.DBTransaction) error {
-	for hash, state := range asss.toAdd {
+	// dbTx reads see only committed data, so everything that depends on the
+	// previous contents of the store is read before anything is written.
+	materialized, err := asss.materializeDependents(dbTx)
+	if err != nil {
+		return err
+	}
+
+	records := make(map[externalapi.DomainHash]*atomicStateRecord, len(asss.toAdd))
+	for hash := range asss.toAdd {
+		_, err := asss.record(dbTx, &hash, records)
+		if err != nil {
+			return err
+		}
+	}
+
+	for hash := range asss.toAdd {
+		err := asss.removeDependencies(dbTx, &hash)
+		if err != nil {
+			return err
+		}
+	}
+	for hash := range asss.toDelete {
+		err := asss.removeDependencies(dbTx, &hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	for hash, state := range materialized {
 		err := dbTx.Put(asss.store.hashAsKey(&hash), state.CanonicalBytes())
 		if err != nil {
 			return err
 		}
-		asss.store.cache.Add(&hash, state.Clone())
+		err = dbTx.Delete(asss.store.baseKey(&hash))
+		if err != nil {
+			return err
+		}
+	}
+
+	for hash, record := range records {
+		err := dbTx.Put(asss.store.hashAsKey(&hash), record.recordBytes)
+		if err != nil {
+			return err
+		}
+		if record.base != nil {
+			err = dbTx.Put(asss.store.baseKey(&hash), serializeDiffBase(record.base, record.depth))
+			if err != nil {
+				return err
+			}
+			err = dbTx.Put(asss.store.dependentKey(record.base, &hash), []byte{})
+			if err != nil {
+				return err
+			}
+		}
+		asss.store.cache.Add(&hash, asss.toAdd[hash].state.Clone())
 	}
 
 	for hash := range asss.toDelete {
@@ -42,6 +105,174 @@ func (asss *atomicStateStagingShard) Commit(dbTx model.DBTransaction) error {
 	return nil
 }
 
+// materializeDependents reconstructs every committed state stored as a diff
+// over a state that this shard replaces or deletes. Dependents that are
+// themselves replaced or deleted are skipped.
+func (asss *atomicStateStagingShard) materializeDependents(dbTx model.DBTransaction) (
+	map[externalapi.DomainHash]*atomicstate.State, error) {
+
+	materialized := make(map[externalapi.DomainHash]*atomicstate.State)
+	materialize := func(base *externalapi.DomainHash) error {
+		dependents, err := asss.committedDependents(dbTx, base)
+		if err != nil {
+			return err
+		}
+		for _, dependent := range dependents {
+			if _, ok := asss.toAdd[*dependent]; ok {
+				continue
+			}
+			if _, ok := asss.toDelete[*dependent]; ok {
+				continue
+			}
+			if _, ok := materialized[*dependent]; ok {
+				continue
+			}
+			state, err := asss.store.committedState(dbTx, dependent)
+			if err != nil {
+				return err
+			}
+			materialized[*dependent] = state
+		}
+		return nil
+	}
+
+	for hash := range asss.toAdd {
+		err := materialize(&hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for hash := range asss.toDelete {
+		err := materialize(&hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return materialized, nil
+}
+
+// removeDependencies removes the committed base and dependent entries of
+// the given block
+func (asss *atomicStateStagingShard) removeDependencies(dbTx model.DBTransaction, blockHash *externalapi.DomainHash) error {
+	base, _, err := asss.store.diffBase(dbTx, blockHash)
+	if err == nil {
+		err = dbTx.Delete(asss.store.baseKey(blockHash))
+		if err != nil {
+			return err
+		}
+		err = dbTx.Delete(asss.store.dependentKey(base, blockHash))
+		if err != nil {
+			return err
+		}
+	} else if !database.IsNotFoundError(err) {
+		return err
+	}
+
+	dependents, err := asss.committedDependents(dbTx, blockHash)
+	if err != nil {
+		return err
+	}
+	for _, dependent := range dependents {
+		err := dbTx.Delete(asss.store.dependentKey(blockHash, dependent))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (asss *atomicStateStagingShard) committedDependents(dbTx model.DBTransaction,
+	base *externalapi.DomainHash) ([]*externalapi.DomainHash, error) {
+
+	cursor, err := dbTx.Cursor(asss.store.dependentsBucket(base))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var dependents []*externalapi.DomainHash
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		dependent, err := externalapi.NewDomainHashFromByteSlice(key.Suffix())
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, dependent)
+	}
+	return dependents, nil
+}
+
+// record builds the record of a staged state. A state is stored as a diff
+// when its base is available after this commit and the diff chain is
+// shorter than checkpointInterval. Otherwise it is stored in full.
+func (asss *atomicStateStagingShard) record(dbTx model.DBTransaction, blockHash *externalapi.DomainHash,
+	records map[externalapi.DomainHash]*atomicStateRecord) (*atomicStateRecord, error) {
+
+	if record, ok := records[*blockHash]; ok {
+		return record, nil
+	}
+
+	staged := asss.toAdd[*blockHash]
+	storeFull := func() *atomicStateRecord {
+		record := &atomicStateRecord{recordBytes: staged.state.CanonicalBytes()}
+		records[*blockHash] = record
+		return record
+	}
+
+	base := staged.base
+	if base == nil || base.Equal(blockHash) || staged.state.IsRootOnly() {
+		return storeFull(), nil
+	}
+	if _, ok := asss.toDelete[*base]; ok {
+		return storeFull(), nil
+	}
+
+	var baseState *atomicstate.State
+	var baseDepth uint64
+	if stagedBase, ok := asss.toAdd[*base]; ok {
+		baseRecord, err := asss.record(dbTx, base, records)
+		if err != nil {
+			return nil, err
+		}
+		baseState = stagedBase.state
+		baseDepth = baseRecord.depth
+	} else {
+		var err error
+		baseDepth, err = asss.store.committedDepth(dbTx, base)
+		if database.IsNotFoundError(err) {
+			return storeFull(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if baseDepth+1 >= checkpointInterval {
+			return storeFull(), nil
+		}
+		baseState, err = asss.store.committedState(dbTx, base)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if baseState.IsRootOnly() || baseDepth+1 >= checkpointInterval {
+		return storeFull(), nil
+	}
+	diff, err := atomicstate.DiffStates(baseState, staged.state)
+	if err != nil {
+		return nil, err
+	}
+	record := &atomicStateRecord{
+		recordBytes: diff.Bytes(),
+		base:        base,
+		depth:       baseDepth + 1,
+	}
+	records[*blockHash] = record
+	return record, nil
+}
+
 func (asss *atomicStateStagingShard) isStaged() bool {
 	return len(asss.toAdd) != 0 || len(asss.toDelete) != 0
 }
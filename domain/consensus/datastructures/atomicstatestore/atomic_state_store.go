@@ -1,36 +1,57 @@
 package atomicstatestore
 
 import (
+	"bytes"
+	"encoding/binary"
+
 	"github.com/cryptix-network/cryptixd/domain/consensus/database"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model"
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/lrucache"
 	"github.com/cryptix-network/cryptixd/util/staging"
+	"github.com/pkg/errors"
 )
 
 var bucketName = []byte("atomic-states")
+var baseBucketName = []byte("atomic-state-bases")
+var dependentBucketName = []byte("atomic-state-dependents")
+
+// checkpointInterval is the maximal length of a chain of diffs. Every
+// checkpointInterval-th chain block is stored as a full state.
+const checkpointInterval = 100
 
 // atomicStateStore represents a store of consensus Atomic states.
+// States are stored in full at checkpoints and as diffs over a base block
+// everywhere else. Each diff record has an entry in the base bucket holding
+// its base hash and depth, and an entry in the dependent bucket of its base
+// so that dependents can be materialized before their base is removed.
 type atomicStateStore struct {
-	shardID model.StagingShardID
-	cache   *lrucache.LRUCache
-	bucket  model.DBBucket
+	shardID         model.StagingShardID
+	cache           *lrucache.LRUCache
+	bucket          model.DBBucket
+	baseBucket      model.DBBucket
+	dependentBucket model.DBBucket
 }
 
 // New instantiates a new AtomicStateStore.
 func New(prefixBucket model.DBBucket, cacheSize int, preallocate bool) model.AtomicStateStore {
 	return &atomicStateStore{
-		shardID: staging.GenerateShardingID(),
-		cache:   lrucache.New(cacheSize, preallocate),
-		bucket:  prefixBucket.Bucket(bucketName),
+		shardID:         staging.GenerateShardingID(),
+		cache:           lrucache.New(cacheSize, preallocate),
+		bucket:          prefixBucket.Bucket(bucketName),
+		baseBucket:      prefixBucket.Bucket(baseBucketName),
+		dependentBucket: prefixBucket.Bucket(dependentBucketName),
 	}
 }
 
-// Stage stages the given Atomic state for the given blockHash.
-func (ass *atomicStateStore) Stage(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash, state *atomicstate.State) {
+// Stage stages the given Atomic state for the given blockHash. If base is
+// not nil the state may be stored as a diff over the state of base.
+func (ass *atomicStateStore) Stage(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash,
+	state *atomicstate.State, base *externalapi.DomainHash) {
+
 	stagingShard := ass.stagingShard(stagingArea)
-	stagingShard.toAdd[*blockHash] = state.Clone()
+	stagingShard.toAdd[*blockHash] = &stagedAtomicState{state: state.Clone(), base: base}
 }
 
 func (ass *atomicStateStore) IsStaged(stagingArea *model.StagingArea) bool {
@@ -43,25 +64,103 @@ func (ass *atomicStateStore) Get(dbContext model.DBReader, stagingArea *model.St
 
 	stagingShard := ass.stagingShard(stagingArea)
 
-	if state, ok := stagingShard.toAdd[*blockHash]; ok {
-		return state.Clone(), nil
+	if staged, ok := stagingShard.toAdd[*blockHash]; ok {
+		return staged.state.Clone(), nil
+	}
+
+	state, err := ass.committedState(dbContext, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return state.Clone(), nil
+}
+
+// committedState reconstructs the committed state of blockHash by walking its
+// diff chain back to a cached state or a full record. The returned state is
+// shared with the cache and must not be modified.
+func (ass *atomicStateStore) committedState(dbContext model.DBReader,
+	blockHash *externalapi.DomainHash) (*atomicstate.State, error) {
+
+	var diffs []*atomicstate.StateDiff
+	var state *atomicstate.State
+	current := blockHash
+	for {
+		if cached, ok := ass.cache.Get(current); ok {
+			state = cached.(*atomicstate.State)
+			break
+		}
+
+		recordBytes, err := dbContext.Get(ass.hashAsKey(current))
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.HasPrefix(recordBytes, atomicstate.StateDiffMagic) {
+			state, err = atomicstate.FromCanonicalBytes(recordBytes)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		diff, err := atomicstate.StateDiffFromBytes(recordBytes)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+
+		base, _, err := ass.diffBase(dbContext, current)
+		if err != nil {
+			return nil, err
+		}
+		current = base
+	}
+
+	if len(diffs) == 0 {
+		ass.cache.Add(blockHash, state)
+		return state, nil
 	}
 
-	if state, ok := ass.cache.Get(blockHash); ok {
-		return state.(*atomicstate.State).Clone(), nil
+	state = state.Clone()
+	for i := len(diffs) - 1; i >= 0; i-- {
+		err := state.ApplyDiff(diffs[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	ass.cache.Add(blockHash, state)
+	return state, nil
+}
 
-	stateBytes, err := dbContext.Get(ass.hashAsKey(blockHash))
+// committedDepth returns the number of diffs between the committed record of
+// blockHash and its checkpoint
+func (ass *atomicStateStore) committedDepth(dbContext model.DBReader,
+	blockHash *externalapi.DomainHash) (uint64, error) {
+
+	_, depth, err := ass.diffBase(dbContext, blockHash)
+	if err == nil {
+		return depth, nil
+	}
+	if !database.IsNotFoundError(err) {
+		return 0, err
+	}
+	has, err := dbContext.Has(ass.hashAsKey(blockHash))
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	if !has {
+		return 0, errors.Wrapf(database.ErrNotFound, "atomic state of %s not found", blockHash)
 	}
+	return 0, nil
+}
+
+func (ass *atomicStateStore) diffBase(dbContext model.DBReader,
+	blockHash *externalapi.DomainHash) (*externalapi.DomainHash, uint64, error) {
 
-	state, err := atomicstate.FromCanonicalBytes(stateBytes)
+	baseBytes, err := dbContext.Get(ass.baseKey(blockHash))
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	ass.cache.Add(blockHash, state)
-	return state.Clone(), nil
+	return deserializeDiffBase(baseBytes)
 }
 
 // Delete deletes the Atomic state associated with the given blockHash.
@@ -123,3 +222,33 @@ func (ass *atomicStateStore) DeleteEntriesAboveDAA(
 func (ass *atomicStateStore) hashAsKey(hash *externalapi.DomainHash) model.DBKey {
 	return ass.bucket.Key(hash.ByteSlice())
 }
+
+func (ass *atomicStateStore) baseKey(hash *externalapi.DomainHash) model.DBKey {
+	return ass.baseBucket.Key(hash.ByteSlice())
+}
+
+func (ass *atomicStateStore) dependentsBucket(base *externalapi.DomainHash) model.DBBucket {
+	return ass.dependentBucket.Bucket(base.ByteSlice())
+}
+
+func (ass *atomicStateStore) dependentKey(base *externalapi.DomainHash, dependent *externalapi.DomainHash) model.DBKey {
+	return ass.dependentsBucket(base).Key(dependent.ByteSlice())
+}
+
+func serializeDiffBase(base *externalapi.DomainHash, depth uint64) []byte {
+	baseBytes := make([]byte, externalapi.DomainHashSize+8)
+	copy(baseBytes, base.ByteSlice())
+	binary.LittleEndian.PutUint64(baseBytes[externalapi.DomainHashSize:], depth)
+	return baseBytes
+}
+
+func deserializeDiffBase(baseBytes []byte) (*externalapi.DomainHash, uint64, error) {
+	if len(baseBytes) != externalapi.DomainHashSize+8 {
+		return nil, 0, errors.Errorf("invalid atomic state diff base length %d", len(baseBytes))
+	}
+	base, err := externalapi.NewDomainHashFromByteSlice(baseBytes[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, 0, err
+	}
+	return base, binary.LittleEndian.Uint64(baseBytes[externalapi.DomainHashSize:]), nil
+}
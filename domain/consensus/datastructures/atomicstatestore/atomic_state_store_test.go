@@ -0,0 +1,185 @@
+package atomicstatestore
+
+import (
+	"bytes"
+	"testing"
+
+	consensusdatabase "github.com/cryptix-network/cryptixd/domain/consensus/database"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database/ldb"
+)
+
+func testBlockHash(i int) *externalapi.DomainHash {
+	var hashBytes [externalapi.DomainHashSize]byte
+	hashBytes[0] = byte(i)
+	hashBytes[1] = byte(i >> 8)
+	hashBytes[2] = 0xAA
+	return externalapi.NewDomainHashFromByteArray(&hashBytes)
+}
+
+func testChainStates(length int) []*atomicstate.State {
+	states := make([]*atomicstate.State, length)
+	state := atomicstate.NewState()
+	for i := range states {
+		state = state.Clone()
+		var ownerID [externalapi.DomainHashSize]byte
+		ownerID[0] = byte(i % 7)
+		state.NextNonces[atomicstate.OwnerNonceKey(ownerID)] = uint64(i)
+		state.AnchorCounts[ownerID] = uint64(i * 3)
+		if i%5 == 0 {
+			delete(state.AnchorCounts, [externalapi.DomainHashSize]byte{})
+		}
+		states[i] = state
+	}
+	return states
+}
+
+func TestAtomicStateStoreDiffChain(t *testing.T) {
+	dbContext, teardown := newTestDBContext(t)
+	defer teardown()
+
+	const chainLength = 2*checkpointInterval + 50
+	states := testChainStates(chainLength)
+
+	store := New(consensusdatabase.MakeBucket(nil), 10, false)
+	for i := 0; i < chainLength; i += 3 {
+		stagingArea := model.NewStagingArea()
+		for j := i; j < i+3 && j < chainLength; j++ {
+			var base *externalapi.DomainHash
+			if j > 0 {
+				base = testBlockHash(j - 1)
+			}
+			store.Stage(stagingArea, testBlockHash(j), states[j], base)
+		}
+		commitStagingArea(t, dbContext, stagingArea)
+	}
+
+	checkpoints := 0
+	for i := 0; i < chainLength; i++ {
+		recordBytes, err := dbContext.Get(store.(*atomicStateStore).hashAsKey(testBlockHash(i)))
+		if err != nil {
+			t.Fatalf("Get record %d: %+v", i, err)
+		}
+		if !bytes.HasPrefix(recordBytes, atomicstate.StateDiffMagic) {
+			checkpoints++
+		}
+	}
+	if checkpoints != chainLength/checkpointInterval+1 {
+		t.Fatalf("expected %d checkpoints, got %d", chainLength/checkpointInterval+1, checkpoints)
+	}
+
+	// A fresh store has an empty cache and must reconstruct every state
+	// from the database.
+	assertStates(t, New(consensusdatabase.MakeBucket(nil), 10, false), dbContext, states, 0)
+
+	pruneAt := checkpointInterval + 20
+	stagingArea := model.NewStagingArea()
+	for i := 0; i < pruneAt; i++ {
+		store.Delete(stagingArea, testBlockHash(i))
+	}
+	commitStagingArea(t, dbContext, stagingArea)
+
+	recordBytes, err := dbContext.Get(store.(*atomicStateStore).hashAsKey(testBlockHash(pruneAt)))
+	if err != nil {
+		t.Fatalf("Get record: %+v", err)
+	}
+	if bytes.HasPrefix(recordBytes, atomicstate.StateDiffMagic) {
+		t.Fatalf("expected the oldest surviving state to be materialized")
+	}
+	assertStates(t, New(consensusdatabase.MakeBucket(nil), 10, false), dbContext, states, pruneAt)
+
+	has, err := dbContext.Has(store.(*atomicStateStore).hashAsKey(testBlockHash(0)))
+	if err != nil {
+		t.Fatalf("Has: %+v", err)
+	}
+	if has {
+		t.Fatalf("expected deleted state to be removed")
+	}
+}
+
+func TestAtomicStateStoreRestageBase(t *testing.T) {
+	dbContext, teardown := newTestDBContext(t)
+	defer teardown()
+
+	states := testChainStates(3)
+	store := New(consensusdatabase.MakeBucket(nil), 10, false)
+	stagingArea := model.NewStagingArea()
+	store.Stage(stagingArea, testBlockHash(0), states[0], nil)
+	store.Stage(stagingArea, testBlockHash(1), states[1], testBlockHash(0))
+	store.Stage(stagingArea, testBlockHash(2), states[2], testBlockHash(1))
+	commitStagingArea(t, dbContext, stagingArea)
+
+	// Restaging a base with a different state must not change the states
+	// of its committed dependents.
+	stagingArea = model.NewStagingArea()
+	store.Stage(stagingArea, testBlockHash(1), states[0], testBlockHash(0))
+	commitStagingArea(t, dbContext, stagingArea)
+
+	freshStore := New(consensusdatabase.MakeBucket(nil), 10, false)
+	got, err := freshStore.Get(dbContext, model.NewStagingArea(), testBlockHash(2))
+	if err != nil {
+		t.Fatalf("Get: %+v", err)
+	}
+	if !bytes.Equal(got.CanonicalBytes(), states[2].CanonicalBytes()) {
+		t.Fatalf("dependent state changed after its base was restaged")
+	}
+	got, err = freshStore.Get(dbContext, model.NewStagingArea(), testBlockHash(1))
+	if err != nil {
+		t.Fatalf("Get: %+v", err)
+	}
+	if !bytes.Equal(got.CanonicalBytes(), states[0].CanonicalBytes()) {
+		t.Fatalf("restaged state was not stored")
+	}
+}
+
+func assertStates(t *testing.T, store model.AtomicStateStore, dbContext model.DBReader,
+	states []*atomicstate.State, from int) {
+
+	t.Helper()
+	for i := from; i < len(states); i++ {
+		got, err := store.Get(dbContext, model.NewStagingArea(), testBlockHash(i))
+		if err != nil {
+			t.Fatalf("Get %d: %+v", i, err)
+		}
+		if !bytes.Equal(got.CanonicalBytes(), states[i].CanonicalBytes()) {
+			t.Fatalf("state %d was not reconstructed correctly", i)
+		}
+	}
+}
+
+func commitStagingArea(t *testing.T, dbContext model.DBManager, stagingArea *model.StagingArea) {
+	t.Helper()
+
+	dbTx, err := dbContext.Begin()
+	if err != nil {
+		t.Fatalf("Begin: %+v", err)
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	err = stagingArea.Commit(dbTx)
+	if err != nil {
+		t.Fatalf("Commit staging area: %+v", err)
+	}
+	err = dbTx.Commit()
+	if err != nil {
+		t.Fatalf("Commit: %+v", err)
+	}
+}
+
+func newTestDBContext(t *testing.T) (model.DBManager, func()) {
+	t.Helper()
+
+	db, err := ldb.NewLevelDB(t.TempDir(), 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %+v", err)
+	}
+
+	return consensusdatabase.New(db), func() {
+		err := db.Close()
+		if err != nil {
+			t.Fatalf("Close: %+v", err)
+		}
+	}
+}
@@ -302,6 +302,7 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.MergeSetSizeLimit,
 		genesisHash,
 		config.PayloadHfActivationDAAScore,
+		config.AtomicMerkleRootHfActivationDAAScore,
 		atomicStateGrowthLimits,
 
 		ghostdagManager,
@@ -359,6 +360,7 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.PruningDepth(),
 		config.EnableSanityCheckPruningUTXOSet,
 		config.PayloadHfActivationDAAScore,
+		config.AtomicMerkleRootHfActivationDAAScore,
 		config.K,
 		config.DifficultyAdjustmentWindowSize,
 	)
@@ -439,6 +441,7 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		ghostdagDataStore,
 		daaBlocksStore,
 		config.PayloadHfActivationDAAScore,
+		config.AtomicMerkleRootHfActivationDAAScore,
 		atomicStateGrowthLimits,
 	)
 
@@ -504,10 +507,12 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		lock:            &sync.Mutex{},
 		databaseContext: dbManager,
 
-		genesisBlock:                config.GenesisBlock,
-		genesisHash:                 config.GenesisHash,
-		payloadHfActivationDAAScore: config.PayloadHfActivationDAAScore,
-		startupRepairPlanPath:       config.StartupRepairPlanPath,
+		genesisBlock:                         config.GenesisBlock,
+		genesisHash:                          config.GenesisHash,
+		payloadHfActivationDAAScore:          config.PayloadHfActivationDAAScore,
+		atomicMerkleRootHfActivationDAAScore: config.AtomicMerkleRootHfActivationDAAScore,
+		atomicStateGrowthLimits:              atomicStateGrowthLimits,
+		startupRepairPlanPath:                config.StartupRepairPlanPath,
 
 		expectedDAAWindowDurationInMilliseconds: config.TargetTimePerBlock.Milliseconds() *
 			int64(config.DifficultyAdjustmentWindowSize),
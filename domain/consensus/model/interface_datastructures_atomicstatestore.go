@@ -8,7 +8,7 @@ import (
 // AtomicStateStore represents a store of consensus Atomic states.
 type AtomicStateStore interface {
 	Store
-	Stage(stagingArea *StagingArea, blockHash *externalapi.DomainHash, state *atomicstate.State)
+	Stage(stagingArea *StagingArea, blockHash *externalapi.DomainHash, state *atomicstate.State, base *externalapi.DomainHash)
 	IsStaged(stagingArea *StagingArea) bool
 	Get(dbContext DBReader, stagingArea *StagingArea, blockHash *externalapi.DomainHash) (*atomicstate.State, error)
 	Delete(stagingArea *StagingArea, blockHash *externalapi.DomainHash)
@@ -32,14 +32,15 @@ type blockBuilder struct {
 	pruningManager        model.PruningManager
 	blockParentBuilder    model.BlockParentBuilder
 
-	acceptanceDataStore         model.AcceptanceDataStore
-	blockRelationStore          model.BlockRelationStore
-	multisetStore               model.MultisetStore
-	atomicStateStore            model.AtomicStateStore
-	ghostdagDataStore           model.GHOSTDAGDataStore
-	daaBlocksStore              model.DAABlocksStore
-	payloadHfActivationDAAScore uint64
-	atomicStateGrowthLimits     atomicstate.StateGrowthLimits
+	acceptanceDataStore                  model.AcceptanceDataStore
+	blockRelationStore                   model.BlockRelationStore
+	multisetStore                        model.MultisetStore
+	atomicStateStore                     model.AtomicStateStore
+	ghostdagDataStore                    model.GHOSTDAGDataStore
+	daaBlocksStore                       model.DAABlocksStore
+	payloadHfActivationDAAScore          uint64
+	atomicMerkleRootHfActivationDAAScore uint64
+	atomicStateGrowthLimits              atomicstate.StateGrowthLimits
 }
 
 var templateCandidateBlockHash = externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{
@@ -81,6 +82,7 @@ func New(
 	ghostdagDataStore model.GHOSTDAGDataStore,
 	daaBlocksStore model.DAABlocksStore,
 	payloadHfActivationDAAScore uint64,
+	atomicMerkleRootHfActivationDAAScore uint64,
 	atomicStateGrowthLimits atomicstate.StateGrowthLimits,
 ) model.BlockBuilder {
 
@@ -98,14 +100,15 @@ func New(
 		blockParentBuilder:    blockParentBuilder,
 		pruningManager:        pruningManager,
 
-		acceptanceDataStore:         acceptanceDataStore,
-		blockRelationStore:          blockRelationStore,
-		multisetStore:               multisetStore,
-		atomicStateStore:            atomicStateStore,
-		ghostdagDataStore:           ghostdagDataStore,
-		daaBlocksStore:              daaBlocksStore,
-		payloadHfActivationDAAScore: payloadHfActivationDAAScore,
-		atomicStateGrowthLimits:     atomicStateGrowthLimits,
+		acceptanceDataStore:                  acceptanceDataStore,
+		blockRelationStore:                   blockRelationStore,
+		multisetStore:                        multisetStore,
+		atomicStateStore:                     atomicStateStore,
+		ghostdagDataStore:                    ghostdagDataStore,
+		daaBlocksStore:                       daaBlocksStore,
+		payloadHfActivationDAAScore:          payloadHfActivationDAAScore,
+		atomicMerkleRootHfActivationDAAScore: atomicMerkleRootHfActivationDAAScore,
+		atomicStateGrowthLimits:              atomicStateGrowthLimits,
 	}
 }
 
@@ -405,10 +408,14 @@ func (bb *blockBuilder) buildHeaderFromTemplateContext(stagingArea *model.Stagin
 	if err != nil {
 		return nil, err
 	}
-	utxoCommitment := templateContext.atomicState.HeaderCommitment(
+	utxoCommitment, err := templateContext.atomicState.HeaderCommitment(
 		templateContext.multiset.Hash(),
 		templateContext.daaScore >= bb.payloadHfActivationDAAScore,
+		templateContext.daaScore >= bb.atomicMerkleRootHfActivationDAAScore,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	return blockheader.NewImmutableBlockHeader(
 		constants.BlockVersion,
@@ -514,7 +521,9 @@ func (bb *blockBuilder) newBlockUTXOCommitment(stagingArea *model.StagingArea) (
 	if err != nil {
 		return nil, err
 	}
-	return newBlockAtomicState.HeaderCommitment(newBlockMultiset.Hash(), newBlockDAAScore >= bb.payloadHfActivationDAAScore), nil
+	return newBlockAtomicState.HeaderCommitment(newBlockMultiset.Hash(),
+		newBlockDAAScore >= bb.payloadHfActivationDAAScore,
+		newBlockDAAScore >= bb.atomicMerkleRootHfActivationDAAScore)
 }
 
 func (bb *blockBuilder) newBlockDAAScore(stagingArea *model.StagingArea) (uint64, error) {
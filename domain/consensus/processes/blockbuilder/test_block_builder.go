@@ -127,7 +127,11 @@ func (bb *testBlockBuilder) buildHeaderWithParents(stagingArea *model.StagingAre
 	if err != nil {
 		return nil, err
 	}
-	utxoCommitment := atomicState.HeaderCommitment(multiset.Hash(), daaScore >= bb.payloadHfActivationDAAScore)
+	utxoCommitment, err := atomicState.HeaderCommitment(multiset.Hash(),
+		daaScore >= bb.payloadHfActivationDAAScore, daaScore >= bb.atomicMerkleRootHfActivationDAAScore)
+	if err != nil {
+		return nil, err
+	}
 
 	return blockheader.NewImmutableBlockHeader(
 		header.Version(),
@@ -152,6 +152,7 @@ func (bp *blockProcessor) ValidateAndInsertBlock(block *externalapi.DomainBlock,
 	shouldValidateAgainstUTXO bool) (*externalapi.VirtualChangeSet, externalapi.BlockStatus, error) {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "ValidateAndInsertBlock")
 	defer onEnd()
+	defer blockProcessingDuration(block).ObserveSince(time.Now())
 
 	stagingArea := model.NewStagingArea()
 	return bp.validateAndInsertBlock(stagingArea, block, false, shouldValidateAgainstUTXO, false)
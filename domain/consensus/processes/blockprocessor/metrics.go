@@ -0,0 +1,16 @@
+package blockprocessor
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/infrastructure/metrics"
+)
+
+var blockProcessingDurationMetric = metrics.NewHistogramVec("cryptixd_block_processing_duration_seconds",
+	"Time it takes to validate and insert a block, by whether it's a header-only block", metrics.DurationBuckets, "type")
+
+func blockProcessingDuration(block *externalapi.DomainBlock) *metrics.Histogram {
+	if isHeaderOnlyBlock(block) {
+		return blockProcessingDurationMetric.WithLabelValues("header")
+	}
+	return blockProcessingDurationMetric.WithLabelValues("block")
+}
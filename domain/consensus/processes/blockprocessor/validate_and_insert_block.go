@@ -76,7 +76,7 @@ func (bp *blockProcessor) updateVirtualAcceptanceDataAfterImportingPruningPoint(
 	bp.multisetStore.Stage(stagingArea, model.VirtualBlockHash, virtualMultiset)
 
 	log.Debugf("Staging virtual Atomic state after importing the pruning point")
-	bp.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, virtualAtomicState)
+	bp.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, virtualAtomicState, nil)
 	return nil
 }
 
@@ -240,9 +240,9 @@ func (bp *blockProcessor) loadUTXODataForGenesis(stagingArea *model.StagingArea,
 	bp.consensusStateStore.StageVirtualUTXODiff(stagingArea, utxo.NewUTXODiff())
 	bp.utxoDiffStore.Stage(stagingArea, blockHash, utxo.NewUTXODiff(), nil)
 	bp.multisetStore.Stage(stagingArea, blockHash, multiset.New())
-	bp.atomicStateStore.Stage(stagingArea, blockHash, atomicstate.NewState())
-	bp.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, atomicstate.NewState())
-	bp.atomicStateStore.Stage(stagingArea, model.VirtualGenesisBlockHash, atomicstate.NewState())
+	bp.atomicStateStore.Stage(stagingArea, blockHash, atomicstate.NewState(), nil)
+	bp.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, atomicstate.NewState(), nil)
+	bp.atomicStateStore.Stage(stagingArea, model.VirtualGenesisBlockHash, atomicstate.NewState(), nil)
 }
 
 func isHeaderOnlyBlock(block *externalapi.DomainBlock) bool {
@@ -10,15 +10,16 @@ import (
 
 // consensusStateManager manages the node's consensus state
 type consensusStateManager struct {
-	maxBlockParents             externalapi.KType
-	mergeSetSizeLimit           uint64
-	genesisHash                 *externalapi.DomainHash
-	databaseContext             model.DBManager
-	payloadHfActivationDAAScore uint64
-	atomicStateGrowthLimits     atomicstate.StateGrowthLimits
-	lastAtomicConsensusLogTime  time.Time
-	lastAtomicConsensusLogState atomicConsensusStateSummary
-	hasAtomicConsensusLogState  bool
+	maxBlockParents                      externalapi.KType
+	mergeSetSizeLimit                    uint64
+	genesisHash                          *externalapi.DomainHash
+	databaseContext                      model.DBManager
+	payloadHfActivationDAAScore          uint64
+	atomicMerkleRootHfActivationDAAScore uint64
+	atomicStateGrowthLimits              atomicstate.StateGrowthLimits
+	lastAtomicConsensusLogTime           time.Time
+	lastAtomicConsensusLogState          atomicConsensusStateSummary
+	hasAtomicConsensusLogState           bool
 
 	ghostdagManager       model.GHOSTDAGManager
 	dagTopologyManager    model.DAGTopologyManager
@@ -54,6 +55,7 @@ func New(
 	mergeSetSizeLimit uint64,
 	genesisHash *externalapi.DomainHash,
 	payloadHfActivationDAAScore uint64,
+	atomicMerkleRootHfActivationDAAScore uint64,
 	atomicStateGrowthLimits atomicstate.StateGrowthLimits,
 
 	ghostdagManager model.GHOSTDAGManager,
@@ -81,11 +83,12 @@ func New(
 	daaBlocksStore model.DAABlocksStore) (model.ConsensusStateManager, error) {
 
 	csm := &consensusStateManager{
-		maxBlockParents:             maxBlockParents,
-		mergeSetSizeLimit:           mergeSetSizeLimit,
-		genesisHash:                 genesisHash,
-		payloadHfActivationDAAScore: payloadHfActivationDAAScore,
-		atomicStateGrowthLimits:     atomicStateGrowthLimits,
+		maxBlockParents:                      maxBlockParents,
+		mergeSetSizeLimit:                    mergeSetSizeLimit,
+		genesisHash:                          genesisHash,
+		payloadHfActivationDAAScore:          payloadHfActivationDAAScore,
+		atomicMerkleRootHfActivationDAAScore: atomicMerkleRootHfActivationDAAScore,
+		atomicStateGrowthLimits:              atomicStateGrowthLimits,
 
 		databaseContext: databaseContext,
 
@@ -83,7 +83,12 @@ func (csm *consensusStateManager) importPruningPointUTXOSet(stagingArea *model.S
 			return closeErr
 		}
 	}
-	expectedUTXOCommitment := importedPruningPointAtomicState.HeaderCommitment(importedPruningPointMultiset.Hash(), payloadHFActive)
+	merkleRootHFActive := newPruningPointHeader.DAAScore() >= csm.atomicMerkleRootHfActivationDAAScore
+	expectedUTXOCommitment, err := importedPruningPointAtomicState.HeaderCommitment(importedPruningPointMultiset.Hash(),
+		payloadHFActive, merkleRootHFActive)
+	if err != nil {
+		return err
+	}
 	if !newPruningPointHeader.UTXOCommitment().Equal(expectedUTXOCommitment) {
 		return errors.Wrapf(ruleerrors.ErrBadPruningPointUTXOSet, "the expected multiset hash of the pruning "+
 			"point UTXO set is %s but got %s", newPruningPointHeader.UTXOCommitment(), expectedUTXOCommitment)
@@ -171,7 +176,7 @@ func (csm *consensusStateManager) importPruningPointUTXOSet(stagingArea *model.S
 	csm.multisetStore.Stage(stagingArea, newPruningPoint, importedPruningPointMultiset)
 
 	log.Debugf("Staging the new pruning point Atomic state")
-	csm.atomicStateStore.Stage(stagingArea, newPruningPoint, importedPruningPointAtomicState)
+	csm.atomicStateStore.Stage(stagingArea, newPruningPoint, importedPruningPointAtomicState, nil)
 
 	_, err = csm.difficultyManager.StageDAADataAndReturnRequiredDifficulty(stagingArea, model.VirtualBlockHash, false)
 	if err != nil {
@@ -342,7 +347,7 @@ func (csm *consensusStateManager) recoverPreHFVirtualAtomicState() error {
 	summary := summarizeAtomicConsensusState(reconstructedAtomicState)
 	atomicLog.Warnf("Atomic pre-HF virtual state reconstructed from UTXO set: daa=%d root=%s anchors=%d",
 		virtualDAAScore, summary.rootHex(), summary.anchors)
-	csm.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, reconstructedAtomicState)
+	csm.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, reconstructedAtomicState, nil)
 	return staging.CommitAllChanges(csm.databaseContext, stagingArea)
 }
 
@@ -0,0 +1,8 @@
+package consensusstatemanager
+
+import (
+	"github.com/cryptix-network/cryptixd/infrastructure/metrics"
+)
+
+var virtualResolutionDuration = metrics.NewHistogram("cryptixd_virtual_resolution_duration_seconds",
+	"Time it takes to resolve a chunk of the virtual", metrics.DurationBuckets)
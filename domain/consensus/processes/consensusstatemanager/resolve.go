@@ -7,6 +7,7 @@ import (
 	"github.com/cryptix-network/cryptixd/util/staging"
 	"github.com/pkg/errors"
 	"sort"
+	"time"
 )
 
 // tipsInDecreasingGHOSTDAGParentSelectionOrder returns the current DAG tips in decreasing parent selection order.
@@ -94,6 +95,7 @@ func (csm *consensusStateManager) getGHOSTDAGLowerTips(stagingArea *model.Stagin
 func (csm *consensusStateManager) ResolveVirtual(maxBlocksToResolve uint64) (*externalapi.VirtualChangeSet, bool, error) {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "csm.ResolveVirtual")
 	defer onEnd()
+	defer virtualResolutionDuration.ObserveSince(time.Now())
 
 	// We use a read-only staging area for some read-only actions, to avoid
 	// confusion with the resolve/updateVirtual staging areas below
@@ -329,7 +329,7 @@ func (csm *consensusStateManager) resolveSingleBlockStatus(stagingArea *model.St
 	csm.multisetStore.Stage(stagingArea, blockHash, multiset)
 
 	log.Tracef("Staging the Atomic state of block %s", blockHash)
-	csm.atomicStateStore.Stage(stagingArea, blockHash, atomicState)
+	csm.atomicStateStore.Stage(stagingArea, blockHash, atomicState, selectedParentHash)
 
 	if csm.genesisHash.Equal(blockHash) {
 		log.Tracef("Staging the utxoDiff of genesis")
@@ -111,7 +111,7 @@ func (csm *consensusStateManager) updateVirtualWithParents(
 	csm.multisetStore.Stage(stagingArea, model.VirtualBlockHash, virtualMultiset)
 
 	log.Debugf("Staging new Atomic state for the virtual block")
-	csm.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, virtualAtomicState)
+	csm.atomicStateStore.Stage(stagingArea, model.VirtualBlockHash, virtualAtomicState, nil)
 
 	log.Debugf("Staging new UTXO diff for the virtual block")
 	csm.consensusStateStore.StageVirtualUTXODiff(stagingArea, virtualUTXODiff)
@@ -159,18 +159,23 @@ func (csm *consensusStateManager) validateUTXOCommitment(
 	}
 
 	multisetHash := multiset.Hash()
-	atomicStateHash := atomicState.CanonicalHash()
 	payloadHFActive := block.Header.DAAScore() >= csm.payloadHfActivationDAAScore
+	merkleRootHFActive := block.Header.DAAScore() >= csm.atomicMerkleRootHfActivationDAAScore
+	atomicStateHash, err := atomicState.CommitmentHash(merkleRootHFActive)
+	if err != nil {
+		return err
+	}
 	expectedCommitment := atomicstate.HeaderCommitment(multisetHash, atomicStateHash, payloadHFActive)
 	if !block.Header.UTXOCommitment().Equal(expectedCommitment) {
 		preHFCommitment := atomicstate.HeaderCommitment(multisetHash, atomicStateHash, false)
 		postHFCommitment := atomicstate.HeaderCommitment(multisetHash, atomicStateHash, true)
 		log.Warnf("UTXO commitment mismatch diagnostics for block %s: daa=%d payload_hf_active=%t "+
-			"header=%s raw_utxo=%s atomic_state_hash=%s pre_hf_commitment=%s post_hf_commitment=%s "+
+			"merkle_root_hf_active=%t header=%s raw_utxo=%s atomic_state_hash=%s pre_hf_commitment=%s post_hf_commitment=%s "+
 			"header_matches_raw=%t header_matches_pre_hf=%t header_matches_post_hf=%t",
 			blockHash,
 			block.Header.DAAScore(),
 			payloadHFActive,
+			merkleRootHFActive,
 			block.Header.UTXOCommitment(),
 			multisetHash,
 			hex.EncodeToString(atomicStateHash[:]),
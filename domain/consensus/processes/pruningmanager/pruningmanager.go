@@ -43,14 +43,15 @@ type pruningManager struct {
 	daaBlocksStore                      model.DAABlocksStore
 	reachabilityDataStore               model.ReachabilityDataStore
 
-	isArchivalNode                  bool
-	genesisHash                     *externalapi.DomainHash
-	finalityInterval                uint64
-	pruningDepth                    uint64
-	shouldSanityCheckPruningUTXOSet bool
-	payloadHfActivationDAAScore     uint64
-	k                               externalapi.KType
-	difficultyAdjustmentWindowSize  int
+	isArchivalNode                       bool
+	genesisHash                          *externalapi.DomainHash
+	finalityInterval                     uint64
+	pruningDepth                         uint64
+	shouldSanityCheckPruningUTXOSet      bool
+	payloadHfActivationDAAScore          uint64
+	atomicMerkleRootHfActivationDAAScore uint64
+	k                                    externalapi.KType
+	difficultyAdjustmentWindowSize       int
 
 	cachedPruningPoint         *externalapi.DomainHash
 	cachedPruningPointAnticone []*externalapi.DomainHash
@@ -86,6 +87,7 @@ func New(
 	pruningDepth uint64,
 	shouldSanityCheckPruningUTXOSet bool,
 	payloadHfActivationDAAScore uint64,
+	atomicMerkleRootHfActivationDAAScore uint64,
 	k externalapi.KType,
 	difficultyAdjustmentWindowSize int,
 ) model.PruningManager {
@@ -112,14 +114,15 @@ func New(
 		reachabilityDataStore:               reachabilityDataStore,
 		blocksWithTrustedDataDAAWindowStore: blocksWithTrustedDataDAAWindowStore,
 
-		isArchivalNode:                  isArchivalNode,
-		genesisHash:                     genesisHash,
-		pruningDepth:                    pruningDepth,
-		finalityInterval:                finalityInterval,
-		shouldSanityCheckPruningUTXOSet: shouldSanityCheckPruningUTXOSet,
-		payloadHfActivationDAAScore:     payloadHfActivationDAAScore,
-		k:                               k,
-		difficultyAdjustmentWindowSize:  difficultyAdjustmentWindowSize,
+		isArchivalNode:                       isArchivalNode,
+		genesisHash:                          genesisHash,
+		pruningDepth:                         pruningDepth,
+		finalityInterval:                     finalityInterval,
+		shouldSanityCheckPruningUTXOSet:      shouldSanityCheckPruningUTXOSet,
+		payloadHfActivationDAAScore:          payloadHfActivationDAAScore,
+		atomicMerkleRootHfActivationDAAScore: atomicMerkleRootHfActivationDAAScore,
+		k:                                    k,
+		difficultyAdjustmentWindowSize:       difficultyAdjustmentWindowSize,
 	}
 }
 
@@ -741,7 +744,11 @@ func (pm *pruningManager) validateUTXOSetFitsCommitment(stagingArea *model.Stagi
 			return err
 		}
 	}
-	expectedUTXOCommitment := pruningPointAtomicState.HeaderCommitment(utxoSetHash, header.DAAScore() >= pm.payloadHfActivationDAAScore)
+	expectedUTXOCommitment, err := pruningPointAtomicState.HeaderCommitment(utxoSetHash,
+		header.DAAScore() >= pm.payloadHfActivationDAAScore, header.DAAScore() >= pm.atomicMerkleRootHfActivationDAAScore)
+	if err != nil {
+		return err
+	}
 
 	if !expectedUTXOCommitment.Equal(header.UTXOCommitment()) {
 		return errors.Errorf("Calculated UTXOSet for next pruning point %s doesn't match it's UTXO commitment\n"+
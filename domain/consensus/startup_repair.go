@@ -301,7 +301,7 @@ func (s *consensus) stageStartupRepairVirtualState(targetHash *externalapi.Domai
 
 	s.acceptanceDataStore.Stage(virtualStagingArea, model.VirtualBlockHash, virtualAcceptanceData)
 	s.multisetStore.Stage(virtualStagingArea, model.VirtualBlockHash, virtualMultiset)
-	s.atomicStateStore.Stage(virtualStagingArea, model.VirtualBlockHash, virtualAtomicState)
+	s.atomicStateStore.Stage(virtualStagingArea, model.VirtualBlockHash, virtualAtomicState, nil)
 	s.consensusStateStore.StageVirtualUTXODiff(virtualStagingArea, virtualUTXODiff)
 
 	targetUTXODiff, err := s.utxoDiffStore.UTXODiff(s.databaseContext, virtualStagingArea, targetHash)
@@ -94,10 +94,7 @@ func ValidateAndApplyTransactionWithCreationContext(tx *externalapi.DomainTransa
 		return err
 	}
 	nonceKey := nonceKeyForOp(ownerID, parsedPayload.Op)
-	expectedNonce := state.NextNonces[nonceKey]
-	if expectedNonce == 0 {
-		expectedNonce = 1
-	}
+	expectedNonce := state.ExpectedNonce(nonceKey)
 	if parsedPayload.Nonce != expectedNonce {
 		return fmt.Errorf("nonce baseline violation for owner `%x` scope `%d:%x`: expected `%d`, got `%d`",
 			ownerID, nonceKey.ScopeKind, nonceKey.ScopeID, expectedNonce, parsedPayload.Nonce)
@@ -0,0 +1,412 @@
+package atomicstate
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+)
+
+// StateDiffMagic prefixes serialized StateDiffs. Canonical state bytes carry a
+// different magic, so the two can be told apart by their prefix.
+var StateDiffMagic = []byte("CATSDF01")
+
+// StateDiff is the set of changes that transforms one atomic state into another.
+// Entries present in a ToSet map are inserted or overwritten, entries present in
+// a ToRemove set are deleted.
+type StateDiff struct {
+	NoncesToSet      map[NonceKey]uint64
+	NoncesToRemove   map[NonceKey]struct{}
+	AssetsToSet      map[[externalapi.DomainHashSize]byte]AssetState
+	AssetsToRemove   map[[externalapi.DomainHashSize]byte]struct{}
+	BalancesToSet    map[BalanceKey]Uint128
+	BalancesToRemove map[BalanceKey]struct{}
+	AnchorsToSet     map[[externalapi.DomainHashSize]byte]uint64
+	AnchorsToRemove  map[[externalapi.DomainHashSize]byte]struct{}
+}
+
+// NewStateDiff returns an empty StateDiff
+func NewStateDiff() *StateDiff {
+	return &StateDiff{
+		NoncesToSet:      make(map[NonceKey]uint64),
+		NoncesToRemove:   make(map[NonceKey]struct{}),
+		AssetsToSet:      make(map[[externalapi.DomainHashSize]byte]AssetState),
+		AssetsToRemove:   make(map[[externalapi.DomainHashSize]byte]struct{}),
+		BalancesToSet:    make(map[BalanceKey]Uint128),
+		BalancesToRemove: make(map[BalanceKey]struct{}),
+		AnchorsToSet:     make(map[[externalapi.DomainHashSize]byte]uint64),
+		AnchorsToRemove:  make(map[[externalapi.DomainHashSize]byte]struct{}),
+	}
+}
+
+// DiffStates returns the diff that transforms base into target. Root-only
+// states carry no entries and cannot be diffed.
+func DiffStates(base *State, target *State) (*StateDiff, error) {
+	if base.IsRootOnly() || target.IsRootOnly() {
+		return nil, fmt.Errorf("cannot diff root-only atomic states")
+	}
+
+	diff := NewStateDiff()
+	for key, value := range target.NextNonces {
+		if baseValue, ok := base.NextNonces[key]; !ok || baseValue != value {
+			diff.NoncesToSet[key] = value
+		}
+	}
+	for key := range base.NextNonces {
+		if _, ok := target.NextNonces[key]; !ok {
+			diff.NoncesToRemove[key] = struct{}{}
+		}
+	}
+
+	var baseAssetBytes, targetAssetBytes []byte
+	for assetID, asset := range target.Assets {
+		baseAsset, ok := base.Assets[assetID]
+		if ok {
+			baseAssetBytes = baseAssetBytes[:0]
+			targetAssetBytes = targetAssetBytes[:0]
+			writeAsset(&baseAssetBytes, baseAsset)
+			writeAsset(&targetAssetBytes, asset)
+			if bytes.Equal(baseAssetBytes, targetAssetBytes) {
+				continue
+			}
+		}
+		diff.AssetsToSet[assetID] = asset.clone()
+	}
+	for assetID := range base.Assets {
+		if _, ok := target.Assets[assetID]; !ok {
+			diff.AssetsToRemove[assetID] = struct{}{}
+		}
+	}
+
+	for key, value := range target.Balances {
+		if baseValue, ok := base.Balances[key]; !ok || baseValue != value {
+			diff.BalancesToSet[key] = value
+		}
+	}
+	for key := range base.Balances {
+		if _, ok := target.Balances[key]; !ok {
+			diff.BalancesToRemove[key] = struct{}{}
+		}
+	}
+
+	for ownerID, value := range target.AnchorCounts {
+		if baseValue, ok := base.AnchorCounts[ownerID]; !ok || baseValue != value {
+			diff.AnchorsToSet[ownerID] = value
+		}
+	}
+	for ownerID := range base.AnchorCounts {
+		if _, ok := target.AnchorCounts[ownerID]; !ok {
+			diff.AnchorsToRemove[ownerID] = struct{}{}
+		}
+	}
+
+	return diff, nil
+}
+
+// ApplyDiff applies the given diff to s in place and rebuilds its liquidity
+// vault outpoint index
+func (s *State) ApplyDiff(diff *StateDiff) error {
+	if s.IsRootOnly() {
+		return fmt.Errorf("cannot apply a diff to a root-only atomic state")
+	}
+
+	for key := range diff.NoncesToRemove {
+		delete(s.NextNonces, key)
+	}
+	for key, value := range diff.NoncesToSet {
+		s.NextNonces[key] = value
+	}
+	for assetID := range diff.AssetsToRemove {
+		delete(s.Assets, assetID)
+	}
+	for assetID, asset := range diff.AssetsToSet {
+		s.Assets[assetID] = asset.clone()
+	}
+	for key := range diff.BalancesToRemove {
+		delete(s.Balances, key)
+	}
+	for key, value := range diff.BalancesToSet {
+		s.Balances[key] = value
+	}
+	for ownerID := range diff.AnchorsToRemove {
+		delete(s.AnchorCounts, ownerID)
+	}
+	for ownerID, value := range diff.AnchorsToSet {
+		s.AnchorCounts[ownerID] = value
+	}
+
+	s.RebuildLiquidityVaultOutpointIndex()
+	return nil
+}
+
+// Bytes serializes the diff in a deterministic order
+func (d *StateDiff) Bytes() []byte {
+	out := make([]byte, 0)
+	out = append(out, StateDiffMagic...)
+
+	nonceKeys := make([]NonceKey, 0, len(d.NoncesToSet))
+	for key := range d.NoncesToSet {
+		nonceKeys = append(nonceKeys, key)
+	}
+	sortNonceKeys(nonceKeys)
+	writeLen(&out, len(nonceKeys))
+	for _, key := range nonceKeys {
+		writeNonceKey(&out, key)
+		writeUint64(&out, d.NoncesToSet[key])
+	}
+	nonceKeys = nonceKeys[:0]
+	for key := range d.NoncesToRemove {
+		nonceKeys = append(nonceKeys, key)
+	}
+	sortNonceKeys(nonceKeys)
+	writeLen(&out, len(nonceKeys))
+	for _, key := range nonceKeys {
+		writeNonceKey(&out, key)
+	}
+
+	assetIDs := make([][externalapi.DomainHashSize]byte, 0, len(d.AssetsToSet))
+	for assetID := range d.AssetsToSet {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sortHashKeys(assetIDs)
+	writeLen(&out, len(assetIDs))
+	for _, assetID := range assetIDs {
+		out = append(out, assetID[:]...)
+		writeAsset(&out, d.AssetsToSet[assetID])
+	}
+	assetIDs = assetIDs[:0]
+	for assetID := range d.AssetsToRemove {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sortHashKeys(assetIDs)
+	writeLen(&out, len(assetIDs))
+	for _, assetID := range assetIDs {
+		out = append(out, assetID[:]...)
+	}
+
+	balanceKeys := make([]BalanceKey, 0, len(d.BalancesToSet))
+	for key := range d.BalancesToSet {
+		balanceKeys = append(balanceKeys, key)
+	}
+	sortBalanceKeys(balanceKeys)
+	writeLen(&out, len(balanceKeys))
+	for _, key := range balanceKeys {
+		out = append(out, key.AssetID[:]...)
+		out = append(out, key.OwnerID[:]...)
+		writeUint128(&out, d.BalancesToSet[key])
+	}
+	balanceKeys = balanceKeys[:0]
+	for key := range d.BalancesToRemove {
+		balanceKeys = append(balanceKeys, key)
+	}
+	sortBalanceKeys(balanceKeys)
+	writeLen(&out, len(balanceKeys))
+	for _, key := range balanceKeys {
+		out = append(out, key.AssetID[:]...)
+		out = append(out, key.OwnerID[:]...)
+	}
+
+	ownerIDs := make([][externalapi.DomainHashSize]byte, 0, len(d.AnchorsToSet))
+	for ownerID := range d.AnchorsToSet {
+		ownerIDs = append(ownerIDs, ownerID)
+	}
+	sortHashKeys(ownerIDs)
+	writeLen(&out, len(ownerIDs))
+	for _, ownerID := range ownerIDs {
+		out = append(out, ownerID[:]...)
+		writeUint64(&out, d.AnchorsToSet[ownerID])
+	}
+	ownerIDs = ownerIDs[:0]
+	for ownerID := range d.AnchorsToRemove {
+		ownerIDs = append(ownerIDs, ownerID)
+	}
+	sortHashKeys(ownerIDs)
+	writeLen(&out, len(ownerIDs))
+	for _, ownerID := range ownerIDs {
+		out = append(out, ownerID[:]...)
+	}
+
+	return out
+}
+
+// StateDiffFromBytes deserializes a diff produced by StateDiff.Bytes
+func StateDiffFromBytes(diffBytes []byte) (*StateDiff, error) {
+	reader := atomicStateReader{bytes: diffBytes}
+	magic, err := reader.readBytes(len(StateDiffMagic))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(magic, StateDiffMagic) {
+		return nil, fmt.Errorf("invalid atomic state diff magic")
+	}
+	diff := NewStateDiff()
+
+	count, err := reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		key, err := reader.readNonceKey()
+		if err != nil {
+			return nil, err
+		}
+		nonce, err := reader.readUint64()
+		if err != nil {
+			return nil, err
+		}
+		diff.NoncesToSet[key] = nonce
+	}
+	count, err = reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		key, err := reader.readNonceKey()
+		if err != nil {
+			return nil, err
+		}
+		diff.NoncesToRemove[key] = struct{}{}
+	}
+
+	count, err = reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		assetID, err := reader.read32()
+		if err != nil {
+			return nil, err
+		}
+		asset, err := reader.readAsset(false)
+		if err != nil {
+			return nil, err
+		}
+		diff.AssetsToSet[assetID] = asset
+	}
+	count, err = reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		assetID, err := reader.read32()
+		if err != nil {
+			return nil, err
+		}
+		diff.AssetsToRemove[assetID] = struct{}{}
+	}
+
+	count, err = reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		key, err := reader.readBalanceKey()
+		if err != nil {
+			return nil, err
+		}
+		amount, err := reader.readUint128()
+		if err != nil {
+			return nil, err
+		}
+		diff.BalancesToSet[key] = amount
+	}
+	count, err = reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		key, err := reader.readBalanceKey()
+		if err != nil {
+			return nil, err
+		}
+		diff.BalancesToRemove[key] = struct{}{}
+	}
+
+	count, err = reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		ownerID, err := reader.read32()
+		if err != nil {
+			return nil, err
+		}
+		anchorCount, err := reader.readUint64()
+		if err != nil {
+			return nil, err
+		}
+		diff.AnchorsToSet[ownerID] = anchorCount
+	}
+	count, err = reader.readLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		ownerID, err := reader.read32()
+		if err != nil {
+			return nil, err
+		}
+		diff.AnchorsToRemove[ownerID] = struct{}{}
+	}
+
+	if err := reader.finish(); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+func writeNonceKey(out *[]byte, key NonceKey) {
+	*out = append(*out, key.OwnerID[:]...)
+	*out = append(*out, byte(key.ScopeKind))
+	*out = append(*out, key.ScopeID[:]...)
+}
+
+func (r *atomicStateReader) readNonceKey() (NonceKey, error) {
+	ownerID, err := r.read32()
+	if err != nil {
+		return NonceKey{}, err
+	}
+	scopeKind, err := r.readByte()
+	if err != nil {
+		return NonceKey{}, err
+	}
+	scopeID, err := r.read32()
+	if err != nil {
+		return NonceKey{}, err
+	}
+	key := NonceKey{OwnerID: ownerID, ScopeKind: NonceScopeKind(scopeKind), ScopeID: scopeID}
+	if err := key.validate(); err != nil {
+		return NonceKey{}, err
+	}
+	return key, nil
+}
+
+func (r *atomicStateReader) readBalanceKey() (BalanceKey, error) {
+	assetID, err := r.read32()
+	if err != nil {
+		return BalanceKey{}, err
+	}
+	ownerID, err := r.read32()
+	if err != nil {
+		return BalanceKey{}, err
+	}
+	return BalanceKey{AssetID: assetID, OwnerID: ownerID}, nil
+}
+
+func sortNonceKeys(keys []NonceKey) {
+	sort.Slice(keys, func(i, j int) bool { return compareNonceKeys(keys[i], keys[j]) < 0 })
+}
+
+func sortHashKeys(keys [][externalapi.DomainHashSize]byte) {
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+}
+
+func sortBalanceKeys(keys []BalanceKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if cmp := bytes.Compare(keys[i].AssetID[:], keys[j].AssetID[:]); cmp != 0 {
+			return cmp < 0
+		}
+		return bytes.Compare(keys[i].OwnerID[:], keys[j].OwnerID[:]) < 0
+	})
+}
@@ -0,0 +1,99 @@
+package atomicstate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+)
+
+func diffTestAsset(name string, supply uint64) AssetState {
+	return AssetState{
+		CreatorOwnerID: tokenIndexTestBytes32(0x33),
+		AssetClass:     AssetClassStandard,
+		TokenVersion:   currentStateTokenVersion,
+		Decimals:       8,
+		SupplyMode:     SupplyModeUncapped,
+		TotalSupply:    Uint128FromUint64(supply),
+		Name:           []byte(name),
+		Symbol:         []byte("DIF"),
+	}
+}
+
+func TestStateDiffRoundTrip(t *testing.T) {
+	assetA := tokenIndexTestBytes32(0x11)
+	assetB := tokenIndexTestBytes32(0x12)
+	ownerA := tokenIndexTestBytes32(0x21)
+	ownerB := tokenIndexTestBytes32(0x22)
+
+	base := NewState()
+	base.NextNonces[OwnerNonceKey(ownerA)] = 2
+	base.NextNonces[AssetNonceKey(ownerB, assetA)] = 5
+	base.Assets[assetA] = diffTestAsset("Alpha", 1_000)
+	base.Assets[assetB] = diffTestAsset("Beta", 50)
+	base.Balances[BalanceKey{AssetID: assetA, OwnerID: ownerA}] = Uint128FromUint64(600)
+	base.Balances[BalanceKey{AssetID: assetA, OwnerID: ownerB}] = Uint128FromUint64(400)
+	base.Balances[BalanceKey{AssetID: assetB, OwnerID: ownerB}] = Uint128FromUint64(50)
+	base.AnchorCounts[ownerA] = 1
+
+	target := base.Clone()
+	target.NextNonces[OwnerNonceKey(ownerA)] = 3
+	delete(target.NextNonces, AssetNonceKey(ownerB, assetA))
+	target.Assets[assetA] = diffTestAsset("Alpha", 1_500)
+	delete(target.Assets, assetB)
+	target.Balances[BalanceKey{AssetID: assetA, OwnerID: ownerA}] = Uint128FromUint64(1_100)
+	delete(target.Balances, BalanceKey{AssetID: assetB, OwnerID: ownerB})
+	target.AnchorCounts[ownerB] = 4
+
+	diff, err := DiffStates(base, target)
+	if err != nil {
+		t.Fatalf("DiffStates: %s", err)
+	}
+	if len(diff.NoncesToSet) != 1 || len(diff.NoncesToRemove) != 1 || len(diff.AssetsToSet) != 1 ||
+		len(diff.AssetsToRemove) != 1 || len(diff.BalancesToSet) != 1 || len(diff.BalancesToRemove) != 1 ||
+		len(diff.AnchorsToSet) != 1 || len(diff.AnchorsToRemove) != 0 {
+		t.Fatalf("unexpected diff %+v", diff)
+	}
+
+	diffBytes := diff.Bytes()
+	deserialized, err := StateDiffFromBytes(diffBytes)
+	if err != nil {
+		t.Fatalf("StateDiffFromBytes: %s", err)
+	}
+	if !bytes.Equal(deserialized.Bytes(), diffBytes) {
+		t.Fatalf("diff serialization is not stable")
+	}
+
+	reconstructed := base.Clone()
+	err = reconstructed.ApplyDiff(deserialized)
+	if err != nil {
+		t.Fatalf("ApplyDiff: %s", err)
+	}
+	if !bytes.Equal(reconstructed.CanonicalBytes(), target.CanonicalBytes()) {
+		t.Fatalf("applying the diff did not reconstruct the target state")
+	}
+}
+
+func TestStateDiffEmptyForEqualStates(t *testing.T) {
+	state := NewState()
+	state.Assets[tokenIndexTestBytes32(0x11)] = diffTestAsset("Alpha", 1)
+
+	diff, err := DiffStates(state, state.Clone())
+	if err != nil {
+		t.Fatalf("DiffStates: %s", err)
+	}
+	empty := NewStateDiff()
+	if !bytes.Equal(diff.Bytes(), empty.Bytes()) {
+		t.Fatalf("expected an empty diff, got %+v", diff)
+	}
+}
+
+func TestStateDiffRejectsRootOnlyStates(t *testing.T) {
+	rootOnly := NewRootOnlyState([externalapi.DomainHashSize]byte{1})
+	if _, err := DiffStates(NewState(), rootOnly); err == nil {
+		t.Fatalf("expected diffing a root-only state to fail")
+	}
+	if err := rootOnly.ApplyDiff(NewStateDiff()); err == nil {
+		t.Fatalf("expected applying a diff to a root-only state to fail")
+	}
+}
@@ -0,0 +1,266 @@
+package atomicstate
+
+import (
+	"errors"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/subnetworks"
+)
+
+// ErrEventsUnavailable is returned when token events are requested for a block
+// whose selected parent only has an Atomic root, e.g. right after a pruning
+// point sync
+var ErrEventsUnavailable = errors.New("the token events are not available")
+
+// EventKind is the kind of a token event
+type EventKind byte
+
+const (
+	EventAssetCreated EventKind = iota
+	EventTransfer
+	EventMint
+	EventBurn
+	EventBuy
+	EventSell
+	EventFeeClaim
+)
+
+var eventKindNames = map[EventKind]string{
+	EventAssetCreated: "assetCreated",
+	EventTransfer:     "transfer",
+	EventMint:         "mint",
+	EventBurn:         "burn",
+	EventBuy:          "buy",
+	EventSell:         "sell",
+	EventFeeClaim:     "feeClaim",
+}
+
+func (kind EventKind) String() string {
+	if name, ok := eventKindNames[kind]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// BalanceChange is the change of a single balance caused by an event
+type BalanceChange struct {
+	OwnerID [externalapi.DomainHashSize]byte
+	Before  Uint128
+	After   Uint128
+}
+
+// Event describes the effect of an accepted CAT operation on the Atomic state
+type Event struct {
+	Kind          EventKind
+	TransactionID externalapi.DomainTransactionID
+	AssetID       [externalapi.DomainHashSize]byte
+	// OwnerID is the owner that authorized the operation
+	OwnerID [externalapi.DomainHashSize]byte
+	// Amount is the token amount created, transferred, minted, burned,
+	// bought or sold
+	Amount Uint128
+	// CPayAmountSompi is the CPAY paid into the pool by a buy, or paid out
+	// of the pool by a sell or a fee claim
+	CPayAmountSompi uint64
+	// Recipients are the owners receiving tokens or fees, if any
+	Recipients     [][externalapi.DomainHashSize]byte
+	BalanceChanges []BalanceChange
+	// Pool is the resulting pool of liquidity assets
+	Pool *LiquidityPoolState
+}
+
+// ValidateAndApplyTransactionWithGrowthAndEvents behaves like
+// ValidateAndApplyTransactionWithGrowthAndCreationContext and additionally
+// returns the token events of tx
+func ValidateAndApplyTransactionWithGrowthAndEvents(tx *externalapi.DomainTransaction, povDAAScore uint64,
+	payloadHFActivationDAAScore uint64, creationContext CreationContext, state *State,
+	growth *BlockStateGrowth, limits StateGrowthLimits) ([]*Event, error) {
+
+	delta, err := EstimateStateGrowthForTransaction(tx, povDAAScore, payloadHFActivationDAAScore, state)
+	if err != nil {
+		return nil, err
+	}
+	if err := growth.EnsureCanAdd(delta, limits); err != nil {
+		return nil, err
+	}
+	events, err := ValidateAndApplyTransactionWithEvents(tx, povDAAScore, payloadHFActivationDAAScore,
+		creationContext, state)
+	if err != nil {
+		return nil, err
+	}
+	growth.Commit(delta)
+	return events, nil
+}
+
+// ValidateAndApplyTransactionWithEvents behaves like
+// ValidateAndApplyTransactionWithCreationContext and additionally returns the
+// token events of tx. Transactions without a CAT payload yield no events.
+func ValidateAndApplyTransactionWithEvents(tx *externalapi.DomainTransaction, povDAAScore uint64,
+	payloadHFActivationDAAScore uint64, creationContext CreationContext, state *State) ([]*Event, error) {
+
+	var parsedPayload *ParsedPayload
+	if povDAAScore >= payloadHFActivationDAAScore && subnetworks.IsPayload(tx.SubnetworkID) && len(tx.Payload) > 0 {
+		var err error
+		parsedPayload, err = ParsePayload(tx.Payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if parsedPayload == nil {
+		return nil, ValidateAndApplyTransactionWithCreationContext(tx, povDAAScore, payloadHFActivationDAAScore,
+			creationContext, state)
+	}
+
+	ownerID, err := resolveOwnerFromPopulatedTx(tx, parsedPayload.AuthInputIndex)
+	if err != nil {
+		return nil, err
+	}
+	txID := *consensushashing.TransactionID(tx)
+	assetID := eventAssetID(txID, parsedPayload.Op)
+
+	keys := eventBalanceOwners(ownerID, parsedPayload.Op)
+	before := make([]Uint128, len(keys))
+	for i, key := range keys {
+		before[i] = state.Balances[BalanceKey{AssetID: assetID, OwnerID: key}]
+	}
+	var vaultBefore uint64
+	if asset, ok := state.Assets[assetID]; ok && asset.Liquidity != nil {
+		vaultBefore = asset.Liquidity.VaultValueSompi
+	}
+
+	err = ValidateAndApplyTransactionWithCreationContext(tx, povDAAScore, payloadHFActivationDAAScore, creationContext, state)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceChanges := make([]BalanceChange, 0, len(keys))
+	for i, key := range keys {
+		after := state.Balances[BalanceKey{AssetID: assetID, OwnerID: key}]
+		if after.Compare(before[i]) != 0 {
+			balanceChanges = append(balanceChanges, BalanceChange{OwnerID: key, Before: before[i], After: after})
+		}
+	}
+	var pool *LiquidityPoolState
+	var vaultAfter uint64
+	if asset, ok := state.Assets[assetID]; ok && asset.Liquidity != nil {
+		clonedAsset := asset.clone()
+		pool = clonedAsset.Liquidity
+		vaultAfter = pool.VaultValueSompi
+	}
+
+	newEvent := func(kind EventKind) *Event {
+		return &Event{
+			Kind:           kind,
+			TransactionID:  txID,
+			AssetID:        assetID,
+			OwnerID:        ownerID,
+			BalanceChanges: balanceChanges,
+			Pool:           pool,
+		}
+	}
+
+	switch op := parsedPayload.Op.(type) {
+	case CreateAssetOp:
+		return []*Event{newEvent(EventAssetCreated)}, nil
+
+	case CreateAssetWithMintOp:
+		created := newEvent(EventAssetCreated)
+		if op.InitialMintAmount.IsZero() {
+			return []*Event{created}, nil
+		}
+		minted := newEvent(EventMint)
+		minted.Amount = op.InitialMintAmount
+		minted.Recipients = [][externalapi.DomainHashSize]byte{op.InitialMintToOwnerID}
+		return []*Event{created, minted}, nil
+
+	case CreateLiquidityAssetOp:
+		created := newEvent(EventAssetCreated)
+		if op.LaunchBuySompi == 0 {
+			return []*Event{created}, nil
+		}
+		bought := newEvent(EventBuy)
+		bought.CPayAmountSompi = op.LaunchBuySompi
+		bought.Amount = state.Assets[assetID].TotalSupply
+		return []*Event{created, bought}, nil
+
+	case TransferOp:
+		transferred := newEvent(EventTransfer)
+		transferred.Amount = op.Amount
+		transferred.Recipients = [][externalapi.DomainHashSize]byte{op.ToOwnerID}
+		return []*Event{transferred}, nil
+
+	case MintOp:
+		minted := newEvent(EventMint)
+		minted.Amount = op.Amount
+		minted.Recipients = [][externalapi.DomainHashSize]byte{op.ToOwnerID}
+		return []*Event{minted}, nil
+
+	case BurnOp:
+		burned := newEvent(EventBurn)
+		burned.Amount = op.Amount
+		return []*Event{burned}, nil
+
+	case BuyLiquidityExactInOp:
+		bought := newEvent(EventBuy)
+		bought.CPayAmountSompi = vaultAfter - vaultBefore
+		if len(balanceChanges) > 0 {
+			bought.Amount, _ = balanceChanges[0].After.Sub(balanceChanges[0].Before)
+		}
+		return []*Event{bought}, nil
+
+	case SellLiquidityExactInOp:
+		sold := newEvent(EventSell)
+		sold.Amount = op.TokenIn
+		sold.CPayAmountSompi = vaultBefore - vaultAfter
+		return []*Event{sold}, nil
+
+	case ClaimLiquidityFeesOp:
+		claimed := newEvent(EventFeeClaim)
+		claimed.CPayAmountSompi = op.ClaimAmountSompi
+		if pool != nil && int(op.RecipientIndex) < len(pool.FeeRecipients) {
+			claimed.Recipients = [][externalapi.DomainHashSize]byte{pool.FeeRecipients[op.RecipientIndex].OwnerID}
+		}
+		return []*Event{claimed}, nil
+	}
+	return nil, nil
+}
+
+// eventAssetID returns the asset an operation acts on. Create operations
+// create an asset whose ID is the ID of their transaction.
+func eventAssetID(txID externalapi.DomainTransactionID, op PayloadOp) [externalapi.DomainHashSize]byte {
+	switch op := op.(type) {
+	case TransferOp:
+		return op.AssetID
+	case MintOp:
+		return op.AssetID
+	case BurnOp:
+		return op.AssetID
+	case BuyLiquidityExactInOp:
+		return op.AssetID
+	case SellLiquidityExactInOp:
+		return op.AssetID
+	case ClaimLiquidityFeesOp:
+		return op.AssetID
+	}
+	return *txID.ByteArray()
+}
+
+// eventBalanceOwners returns the owners whose balance of the operated asset
+// an operation may change
+func eventBalanceOwners(ownerID [externalapi.DomainHashSize]byte, op PayloadOp) [][externalapi.DomainHashSize]byte {
+	switch op := op.(type) {
+	case TransferOp:
+		if op.ToOwnerID == ownerID {
+			return [][externalapi.DomainHashSize]byte{ownerID}
+		}
+		return [][externalapi.DomainHashSize]byte{ownerID, op.ToOwnerID}
+	case MintOp:
+		return [][externalapi.DomainHashSize]byte{op.ToOwnerID}
+	case CreateAssetWithMintOp:
+		return [][externalapi.DomainHashSize]byte{op.InitialMintToOwnerID}
+	case CreateAssetOp, ClaimLiquidityFeesOp:
+		return nil
+	}
+	return [][externalapi.DomainHashSize]byte{ownerID}
+}
@@ -0,0 +1,124 @@
+package atomicstate
+
+import (
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+)
+
+func TestTransferEvents(t *testing.T) {
+	ownerScript := testOwnerScript(0xD1)
+	ownerID := mustOwnerIDFromScript(t, ownerScript)
+	assetID := bytes32(0x31)
+	receiverID := bytes32(0xC8)
+	state := testTransferState(ownerID, assetID, 10)
+
+	tx := testTransferTx(ownerScript, 0x31, testTransferPayload(1, assetID, receiverID, Uint128FromUint64(4)))
+	events, err := ValidateAndApplyTransactionWithEvents(tx, 1, 0, CreationContext{}, state)
+	if err != nil {
+		t.Fatalf("transfer failed: %s", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	event := events[0]
+	if event.Kind != EventTransfer || event.AssetID != assetID || event.OwnerID != ownerID {
+		t.Fatalf("unexpected event %+v", event)
+	}
+	if event.TransactionID != *consensushashing.TransactionID(tx) {
+		t.Fatalf("event transaction ID mismatch")
+	}
+	if event.Amount.Compare(Uint128FromUint64(4)) != 0 {
+		t.Fatalf("event amount got %s want 4", event.Amount.Big())
+	}
+	if len(event.Recipients) != 1 || event.Recipients[0] != receiverID {
+		t.Fatalf("unexpected recipients %v", event.Recipients)
+	}
+	if len(event.BalanceChanges) != 2 {
+		t.Fatalf("expected 2 balance changes, got %d", len(event.BalanceChanges))
+	}
+	sender, receiver := event.BalanceChanges[0], event.BalanceChanges[1]
+	if sender.OwnerID != ownerID || sender.Before.Compare(Uint128FromUint64(10)) != 0 ||
+		sender.After.Compare(Uint128FromUint64(6)) != 0 {
+		t.Fatalf("unexpected sender balance change %+v", sender)
+	}
+	if receiver.OwnerID != receiverID || !receiver.Before.IsZero() || receiver.After.Compare(Uint128FromUint64(4)) != 0 {
+		t.Fatalf("unexpected receiver balance change %+v", receiver)
+	}
+}
+
+func TestCreateAssetWithMintEvents(t *testing.T) {
+	ownerScript := testOwnerScript(0xD2)
+	ownerID := mustOwnerIDFromScript(t, ownerScript)
+	receiverID := bytes32(0xC9)
+	state := NewState()
+	state.AnchorCounts[ownerID] = 1
+
+	payload := testCreateAssetWithMintPayload(1, 8, PayloadSupplyModeUncapped, Uint128{}, ownerID,
+		[]byte("Event Token"), []byte("EVT"), nil, nil, Uint128FromUint64(500), receiverID)
+	tx := testTransferTx(ownerScript, 0x32, payload)
+	events, err := ValidateAndApplyTransactionWithEvents(tx, 1, 0, CreationContext{}, state)
+	if err != nil {
+		t.Fatalf("create asset with mint failed: %s", err)
+	}
+	if len(events) != 2 || events[0].Kind != EventAssetCreated || events[1].Kind != EventMint {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	assetID := *consensushashing.TransactionID(tx).ByteArray()
+	if events[1].AssetID != assetID || events[1].Amount.Compare(Uint128FromUint64(500)) != 0 {
+		t.Fatalf("unexpected mint event %+v", events[1])
+	}
+	if len(events[1].Recipients) != 1 || events[1].Recipients[0] != receiverID {
+		t.Fatalf("unexpected mint recipients %v", events[1].Recipients)
+	}
+}
+
+func TestEventsRejectedTransaction(t *testing.T) {
+	ownerScript := testOwnerScript(0xD3)
+	ownerID := mustOwnerIDFromScript(t, ownerScript)
+	assetID := bytes32(0x33)
+	state := testTransferState(ownerID, assetID, 1)
+
+	tx := testTransferTx(ownerScript, 0x33, testTransferPayload(1, assetID, bytes32(0xCA), Uint128FromUint64(4)))
+	events, err := ValidateAndApplyTransactionWithEvents(tx, 1, 0, CreationContext{}, state)
+	if err == nil {
+		t.Fatalf("expected an overdrawn transfer to fail")
+	}
+	if events != nil {
+		t.Fatalf("expected no events for a rejected transaction")
+	}
+}
+
+func TestEventsWithGrowthRejectsBeforeMutation(t *testing.T) {
+	ownerScript := testOwnerScript(0xD4)
+	ownerID := mustOwnerIDFromScript(t, ownerScript)
+	assetID := bytes32(0x34)
+	receiverID := bytes32(0xCB)
+	state := testTransferState(ownerID, assetID, 10)
+	growth := &BlockStateGrowth{}
+
+	tx := testTransferTx(ownerScript, 0x34, testTransferPayload(1, assetID, receiverID, Uint128FromUint64(4)))
+	events, err := ValidateAndApplyTransactionWithGrowthAndEvents(tx, 1, 0, CreationContext{}, state, growth,
+		StateGrowthLimits{MaxNewAssets: 1, MaxNewNonceKeys: 1, MaxNewPools: 1, MaxNewAnchorOwnerKeys: 1})
+	if err == nil {
+		t.Fatalf("expected a transfer to a new balance key to exceed the growth limits")
+	}
+	if events != nil {
+		t.Fatalf("expected no events for a rejected transaction")
+	}
+	if got := state.Balances[BalanceKey{AssetID: assetID, OwnerID: receiverID}]; !got.IsZero() {
+		t.Fatalf("receiver balance inserted after rejected growth: %s", got.Big().String())
+	}
+
+	events, err = ValidateAndApplyTransactionWithGrowthAndEvents(tx, 1, 0, CreationContext{}, state, growth,
+		StateGrowthLimits{MaxNewAssets: 1, MaxNewBalanceKeys: 1, MaxNewNonceKeys: 1, MaxNewPools: 1, MaxNewAnchorOwnerKeys: 1})
+	if err != nil {
+		t.Fatalf("transfer failed: %s", err)
+	}
+	if len(events) != 1 || events[0].Kind != EventTransfer {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	if growth.Used().NewBalanceKeys != 1 {
+		t.Fatalf("expected 1 new balance key to be committed, got %d", growth.Used().NewBalanceKeys)
+	}
+}
@@ -0,0 +1,90 @@
+package atomicstate
+
+import (
+	"fmt"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/constants"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/txscript"
+)
+
+// LiquidityMinPayoutSompi is the smallest sell or fee claim payout accepted by consensus
+const LiquidityMinPayoutSompi = liquidityMinPayoutSompi
+
+// LiquidityBuyQuote is the outcome of a BuyLiquidityExactInOp as computed by consensus
+type LiquidityBuyQuote struct {
+	// CPayInSompi is the canonical gross input for TokenOut. It is the value
+	// the vault must grow by, and the value BuyLiquidityExactInOp must carry
+	CPayInSompi uint64
+	FeeSompi    uint64
+	TokenOut    Uint128
+}
+
+// LiquiditySellQuote is the outcome of a SellLiquidityExactInOp as computed by consensus
+type LiquiditySellQuote struct {
+	TokenIn      Uint128
+	FeeSompi     uint64
+	CPayOutSompi uint64
+}
+
+// LiquidityVaultScriptPublicKey returns the script public key of CAT liquidity vault outputs
+func LiquidityVaultScriptPublicKey() *externalapi.ScriptPublicKey {
+	return &externalapi.ScriptPublicKey{
+		Script:  []byte{txscript.OpData4, 'C', 'L', 'V', '1', txscript.OpDrop, txscript.OpTrue},
+		Version: constants.MaxScriptPublicKeyVersion,
+	}
+}
+
+// LiquiditySellLocked returns whether sells and fee claims are locked for the given pool
+func LiquiditySellLocked(pool LiquidityPoolState) bool {
+	return liquiditySellLocked(pool)
+}
+
+// QuoteLiquidityBuy quotes a buy spending at most maxCPayInSompi. The returned
+// CPayInSompi is the canonical input for the quoted token output, and may be
+// lower than maxCPayInSompi.
+func QuoteLiquidityBuy(pool LiquidityPoolState, maxCPayInSompi uint64) (LiquidityBuyQuote, error) {
+	fee, err := calculateTradeFee(maxCPayInSompi, pool.FeeBPS)
+	if err != nil {
+		return LiquidityBuyQuote{}, err
+	}
+	netIn, ok := checkedSubUint64(maxCPayInSompi, fee)
+	if !ok {
+		return LiquidityBuyQuote{}, fmt.Errorf("buy fee underflow")
+	}
+	tokenOut, _, _, _, err := cpmmBuy(pool.RealTokenReserves, pool.VirtualCPayReserves, pool.VirtualTokenReserves, netIn)
+	if err != nil {
+		return LiquidityBuyQuote{}, err
+	}
+	cpayIn, err := minGrossInputForTokenOut(pool.RealTokenReserves, pool.VirtualCPayReserves, pool.VirtualTokenReserves,
+		tokenOut, pool.FeeBPS)
+	if err != nil {
+		return LiquidityBuyQuote{}, err
+	}
+	fee, err = calculateTradeFee(cpayIn, pool.FeeBPS)
+	if err != nil {
+		return LiquidityBuyQuote{}, err
+	}
+	return LiquidityBuyQuote{CPayInSompi: cpayIn, FeeSompi: fee, TokenOut: tokenOut}, nil
+}
+
+// QuoteLiquiditySell quotes a sell of tokenIn into the given pool
+func QuoteLiquiditySell(pool LiquidityPoolState, tokenIn Uint128) (LiquiditySellQuote, error) {
+	if liquiditySellLocked(pool) {
+		return LiquiditySellQuote{}, fmt.Errorf("liquidity sell locked until real CPAY reserve reaches `%d` sompi",
+			pool.UnlockTargetSompi)
+	}
+	grossOut, _, _, _, err := cpmmSell(pool.RealCPayReservesSompi, pool.VirtualCPayReserves, pool.VirtualTokenReserves, tokenIn)
+	if err != nil {
+		return LiquiditySellQuote{}, err
+	}
+	fee, err := calculateTradeFee(grossOut, pool.FeeBPS)
+	if err != nil {
+		return LiquiditySellQuote{}, err
+	}
+	cpayOut, ok := checkedSubUint64(grossOut, fee)
+	if !ok || cpayOut < liquidityMinPayoutSompi {
+		return LiquiditySellQuote{}, fmt.Errorf("sell payout below liquidity_min_payout_sompi")
+	}
+	return LiquiditySellQuote{TokenIn: tokenIn, FeeSompi: fee, CPayOutSompi: cpayOut}, nil
+}
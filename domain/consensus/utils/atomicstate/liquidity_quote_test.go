@@ -0,0 +1,74 @@
+package atomicstate
+
+import (
+	"strings"
+	"testing"
+)
+
+func quoteTestPool() LiquidityPoolState {
+	return LiquidityPoolState{
+		RealCPayReservesSompi: 1_000_000,
+		RealTokenReserves:     Uint128FromUint64(1_000_000_000),
+		VirtualCPayReserves:   10_000_000,
+		VirtualTokenReserves:  Uint128FromUint64(1_000_000_000),
+		FeeBPS:                100,
+		Unlocked:              true,
+	}
+}
+
+func TestQuoteLiquidityBuyReturnsCanonicalInput(t *testing.T) {
+	pool := quoteTestPool()
+	quote, err := QuoteLiquidityBuy(pool, 123_457)
+	if err != nil {
+		t.Fatalf("quote failed: %v", err)
+	}
+	if quote.CPayInSompi > 123_457 {
+		t.Fatalf("quoted input %d exceeds the budget", quote.CPayInSompi)
+	}
+
+	canonical, err := minGrossInputForTokenOut(pool.RealTokenReserves, pool.VirtualCPayReserves, pool.VirtualTokenReserves,
+		quote.TokenOut, pool.FeeBPS)
+	if err != nil {
+		t.Fatalf("minGrossInputForTokenOut failed: %v", err)
+	}
+	if quote.CPayInSompi != canonical {
+		t.Fatalf("quoted input %d is not canonical, want %d", quote.CPayInSompi, canonical)
+	}
+
+	_, _, tokenOut, _, _, _, err := buyWithGrossForTest(pool.RealTokenReserves, pool.VirtualCPayReserves,
+		pool.VirtualTokenReserves, quote.CPayInSompi, pool.FeeBPS)
+	if err != nil {
+		t.Fatalf("buy failed: %v", err)
+	}
+	if tokenOut.Compare(quote.TokenOut) != 0 {
+		t.Fatalf("buying with the quoted input got %s tokens, want %s", tokenOut.Big(), quote.TokenOut.Big())
+	}
+}
+
+func TestQuoteLiquiditySellMatchesConsensus(t *testing.T) {
+	pool := quoteTestPool()
+	tokenIn := Uint128FromUint64(5_000_000)
+	quote, err := QuoteLiquiditySell(pool, tokenIn)
+	if err != nil {
+		t.Fatalf("quote failed: %v", err)
+	}
+
+	grossOut, _, _, _, err := cpmmSell(pool.RealCPayReservesSompi, pool.VirtualCPayReserves, pool.VirtualTokenReserves, tokenIn)
+	if err != nil {
+		t.Fatalf("sell failed: %v", err)
+	}
+	if quote.CPayOutSompi+quote.FeeSompi != grossOut {
+		t.Fatalf("quote %+v does not add up to gross output %d", quote, grossOut)
+	}
+}
+
+func TestQuoteLiquiditySellRejectsLockedPool(t *testing.T) {
+	pool := quoteTestPool()
+	pool.UnlockTargetSompi = 2_000_000
+	pool.Unlocked = false
+
+	_, err := QuoteLiquiditySell(pool, Uint128FromUint64(1_000))
+	if err == nil || !strings.Contains(err.Error(), "locked") {
+		t.Fatalf("expected a locked pool error, got %v", err)
+	}
+}
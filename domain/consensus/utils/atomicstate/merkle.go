@@ -0,0 +1,299 @@
+package atomicstate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"golang.org/x/crypto/blake2b"
+)
+
+// The Merkle root commits to the same namespace entries as the XOR root
+// accumulator, arranged in a sparse binary Merkle tree. Every entry is a
+// leaf at a 256-bit path derived from its namespace and key. Subtrees
+// without leaves hash to zero and subtrees with a single leaf are replaced by
+// that leaf, so a tree of n entries has a depth of about log2(n).
+
+var atomicMerkleHashDomain = []byte("cryptix-atomic-state-merkle-v1")
+
+const (
+	atomicMerkleRootVersion = byte(3)
+	atomicMerklePathDepth   = externalapi.DomainHashSize * 8
+
+	atomicMerkleTagPath = byte('p')
+	atomicMerkleTagLeaf = byte('l')
+	atomicMerkleTagNode = byte('i')
+	atomicMerkleTagRoot = byte('r')
+)
+
+// MerkleProof proves whether a leaf is in the Atomic state Merkle tree.
+// Siblings are ordered from the root downwards. A proof that a path is absent
+// either ends at an empty subtree or at the single other leaf occupying the
+// subtree, given by OtherLeafPath and OtherLeafEntryHash.
+type MerkleProof struct {
+	Siblings           [][externalapi.DomainHashSize]byte
+	OtherLeafPath      *[externalapi.DomainHashSize]byte
+	OtherLeafEntryHash [externalapi.DomainHashSize]byte
+}
+
+// BalanceProof proves the balance of Key in an Atomic state. Amount is zero
+// and Included is false when the state holds no balance for Key.
+type BalanceProof struct {
+	Key      BalanceKey
+	Amount   Uint128
+	Included bool
+	Proof    MerkleProof
+}
+
+// CommittedBalanceProof is a balance proof together with everything needed to
+// check it against the header of Block: the header's UTXO commitment is the
+// HeaderCommitment of RawUTXOCommitment and MerkleRoot.
+type CommittedBalanceProof struct {
+	Block             *externalapi.DomainHash
+	Header            externalapi.BlockHeader
+	RawUTXOCommitment *externalapi.DomainHash
+	MerkleRoot        [externalapi.DomainHashSize]byte
+	Proof             *BalanceProof
+}
+
+// ErrMerkleRootHFNotActive is returned when a proof is requested for a block
+// whose header does not commit to an Atomic Merkle root
+var ErrMerkleRootHFNotActive = errors.New("the Atomic Merkle root hardfork is not active")
+
+type merkleLeaf struct {
+	path      [externalapi.DomainHashSize]byte
+	entryHash [externalapi.DomainHashSize]byte
+}
+
+// MerkleRoot returns the root of the sparse Merkle tree over the nonce,
+// asset, balance and anchor entries of the state
+func (s *State) MerkleRoot() ([externalapi.DomainHashSize]byte, error) {
+	leaves, err := s.merkleLeaves()
+	if err != nil {
+		return [externalapi.DomainHashSize]byte{}, err
+	}
+	return merkleRootFromTop(merkleSubtreeHash(leaves, 0)), nil
+}
+
+// CommitmentHash returns the Atomic state hash committed to in block headers.
+// Once the Merkle root hardfork is active it is the Merkle root, and the XOR
+// accumulator root before that. Root-only states commit to the hash they were
+// created with.
+func (s *State) CommitmentHash(merkleRootHFActive bool) ([externalapi.DomainHashSize]byte, error) {
+	if !merkleRootHFActive || s.IsRootOnly() {
+		return s.CanonicalHash(), nil
+	}
+	return s.MerkleRoot()
+}
+
+// ProveBalance returns a proof of the balance of key against the Merkle root
+// of the state, along with that root
+func (s *State) ProveBalance(key BalanceKey) (*BalanceProof, [externalapi.DomainHashSize]byte, error) {
+	leaves, err := s.merkleLeaves()
+	if err != nil {
+		return nil, [externalapi.DomainHashSize]byte{}, err
+	}
+
+	amount, included := s.Balances[key]
+	proof := &BalanceProof{
+		Key:      key,
+		Amount:   amount,
+		Included: included,
+		Proof:    merkleProve(leaves, balanceMerklePath(key)),
+	}
+	return proof, merkleRootFromTop(merkleSubtreeHash(leaves, 0)), nil
+}
+
+// VerifyBalanceProof checks proof against the Merkle root of an Atomic state
+func VerifyBalanceProof(merkleRoot [externalapi.DomainHashSize]byte, proof *BalanceProof) error {
+	if proof == nil {
+		return fmt.Errorf("balance proof is nil")
+	}
+	var entryHash *[externalapi.DomainHashSize]byte
+	if proof.Included {
+		hash := hashBalanceEntry(proof.Key, proof.Amount)
+		entryHash = &hash
+	} else if !proof.Amount.IsZero() {
+		return fmt.Errorf("balance proof of absence has a non-zero amount")
+	}
+	return verifyMerkleProof(merkleRoot, balanceMerklePath(proof.Key), entryHash, &proof.Proof)
+}
+
+func (s *State) merkleLeaves() ([]merkleLeaf, error) {
+	if s.IsRootOnly() {
+		return nil, fmt.Errorf("cannot build the Merkle tree of a root-only Atomic state")
+	}
+
+	leaves := make([]merkleLeaf, 0, len(s.NextNonces)+len(s.Assets)+len(s.Balances)+len(s.AnchorCounts))
+	for key, value := range s.NextNonces {
+		leaves = append(leaves, merkleLeaf{path: nonceMerklePath(key), entryHash: hashNonceEntry(key, value)})
+	}
+	for assetID, asset := range s.Assets {
+		leaves = append(leaves, merkleLeaf{path: assetMerklePath(assetID), entryHash: hashAssetEntry(assetID, asset)})
+	}
+	for key, value := range s.Balances {
+		leaves = append(leaves, merkleLeaf{path: balanceMerklePath(key), entryHash: hashBalanceEntry(key, value)})
+	}
+	for ownerID, value := range s.AnchorCounts {
+		leaves = append(leaves, merkleLeaf{path: anchorMerklePath(ownerID), entryHash: hashAnchorEntry(ownerID, value)})
+	}
+	sort.Slice(leaves, func(i, j int) bool { return bytes.Compare(leaves[i].path[:], leaves[j].path[:]) < 0 })
+	for i := 1; i < len(leaves); i++ {
+		if leaves[i].path == leaves[i-1].path {
+			return nil, fmt.Errorf("duplicate Atomic Merkle path %x", leaves[i].path)
+		}
+	}
+	return leaves, nil
+}
+
+// merkleSubtreeHash returns the hash of the subtree at depth holding leaves,
+// which are sorted and share their first depth path bits
+func merkleSubtreeHash(leaves []merkleLeaf, depth int) [externalapi.DomainHashSize]byte {
+	switch len(leaves) {
+	case 0:
+		return [externalapi.DomainHashSize]byte{}
+	case 1:
+		return merkleLeafHash(leaves[0].path, leaves[0].entryHash)
+	}
+	split := merkleSplit(leaves, depth)
+	return merkleNodeHash(merkleSubtreeHash(leaves[:split], depth+1), merkleSubtreeHash(leaves[split:], depth+1))
+}
+
+func merkleProve(leaves []merkleLeaf, path [externalapi.DomainHashSize]byte) MerkleProof {
+	proof := MerkleProof{}
+	for depth := 0; len(leaves) > 1; depth++ {
+		split := merkleSplit(leaves, depth)
+		if merklePathBit(path, depth) == 0 {
+			proof.Siblings = append(proof.Siblings, merkleSubtreeHash(leaves[split:], depth+1))
+			leaves = leaves[:split]
+		} else {
+			proof.Siblings = append(proof.Siblings, merkleSubtreeHash(leaves[:split], depth+1))
+			leaves = leaves[split:]
+		}
+	}
+	if len(leaves) == 1 && leaves[0].path != path {
+		otherLeafPath := leaves[0].path
+		proof.OtherLeafPath = &otherLeafPath
+		proof.OtherLeafEntryHash = leaves[0].entryHash
+	}
+	return proof
+}
+
+// verifyMerkleProof checks that path holds entryHash, or that path is absent
+// if entryHash is nil, in the tree with the given root
+func verifyMerkleProof(merkleRoot [externalapi.DomainHashSize]byte, path [externalapi.DomainHashSize]byte,
+	entryHash *[externalapi.DomainHashSize]byte, proof *MerkleProof) error {
+
+	depth := len(proof.Siblings)
+	if depth > atomicMerklePathDepth {
+		return fmt.Errorf("atomic Merkle proof has %d siblings, the maximum is %d", depth, atomicMerklePathDepth)
+	}
+
+	var hash [externalapi.DomainHashSize]byte
+	switch {
+	case entryHash != nil:
+		if proof.OtherLeafPath != nil {
+			return fmt.Errorf("atomic Merkle proof of inclusion ends at another leaf")
+		}
+		hash = merkleLeafHash(path, *entryHash)
+	case proof.OtherLeafPath != nil:
+		otherLeafPath := *proof.OtherLeafPath
+		if otherLeafPath == path {
+			return fmt.Errorf("atomic Merkle proof of absence ends at the proven path")
+		}
+		for i := 0; i < depth; i++ {
+			if merklePathBit(otherLeafPath, i) != merklePathBit(path, i) {
+				return fmt.Errorf("atomic Merkle proof of absence ends at a leaf outside the proven subtree")
+			}
+		}
+		hash = merkleLeafHash(otherLeafPath, proof.OtherLeafEntryHash)
+	}
+
+	for i := depth - 1; i >= 0; i-- {
+		if merklePathBit(path, i) == 0 {
+			hash = merkleNodeHash(hash, proof.Siblings[i])
+		} else {
+			hash = merkleNodeHash(proof.Siblings[i], hash)
+		}
+	}
+	if merkleRootFromTop(hash) != merkleRoot {
+		return fmt.Errorf("atomic Merkle proof does not match root %x", merkleRoot)
+	}
+	return nil
+}
+
+// merkleSplit returns the index of the first leaf whose path bit at depth is set
+func merkleSplit(leaves []merkleLeaf, depth int) int {
+	if depth >= atomicMerklePathDepth {
+		panic("Atomic Merkle paths are not unique")
+	}
+	return sort.Search(len(leaves), func(i int) bool { return merklePathBit(leaves[i].path, depth) == 1 })
+}
+
+func merklePathBit(path [externalapi.DomainHashSize]byte, depth int) byte {
+	return (path[depth/8] >> (7 - uint(depth%8))) & 1
+}
+
+func nonceMerklePath(key NonceKey) [externalapi.DomainHashSize]byte {
+	hasher := newAtomicMerkleHasher(atomicMerkleTagPath)
+	hashByte(hasher, atomicRootNamespaceNonce)
+	_, _ = hasher.Write(key.OwnerID[:])
+	hashByte(hasher, byte(key.ScopeKind))
+	_, _ = hasher.Write(key.ScopeID[:])
+	return finalizeAtomicHash(hasher)
+}
+
+func assetMerklePath(assetID [externalapi.DomainHashSize]byte) [externalapi.DomainHashSize]byte {
+	hasher := newAtomicMerkleHasher(atomicMerkleTagPath)
+	hashByte(hasher, atomicRootNamespaceAsset)
+	_, _ = hasher.Write(assetID[:])
+	return finalizeAtomicHash(hasher)
+}
+
+func balanceMerklePath(key BalanceKey) [externalapi.DomainHashSize]byte {
+	hasher := newAtomicMerkleHasher(atomicMerkleTagPath)
+	hashByte(hasher, atomicRootNamespaceBalance)
+	_, _ = hasher.Write(key.AssetID[:])
+	_, _ = hasher.Write(key.OwnerID[:])
+	return finalizeAtomicHash(hasher)
+}
+
+func anchorMerklePath(ownerID [externalapi.DomainHashSize]byte) [externalapi.DomainHashSize]byte {
+	hasher := newAtomicMerkleHasher(atomicMerkleTagPath)
+	hashByte(hasher, atomicRootNamespaceAnchor)
+	_, _ = hasher.Write(ownerID[:])
+	return finalizeAtomicHash(hasher)
+}
+
+func merkleLeafHash(path [externalapi.DomainHashSize]byte, entryHash [externalapi.DomainHashSize]byte) [externalapi.DomainHashSize]byte {
+	hasher := newAtomicMerkleHasher(atomicMerkleTagLeaf)
+	_, _ = hasher.Write(path[:])
+	_, _ = hasher.Write(entryHash[:])
+	return finalizeAtomicHash(hasher)
+}
+
+func merkleNodeHash(left [externalapi.DomainHashSize]byte, right [externalapi.DomainHashSize]byte) [externalapi.DomainHashSize]byte {
+	hasher := newAtomicMerkleHasher(atomicMerkleTagNode)
+	_, _ = hasher.Write(left[:])
+	_, _ = hasher.Write(right[:])
+	return finalizeAtomicHash(hasher)
+}
+
+func merkleRootFromTop(top [externalapi.DomainHashSize]byte) [externalapi.DomainHashSize]byte {
+	hasher := newAtomicMerkleHasher(atomicMerkleTagRoot)
+	hashByte(hasher, atomicMerkleRootVersion)
+	_, _ = hasher.Write(top[:])
+	return finalizeAtomicHash(hasher)
+}
+
+func newAtomicMerkleHasher(tag byte) hashWriter {
+	hasher, err := blake2b.New256(nil)
+	if err != nil {
+		panic(err)
+	}
+	_, _ = hasher.Write(atomicMerkleHashDomain)
+	hashByte(hasher, tag)
+	return hasher
+}
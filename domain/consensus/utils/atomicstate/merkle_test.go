@@ -0,0 +1,121 @@
+package atomicstate
+
+import (
+	"testing"
+)
+
+func merkleTestState(balances int) *State {
+	state := NewState()
+	assetID := bytes32(0xA1)
+	state.Assets[assetID] = diffTestAsset("merkle", uint64(balances))
+	for i := 0; i < balances; i++ {
+		ownerID := bytes32(byte(i))
+		ownerID[1] = byte(i >> 8)
+		state.Balances[BalanceKey{AssetID: assetID, OwnerID: ownerID}] = Uint128FromUint64(uint64(i + 1))
+		state.NextNonces[OwnerNonceKey(ownerID)] = uint64(i + 2)
+	}
+	state.AnchorCounts[bytes32(0xA2)] = 3
+	return state
+}
+
+func TestBalanceProofs(t *testing.T) {
+	state := merkleTestState(100)
+	root, err := state.MerkleRoot()
+	if err != nil {
+		t.Fatalf("MerkleRoot: %s", err)
+	}
+
+	for key := range state.Balances {
+		proof, proofRoot, err := state.ProveBalance(key)
+		if err != nil {
+			t.Fatalf("ProveBalance: %s", err)
+		}
+		if proofRoot != root {
+			t.Fatalf("ProveBalance returned root %x, expected %x", proofRoot, root)
+		}
+		if !proof.Included {
+			t.Fatalf("expected balance %+v to be included", key)
+		}
+		if err := VerifyBalanceProof(root, proof); err != nil {
+			t.Fatalf("VerifyBalanceProof: %s", err)
+		}
+
+		tampered := *proof
+		tampered.Amount = Uint128FromUint64(1_000_000)
+		if err := VerifyBalanceProof(root, &tampered); err == nil {
+			t.Fatalf("expected a proof with a tampered amount to fail")
+		}
+		tampered = *proof
+		tampered.Included = false
+		tampered.Amount = Uint128{}
+		if err := VerifyBalanceProof(root, &tampered); err == nil {
+			t.Fatalf("expected a proof of absence for an included balance to fail")
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		absentKey := BalanceKey{AssetID: bytes32(0xA3), OwnerID: bytes32(byte(i))}
+		proof, _, err := state.ProveBalance(absentKey)
+		if err != nil {
+			t.Fatalf("ProveBalance: %s", err)
+		}
+		if proof.Included {
+			t.Fatalf("expected balance %+v to be absent", absentKey)
+		}
+		if err := VerifyBalanceProof(root, proof); err != nil {
+			t.Fatalf("VerifyBalanceProof of absence: %s", err)
+		}
+	}
+}
+
+func TestMerkleRootTracksEveryNamespace(t *testing.T) {
+	state := merkleTestState(10)
+	root, err := state.MerkleRoot()
+	if err != nil {
+		t.Fatalf("MerkleRoot: %s", err)
+	}
+
+	changed := state.Clone()
+	changed.AnchorCounts[bytes32(0xA2)]++
+	changedRoot, err := changed.MerkleRoot()
+	if err != nil {
+		t.Fatalf("MerkleRoot: %s", err)
+	}
+	if changedRoot == root {
+		t.Fatalf("expected an anchor change to change the Merkle root")
+	}
+
+	preHFCommitmentHash, err := state.CommitmentHash(false)
+	if err != nil {
+		t.Fatalf("CommitmentHash: %s", err)
+	}
+	if preHFCommitmentHash != state.CanonicalHash() {
+		t.Fatalf("expected the pre-HF commitment hash to be the canonical hash")
+	}
+	postHFCommitmentHash, err := state.CommitmentHash(true)
+	if err != nil {
+		t.Fatalf("CommitmentHash: %s", err)
+	}
+	if postHFCommitmentHash != root {
+		t.Fatalf("expected the post-HF commitment hash to be the Merkle root")
+	}
+
+	rootOnly := NewRootOnlyState(state.CanonicalHash())
+	if _, err := rootOnly.MerkleRoot(); err == nil {
+		t.Fatalf("expected MerkleRoot of a root-only state to fail")
+	}
+}
+
+func TestBalanceProofOfEmptyState(t *testing.T) {
+	state := NewState()
+	proof, root, err := state.ProveBalance(BalanceKey{AssetID: bytes32(1), OwnerID: bytes32(2)})
+	if err != nil {
+		t.Fatalf("ProveBalance: %s", err)
+	}
+	if len(proof.Proof.Siblings) != 0 || proof.Proof.OtherLeafPath != nil {
+		t.Fatalf("unexpected proof for an empty state %+v", proof.Proof)
+	}
+	if err := VerifyBalanceProof(root, proof); err != nil {
+		t.Fatalf("VerifyBalanceProof: %s", err)
+	}
+}
@@ -0,0 +1,197 @@
+package atomicstate
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+)
+
+const (
+	// CurrentTokenVersion is the token version accepted by the create ops
+	CurrentTokenVersion = currentTokenVersion
+
+	// CurrentLiquidityCurveVersion is the curve version accepted by CreateLiquidityAssetOp
+	CurrentLiquidityCurveVersion = currentLiquidityCurveVersion
+)
+
+// Uint128FromBig converts a big.Int to a Uint128. It returns false if the value
+// is negative or does not fit in 128 bits.
+func Uint128FromBig(value *big.Int) (Uint128, bool) {
+	return uint128FromBig(value)
+}
+
+// EncodePayload serializes the given payload into the CAT wire format. The
+// result is validated with ParsePayload, so any payload returned by this
+// function is accepted by consensus shape validation.
+func EncodePayload(payload *ParsedPayload) ([]byte, error) {
+	if payload == nil || payload.Op == nil {
+		return nil, fmt.Errorf("missing CAT op")
+	}
+	if err := validateEncodedFieldLengths(payload.Op); err != nil {
+		return nil, err
+	}
+
+	var opcode byte
+	var body []byte
+	switch op := payload.Op.(type) {
+	case CreateAssetOp:
+		opcode = 0
+		body = encodeCreateAssetCommon(op.TokenVersion, op.Decimals, op.SupplyMode, op.MaxSupply,
+			op.MintAuthorityOwnerID, op.Name, op.Symbol, op.Metadata)
+		body = appendOptionalPlatformTag(body, op.PlatformTag)
+	case TransferOp:
+		opcode = 1
+		body = append(body, op.AssetID[:]...)
+		body = append(body, op.ToOwnerID[:]...)
+		body = appendUint128LE(body, op.Amount)
+	case MintOp:
+		opcode = 2
+		body = append(body, op.AssetID[:]...)
+		body = append(body, op.ToOwnerID[:]...)
+		body = appendUint128LE(body, op.Amount)
+	case BurnOp:
+		opcode = 3
+		body = append(body, op.AssetID[:]...)
+		body = appendUint128LE(body, op.Amount)
+	case CreateAssetWithMintOp:
+		opcode = 4
+		body = encodeCreateAssetCommon(op.TokenVersion, op.Decimals, op.SupplyMode, op.MaxSupply,
+			op.MintAuthorityOwnerID, op.Name, op.Symbol, op.Metadata)
+		body = appendUint128LE(body, op.InitialMintAmount)
+		body = append(body, op.InitialMintToOwnerID[:]...)
+		body = appendOptionalPlatformTag(body, op.PlatformTag)
+	case CreateLiquidityAssetOp:
+		opcode = 5
+		body = encodeCreateLiquidityAsset(op)
+	case BuyLiquidityExactInOp:
+		opcode = 6
+		body = append(body, op.AssetID[:]...)
+		body = binary.LittleEndian.AppendUint64(body, op.ExpectedPoolNonce)
+		body = binary.LittleEndian.AppendUint64(body, op.CPayInSompi)
+		body = appendUint128LE(body, op.MinTokenOut)
+	case SellLiquidityExactInOp:
+		opcode = 7
+		body = append(body, op.AssetID[:]...)
+		body = binary.LittleEndian.AppendUint64(body, op.ExpectedPoolNonce)
+		body = appendUint128LE(body, op.TokenIn)
+		body = binary.LittleEndian.AppendUint64(body, op.MinCPayOutSompi)
+		body = binary.LittleEndian.AppendUint16(body, op.CPayReceiveOutputIndex)
+	case ClaimLiquidityFeesOp:
+		opcode = 8
+		body = append(body, op.AssetID[:]...)
+		body = binary.LittleEndian.AppendUint64(body, op.ExpectedPoolNonce)
+		body = append(body, op.RecipientIndex)
+		body = binary.LittleEndian.AppendUint64(body, op.ClaimAmountSompi)
+		body = binary.LittleEndian.AppendUint16(body, op.ClaimReceiveOutputIndex)
+	default:
+		return nil, fmt.Errorf("unsupported CAT op type %T", payload.Op)
+	}
+
+	encoded := make([]byte, 0, len(catMagic)+13+len(body))
+	encoded = append(encoded, catMagic...)
+	encoded = append(encoded, catVersion, opcode, 0)
+	encoded = binary.LittleEndian.AppendUint16(encoded, payload.AuthInputIndex)
+	encoded = binary.LittleEndian.AppendUint64(encoded, payload.Nonce)
+	encoded = append(encoded, body...)
+
+	if _, err := ParsePayload(encoded); err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
+
+// validateEncodedFieldLengths makes sure that variable length fields fit their
+// length prefixes, so that they can't wrap around and encode a different payload
+func validateEncodedFieldLengths(op PayloadOp) error {
+	var name, symbol, metadata, platformTag []byte
+	switch op := op.(type) {
+	case CreateAssetOp:
+		name, symbol, metadata, platformTag = op.Name, op.Symbol, op.Metadata, op.PlatformTag
+	case CreateAssetWithMintOp:
+		name, symbol, metadata, platformTag = op.Name, op.Symbol, op.Metadata, op.PlatformTag
+	case CreateLiquidityAssetOp:
+		name, symbol, metadata, platformTag = op.Name, op.Symbol, op.Metadata, op.PlatformTag
+		if len(op.Recipients) > maxLiquidityFeeRecipients {
+			return fmt.Errorf("recipient_count above max `%d`", maxLiquidityFeeRecipients)
+		}
+	default:
+		return nil
+	}
+	if len(name) > catMaxNameLen {
+		return fmt.Errorf("name length exceeds max `%d`", catMaxNameLen)
+	}
+	if len(symbol) > catMaxSymbolLen {
+		return fmt.Errorf("symbol length exceeds max `%d`", catMaxSymbolLen)
+	}
+	if len(metadata) > catMaxMetadataLen {
+		return fmt.Errorf("metadata length exceeds max `%d`", catMaxMetadataLen)
+	}
+	if len(platformTag) > catMaxPlatformTagLen {
+		return fmt.Errorf("platform tag length exceeds max `%d`", catMaxPlatformTagLen)
+	}
+	return nil
+}
+
+func encodeCreateAssetCommon(tokenVersion byte, decimals byte, supplyMode PayloadSupplyMode, maxSupply Uint128,
+	mintAuthorityOwnerID [externalapi.DomainHashSize]byte, name, symbol, metadata []byte) []byte {
+
+	body := []byte{tokenVersion, decimals, byte(supplyMode)}
+	body = appendUint128LE(body, maxSupply)
+	body = append(body, mintAuthorityOwnerID[:]...)
+	return appendStringFields(body, name, symbol, metadata)
+}
+
+func encodeCreateLiquidityAsset(op CreateLiquidityAssetOp) []byte {
+	body := []byte{op.TokenVersion, op.CurveVersion, op.Decimals}
+	body = appendUint128LE(body, op.MaxSupply)
+	body = appendStringFields(body, op.Name, op.Symbol, op.Metadata)
+	body = binary.LittleEndian.AppendUint64(body, op.SeedReserveSompi)
+	body = binary.LittleEndian.AppendUint16(body, op.FeeBPS)
+	body = append(body, byte(len(op.Recipients)))
+	for _, recipient := range op.Recipients {
+		body = append(body, recipient.AddressVersion)
+		body = append(body, recipient.AddressPayload...)
+	}
+	body = binary.LittleEndian.AppendUint64(body, op.LaunchBuySompi)
+	body = appendUint128LE(body, op.LaunchBuyMinTokenOut)
+
+	hasCurveMode := op.CurveMode != defaultLiquidityCurveMode
+	if len(op.PlatformTag) == 0 && op.UnlockTargetSompi == 0 && !hasCurveMode {
+		return body
+	}
+	body = append(body, byte(len(op.PlatformTag)))
+	body = append(body, op.PlatformTag...)
+	body = binary.LittleEndian.AppendUint64(body, op.UnlockTargetSompi)
+	if !hasCurveMode {
+		return body
+	}
+	body = append(body, op.CurveMode)
+	if op.CurveMode == liquidityCurveModeIndividual {
+		body = binary.LittleEndian.AppendUint64(body, op.IndividualVirtualCPayReservesSompi)
+		body = binary.LittleEndian.AppendUint16(body, op.IndividualVirtualTokenMultiplierBPS)
+	}
+	return body
+}
+
+func appendStringFields(body []byte, name, symbol, metadata []byte) []byte {
+	body = append(body, byte(len(name)), byte(len(symbol)))
+	body = binary.LittleEndian.AppendUint16(body, uint16(len(metadata)))
+	body = append(body, name...)
+	body = append(body, symbol...)
+	return append(body, metadata...)
+}
+
+func appendOptionalPlatformTag(body []byte, platformTag []byte) []byte {
+	if len(platformTag) == 0 {
+		return body
+	}
+	body = append(body, byte(len(platformTag)))
+	return append(body, platformTag...)
+}
+
+func appendUint128LE(body []byte, value Uint128) []byte {
+	bytes := value.ToLE()
+	return append(body, bytes[:]...)
+}
@@ -0,0 +1,92 @@
+package atomicstate
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestEncodePayloadRoundTrip(t *testing.T) {
+	recipientA := PayloadRecipientAddress{AddressVersion: 0, AddressPayload: bytes.Repeat([]byte{0x01}, 32)}
+	recipientB := PayloadRecipientAddress{AddressVersion: 8, AddressPayload: bytes.Repeat([]byte{0x02}, 32)}
+
+	ops := []PayloadOp{
+		CreateAssetOp{
+			TokenVersion:         CurrentTokenVersion,
+			Decimals:             8,
+			SupplyMode:           PayloadSupplyModeCapped,
+			MaxSupply:            Uint128FromUint64(1_000_000),
+			MintAuthorityOwnerID: bytes32(0x11),
+			Name:                 []byte("Token"),
+			Symbol:               []byte("TKN"),
+			Metadata:             []byte{0xde, 0xad},
+			PlatformTag:          []byte("wallet"),
+		},
+		TransferOp{AssetID: bytes32(0x21), ToOwnerID: bytes32(0x22), Amount: Uint128{Lo: 5, Hi: 1}},
+		MintOp{AssetID: bytes32(0x31), ToOwnerID: bytes32(0x32), Amount: Uint128FromUint64(7)},
+		BurnOp{AssetID: bytes32(0x41), Amount: Uint128FromUint64(9)},
+		CreateAssetWithMintOp{
+			TokenVersion:         CurrentTokenVersion,
+			SupplyMode:           PayloadSupplyModeUncapped,
+			MintAuthorityOwnerID: bytes32(0x51),
+			Name:                 []byte("Minted"),
+			Symbol:               []byte("MNT"),
+			InitialMintAmount:    Uint128FromUint64(100),
+			InitialMintToOwnerID: bytes32(0x52),
+		},
+		CreateLiquidityAssetOp{
+			TokenVersion:                        CurrentTokenVersion,
+			CurveVersion:                        CurrentLiquidityCurveVersion,
+			CurveMode:                           liquidityCurveModeIndividual,
+			IndividualVirtualCPayReservesSompi:  individualMinVirtualCPay,
+			IndividualVirtualTokenMultiplierBPS: individualMinMultiplierBPS,
+			MaxSupply:                           Uint128FromUint64(liquidityTokenSupplyRaw),
+			Name:                                []byte("Curve"),
+			Symbol:                              []byte("CRV"),
+			SeedReserveSompi:                    minLiquiditySeedReserve,
+			FeeBPS:                              minLiquidityFeeBPS,
+			Recipients:                          []PayloadRecipientAddress{recipientA, recipientB},
+			PlatformTag:                         []byte("pad"),
+		},
+		BuyLiquidityExactInOp{AssetID: bytes32(0x61), ExpectedPoolNonce: 3, CPayInSompi: 1000, MinTokenOut: Uint128FromUint64(10)},
+		SellLiquidityExactInOp{AssetID: bytes32(0x71), ExpectedPoolNonce: 4, TokenIn: Uint128FromUint64(10),
+			MinCPayOutSompi: 900, CPayReceiveOutputIndex: 1},
+		ClaimLiquidityFeesOp{AssetID: bytes32(0x81), ExpectedPoolNonce: 5, RecipientIndex: 1,
+			ClaimAmountSompi: 50, ClaimReceiveOutputIndex: 2},
+	}
+
+	for _, op := range ops {
+		payload := &ParsedPayload{AuthInputIndex: 1, Nonce: 42, Op: op}
+		encoded, err := EncodePayload(payload)
+		if err != nil {
+			t.Fatalf("EncodePayload(%T): %s", op, err)
+		}
+		parsed, err := ParsePayload(encoded)
+		if err != nil {
+			t.Fatalf("ParsePayload(%T): %s", op, err)
+		}
+		if !reflect.DeepEqual(parsed, payload) {
+			t.Fatalf("%T round trip mismatch: got %+v, want %+v", op, parsed, payload)
+		}
+	}
+}
+
+func TestEncodePayloadRejectsInvalidPayloads(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload *ParsedPayload
+	}{
+		{"missing op", &ParsedPayload{Nonce: 1}},
+		{"zero nonce", &ParsedPayload{Op: BurnOp{Amount: Uint128FromUint64(1)}}},
+		{"zero amount", &ParsedPayload{Nonce: 1, Op: TransferOp{}}},
+		{"name too long", &ParsedPayload{Nonce: 1, Op: CreateAssetOp{
+			TokenVersion: CurrentTokenVersion,
+			Name:         bytes.Repeat([]byte{'a'}, 256+catMaxNameLen),
+		}}},
+	}
+	for _, test := range tests {
+		if _, err := EncodePayload(test.payload); err == nil {
+			t.Fatalf("%s: expected an error", test.name)
+		}
+	}
+}
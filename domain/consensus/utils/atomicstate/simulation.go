@@ -0,0 +1,109 @@
+package atomicstate
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+)
+
+// SimulatedBalanceChange is the change of a single balance caused by a simulated transaction
+type SimulatedBalanceChange struct {
+	Key    BalanceKey
+	Before Uint128
+	After  Uint128
+}
+
+// SimulatedNonceChange is the change of a single nonce scope caused by a simulated transaction
+type SimulatedNonceChange struct {
+	Key    NonceKey
+	Before uint64
+	After  uint64
+}
+
+// SimulatedPoolChange is the change of a single liquidity pool caused by a
+// simulated transaction. Before is nil for pools created by the transaction.
+type SimulatedPoolChange struct {
+	AssetID [externalapi.DomainHashSize]byte
+	Before  *LiquidityPoolState
+	After   *LiquidityPoolState
+}
+
+// Simulation is the outcome of applying a transaction to a clone of an Atomic state
+type Simulation struct {
+	Growth         StateGrowth
+	BalanceChanges []SimulatedBalanceChange
+	NonceChanges   []SimulatedNonceChange
+	PoolChanges    []SimulatedPoolChange
+}
+
+// SimulateTransaction applies tx to a clone of state the same way the block
+// builder does, enforcing the state-growth limits of a block that contains
+// only tx, and returns the resulting changes. state itself is not modified.
+func SimulateTransaction(tx *externalapi.DomainTransaction, povDAAScore uint64,
+	payloadHFActivationDAAScore uint64, state *State, limits StateGrowthLimits) (*Simulation, error) {
+
+	simulatedState := state.Clone()
+	growth := &BlockStateGrowth{}
+	err := ValidateAndApplyTransactionWithGrowth(tx, povDAAScore, payloadHFActivationDAAScore,
+		simulatedState, growth, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := DiffStates(state, simulatedState)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := &Simulation{Growth: growth.Used()}
+
+	balanceKeys := make([]BalanceKey, 0, len(diff.BalancesToSet)+len(diff.BalancesToRemove))
+	for key := range diff.BalancesToSet {
+		balanceKeys = append(balanceKeys, key)
+	}
+	for key := range diff.BalancesToRemove {
+		balanceKeys = append(balanceKeys, key)
+	}
+	sortBalanceKeys(balanceKeys)
+	for _, key := range balanceKeys {
+		simulation.BalanceChanges = append(simulation.BalanceChanges, SimulatedBalanceChange{
+			Key:    key,
+			Before: state.Balances[key],
+			After:  simulatedState.Balances[key],
+		})
+	}
+
+	nonceKeys := make([]NonceKey, 0, len(diff.NoncesToSet))
+	for key := range diff.NoncesToSet {
+		nonceKeys = append(nonceKeys, key)
+	}
+	sortNonceKeys(nonceKeys)
+	for _, key := range nonceKeys {
+		simulation.NonceChanges = append(simulation.NonceChanges, SimulatedNonceChange{
+			Key:    key,
+			Before: state.NextNonces[key],
+			After:  simulatedState.NextNonces[key],
+		})
+	}
+
+	assetIDs := make([][externalapi.DomainHashSize]byte, 0, len(diff.AssetsToSet))
+	for assetID := range diff.AssetsToSet {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sortHashKeys(assetIDs)
+	for _, assetID := range assetIDs {
+		after := simulatedState.Assets[assetID].Liquidity
+		if after == nil {
+			continue
+		}
+		var before *LiquidityPoolState
+		if asset, ok := state.Assets[assetID]; ok {
+			before = asset.Liquidity
+		}
+		simulation.PoolChanges = append(simulation.PoolChanges, SimulatedPoolChange{
+			AssetID: assetID,
+			Before:  before,
+			After:   after,
+		})
+	}
+
+	return simulation, nil
+}
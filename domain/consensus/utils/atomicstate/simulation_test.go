@@ -0,0 +1,78 @@
+package atomicstate
+
+import (
+	"testing"
+)
+
+var testSimulationLimits = StateGrowthLimits{
+	MaxNewAssets:          1,
+	MaxNewBalanceKeys:     4,
+	MaxNewNonceKeys:       1,
+	MaxNewPools:           1,
+	MaxNewAnchorOwnerKeys: 4,
+}
+
+func TestSimulateTransfer(t *testing.T) {
+	ownerScript := testOwnerScript(0xF1)
+	ownerID := mustOwnerIDFromScript(t, ownerScript)
+	assetID := bytes32(0xF2)
+	receiverID := bytes32(0xF3)
+	state := testTransferState(ownerID, assetID, 10)
+	stateHash := state.CanonicalHash()
+
+	tx := testTransferTx(ownerScript, 0xF4, testTransferPayload(1, assetID, receiverID, Uint128FromUint64(4)))
+	simulation, err := SimulateTransaction(tx, 1, 0, state, testSimulationLimits)
+	if err != nil {
+		t.Fatalf("SimulateTransaction: %s", err)
+	}
+	if state.CanonicalHash() != stateHash {
+		t.Fatalf("SimulateTransaction modified the given state")
+	}
+
+	if simulation.Growth.NewBalanceKeys != 1 || simulation.Growth.NewNonceKeys != 1 {
+		t.Fatalf("unexpected growth %+v", simulation.Growth)
+	}
+	if len(simulation.BalanceChanges) != 2 {
+		t.Fatalf("expected 2 balance changes, got %+v", simulation.BalanceChanges)
+	}
+	for _, change := range simulation.BalanceChanges {
+		switch change.Key.OwnerID {
+		case ownerID:
+			if change.Before.Compare(Uint128FromUint64(10)) != 0 || change.After.Compare(Uint128FromUint64(6)) != 0 {
+				t.Fatalf("unexpected sender balance change %+v", change)
+			}
+		case receiverID:
+			if !change.Before.IsZero() || change.After.Compare(Uint128FromUint64(4)) != 0 {
+				t.Fatalf("unexpected receiver balance change %+v", change)
+			}
+		default:
+			t.Fatalf("unexpected balance change %+v", change)
+		}
+	}
+	if len(simulation.NonceChanges) != 1 || simulation.NonceChanges[0].Key != AssetNonceKey(ownerID, assetID) ||
+		simulation.NonceChanges[0].After != 2 {
+		t.Fatalf("unexpected nonce changes %+v", simulation.NonceChanges)
+	}
+	if len(simulation.PoolChanges) != 0 {
+		t.Fatalf("unexpected pool changes %+v", simulation.PoolChanges)
+	}
+}
+
+func TestSimulateRejectedTransfer(t *testing.T) {
+	ownerScript := testOwnerScript(0xF5)
+	ownerID := mustOwnerIDFromScript(t, ownerScript)
+	assetID := bytes32(0xF6)
+	state := testTransferState(ownerID, assetID, 1)
+
+	tx := testTransferTx(ownerScript, 0xF7, testTransferPayload(1, assetID, bytes32(0xF8), Uint128FromUint64(4)))
+	_, err := SimulateTransaction(tx, 1, 0, state, testSimulationLimits)
+	if err == nil {
+		t.Fatalf("expected an overdrawn transfer to be rejected")
+	}
+
+	wrongNonce := testTransferTx(ownerScript, 0xF9, testTransferPayload(5, assetID, bytes32(0xF8), Uint128FromUint64(1)))
+	_, err = SimulateTransaction(wrongNonce, 1, 0, state, testSimulationLimits)
+	if err == nil {
+		t.Fatalf("expected a transfer with a wrong nonce to be rejected")
+	}
+}
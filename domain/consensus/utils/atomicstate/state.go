@@ -173,6 +173,16 @@ func (s *State) IsRootOnly() bool {
 	return s != nil && s.rootHashOverride != nil
 }
 
+// ExpectedNonce returns the nonce the next payload operation in the given scope
+// must carry. Scopes that have never been used start at 1.
+func (s *State) ExpectedNonce(key NonceKey) uint64 {
+	expectedNonce := s.NextNonces[key]
+	if expectedNonce == 0 {
+		expectedNonce = 1
+	}
+	return expectedNonce
+}
+
 func (s *State) Clone() *State {
 	if s == nil {
 		return NewState()
@@ -635,8 +645,17 @@ func HeaderCommitment(utxoCommitment *externalapi.DomainHash, atomicStateHash [e
 	return externalapi.NewDomainHashFromByteArray(&out)
 }
 
-func (s *State) HeaderCommitment(utxoCommitment *externalapi.DomainHash, payloadHFActive bool) *externalapi.DomainHash {
-	return HeaderCommitment(utxoCommitment, s.CanonicalHash(), payloadHFActive)
+func (s *State) HeaderCommitment(utxoCommitment *externalapi.DomainHash, payloadHFActive bool, merkleRootHFActive bool) (
+	*externalapi.DomainHash, error) {
+
+	if !payloadHFActive {
+		return utxoCommitment, nil
+	}
+	atomicStateHash, err := s.CommitmentHash(merkleRootHFActive)
+	if err != nil {
+		return nil, err
+	}
+	return HeaderCommitment(utxoCommitment, atomicStateHash, payloadHFActive), nil
 }
 
 func FromCanonicalBytes(stateBytes []byte) (*State, error) {
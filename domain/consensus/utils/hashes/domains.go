@@ -17,6 +17,7 @@ const (
 	proofOfWorkDomain             = "ProofOfWorkHash"
 	heavyHashDomain               = "HeavyHash"
 	merkleBranchDomain            = "MerkleBranchHash"
+	fastIntentIDDomain            = "FastIntentID"
 )
 
 // transactionSigningECDSADomainHash is a hashed version of transcationSigningECDSADomain that is used
@@ -87,3 +88,12 @@ func NewMerkleBranchHashWriter() HashWriter {
 	}
 	return HashWriter{blake}
 }
+
+// NewFastIntentIDWriter Returns a new HashWriter used for fast intent IDs
+func NewFastIntentIDWriter() HashWriter {
+	blake, err := blake2b.New256([]byte(fastIntentIDDomain))
+	if err != nil {
+		panic(errors.Wrapf(err, "this should never happen. %s is less than 64 bytes", fastIntentIDDomain))
+	}
+	return HashWriter{blake}
+}
@@ -1,6 +1,7 @@
 package dagconfig
 
 import (
+	"math"
 	"time"
 
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/constants"
@@ -36,6 +37,8 @@ const (
 	defaultAtomicMaxNewNonceKeysPerBlock       = 4096
 	defaultAtomicMaxNewPoolsPerBlock           = 64
 	defaultAtomicMaxNewAnchorOwnerKeysPerBlock = 8192
+	// defaultAtomicMerkleRootHfActivationDAAScore leaves the Atomic Merkle root hardfork unscheduled.
+	defaultAtomicMerkleRootHfActivationDAAScore = math.MaxUint64
 	// defaultMaxBlockMass is a bound on the mass of a block, larger values increase the bound d
 	// on the round trip time of a block, which affects the other parameters as described below
 	defaultMaxBlockMass = 500_000
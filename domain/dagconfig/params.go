@@ -188,6 +188,10 @@ type Params struct {
 	// PayloadHfActivationDAAScore is the DAA score after which payload-subnetwork transactions are accepted.
 	PayloadHfActivationDAAScore uint64
 
+	// AtomicMerkleRootHfActivationDAAScore is the DAA score after which block UTXO commitments
+	// commit to the Merkle root of the Atomic state rather than to its accumulator root.
+	AtomicMerkleRootHfActivationDAAScore uint64
+
 	// PayloadMaxLengthConsensus is the consensus hard cap for non-coinbase payload length.
 	PayloadMaxLengthConsensus uint64
 
@@ -294,6 +298,7 @@ var MainnetParams = Params{
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
 	PayloadHfActivationDAAScore:             33739200,
+	AtomicMerkleRootHfActivationDAAScore:    defaultAtomicMerkleRootHfActivationDAAScore,
 	PayloadMaxLengthConsensus:               defaultPayloadMaxLengthConsensus,
 	PayloadMaxLengthStandard:                defaultPayloadMaxLengthStandard,
 	AtomicMaxNewAssetsPerBlock:              defaultAtomicMaxNewAssetsPerBlock,
@@ -378,6 +383,7 @@ var TestnetParams = Params{
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
 	PayloadHfActivationDAAScore:             1111,
+	AtomicMerkleRootHfActivationDAAScore:    defaultAtomicMerkleRootHfActivationDAAScore,
 	PayloadMaxLengthConsensus:               defaultPayloadMaxLengthConsensus,
 	PayloadMaxLengthStandard:                defaultPayloadMaxLengthStandard,
 	AtomicMaxNewAssetsPerBlock:              defaultAtomicMaxNewAssetsPerBlock,
@@ -453,6 +459,7 @@ var SimnetParams = Params{
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
 	PayloadHfActivationDAAScore:             1111,
+	AtomicMerkleRootHfActivationDAAScore:    defaultAtomicMerkleRootHfActivationDAAScore,
 	PayloadMaxLengthConsensus:               defaultPayloadMaxLengthConsensus,
 	PayloadMaxLengthStandard:                defaultPayloadMaxLengthStandard,
 	AtomicMaxNewAssetsPerBlock:              defaultAtomicMaxNewAssetsPerBlock,
@@ -524,6 +531,7 @@ var DevnetParams = Params{
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
 	PayloadHfActivationDAAScore:             1111,
+	AtomicMerkleRootHfActivationDAAScore:    defaultAtomicMerkleRootHfActivationDAAScore,
 	PayloadMaxLengthConsensus:               defaultPayloadMaxLengthConsensus,
 	PayloadMaxLengthStandard:                defaultPayloadMaxLengthStandard,
 	AtomicMaxNewAssetsPerBlock:              defaultAtomicMaxNewAssetsPerBlock,
@@ -29,6 +29,10 @@ type candidateTx struct {
 	txValue  float64
 	gasLimit uint64
 
+	// packageFeeRate is the fee rate of the best package this transaction is an ancestor of,
+	// or zero if no package pays more than the transaction itself
+	packageFeeRate float64
+
 	p     float64
 	start float64
 	end   float64
@@ -180,9 +184,11 @@ func (btb *blockTemplateBuilder) buildBlockTemplateOnce(
 ) {
 
 	mempoolTransactions := btb.mempool.BlockCandidateTransactions()
+	packageFeeRates := btb.mempool.BlockCandidatePackageFeeRates()
 	candidateTxs := make([]*candidateTx, 0, len(mempoolTransactions))
 	for _, tx := range mempoolTransactions {
-		if _, excluded := excludedTxIDs[*consensushashing.TransactionID(tx)]; excluded {
+		txID := consensushashing.TransactionID(tx)
+		if _, excluded := excludedTxIDs[*txID]; excluded {
 			continue
 		}
 		// Calculate the tx value
@@ -191,10 +197,12 @@ func (btb *blockTemplateBuilder) buildBlockTemplateOnce(
 			log.Warnf("Skipping transaction from unsupported subnetwork %s while building a block template", tx.SubnetworkID)
 			continue
 		}
+		packageFeeRate := packageFeeRates[*txID]
 		candidateTxs = append(candidateTxs, &candidateTx{
 			DomainTransaction: tx,
-			txValue:           btb.calcTxValue(tx),
+			txValue:           btb.calcTxValue(tx, packageFeeRate),
 			gasLimit:          gasLimit,
+			packageFeeRate:    packageFeeRate,
 		})
 	}
 	candidatePayloadTxs := countPayloadCandidateTransactions(candidateTxs)
@@ -428,15 +436,17 @@ func (btb *blockTemplateBuilder) ModifyBlockTemplate(newCoinbaseData *consensuse
 // calcTxValue calculates a value to be used in transaction selection.
 // The higher the number the more likely it is that the transaction will be
 // included in the block.
-func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction) float64 {
+// A transaction whose descendants pay for it is valued as if it paid the package
+// fee rate, so that a high-fee child can pull in its low-fee parents.
+func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction, packageFeeRate float64) float64 {
 	massLimit := btb.policy.BlockMaxMass
 
 	mass := tx.Mass
-	fee := tx.Fee
+	fee := max(float64(tx.Fee), packageFeeRate*float64(mass))
 	if subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
-		return float64(fee) / (float64(mass) / float64(massLimit))
+		return fee / (float64(mass) / float64(massLimit))
 	}
 	// TODO: Replace with real gas once implemented
 	gasLimit := uint64(math.MaxUint64)
-	return float64(fee) / (float64(mass)/float64(massLimit) + float64(tx.Gas)/float64(gasLimit))
+	return fee / (float64(mass)/float64(massLimit) + float64(tx.Gas)/float64(gasLimit))
 }
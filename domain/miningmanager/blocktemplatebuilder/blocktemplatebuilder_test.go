@@ -89,6 +89,41 @@ func TestOrderAtomicCandidateTransactionsPlacesCreateBeforeReference(t *testing.
 	assertCandidateOrder(t, candidates, create, transfer)
 }
 
+func TestCalcTxValueUsesPackageFeeRate(t *testing.T) {
+	btb := &blockTemplateBuilder{policy: policy{BlockMaxMass: 500_000}}
+	tx := testTemplateTx(1)
+	tx.Fee = 1
+	tx.Mass = 1000
+
+	ownValue := btb.calcTxValue(tx, 0)
+	if boostedValue := btb.calcTxValue(tx, 50); boostedValue != ownValue*50_000 {
+		t.Fatalf("expected the package fee rate to boost the transaction value to %f, got %f",
+			ownValue*50_000, boostedValue)
+	}
+	if lowerValue := btb.calcTxValue(tx, 0.0001); lowerValue != ownValue {
+		t.Fatalf("expected a package fee rate below the own fee rate to be ignored, got %f", lowerValue)
+	}
+}
+
+func TestPayloadPolicyAllowsSelectionUsesPackageFeeRate(t *testing.T) {
+	btb := &blockTemplateBuilder{policy: policy{
+		PayloadSoftCapPerBlockBytes:     1,
+		PayloadOvercapFeerateMultiplier: 2,
+		MinimumRelayFeerate:             1,
+	}}
+	candidate := &candidateTx{DomainTransaction: testPayloadTemplateTx(1)}
+	candidate.Fee = 1
+	candidate.Mass = 1000
+
+	if btb.payloadPolicyAllowsSelection(candidate, 1) {
+		t.Fatalf("expected the low-fee payload transaction to be rejected over the soft cap")
+	}
+	candidate.packageFeeRate = 2
+	if !btb.payloadPolicyAllowsSelection(candidate, 1) {
+		t.Fatalf("expected the payload transaction to be selected over the soft cap by its package fee rate")
+	}
+}
+
 func testRuleError(t *testing.T, err error) *ruleerrors.RuleError {
 	t.Helper()
 
@@ -188,7 +188,7 @@ func (btb *blockTemplateBuilder) payloadPolicyAllowsSelection(candidate *candida
 		return false
 	}
 
-	feerate := float64(candidate.Fee) / float64(candidate.Mass)
+	feerate := max(float64(candidate.Fee)/float64(candidate.Mass), candidate.packageFeeRate)
 	return feerate >= btb.policy.payloadOvercapFeerateFloor()
 }
 
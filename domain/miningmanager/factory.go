@@ -33,8 +33,10 @@ func (f *factory) NewMiningManager(consensusReference consensusreference.Consens
 		consensusReference:   consensusReference,
 		mempool:              mempool,
 		blockTemplateBuilder: blockTemplateBuilder,
-		cachingTime:          time.Time{},
-		cacheLock:            &sync.Mutex{},
+		feeEstimator: newFeeEstimator(params.MaxBlockMass, params.TargetTimePerBlock,
+			float64(mempoolConfig.MinimumRelayTransactionFee)/1000),
+		cachingTime: time.Time{},
+		cacheLock:   &sync.Mutex{},
 	}
 }
 
@@ -0,0 +1,202 @@
+package miningmanager
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/transactionhelper"
+	miningmanagermodel "github.com/cryptix-network/cryptixd/domain/miningmanager/model"
+)
+
+const (
+	// feeRateCutoffMargin is applied on top of the fee rate of the first
+	// transaction that doesn't fit into a target, so that a transaction paying
+	// the estimated fee rate outbids it rather than ties with it
+	feeRateCutoffMargin = 1.05
+
+	// saturatedTemplateMassRatio is the fraction of the block mass limit above
+	// which a block template is considered full
+	saturatedTemplateMassRatio = 0.9
+
+	// templateObservationValidityBlocks is the number of block intervals for
+	// which an observed block template is still considered recent
+	templateObservationValidityBlocks = 10
+)
+
+var (
+	// normalBucketTargets are the inclusion times normal buckets aim for. The
+	// first target must be below one minute
+	normalBucketTargets = []time.Duration{30 * time.Second, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute}
+
+	// lowBucketTargets are the inclusion times low buckets aim for. The first
+	// target must be below one hour
+	lowBucketTargets = []time.Duration{30 * time.Minute, 2 * time.Hour}
+)
+
+// FeeRateBucket is a fee rate, in sompi/gram, along with the estimated time it
+// takes a transaction paying that fee rate to be included in the DAG
+type FeeRateBucket struct {
+	FeeRate          float64
+	EstimatedSeconds float64
+}
+
+// FeeEstimate is a set of fee rate buckets ordered from the fastest to the
+// slowest estimated inclusion time
+type FeeEstimate struct {
+	PriorityBucket FeeRateBucket
+	NormalBuckets  []FeeRateBucket
+	LowBuckets     []FeeRateBucket
+}
+
+// feeEstimator estimates the fee rates required for timely inclusion given
+// the fee rate distribution of the mempool and the recently built block
+// templates
+type feeEstimator struct {
+	blockMassLimit     uint64
+	targetTimePerBlock time.Duration
+	minimumFeeRate     float64
+
+	lock                    sync.Mutex
+	lastTemplateMinFeeRate  float64
+	lastTemplateIsSaturated bool
+	lastTemplateTime        time.Time
+}
+
+func newFeeEstimator(blockMassLimit uint64, targetTimePerBlock time.Duration, minimumFeeRate float64) *feeEstimator {
+	return &feeEstimator{
+		blockMassLimit:     blockMassLimit,
+		targetTimePerBlock: targetTimePerBlock,
+		minimumFeeRate:     minimumFeeRate,
+	}
+}
+
+// observeBlockTemplate records the lowest fee rate included in the given block
+// template, and whether the template was filled up to the mass limit
+func (fe *feeEstimator) observeBlockTemplate(block *externalapi.DomainBlock) {
+	minFeeRate := 0.0
+	totalMass := uint64(0)
+	for _, transaction := range block.Transactions {
+		if transactionhelper.IsCoinBase(transaction) || transaction.Mass == 0 {
+			continue
+		}
+		totalMass += transaction.Mass
+		feeRate := float64(transaction.Fee) / float64(transaction.Mass)
+		if minFeeRate == 0 || feeRate < minFeeRate {
+			minFeeRate = feeRate
+		}
+	}
+
+	fe.lock.Lock()
+	defer fe.lock.Unlock()
+
+	fe.lastTemplateMinFeeRate = minFeeRate
+	fe.lastTemplateIsSaturated = float64(totalMass) >= saturatedTemplateMassRatio*float64(fe.blockMassLimit)
+	fe.lastTemplateTime = time.Now()
+}
+
+// recentTemplateFeeRateFloor returns the lowest fee rate included in the last
+// block template, if that template was recent and saturated. Otherwise it
+// returns 0
+func (fe *feeEstimator) recentTemplateFeeRateFloor() float64 {
+	fe.lock.Lock()
+	defer fe.lock.Unlock()
+
+	if !fe.lastTemplateIsSaturated {
+		return 0
+	}
+	if time.Since(fe.lastTemplateTime) > templateObservationValidityBlocks*fe.targetTimePerBlock {
+		return 0
+	}
+	return fe.lastTemplateMinFeeRate
+}
+
+// estimate builds a FeeEstimate out of the fee rates of the transactions
+// currently in the mempool
+func (fe *feeEstimator) estimate(feeRates []miningmanagermodel.TransactionFeeRate) FeeEstimate {
+	sortedFeeRates := make([]miningmanagermodel.TransactionFeeRate, len(feeRates))
+	copy(sortedFeeRates, feeRates)
+	sort.Slice(sortedFeeRates, func(i, j int) bool {
+		return sortedFeeRates[i].FeeRate > sortedFeeRates[j].FeeRate
+	})
+
+	priorityFeeRate := fe.feeRateForBlocks(sortedFeeRates, 1)
+	templateFloor := fe.recentTemplateFeeRateFloor() * feeRateCutoffMargin
+	if templateFloor > priorityFeeRate {
+		priorityFeeRate = templateFloor
+	}
+
+	estimate := FeeEstimate{
+		PriorityBucket: FeeRateBucket{
+			FeeRate:          priorityFeeRate,
+			EstimatedSeconds: fe.targetTimePerBlock.Seconds(),
+		},
+	}
+	estimate.NormalBuckets = fe.buckets(sortedFeeRates, normalBucketTargets, priorityFeeRate)
+	estimate.LowBuckets = fe.buckets(sortedFeeRates, lowBucketTargets,
+		estimate.NormalBuckets[len(estimate.NormalBuckets)-1].FeeRate)
+
+	return estimate
+}
+
+// buckets returns a bucket per target inclusion time. Buckets that would not
+// lower the fee rate of the bucket preceding them are dropped, except for the
+// first one, which is always returned
+func (fe *feeEstimator) buckets(sortedFeeRates []miningmanagermodel.TransactionFeeRate,
+	targets []time.Duration, previousFeeRate float64) []FeeRateBucket {
+
+	buckets := make([]FeeRateBucket, 0, len(targets))
+	for i, target := range targets {
+		blocks := uint64(target / fe.targetTimePerBlock)
+		if blocks == 0 {
+			blocks = 1
+		}
+		feeRate := fe.feeRateForBlocks(sortedFeeRates, blocks)
+		if feeRate > previousFeeRate {
+			feeRate = previousFeeRate
+		}
+		if i > 0 && feeRate >= previousFeeRate {
+			continue
+		}
+		buckets = append(buckets, FeeRateBucket{
+			FeeRate:          feeRate,
+			EstimatedSeconds: fe.estimatedSeconds(sortedFeeRates, feeRate),
+		})
+		previousFeeRate = feeRate
+	}
+	return buckets
+}
+
+// feeRateForBlocks returns the fee rate required for a transaction to be
+// included within the given amount of blocks, assuming the mempool is
+// drained in fee rate order
+func (fe *feeEstimator) feeRateForBlocks(sortedFeeRates []miningmanagermodel.TransactionFeeRate, blocks uint64) float64 {
+	capacity := blocks * fe.blockMassLimit
+	accumulatedMass := uint64(0)
+	for _, feeRate := range sortedFeeRates {
+		accumulatedMass += feeRate.Mass
+		if accumulatedMass > capacity {
+			required := feeRate.FeeRate * feeRateCutoffMargin
+			if required < fe.minimumFeeRate {
+				return fe.minimumFeeRate
+			}
+			return required
+		}
+	}
+	return fe.minimumFeeRate
+}
+
+// estimatedSeconds returns the estimated time until a transaction paying the
+// given fee rate is included, given the mass of the transactions outbidding it
+func (fe *feeEstimator) estimatedSeconds(sortedFeeRates []miningmanagermodel.TransactionFeeRate, feeRate float64) float64 {
+	massAhead := uint64(0)
+	for _, transactionFeeRate := range sortedFeeRates {
+		if transactionFeeRate.FeeRate < feeRate {
+			break
+		}
+		massAhead += transactionFeeRate.Mass
+	}
+	blocks := massAhead/fe.blockMassLimit + 1
+	return float64(blocks) * fe.targetTimePerBlock.Seconds()
+}
@@ -0,0 +1,80 @@
+package miningmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	miningmanagermodel "github.com/cryptix-network/cryptixd/domain/miningmanager/model"
+)
+
+func TestFeeEstimatorEmptyMempool(t *testing.T) {
+	estimator := newFeeEstimator(1000, time.Second, 1)
+	estimate := estimator.estimate(nil)
+
+	if estimate.PriorityBucket.FeeRate != 1 || estimate.PriorityBucket.EstimatedSeconds != 1 {
+		t.Fatalf("unexpected priority bucket %+v", estimate.PriorityBucket)
+	}
+	if len(estimate.NormalBuckets) != 1 || estimate.NormalBuckets[0].FeeRate != 1 {
+		t.Fatalf("unexpected normal buckets %+v", estimate.NormalBuckets)
+	}
+	if len(estimate.LowBuckets) != 1 || estimate.LowBuckets[0].FeeRate != 1 {
+		t.Fatalf("unexpected low buckets %+v", estimate.LowBuckets)
+	}
+}
+
+func TestFeeEstimatorCongestedMempool(t *testing.T) {
+	estimator := newFeeEstimator(1000, time.Second, 1)
+
+	// 100 blocks worth of transactions paying 100 sompi/gram followed by 1000
+	// blocks worth of transactions paying 10 sompi/gram
+	var feeRates []miningmanagermodel.TransactionFeeRate
+	for i := 0; i < 100; i++ {
+		feeRates = append(feeRates, miningmanagermodel.TransactionFeeRate{FeeRate: 100, Mass: 1000})
+	}
+	for i := 0; i < 1000; i++ {
+		feeRates = append(feeRates, miningmanagermodel.TransactionFeeRate{FeeRate: 10, Mass: 1000})
+	}
+	estimate := estimator.estimate(feeRates)
+
+	if estimate.PriorityBucket.FeeRate <= 100 {
+		t.Fatalf("expected the priority fee rate to outbid the mempool, got %f", estimate.PriorityBucket.FeeRate)
+	}
+	if estimate.NormalBuckets[0].EstimatedSeconds >= time.Minute.Seconds() {
+		t.Fatalf("expected the first normal bucket to be sub-minute, got %+v", estimate.NormalBuckets[0])
+	}
+	if estimate.LowBuckets[0].EstimatedSeconds >= time.Hour.Seconds() {
+		t.Fatalf("expected the first low bucket to be sub-hour, got %+v", estimate.LowBuckets[0])
+	}
+
+	previous := estimate.PriorityBucket
+	for _, bucket := range append(estimate.NormalBuckets, estimate.LowBuckets...) {
+		if bucket.FeeRate > previous.FeeRate || bucket.EstimatedSeconds < previous.EstimatedSeconds {
+			t.Fatalf("bucket %+v is not ordered after %+v", bucket, previous)
+		}
+		previous = bucket
+	}
+	if previous.FeeRate != 1 {
+		t.Fatalf("expected the slowest bucket to pay the minimum fee rate, got %f", previous.FeeRate)
+	}
+}
+
+func TestFeeEstimatorSaturatedTemplate(t *testing.T) {
+	estimator := newFeeEstimator(1000, time.Second, 1)
+	estimator.observeBlockTemplate(&externalapi.DomainBlock{
+		Transactions: []*externalapi.DomainTransaction{
+			{Mass: 500, Fee: 25000},
+			{Mass: 450, Fee: 22500},
+		},
+	})
+
+	estimate := estimator.estimate(nil)
+	if estimate.PriorityBucket.FeeRate < 50 {
+		t.Fatalf("expected the priority fee rate to respect the saturated template, got %f",
+			estimate.PriorityBucket.FeeRate)
+	}
+	if estimate.NormalBuckets[0].FeeRate > estimate.PriorityBucket.FeeRate {
+		t.Fatalf("normal bucket %+v outbids the priority bucket %+v",
+			estimate.NormalBuckets[0], estimate.PriorityBucket)
+	}
+}
@@ -161,8 +161,6 @@ func (mp *mempool) IsTransactionOutputDust(output *externalapi.DomainTransaction
 // context of this function is one whose referenced public key script is of a
 // standard form and, for pay-to-script-hash, does not have more than
 // maxStandardP2SHSigOps signature operations.
-// In addition, makes sure that the transaction's fee is above the minimum for acceptance
-// into the mempool and relay
 func (mp *mempool) checkTransactionStandardInContext(transaction *externalapi.DomainTransaction) error {
 	for i, input := range transaction.Inputs {
 		// It is safe to elide existence and index checks here since
@@ -189,6 +187,12 @@ func (mp *mempool) checkTransactionStandardInContext(transaction *externalapi.Do
 		}
 	}
 
+	return nil
+}
+
+// checkTransactionRelayFee makes sure that the transaction's fee is above the minimum for
+// acceptance into the mempool and relay
+func (mp *mempool) checkTransactionRelayFee(transaction *externalapi.DomainTransaction) error {
 	minimumFee := mp.minimumRequiredTransactionRelayFee(transaction.Mass)
 	if transaction.Fee < minimumFee {
 		str := fmt.Sprintf("transaction %s has %d fees which is under the required amount of %d",
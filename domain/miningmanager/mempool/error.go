@@ -68,6 +68,7 @@ var rejectCodeStrings = map[RejectCode]string{
 	RejectNotRequested:    "REJECT_NOT_REQUESTED",
 	RejectImmatureSpend:   "REJECT_IMMATURE_SPEND",
 	RejectBadOrphan:       "REJECT_BAD_ORPHAN",
+	RejectSpamTx:          "REJECT_SPAM_TX",
 }
 
 // String returns the RejectCode in human-readable form.
@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"fmt"
+
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/transactionhelper"
@@ -67,6 +69,9 @@ func (mp *mempool) handleAcceptedTransactions(acceptedTransactions []*externalap
 func (mp *mempool) removeDoubleSpends(transaction *externalapi.DomainTransaction) error {
 	for _, input := range transaction.Inputs {
 		if redeemer, ok := mp.mempoolUTXOSet.transactionByPreviousOutpoint[input.PreviousOutpoint]; ok {
+			mp.recordRemovedTransaction(redeemer.TransactionID(), true, RejectDuplicate, fmt.Sprintf(
+				"double spends %s with accepted transaction %s",
+				input.PreviousOutpoint, consensushashing.TransactionID(transaction)))
 			err := mp.removeTransaction(redeemer.TransactionID(), true)
 			if err != nil {
 				return err
@@ -90,5 +95,7 @@ func (mp *mempool) removeAcceptedAtomicConflicts(transaction *externalapi.Domain
 		return nil
 	}
 
+	mp.recordRemovedTransaction(&conflictingTransactionID, true, RejectDuplicate, fmt.Sprintf(
+		"conflicts over %s with accepted transaction %s", slot, consensushashing.TransactionID(transaction)))
 	return mp.removeTransaction(&conflictingTransactionID, true)
 }
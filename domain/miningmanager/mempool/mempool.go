@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/cryptix-network/cryptixd/domain/consensus/ruleerrors"
@@ -24,6 +25,7 @@ type mempool struct {
 	mempoolUTXOSet   *mempoolUTXOSet
 	transactionsPool *transactionsPool
 	orphansPool      *orphansPool
+	recentRejects    *recentRejects
 }
 
 // New constructs a new mempool
@@ -36,6 +38,7 @@ func New(config *Config, consensusReference consensusreference.ConsensusReferenc
 	mp.mempoolUTXOSet = newMempoolUTXOSet(mp)
 	mp.transactionsPool = newTransactionsPool(mp)
 	mp.orphansPool = newOrphansPool(mp)
+	mp.recentRejects = newRecentRejects()
 
 	return mp
 }
@@ -46,7 +49,11 @@ func (mp *mempool) ValidateAndInsertTransaction(transaction *externalapi.DomainT
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
-	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
+	acceptedTransactions, err = mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
+	if err != nil {
+		mp.recordRejectedTransactions([]*externalapi.DomainTransaction{transaction}, err)
+	}
+	return acceptedTransactions, err
 }
 
 func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID,
@@ -118,6 +125,21 @@ func (mp *mempool) AllTransactions(includeTransactionPool bool, includeOrphanPoo
 	return transactionPoolTransactions, orphanPoolTransactions
 }
 
+// TransactionsByShortID returns the transactions of the transaction pool and the orphan pool whose
+// short ID, as computed by the given function out of the transaction hash, is one of the given
+// short IDs. Short IDs are keyed by their block, so they can't be indexed ahead of time, but the
+// transaction hashes they're computed from are cached as transactions enter the mempool, and only
+// the matching transactions are cloned out of it.
+func (mp *mempool) TransactionsByShortID(shortIDs map[uint64]struct{},
+	shortID func(transactionHash *externalapi.DomainHash) uint64) []*externalapi.DomainTransaction {
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	transactions := mp.transactionsPool.getTransactionsByShortID(shortIDs, shortID)
+	return append(transactions, mp.orphansPool.getOrphanTransactionsByShortID(shortIDs, shortID)...)
+}
+
 func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
@@ -134,6 +156,13 @@ func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPo
 	return transactionCount
 }
 
+func (mp *mempool) TransactionFeeRates() []miningmanagermodel.TransactionFeeRate {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.transactionFeeRates()
+}
+
 func (mp *mempool) HandleNewBlockTransactions(transactions []*externalapi.DomainTransaction) (
 	acceptedOrphans []*externalapi.DomainTransaction, err error) {
 
@@ -214,6 +243,13 @@ func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction
 	return candidateTxs
 }
 
+func (mp *mempool) BlockCandidatePackageFeeRates() map[externalapi.DomainTransactionID]float64 {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.blockCandidatePackageFeeRates()
+}
+
 func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error) {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
@@ -261,6 +297,8 @@ func (mp *mempool) RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransacti
 
 	for _, tx := range err.InvalidTransactions {
 		removeRedeemers := !errors.As(tx.Error, &ruleerrors.ErrMissingTxOut{})
+		mp.recordRemovedTransaction(consensushashing.TransactionID(tx.Transaction), removeRedeemers, RejectInvalid,
+			fmt.Sprintf("invalid in a new block: %s", tx.Error))
 		err := mp.removeTransaction(consensushashing.TransactionID(tx.Transaction), removeRedeemers)
 		if err != nil {
 			return err
@@ -0,0 +1,49 @@
+package mempool
+
+import (
+	"github.com/cryptix-network/cryptixd/infrastructure/metrics"
+)
+
+const (
+	atomicTransactionKind    = "atomic"
+	nonAtomicTransactionKind = "non_atomic"
+)
+
+var (
+	transactionsMetric = metrics.NewGaugeVec("cryptixd_mempool_transactions",
+		"Number of transactions in the mempool, by whether they hold atomic (CAT) slots", "kind")
+	massMetric = metrics.NewGaugeVec("cryptixd_mempool_mass",
+		"Total mass of the transactions in the mempool, by whether they hold atomic (CAT) slots", "kind")
+	orphansMetric = metrics.NewGauge("cryptixd_mempool_orphans",
+		"Number of orphan transactions in the mempool")
+)
+
+// poolTotals are the number and total mass of a kind of transactions in the
+// transactions pool
+type poolTotals struct {
+	count uint64
+	mass  uint64
+}
+
+func transactionKind(isAtomic bool) string {
+	if isAtomic {
+		return atomicTransactionKind
+	}
+	return nonAtomicTransactionKind
+}
+
+func (tp *transactionsPool) updateTotals(isAtomic bool, mass uint64, isAdded bool) {
+	kind := transactionKind(isAtomic)
+	totals := tp.totalsByKind[kind]
+	if isAdded {
+		totals.count++
+		totals.mass += mass
+	} else {
+		totals.count--
+		totals.mass -= mass
+	}
+	tp.totalsByKind[kind] = totals
+
+	transactionsMetric.WithLabelValues(kind).Set(float64(totals.count))
+	massMetric.WithLabelValues(kind).Set(float64(totals.mass))
+}
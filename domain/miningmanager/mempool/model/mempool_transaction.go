@@ -8,6 +8,7 @@ import (
 // MempoolTransaction represents a transaction inside the main TransactionPool
 type MempoolTransaction struct {
 	transaction              *externalapi.DomainTransaction
+	transactionHash          *externalapi.DomainHash
 	parentTransactionsInPool IDToTransactionMap
 	isHighPriority           bool
 	addedAtDAAScore          uint64
@@ -23,6 +24,7 @@ func NewMempoolTransaction(
 ) *MempoolTransaction {
 	return &MempoolTransaction{
 		transaction:              transaction,
+		transactionHash:          consensushashing.TransactionHash(transaction),
 		parentTransactionsInPool: parentTransactionsInPool,
 		isHighPriority:           isHighPriority,
 		addedAtDAAScore:          addedAtDAAScore,
@@ -35,6 +37,12 @@ func (mt *MempoolTransaction) TransactionID() *externalapi.DomainTransactionID {
 	return consensushashing.TransactionID(mt.transaction)
 }
 
+// TransactionHash returns the hash of this MempoolTransaction, including its signature scripts.
+// It's computed once, as the transaction is added to the mempool.
+func (mt *MempoolTransaction) TransactionHash() *externalapi.DomainHash {
+	return mt.transactionHash
+}
+
 // Transaction return the DomainTransaction associated with this MempoolTransaction:
 func (mt *MempoolTransaction) Transaction() *externalapi.DomainTransaction {
 	return mt.transaction
@@ -50,6 +58,12 @@ func (mt *MempoolTransaction) RemoveParentTransactionInPool(transactionID *exter
 	delete(mt.parentTransactionsInPool, *transactionID)
 }
 
+// SetAddedAtDAAScore sets the DAA score the transaction's expiry is counted from. It's used when
+// restoring a persisted mempool, so that a restart doesn't extend the lifetime of a transaction.
+func (mt *MempoolTransaction) SetAddedAtDAAScore(daaScore uint64) {
+	mt.addedAtDAAScore = daaScore
+}
+
 // SetReadyAtDAAScore resets the transaction's ready/frontier age anchor.
 func (mt *MempoolTransaction) SetReadyAtDAAScore(daaScore uint64) {
 	mt.readyAtDAAScore = daaScore
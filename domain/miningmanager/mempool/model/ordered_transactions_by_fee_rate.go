@@ -59,6 +59,16 @@ func (tobf *TransactionsOrderedByFeeRate) RemoveAtIndex(index int) error {
 	return nil
 }
 
+// Rank returns the 1-based position of the given transaction in the set, counting from the
+// highest fee rate. Returns wasFound=false if the transaction does not exist in the set.
+func (tobf *TransactionsOrderedByFeeRate) Rank(transaction *MempoolTransaction) (rank int, wasFound bool, err error) {
+	index, wasFound, err := tobf.findTransactionIndex(transaction)
+	if err != nil || !wasFound {
+		return 0, false, err
+	}
+	return len(tobf.slice) - index, true, nil
+}
+
 // findTransactionIndex finds the given transaction inside the list of transactions ordered by fee rate.
 // If the transaction was not found, will return wasFound=false and index=the index at which transaction can be inserted
 // while preserving the order.
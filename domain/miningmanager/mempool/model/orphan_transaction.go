@@ -8,6 +8,7 @@ import (
 // OrphanTransaction represents a transaction in the OrphanPool
 type OrphanTransaction struct {
 	transaction     *externalapi.DomainTransaction
+	transactionHash *externalapi.DomainHash
 	isHighPriority  bool
 	addedAtDAAScore uint64
 }
@@ -20,6 +21,7 @@ func NewOrphanTransaction(
 ) *OrphanTransaction {
 	return &OrphanTransaction{
 		transaction:     transaction,
+		transactionHash: consensushashing.TransactionHash(transaction),
 		isHighPriority:  isHighPriority,
 		addedAtDAAScore: addedAtDAAScore,
 	}
@@ -30,6 +32,12 @@ func (ot *OrphanTransaction) TransactionID() *externalapi.DomainTransactionID {
 	return consensushashing.TransactionID(ot.transaction)
 }
 
+// TransactionHash returns the hash of this OrphanTransaction, including its signature scripts.
+// It's computed once, as the transaction is added to the orphan pool.
+func (ot *OrphanTransaction) TransactionHash() *externalapi.DomainHash {
+	return ot.transactionHash
+}
+
 // Transaction return the DomainTransaction associated with this OrphanTransaction:
 func (ot *OrphanTransaction) Transaction() *externalapi.DomainTransaction {
 	return ot.transaction
@@ -44,3 +52,9 @@ func (ot *OrphanTransaction) IsHighPriority() bool {
 func (ot *OrphanTransaction) AddedAtDAAScore() uint64 {
 	return ot.addedAtDAAScore
 }
+
+// SetAddedAtDAAScore sets the DAA score the orphan's expiry is counted from. It's used when
+// restoring a persisted mempool, so that a restart doesn't extend the lifetime of an orphan.
+func (ot *OrphanTransaction) SetAddedAtDAAScore(daaScore uint64) {
+	ot.addedAtDAAScore = daaScore
+}
@@ -80,6 +80,8 @@ func (op *orphansPool) limitOrphanPoolSize() error {
 
 		// Don't remove redeemers in the case of a random eviction since the evicted transaction is
 		// not invalid, therefore it's redeemers are as good as any orphan that just arrived.
+		op.mempool.recordRemovedTransaction(orphanToRemove.TransactionID(), false, RejectBadOrphan,
+			"evicted from the full orphan pool")
 		err := op.removeOrphan(orphanToRemove.TransactionID(), false)
 		if err != nil {
 			return err
@@ -127,6 +129,7 @@ func (op *orphansPool) addOrphan(transaction *externalapi.DomainTransaction, isH
 	orphanTransaction := model.NewOrphanTransaction(transaction, isHighPriority, virtualDAAScore)
 
 	op.allOrphans[*orphanTransaction.TransactionID()] = orphanTransaction
+	orphansMetric.Set(float64(len(op.allOrphans)))
 	for _, input := range transaction.Inputs {
 		op.orphansByPreviousOutpoint[input.PreviousOutpoint] = orphanTransaction
 	}
@@ -203,6 +206,8 @@ func (op *orphansPool) revalidateOrphanTransactions() ([]*externalapi.DomainTran
 					log.Infof("Removing CAT orphan after UTXO revalidation rule failure: tx=%s err=%s",
 						orphan.TransactionID(), err)
 				}
+				code, _ := extractRejectCode(err)
+				op.mempool.recordRemovedTransaction(orphan.TransactionID(), true, code, err.Error())
 				err := op.removeOrphan(orphan.TransactionID(), true)
 				if err != nil {
 					return nil, err
@@ -321,6 +326,7 @@ func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTrans
 	}
 
 	delete(op.allOrphans, *orphanTransactionID)
+	orphansMetric.Set(float64(len(op.allOrphans)))
 
 	for i, input := range orphanTransaction.Transaction().Inputs {
 		if _, ok := op.orphansByPreviousOutpoint[input.PreviousOutpoint]; !ok {
@@ -373,6 +379,9 @@ func (op *orphansPool) expireOrphanTransactions() error {
 
 		// Remove all transactions whose addedAtDAAScore is older then TransactionExpireIntervalDAAScore
 		if virtualDAAScore-orphanTransaction.AddedAtDAAScore() > op.mempool.config.OrphanExpireIntervalDAAScore {
+			op.mempool.recordRemovedTransaction(orphanTransaction.TransactionID(), false, RejectObsolete, fmt.Sprintf(
+				"expired from the orphan pool after the DAA score moved by %d",
+				virtualDAAScore-orphanTransaction.AddedAtDAAScore()))
 			err = op.removeOrphan(orphanTransaction.TransactionID(), false)
 			if err != nil {
 				return err
@@ -457,6 +466,18 @@ func (op *orphansPool) getAllOrphanTransactions() []*externalapi.DomainTransacti
 	return allOrphanTransactions
 }
 
+func (op *orphansPool) getOrphanTransactionsByShortID(shortIDs map[uint64]struct{},
+	shortID func(transactionHash *externalapi.DomainHash) uint64) []*externalapi.DomainTransaction {
+
+	var orphanTransactions []*externalapi.DomainTransaction
+	for _, mempoolTransaction := range op.allOrphans {
+		if _, ok := shortIDs[shortID(mempoolTransaction.TransactionHash())]; ok {
+			orphanTransactions = append(orphanTransactions, mempoolTransaction.Transaction().Clone()) //these pointers leave the mempool, hence we clone.
+		}
+	}
+	return orphanTransactions
+}
+
 func (op *orphansPool) orphanTransactionCount() int {
 	return len(op.allOrphans)
 }
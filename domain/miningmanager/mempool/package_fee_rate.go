@@ -0,0 +1,169 @@
+package mempool
+
+import (
+	"math"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool/model"
+)
+
+// maximumPackageWalkTransactionCount bounds the number of ancestors and descendants walked when
+// scoring a package, so that a long chain can't make scoring quadratic in the mempool size.
+// Transactions with more ancestors than that are scored by their own fee rate only.
+const maximumPackageWalkTransactionCount = 25
+
+// A package is a transaction together with its ancestors in the mempool: the parents whose
+// outputs it spends, and the transactions holding the lower nonces of its CAT nonce key. A
+// package can only be mined in that order, so its fee rate is the one a miner gets out of
+// including the whole package.
+//
+// Since a block can't contain chained transactions, a package is mined over several blocks,
+// starting from its ready ancestors. A transaction is therefore scored by the best package fee
+// rate among the packages it's an ancestor of, which lets a high-fee child pay for its low-fee
+// parents, both for block template selection and for eviction.
+
+func transactionFeeRate(transaction *model.MempoolTransaction) float64 {
+	if transaction.Transaction().Mass == 0 {
+		return 0
+	}
+	return float64(transaction.Transaction().Fee) / float64(transaction.Transaction().Mass)
+}
+
+// packageFeeRate returns the highest ancestor-package fee rate among the given transaction and
+// its descendants. It's never lower than the fee rate of the transaction itself.
+func (tp *transactionsPool) packageFeeRate(transaction *model.MempoolTransaction) float64 {
+	feeRate := transactionFeeRate(transaction)
+	for _, descendant := range tp.packageDescendants(transaction) {
+		descendantPackageFeeRate, ok := tp.ancestorPackageFeeRate(descendant)
+		if ok && descendantPackageFeeRate > feeRate {
+			feeRate = descendantPackageFeeRate
+		}
+	}
+	return feeRate
+}
+
+// ancestorPackageFeeRate returns the fee rate of the given transaction together with all its
+// ancestors. It returns false if the transaction has more than maximumPackageWalkTransactionCount
+// ancestors.
+func (tp *transactionsPool) ancestorPackageFeeRate(transaction *model.MempoolTransaction) (float64, bool) {
+	fee, mass := uint64(0), uint64(0)
+	visited := map[externalapi.DomainTransactionID]struct{}{*transaction.TransactionID(): {}}
+	stack := []*model.MempoolTransaction{transaction}
+	for len(stack) > 0 {
+		var current *model.MempoolTransaction
+		current, stack = stack[len(stack)-1], stack[:len(stack)-1]
+		fee += current.Transaction().Fee
+		mass += current.Transaction().Mass
+
+		for _, ancestor := range tp.packageParents(current) {
+			if _, ok := visited[*ancestor.TransactionID()]; ok {
+				continue
+			}
+			if len(visited) > maximumPackageWalkTransactionCount {
+				return 0, false
+			}
+			visited[*ancestor.TransactionID()] = struct{}{}
+			stack = append(stack, ancestor)
+		}
+	}
+	if mass == 0 {
+		return 0, false
+	}
+	return float64(fee) / float64(mass), true
+}
+
+// packageDescendants returns up to maximumPackageWalkTransactionCount transactions that have the
+// given transaction as an ancestor
+func (tp *transactionsPool) packageDescendants(transaction *model.MempoolTransaction) []*model.MempoolTransaction {
+	descendants := []*model.MempoolTransaction{}
+	visited := map[externalapi.DomainTransactionID]struct{}{*transaction.TransactionID(): {}}
+	stack := []*model.MempoolTransaction{transaction}
+	for len(stack) > 0 {
+		var current *model.MempoolTransaction
+		current, stack = stack[len(stack)-1], stack[:len(stack)-1]
+
+		for _, descendant := range tp.packageChildren(current) {
+			if _, ok := visited[*descendant.TransactionID()]; ok {
+				continue
+			}
+			if len(descendants) >= maximumPackageWalkTransactionCount {
+				return descendants
+			}
+			visited[*descendant.TransactionID()] = struct{}{}
+			descendants = append(descendants, descendant)
+			stack = append(stack, descendant)
+		}
+	}
+	return descendants
+}
+
+// packageParents returns the direct ancestors of the given transaction: its parents in the
+// mempool, and the transaction holding the previous nonce of its CAT nonce key
+func (tp *transactionsPool) packageParents(transaction *model.MempoolTransaction) []*model.MempoolTransaction {
+	parents := make([]*model.MempoolTransaction, 0, len(transaction.ParentTransactionsInPool())+1)
+	for _, parent := range transaction.ParentTransactionsInPool() {
+		parents = append(parents, parent)
+	}
+	if previousNonceHolder, ok := tp.nonceNeighbour(transaction, false); ok {
+		parents = append(parents, previousNonceHolder)
+	}
+	return parents
+}
+
+// packageChildren returns the direct descendants of the given transaction: the transactions
+// that spend its outputs, and the transaction holding the next nonce of its CAT nonce key
+func (tp *transactionsPool) packageChildren(transaction *model.MempoolTransaction) []*model.MempoolTransaction {
+	redeemers := tp.chainedTransactionsByParentID[*transaction.TransactionID()]
+	children := make([]*model.MempoolTransaction, 0, len(redeemers)+1)
+	children = append(children, redeemers...)
+	if nextNonceHolder, ok := tp.nonceNeighbour(transaction, true); ok {
+		children = append(children, nextNonceHolder)
+	}
+	return children
+}
+
+// nonceNeighbour returns the transaction holding the next nonce, or the previous one if next
+// is false, of the CAT nonce key of the given transaction
+func (tp *transactionsPool) nonceNeighbour(transaction *model.MempoolTransaction, next bool) (
+	*model.MempoolTransaction, bool) {
+
+	for _, slot := range tp.atomicSlotsByTransactionID[*transaction.TransactionID()] {
+		if slot.kind != atomicMempoolSlotKindNonce {
+			continue
+		}
+		if next {
+			if slot.nonce == math.MaxUint64 {
+				return nil, false
+			}
+			slot.nonce++
+		} else {
+			if slot.nonce == 0 {
+				return nil, false
+			}
+			slot.nonce--
+		}
+		neighbourID, ok := tp.atomicSlotOwners[slot]
+		if !ok {
+			return nil, false
+		}
+		neighbour, ok := tp.allTransactions[neighbourID]
+		return neighbour, ok
+	}
+	return nil, false
+}
+
+// blockCandidatePackageFeeRates returns the package fee rates of the ready transactions whose
+// descendants raise them above their own fee rate
+func (tp *transactionsPool) blockCandidatePackageFeeRates() map[externalapi.DomainTransactionID]float64 {
+	packageFeeRates := make(map[externalapi.DomainTransactionID]float64)
+	for transactionID, mempoolTransaction := range tp.allTransactions {
+		if len(mempoolTransaction.ParentTransactionsInPool()) > 0 {
+			continue
+		}
+		packageFeeRate := tp.packageFeeRate(mempoolTransaction)
+		if packageFeeRate > transactionFeeRate(mempoolTransaction) {
+			packageFeeRates[transactionID] = packageFeeRate
+		}
+	}
+	return packageFeeRates
+}
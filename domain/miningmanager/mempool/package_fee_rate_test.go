@@ -0,0 +1,192 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/constants"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/subnetworks"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/txscript"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/utxo"
+	"github.com/cryptix-network/cryptixd/domain/dagconfig"
+	mempoolmodel "github.com/cryptix-network/cryptixd/domain/miningmanager/mempool/model"
+)
+
+func TestPackageFeeRateOfUTXOChain(t *testing.T) {
+	mp := newPackageTestMempool()
+
+	parent := packageTestTransaction(1, 1, 1000, nil, nil)
+	child := packageTestTransaction(2, 99_999, 1000, nil, parent)
+	unrelated := packageTestTransaction(3, 10_000, 1000, nil, nil)
+	addPackageTestTransactions(t, mp, parent, child, unrelated)
+
+	parentID := consensushashing.TransactionID(parent)
+	parentPackageFeeRate := mp.transactionsPool.packageFeeRate(mp.transactionsPool.allTransactions[*parentID])
+	if parentPackageFeeRate != 50 {
+		t.Fatalf("expected the package fee rate of the parent to be 50, got %f", parentPackageFeeRate)
+	}
+
+	packageFeeRates := mp.BlockCandidatePackageFeeRates()
+	if len(packageFeeRates) != 1 {
+		t.Fatalf("expected only the parent to have a package fee rate, got %d entries", len(packageFeeRates))
+	}
+	if packageFeeRates[*parentID] != parentPackageFeeRate {
+		t.Fatalf("expected the block candidate package fee rate of the parent to be %f, got %f",
+			parentPackageFeeRate, packageFeeRates[*parentID])
+	}
+}
+
+func TestPackageFeeRateOfCATNonceChain(t *testing.T) {
+	mp := newPackageTestMempool()
+
+	var assetID [externalapi.DomainHashSize]byte
+	assetID[0] = 0x42
+	nonceOne := packageTestTransaction(1, 1, 1000, testCATTransferPayload(assetID, 1), nil)
+	nonceTwo := packageTestTransaction(2, 99_999, 1000, testCATTransferPayload(assetID, 2), nil)
+	addPackageTestTransactions(t, mp, nonceOne, nonceTwo)
+
+	packageFeeRates := mp.BlockCandidatePackageFeeRates()
+	nonceOneID := consensushashing.TransactionID(nonceOne)
+	if packageFeeRates[*nonceOneID] != 50 {
+		t.Fatalf("expected the nonce frontier to be boosted to 50, got %f", packageFeeRates[*nonceOneID])
+	}
+	if _, ok := packageFeeRates[*consensushashing.TransactionID(nonceTwo)]; ok {
+		t.Fatalf("expected the high-fee nonce successor to keep its own fee rate")
+	}
+}
+
+func TestLimitTransactionCountEvictsByPackageFeeRate(t *testing.T) {
+	mp := newPackageTestMempool()
+	mp.config.MaximumTransactionCount = 2
+
+	parent := packageTestTransaction(1, 1, 1000, nil, nil)
+	child := packageTestTransaction(2, 99_999, 1000, nil, parent)
+	midFee := packageTestTransaction(3, 10_000, 1000, nil, nil)
+	addPackageTestTransactions(t, mp, parent, child, midFee)
+
+	err := mp.transactionsPool.limitTransactionCount(nil)
+	if err != nil {
+		t.Fatalf("limitTransactionCount: %s", err)
+	}
+	if _, ok := mp.transactionsPool.allTransactions[*consensushashing.TransactionID(midFee)]; ok {
+		t.Fatalf("expected the mid-fee transaction to be evicted ahead of the boosted parent")
+	}
+	for _, transaction := range []*externalapi.DomainTransaction{parent, child} {
+		if _, ok := mp.transactionsPool.allTransactions[*consensushashing.TransactionID(transaction)]; !ok {
+			t.Fatalf("expected transaction %s of the package to stay in the mempool",
+				consensushashing.TransactionID(transaction))
+		}
+	}
+
+	// A transaction paying less than the package is rejected rather than evicting it
+	lowFee := packageTestTransaction(4, 100, 1000, nil, nil)
+	addPackageTestTransactions(t, mp, lowFee)
+	lowFeeTransaction := mp.transactionsPool.allTransactions[*consensushashing.TransactionID(lowFee)]
+	err = mp.transactionsPool.limitTransactionCount(lowFeeTransaction)
+	if err == nil {
+		t.Fatalf("expected the low-fee transaction to be rejected")
+	}
+	if _, ok := mp.transactionsPool.allTransactions[*consensushashing.TransactionID(parent)]; !ok {
+		t.Fatalf("expected the boosted parent to stay in the mempool")
+	}
+}
+
+func TestPackageEvictionCandidatesLeaveThePoolUntouched(t *testing.T) {
+	mp := newPackageTestMempool()
+	mp.config.MaximumTransactionCount = 2
+
+	lowFee := packageTestTransaction(1, 1000, 1000, nil, nil)
+	highFee := packageTestTransaction(2, 100_000, 1000, nil, nil)
+	addPackageTestTransactions(t, mp, lowFee, highFee)
+
+	// The package doesn't fit: evicting the low-fee transaction isn't enough, and the next
+	// candidate is part of the package
+	packageHighFee := packageTestTransaction(3, 10_000, 1000, nil, nil)
+	packageLowFee := packageTestTransaction(4, 2000, 1000, nil, nil)
+	addPackageTestTransactions(t, mp, packageHighFee, packageLowFee)
+	packageTransactions := []*mempoolmodel.MempoolTransaction{
+		mp.transactionsPool.allTransactions[*consensushashing.TransactionID(packageHighFee)],
+		mp.transactionsPool.allTransactions[*consensushashing.TransactionID(packageLowFee)],
+	}
+	_, err := mp.transactionsPool.packageEvictionCandidates(packageTransactions)
+	if err == nil {
+		t.Fatalf("expected the package to be rejected")
+	}
+	if len(mp.transactionsPool.allTransactions) != 4 {
+		t.Fatalf("expected no transaction to be evicted, got %d transactions in the mempool",
+			len(mp.transactionsPool.allTransactions))
+	}
+
+	// Once the mempool has room for one more transaction, only the low-fee transaction is evicted
+	mp.config.MaximumTransactionCount = 3
+	evictionCandidates, err := mp.transactionsPool.packageEvictionCandidates(packageTransactions)
+	if err != nil {
+		t.Fatalf("packageEvictionCandidates: %s", err)
+	}
+	if len(evictionCandidates) != 1 ||
+		!evictionCandidates[0].TransactionID().Equal(consensushashing.TransactionID(lowFee)) {
+		t.Fatalf("expected only the low-fee transaction to be an eviction candidate, got %d candidates",
+			len(evictionCandidates))
+	}
+	if len(mp.transactionsPool.allTransactions) != 4 {
+		t.Fatalf("expected packageEvictionCandidates not to evict, got %d transactions in the mempool",
+			len(mp.transactionsPool.allTransactions))
+	}
+}
+
+func newPackageTestMempool() *mempool {
+	mp := &mempool{config: DefaultConfig(&dagconfig.SimnetParams)}
+	mp.transactionsPool = newTransactionsPool(mp)
+	mp.orphansPool = newOrphansPool(mp)
+	mp.mempoolUTXOSet = newMempoolUTXOSet(mp)
+	mp.recentRejects = newRecentRejects()
+	return mp
+}
+
+func addPackageTestTransactions(t *testing.T, mp *mempool, transactions ...*externalapi.DomainTransaction) {
+	for _, transaction := range transactions {
+		parentsInPool := mp.transactionsPool.getParentTransactionsInPool(transaction)
+		err := mp.transactionsPool.addMempoolTransaction(
+			mempoolmodel.NewMempoolTransaction(transaction, parentsInPool, false, 0))
+		if err != nil {
+			t.Fatalf("addMempoolTransaction: %s", err)
+		}
+	}
+}
+
+// packageTestTransaction returns a transaction spending the first output of parent, or an
+// output outside the mempool if parent is nil
+func packageTestTransaction(tag byte, fee uint64, mass uint64, payload []byte,
+	parent *externalapi.DomainTransaction) *externalapi.DomainTransaction {
+
+	ownerScript := make([]byte, 34)
+	ownerScript[0] = txscript.OpData32
+	for i := 1; i <= 32; i++ {
+		ownerScript[i] = 0x11
+	}
+	ownerScript[33] = txscript.OpCheckSig
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: ownerScript, Version: constants.MaxScriptPublicKeyVersion}
+
+	previousOutpoint := externalapi.DomainOutpoint{TransactionID: externalapi.DomainTransactionID{}, Index: uint32(tag)}
+	if parent != nil {
+		previousOutpoint = externalapi.DomainOutpoint{TransactionID: *consensushashing.TransactionID(parent), Index: 0}
+	}
+	subnetworkID := subnetworks.SubnetworkIDNative
+	if payload != nil {
+		subnetworkID = subnetworks.SubnetworkIDPayload
+	}
+	return &externalapi.DomainTransaction{
+		Version: constants.MaxTransactionVersion,
+		Inputs: []*externalapi.DomainTransactionInput{{
+			PreviousOutpoint: previousOutpoint,
+			Sequence:         constants.MaxTxInSequenceNum,
+			UTXOEntry:        utxo.NewUTXOEntry(constants.SompiPerCryptix, scriptPublicKey, false, 1),
+		}},
+		Outputs:      []*externalapi.DomainTransactionOutput{{Value: constants.SompiPerCryptix - fee, ScriptPublicKey: scriptPublicKey}},
+		SubnetworkID: subnetworkID,
+		Fee:          fee,
+		Mass:         mass,
+		Payload:      payload,
+	}
+}
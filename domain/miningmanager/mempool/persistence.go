@@ -0,0 +1,359 @@
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/database/serialization"
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/atomicstate"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool/model"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// A persisted mempool file starts with persistenceFileMagic and the file format version,
+// followed by one record per transaction: a flags byte, the DAA scores at which the
+// transaction was added to the mempool and became ready, and a little-endian uint32 length
+// followed by a serialized DbTransaction.
+//
+// Records are ordered so that every transaction comes after its parents in the mempool and
+// after the lower nonces of its CAT nonce chain, and orphans come last. Loading the records in
+// order therefore never creates an orphan or a nonce gap that wasn't there on shutdown.
+var persistenceFileMagic = [8]byte{'C', 'R', 'Y', 'X', 'M', 'P', 'O', 'L'}
+
+const (
+	persistenceFileVersion = uint32(1)
+
+	// maxPersistedTransactionSize bounds the memory a corrupted record length can make the
+	// loader allocate
+	maxPersistedTransactionSize = 16 << 20
+
+	persistedFlagHighPriority = byte(1 << 0)
+)
+
+type persistedTransaction struct {
+	transaction     *externalapi.DomainTransaction
+	isHighPriority  bool
+	addedAtDAAScore uint64
+	readyAtDAAScore uint64
+}
+
+func (mp *mempool) SaveToFile(path string) (savedTransactions int, err error) {
+	mp.mtx.RLock()
+	records := mp.persistedTransactions()
+	mp.mtx.RUnlock()
+
+	// Write to a temporary file first, so that a crash mid-write doesn't leave a truncated
+	// file behind
+	temporaryPath := path + ".tmp"
+	file, err := os.Create(temporaryPath)
+	if err != nil {
+		return 0, err
+	}
+	err = writePersistedTransactions(file, records)
+	if err != nil {
+		file.Close()
+		os.Remove(temporaryPath)
+		return 0, err
+	}
+	err = file.Close()
+	if err != nil {
+		os.Remove(temporaryPath)
+		return 0, err
+	}
+	err = os.Rename(temporaryPath, path)
+	if err != nil {
+		return 0, err
+	}
+	return len(records), nil
+}
+
+func (mp *mempool) LoadFromFile(path string) (loadedTransactions int, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	records, err := readPersistedTransactions(file)
+	file.Close()
+	if err != nil {
+		return 0, errors.Wrapf(err, "error reading the persisted mempool %s", path)
+	}
+
+	// The file is a snapshot of the mempool at shutdown. Move it aside while it's restored, so
+	// that it isn't loaded again after an unclean shutdown, and only delete it once all of it
+	// was restored.
+	loadingPath := path + ".loading"
+	err = os.Rename(path, loadingPath)
+	if err != nil {
+		return 0, err
+	}
+
+	loadedTransactions, err = mp.restorePersistedTransactions(records)
+	if err != nil {
+		renameErr := os.Rename(loadingPath, path)
+		if renameErr != nil {
+			log.Warnf("Could not move the persisted mempool %s back to %s: %s", loadingPath, path, renameErr)
+		}
+		return loadedTransactions, err
+	}
+	return loadedTransactions, os.Remove(loadingPath)
+}
+
+// restorePersistedTransactions restores the given records into the mempool, dropping the
+// transactions that are no longer valid
+func (mp *mempool) restorePersistedTransactions(records []*persistedTransaction) (loadedTransactions int, err error) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for _, record := range records {
+		err := mp.restorePersistedTransaction(record)
+		if err != nil {
+			if !errors.As(err, &RuleError{}) {
+				return loadedTransactions, err
+			}
+			log.Debugf("Dropped persisted transaction %s: %s",
+				consensushashing.TransactionID(record.transaction), err)
+			continue
+		}
+		loadedTransactions++
+	}
+
+	// Apply the expiry intervals right away, in case the persisted transactions
+	// expired while the node was down
+	err = mp.orphansPool.expireOrphanTransactions()
+	if err != nil {
+		return loadedTransactions, err
+	}
+	err = mp.transactionsPool.expireOldTransactions()
+	if err != nil {
+		return loadedTransactions, err
+	}
+	return loadedTransactions, nil
+}
+
+// restorePersistedTransaction revalidates a persisted transaction and inserts it into the
+// mempool. The DAA scores of the record are restored, so that the expiry intervals keep
+// counting from the moment the transaction was first added rather than from the restart.
+func (mp *mempool) restorePersistedTransaction(record *persistedTransaction) error {
+	_, err := mp.validateAndInsertTransaction(record.transaction, record.isHighPriority, true)
+	if err != nil {
+		return err
+	}
+
+	transactionID := consensushashing.TransactionID(record.transaction)
+	if mempoolTransaction, ok := mp.transactionsPool.allTransactions[*transactionID]; ok {
+		mempoolTransaction.SetAddedAtDAAScore(min(record.addedAtDAAScore, mempoolTransaction.AddedAtDAAScore()))
+		mempoolTransaction.SetReadyAtDAAScore(min(record.readyAtDAAScore, mempoolTransaction.ReadyAtDAAScore()))
+	} else if orphanTransaction, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
+		orphanTransaction.SetAddedAtDAAScore(min(record.addedAtDAAScore, orphanTransaction.AddedAtDAAScore()))
+	}
+	return nil
+}
+
+// persistedTransactions returns all the transactions of the mempool in the order they should
+// be loaded back in
+func (mp *mempool) persistedTransactions() []*persistedTransaction {
+	records := make([]*persistedTransaction, 0, len(mp.transactionsPool.allTransactions)+len(mp.orphansPool.allOrphans))
+	for _, mempoolTransaction := range mp.transactionsPool.persistenceOrder() {
+		records = append(records, &persistedTransaction{
+			transaction:     mempoolTransaction.Transaction(),
+			isHighPriority:  mempoolTransaction.IsHighPriority(),
+			addedAtDAAScore: mempoolTransaction.AddedAtDAAScore(),
+			readyAtDAAScore: mempoolTransaction.ReadyAtDAAScore(),
+		})
+	}
+
+	orphanTransactions := make([]*model.OrphanTransaction, 0, len(mp.orphansPool.allOrphans))
+	for _, orphanTransaction := range mp.orphansPool.allOrphans {
+		orphanTransactions = append(orphanTransactions, orphanTransaction)
+	}
+	sort.Slice(orphanTransactions, func(i, j int) bool {
+		return orphanTransactions[i].AddedAtDAAScore() < orphanTransactions[j].AddedAtDAAScore()
+	})
+	for _, orphanTransaction := range orphanTransactions {
+		records = append(records, &persistedTransaction{
+			transaction:     orphanTransaction.Transaction(),
+			isHighPriority:  orphanTransaction.IsHighPriority(),
+			addedAtDAAScore: orphanTransaction.AddedAtDAAScore(),
+			readyAtDAAScore: orphanTransaction.AddedAtDAAScore(),
+		})
+	}
+	return records
+}
+
+// persistenceOrder returns the transactions of the pool sorted topologically: every
+// transaction comes after its parents in the pool and after the transactions that hold the
+// lower nonces of the same CAT nonce key. Other than that, transactions are kept in the order
+// they were added.
+func (tp *transactionsPool) persistenceOrder() []*model.MempoolTransaction {
+	transactions := make([]*model.MempoolTransaction, 0, len(tp.allTransactions))
+	for _, mempoolTransaction := range tp.allTransactions {
+		transactions = append(transactions, mempoolTransaction)
+	}
+	sort.Slice(transactions, func(i, j int) bool {
+		if transactions[i].AddedAtDAAScore() != transactions[j].AddedAtDAAScore() {
+			return transactions[i].AddedAtDAAScore() < transactions[j].AddedAtDAAScore()
+		}
+		return transactions[i].TransactionID().Less(transactions[j].TransactionID())
+	})
+
+	type nonceSlotHolder struct {
+		nonce       uint64
+		transaction *model.MempoolTransaction
+	}
+	holdersByNonceKey := make(map[atomicstate.NonceKey][]nonceSlotHolder)
+	for _, mempoolTransaction := range transactions {
+		for _, slot := range tp.atomicSlotsByTransactionID[*mempoolTransaction.TransactionID()] {
+			if slot.kind != atomicMempoolSlotKindNonce {
+				continue
+			}
+			holdersByNonceKey[slot.nonceKey] = append(holdersByNonceKey[slot.nonceKey],
+				nonceSlotHolder{nonce: slot.nonce, transaction: mempoolTransaction})
+		}
+	}
+	noncePredecessors := make(map[externalapi.DomainTransactionID]*model.MempoolTransaction)
+	for _, holders := range holdersByNonceKey {
+		sort.Slice(holders, func(i, j int) bool { return holders[i].nonce < holders[j].nonce })
+		for i := 1; i < len(holders); i++ {
+			noncePredecessors[*holders[i].transaction.TransactionID()] = holders[i-1].transaction
+		}
+	}
+
+	ordered := make([]*model.MempoolTransaction, 0, len(transactions))
+	visited := make(map[externalapi.DomainTransactionID]struct{}, len(transactions))
+	var visit func(mempoolTransaction *model.MempoolTransaction)
+	visit = func(mempoolTransaction *model.MempoolTransaction) {
+		transactionID := *mempoolTransaction.TransactionID()
+		if _, ok := visited[transactionID]; ok {
+			return
+		}
+		visited[transactionID] = struct{}{}
+
+		for _, parentTransaction := range mempoolTransaction.ParentTransactionsInPool() {
+			visit(parentTransaction)
+		}
+		if noncePredecessor, ok := noncePredecessors[transactionID]; ok {
+			visit(noncePredecessor)
+		}
+		ordered = append(ordered, mempoolTransaction)
+	}
+	for _, mempoolTransaction := range transactions {
+		visit(mempoolTransaction)
+	}
+	return ordered
+}
+
+func writePersistedTransactions(w io.Writer, records []*persistedTransaction) error {
+	writer := bufio.NewWriter(w)
+	_, err := writer.Write(persistenceFileMagic[:])
+	if err != nil {
+		return err
+	}
+	err = binary.Write(writer, binary.LittleEndian, persistenceFileVersion)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		transactionBytes, err := proto.Marshal(serialization.DomainTransactionToDbTransaction(record.transaction))
+		if err != nil {
+			return err
+		}
+
+		flags := byte(0)
+		if record.isHighPriority {
+			flags |= persistedFlagHighPriority
+		}
+		err = writer.WriteByte(flags)
+		if err != nil {
+			return err
+		}
+		err = binary.Write(writer, binary.LittleEndian, record.addedAtDAAScore)
+		if err != nil {
+			return err
+		}
+		err = binary.Write(writer, binary.LittleEndian, record.readyAtDAAScore)
+		if err != nil {
+			return err
+		}
+		err = binary.Write(writer, binary.LittleEndian, uint32(len(transactionBytes)))
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(transactionBytes)
+		if err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+func readPersistedTransactions(r io.Reader) ([]*persistedTransaction, error) {
+	reader := bufio.NewReader(r)
+
+	var magic [len(persistenceFileMagic)]byte
+	_, err := io.ReadFull(reader, magic[:])
+	if err != nil {
+		return nil, err
+	}
+	if magic != persistenceFileMagic {
+		return nil, errors.New("not a persisted mempool file")
+	}
+	var version uint32
+	err = binary.Read(reader, binary.LittleEndian, &version)
+	if err != nil {
+		return nil, err
+	}
+	if version != persistenceFileVersion {
+		return nil, errors.Errorf("unsupported persisted mempool version %d. Expected version: %d",
+			version, persistenceFileVersion)
+	}
+
+	var records []*persistedTransaction
+	for {
+		flags, err := reader.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+			return nil, err
+		}
+
+		record := &persistedTransaction{isHighPriority: flags&persistedFlagHighPriority != 0}
+		var length uint32
+		for _, value := range []any{&record.addedAtDAAScore, &record.readyAtDAAScore, &length} {
+			err = binary.Read(reader, binary.LittleEndian, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if length > maxPersistedTransactionSize {
+			return nil, errors.Errorf("persisted transaction of %d bytes is larger than the maximum of %d",
+				length, maxPersistedTransactionSize)
+		}
+		transactionBytes := make([]byte, length)
+		_, err = io.ReadFull(reader, transactionBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		dbTransaction := &serialization.DbTransaction{}
+		err = proto.Unmarshal(transactionBytes, dbTransaction)
+		if err != nil {
+			return nil, err
+		}
+		record.transaction, err = serialization.DbTransactionToDomainTransaction(dbTransaction)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+}
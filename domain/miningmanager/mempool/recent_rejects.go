@@ -0,0 +1,102 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// maximumRecentRejects bounds the number of transactions whose last reject reason is kept.
+// Once it's reached, the oldest reject is forgotten.
+const maximumRecentRejects = 10_000
+
+type recentReject struct {
+	code         RejectCode
+	reason       string
+	rejectedAtMs int64
+}
+
+// recentRejects remembers why transactions were recently rejected by the mempool or removed
+// from it without being accepted by the DAG, so that their status can still be reported
+// after they're gone
+type recentRejects struct {
+	rejects map[externalapi.DomainTransactionID]*recentReject
+	order   []externalapi.DomainTransactionID
+}
+
+func newRecentRejects() *recentRejects {
+	return &recentRejects{
+		rejects: make(map[externalapi.DomainTransactionID]*recentReject),
+	}
+}
+
+func (rr *recentRejects) add(transactionID *externalapi.DomainTransactionID, code RejectCode, reason string) {
+	reject := &recentReject{
+		code:         code,
+		reason:       reason,
+		rejectedAtMs: mstime.Now().UnixMilliseconds(),
+	}
+	if _, ok := rr.rejects[*transactionID]; ok {
+		rr.rejects[*transactionID] = reject
+		return
+	}
+
+	rr.rejects[*transactionID] = reject
+	rr.order = append(rr.order, *transactionID)
+	for len(rr.order) > maximumRecentRejects {
+		delete(rr.rejects, rr.order[0])
+		rr.order = rr.order[1:]
+	}
+}
+
+func (rr *recentRejects) get(transactionID *externalapi.DomainTransactionID) (*recentReject, bool) {
+	reject, ok := rr.rejects[*transactionID]
+	return reject, ok
+}
+
+// recordRejectedTransactions remembers the reject reason of the given transactions if err is
+// a rule error. Transactions that made it into the mempool regardless, for example because
+// they were already there, are skipped.
+func (mp *mempool) recordRejectedTransactions(transactions []*externalapi.DomainTransaction, err error) {
+	if !errors.As(err, &RuleError{}) {
+		return
+	}
+	code, _ := extractRejectCode(err)
+	for _, transaction := range transactions {
+		transactionID := consensushashing.TransactionID(transaction)
+		if _, ok := mp.transactionsPool.allTransactions[*transactionID]; ok {
+			continue
+		}
+		if _, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
+			continue
+		}
+		mp.recentRejects.add(transactionID, code, err.Error())
+	}
+}
+
+// recordRemovedTransaction remembers why the given transaction is about to be removed from
+// the mempool. If removeRedeemers is set, its redeemers in the transaction pool are recorded
+// as well.
+func (mp *mempool) recordRemovedTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool,
+	code RejectCode, reason string) {
+
+	if _, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
+		mp.recentRejects.add(transactionID, code, reason)
+		return
+	}
+	mempoolTransaction, ok := mp.transactionsPool.allTransactions[*transactionID]
+	if !ok {
+		return
+	}
+	mp.recentRejects.add(transactionID, code, reason)
+	if !removeRedeemers {
+		return
+	}
+	for _, redeemer := range mp.transactionsPool.getRedeemers(mempoolTransaction) {
+		mp.recentRejects.add(redeemer.TransactionID(), code,
+			fmt.Sprintf("ancestor %s was removed: %s", transactionID, reason))
+	}
+}
@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
@@ -128,6 +130,8 @@ func (mp *mempool) revalidateTransaction(transaction *model.MempoolTransaction)
 	}
 	if len(missingParents) > 0 {
 		log.Debugf("Removing transaction %s, it failed revalidation", transaction.TransactionID())
+		mp.recordRemovedTransaction(transaction.TransactionID(), false, RejectBadOrphan, fmt.Sprintf(
+			"failed revalidation: spends missing outpoints %s", summarizeMissingOutpoints(missingParents, 3)))
 		err := mp.removeTransaction(transaction.TransactionID(), false)
 		if err != nil {
 			return false, err
@@ -145,6 +149,9 @@ func (mp *mempool) revalidateTransaction(transaction *model.MempoolTransaction)
 				log.Debugf("Removing transaction %s, it failed full mempool revalidation: %s",
 					transaction.TransactionID(), err)
 			}
+			code, _ := extractRejectCode(err)
+			mp.recordRemovedTransaction(transaction.TransactionID(), true, code,
+				fmt.Sprintf("failed revalidation: %s", err))
 			err := mp.removeTransaction(transaction.TransactionID(), true)
 			if err != nil {
 				return false, err
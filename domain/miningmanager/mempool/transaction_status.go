@@ -0,0 +1,65 @@
+package mempool
+
+import (
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	miningmanagermodel "github.com/cryptix-network/cryptixd/domain/miningmanager/model"
+)
+
+func (mp *mempool) TransactionStatus(transactionID *externalapi.DomainTransactionID) (
+	miningmanagermodel.TransactionStatus, error) {
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionStatus(transactionID)
+}
+
+func (mp *mempool) transactionStatus(transactionID *externalapi.DomainTransactionID) (
+	miningmanagermodel.TransactionStatus, error) {
+
+	if mempoolTransaction, ok := mp.transactionsPool.allTransactions[*transactionID]; ok {
+		rank, _, err := mp.transactionsPool.transactionsOrderedByFeeRate.Rank(mempoolTransaction)
+		if err != nil {
+			return miningmanagermodel.TransactionStatus{}, err
+		}
+		slots := mp.transactionsPool.atomicSlotsByTransactionID[*transactionID]
+		atomicSlots := make([]string, 0, len(slots))
+		for _, slot := range slots {
+			atomicSlots = append(atomicSlots, slot.String())
+		}
+		return miningmanagermodel.TransactionStatus{
+			State:            miningmanagermodel.TransactionStateInMempool,
+			FeeRate:          transactionFeeRate(mempoolTransaction),
+			PackageFeeRate:   mp.transactionsPool.packageFeeRate(mempoolTransaction),
+			FeeRateRank:      uint64(rank),
+			TransactionCount: uint64(mp.transactionsPool.transactionCount()),
+			AtomicSlots:      atomicSlots,
+		}, nil
+	}
+
+	if orphanTransaction, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
+		// The inputs of an orphan are filled as the outpoints they spend become available, so
+		// the ones still missing are those without a UTXO entry
+		var missingOutpoints []*externalapi.DomainOutpoint
+		for _, input := range orphanTransaction.Transaction().Inputs {
+			if input.UTXOEntry == nil {
+				missingOutpoints = append(missingOutpoints, input.PreviousOutpoint.Clone())
+			}
+		}
+		return miningmanagermodel.TransactionStatus{
+			State:            miningmanagermodel.TransactionStateOrphan,
+			MissingOutpoints: missingOutpoints,
+		}, nil
+	}
+
+	if reject, ok := mp.recentRejects.get(transactionID); ok {
+		return miningmanagermodel.TransactionStatus{
+			State:        miningmanagermodel.TransactionStateRejected,
+			RejectCode:   reject.code.String(),
+			RejectReason: reject.reason,
+			RejectedAtMs: reject.rejectedAtMs,
+		}, nil
+	}
+
+	return miningmanagermodel.TransactionStatus{State: miningmanagermodel.TransactionStateUnknown}, nil
+}
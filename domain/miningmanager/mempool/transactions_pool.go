@@ -8,6 +8,7 @@ import (
 	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
 	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
 	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/cryptix-network/cryptixd/domain/miningmanager/model"
 )
 
 type transactionsPool struct {
@@ -18,6 +19,7 @@ type transactionsPool struct {
 	transactionsOrderedByFeeRate  model.TransactionsOrderedByFeeRate
 	atomicSlotOwners              map[atomicMempoolSlot]externalapi.DomainTransactionID
 	atomicSlotsByTransactionID    map[externalapi.DomainTransactionID][]atomicMempoolSlot
+	totalsByKind                  map[string]poolTotals
 	lastExpireScanDAAScore        uint64
 }
 
@@ -30,6 +32,7 @@ func newTransactionsPool(mp *mempool) *transactionsPool {
 		transactionsOrderedByFeeRate:  model.TransactionsOrderedByFeeRate{},
 		atomicSlotOwners:              map[atomicMempoolSlot]externalapi.DomainTransactionID{},
 		atomicSlotsByTransactionID:    map[externalapi.DomainTransactionID][]atomicMempoolSlot{},
+		totalsByKind:                  map[string]poolTotals{},
 		lastExpireScanDAAScore:        0,
 	}
 }
@@ -96,11 +99,17 @@ func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTran
 		tp.highPriorityTransactions[*transaction.TransactionID()] = transaction
 	}
 
+	tp.updateTotals(len(atomicSlots) > 0, transaction.Transaction().Mass, true)
+
 	return nil
 }
 
 func (tp *transactionsPool) removeTransaction(transaction *model.MempoolTransaction) error {
 	transactionID := *transaction.TransactionID()
+	if _, ok := tp.allTransactions[transactionID]; ok {
+		_, isAtomic := tp.atomicSlotsByTransactionID[transactionID]
+		tp.updateTotals(isAtomic, transaction.Transaction().Mass, false)
+	}
 	delete(tp.allTransactions, transactionID)
 	tp.removeAtomicSlots(transactionID)
 
@@ -176,6 +185,8 @@ func (tp *transactionsPool) expireOldTransactions() error {
 		daaScoreSinceAdded := virtualDAAScore - mempoolTransaction.AddedAtDAAScore()
 		log.Debugf("Removing transaction %s, because it expired. DAAScore moved by %d, expire interval: %d",
 			mempoolTransaction.TransactionID(), daaScoreSinceAdded, expireInterval)
+		tp.mempool.recordRemovedTransaction(mempoolTransaction.TransactionID(), true, RejectObsolete, fmt.Sprintf(
+			"expired from the mempool after the DAA score moved by %d", daaScoreSinceAdded))
 		err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true)
 		if err != nil {
 			return err
@@ -243,32 +254,21 @@ func (tp *transactionsPool) limitTransactionCount(admittedTransaction *model.Mem
 	}
 
 	for uint64(len(tp.allTransactions)) > tp.mempool.config.MaximumTransactionCount {
+		// Transactions are evicted by their package fee rate, so that a low-fee transaction
+		// isn't evicted while a high-fee descendant pays for it. The package fee rate of a
+		// transaction is never lower than its own fee rate, so the scan over the transactions
+		// ordered by fee rate can stop once their own fee rate reaches the best candidate's.
 		var transactionToRemove *model.MempoolTransaction
-		for {
-			if currentIndex >= len(tp.allTransactions) {
-				if !blockedByPolicy {
-					log.Warnf(
-						"Number of high-priority transactions in mempool (%d) is higher than maximum allowed (%d)",
-						len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
-					return nil
-				}
-				return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
-					"mempool is full and no removable low-priority transaction is available"))
-			}
-			transactionToRemove = tp.transactionsOrderedByFeeRate.GetByIndex(currentIndex)
-			if admittedTransactionID != nil && transactionToRemove.TransactionID().Equal(admittedTransactionID) {
-				if admittedTransaction.IsHighPriority() {
-					currentIndex++
-					continue
-				}
-				blockedByPolicy = true
-				return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
-					"transaction %s rejected: mempool is full and it would be the eviction candidate",
-					admittedTransactionID))
+		lowestPackageFeeRate := 0.0
+		for index := currentIndex; index < len(tp.allTransactions); index++ {
+			candidate := tp.transactionsOrderedByFeeRate.GetByIndex(index)
+			if transactionToRemove != nil && transactionFeeRate(candidate) >= lowestPackageFeeRate {
+				break
 			}
-			canRemove := !transactionToRemove.IsHighPriority()
-			if canRemove && len(admittedSlots) > 0 {
-				transactionSlots, err := atomicMempoolSlots(transactionToRemove.Transaction())
+			isAdmittedTransaction := admittedTransactionID != nil && candidate.TransactionID().Equal(admittedTransactionID)
+			canRemove := !candidate.IsHighPriority()
+			if canRemove && !isAdmittedTransaction && len(admittedSlots) > 0 {
+				transactionSlots, err := atomicMempoolSlots(candidate.Transaction())
 				if err != nil {
 					return err
 				}
@@ -277,18 +277,50 @@ func (tp *transactionsPool) limitTransactionCount(admittedTransaction *model.Mem
 					canRemove = false
 				}
 			}
-			if canRemove {
-				break
+			if !canRemove {
+				if transactionToRemove == nil {
+					currentIndex = index + 1
+				}
+				continue
+			}
+			packageFeeRate := tp.packageFeeRate(candidate)
+			if transactionToRemove == nil || packageFeeRate < lowestPackageFeeRate {
+				transactionToRemove = candidate
+				lowestPackageFeeRate = packageFeeRate
 			}
-			currentIndex++
+		}
+		if transactionToRemove != nil && admittedTransactionID != nil &&
+			transactionToRemove.TransactionID().Equal(admittedTransactionID) {
+			return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+				"transaction %s rejected: mempool is full and it would be the eviction candidate",
+				admittedTransactionID))
+		}
+		if transactionToRemove == nil {
+			if !blockedByPolicy {
+				log.Warnf(
+					"Number of high-priority transactions in mempool (%d) is higher than maximum allowed (%d)",
+					len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
+				return nil
+			}
+			return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+				"mempool is full and no removable low-priority transaction is available"))
 		}
 
 		log.Debugf("Removing transaction %s, because mempoolTransaction count (%d) exceeded the limit (%d)",
 			transactionToRemove.TransactionID(), len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
+		tp.mempool.recordRemovedTransaction(transactionToRemove.TransactionID(), true, RejectInsufficientFee, fmt.Sprintf(
+			"evicted from the full mempool with a package fee rate of %f", lowestPackageFeeRate))
 		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true)
 		if err != nil {
 			return err
 		}
+		if admittedTransactionID != nil {
+			if _, ok := tp.allTransactions[*admittedTransactionID]; !ok {
+				return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+					"transaction %s rejected: mempool is full and its package would be the eviction candidate",
+					admittedTransactionID))
+			}
+		}
 		if currentIndex >= len(tp.allTransactions) {
 			break
 		}
@@ -364,6 +396,109 @@ func (tp *transactionsPool) getAllTransactions() []*externalapi.DomainTransactio
 	return allTransactions
 }
 
+func (tp *transactionsPool) getTransactionsByShortID(shortIDs map[uint64]struct{},
+	shortID func(transactionHash *externalapi.DomainHash) uint64) []*externalapi.DomainTransaction {
+
+	var transactions []*externalapi.DomainTransaction
+	for _, mempoolTransaction := range tp.allTransactions {
+		if _, ok := shortIDs[shortID(mempoolTransaction.TransactionHash())]; ok {
+			transactions = append(transactions, mempoolTransaction.Transaction().Clone()) //this pointer leaves the mempool, hence we clone.
+		}
+	}
+	return transactions
+}
+
+func (tp *transactionsPool) transactionFeeRates() []miningmanagermodel.TransactionFeeRate {
+	feeRates := make([]miningmanagermodel.TransactionFeeRate, 0, len(tp.allTransactions))
+	for _, mempoolTransaction := range tp.allTransactions {
+		transaction := mempoolTransaction.Transaction()
+		if transaction.Mass == 0 {
+			continue
+		}
+		feeRates = append(feeRates, miningmanagermodel.TransactionFeeRate{
+			FeeRate: float64(transaction.Fee) / float64(transaction.Mass),
+			Mass:    transaction.Mass,
+		})
+	}
+	return feeRates
+}
+
 func (tp *transactionsPool) transactionCount() int {
 	return len(tp.allTransactions)
 }
+
+// packageEvictionCandidates returns the transactions limitTransactionCount would evict to
+// make room for a package whose transactions were just added to the pool, without touching
+// the pool. This way a package that doesn't fit is rejected before any unrelated transaction
+// is evicted for it.
+func (tp *transactionsPool) packageEvictionCandidates(packageTransactions []*model.MempoolTransaction) (
+	[]*model.MempoolTransaction, error) {
+
+	isPackageTransaction := make(map[externalapi.DomainTransactionID]struct{}, len(packageTransactions))
+	var packageSlots []atomicMempoolSlot
+	for _, packageTransaction := range packageTransactions {
+		isPackageTransaction[*packageTransaction.TransactionID()] = struct{}{}
+		slots, err := atomicMempoolSlots(packageTransaction.Transaction())
+		if err != nil {
+			return nil, err
+		}
+		packageSlots = append(packageSlots, slots...)
+	}
+
+	var candidates []*model.MempoolTransaction
+	evicted := make(map[externalapi.DomainTransactionID]struct{})
+	blockedByPolicy := false
+	for uint64(len(tp.allTransactions)-len(evicted)) > tp.mempool.config.MaximumTransactionCount {
+		var transactionToRemove *model.MempoolTransaction
+		lowestPackageFeeRate := 0.0
+		for index := 0; index < len(tp.allTransactions); index++ {
+			candidate := tp.transactionsOrderedByFeeRate.GetByIndex(index)
+			if transactionToRemove != nil && transactionFeeRate(candidate) >= lowestPackageFeeRate {
+				break
+			}
+			if _, ok := evicted[*candidate.TransactionID()]; ok {
+				continue
+			}
+			if candidate.IsHighPriority() {
+				continue
+			}
+			if _, ok := isPackageTransaction[*candidate.TransactionID()]; !ok && len(packageSlots) > 0 {
+				transactionSlots, err := atomicMempoolSlots(candidate.Transaction())
+				if err != nil {
+					return nil, err
+				}
+				if atomicSlotsBlockCapacityEviction(packageSlots, transactionSlots) {
+					blockedByPolicy = true
+					continue
+				}
+			}
+			packageFeeRate := tp.packageFeeRate(candidate)
+			if transactionToRemove == nil || packageFeeRate < lowestPackageFeeRate {
+				transactionToRemove = candidate
+				lowestPackageFeeRate = packageFeeRate
+			}
+		}
+		if transactionToRemove == nil {
+			if !blockedByPolicy {
+				log.Warnf(
+					"Number of high-priority transactions in mempool (%d) is higher than maximum allowed (%d)",
+					len(tp.allTransactions)-len(evicted), tp.mempool.config.MaximumTransactionCount)
+				return candidates, nil
+			}
+			return nil, transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+				"mempool is full and no removable low-priority transaction is available"))
+		}
+
+		removedTransactions := append([]*model.MempoolTransaction{transactionToRemove}, tp.getRedeemers(transactionToRemove)...)
+		for _, removedTransaction := range removedTransactions {
+			if _, ok := isPackageTransaction[*removedTransaction.TransactionID()]; ok {
+				return nil, transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+					"transaction %s rejected: mempool is full and its package would be the eviction candidate",
+					removedTransaction.TransactionID()))
+			}
+			evicted[*removedTransaction.TransactionID()] = struct{}{}
+		}
+		candidates = append(candidates, transactionToRemove)
+	}
+	return candidates, nil
+}
@@ -0,0 +1,227 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/consensushashing"
+	"github.com/cryptix-network/cryptixd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/cryptix-network/cryptixd/domain/miningmanager/model"
+	"github.com/cryptix-network/cryptixd/infrastructure/logger"
+)
+
+// A transaction package is a topologically sorted set of dependent transactions: every
+// transaction comes after the transactions it spends from, and after the lower nonces of its
+// CAT nonce key. The package is validated as a whole, so that a child doesn't have to wait in
+// the orphan pool for its parents, and a parent that pays less than the minimum relay fee is
+// accepted as long as its descendants in the package pay for it.
+//
+// A package is inserted atomically: if any of its transactions is rejected, none are kept.
+// Orphans that the package makes valid are only moved out of the orphan pool once the whole
+// package is in.
+
+func (mp *mempool) ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	acceptedTransactions, err = mp.validateAndInsertTransactionPackage(transactions, isHighPriority)
+	if err != nil {
+		mp.recordRejectedTransactions(transactions, err)
+	}
+	return acceptedTransactions, err
+}
+
+func (mp *mempool) validateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log,
+		fmt.Sprintf("validateAndInsertTransactionPackage of %d transactions", len(transactions)))
+	defer onEnd()
+
+	err = checkTransactionPackageTopology(transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	insertedTransactions := make([]*model.MempoolTransaction, 0, len(transactions))
+	isCommitted := false
+	defer func() {
+		if err == nil || isCommitted {
+			return
+		}
+		// Remove the inserted transactions, children first, so that no redeemer outside the
+		// package is touched
+		for i := len(insertedTransactions) - 1; i >= 0; i-- {
+			removeErr := mp.removeTransaction(insertedTransactions[i].TransactionID(), false)
+			if removeErr != nil {
+				log.Warnf("Failed to remove transaction %s of a rejected package: %s",
+					insertedTransactions[i].TransactionID(), removeErr)
+			}
+		}
+	}()
+
+	for _, transaction := range transactions {
+		transactionID := consensushashing.TransactionID(transaction)
+		if _, ok := mp.transactionsPool.allTransactions[*transactionID]; ok {
+			// Parts of a package may already have been relayed on their own
+			continue
+		}
+
+		mempoolTransaction, err := mp.insertPackageTransaction(transaction, isHighPriority)
+		if err != nil {
+			return nil, err
+		}
+		insertedTransactions = append(insertedTransactions, mempoolTransaction)
+	}
+
+	if !mp.config.AcceptNonStandard {
+		for _, mempoolTransaction := range insertedTransactions {
+			err = mp.checkPackageTransactionRelayFee(mempoolTransaction)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The transactions to evict for the package are picked before any of them is removed, so
+	// that a package that doesn't fit is rolled back without evicting unrelated transactions
+	evictionCandidates, err := mp.transactionsPool.packageEvictionCandidates(insertedTransactions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole package is in. The orphan pool is only touched from here on, so that a
+	// failure below never leaves orphans promoted on top of rolled back package transactions.
+	// Failures from here on are logged rather than returned, since the package stays in.
+	isCommitted = true
+
+	for _, evictionCandidate := range evictionCandidates {
+		if _, ok := mp.transactionsPool.allTransactions[*evictionCandidate.TransactionID()]; !ok {
+			// Already evicted as the redeemer of a previous candidate
+			continue
+		}
+		log.Debugf("Removing transaction %s to make room for a transaction package, because "+
+			"mempoolTransaction count (%d) exceeded the limit (%d)", evictionCandidate.TransactionID(),
+			len(mp.transactionsPool.allTransactions), mp.config.MaximumTransactionCount)
+		mp.recordRemovedTransaction(evictionCandidate.TransactionID(), true, RejectInsufficientFee, fmt.Sprintf(
+			"evicted from the full mempool with a package fee rate of %f",
+			mp.transactionsPool.packageFeeRate(evictionCandidate)))
+		removeErr := mp.removeTransaction(evictionCandidate.TransactionID(), true)
+		if removeErr != nil {
+			log.Warnf("Failed to evict transaction %s for a transaction package: %s",
+				evictionCandidate.TransactionID(), removeErr)
+		}
+	}
+
+	// Transactions of the package that were previously received on their own may be waiting in
+	// the orphan pool. They're in the transaction pool now.
+	for _, mempoolTransaction := range insertedTransactions {
+		if _, ok := mp.orphansPool.allOrphans[*mempoolTransaction.TransactionID()]; ok {
+			removeErr := mp.orphansPool.removeOrphan(mempoolTransaction.TransactionID(), false)
+			if removeErr != nil {
+				log.Warnf("Failed to remove orphan %s that was accepted in a transaction package: %s",
+					mempoolTransaction.TransactionID(), removeErr)
+			}
+		}
+	}
+
+	acceptedTransactions = make([]*externalapi.DomainTransaction, 0, len(insertedTransactions))
+	for _, mempoolTransaction := range insertedTransactions {
+		acceptedTransactions = append(acceptedTransactions, mempoolTransaction.Transaction().Clone())
+	}
+	for _, mempoolTransaction := range insertedTransactions {
+		acceptedOrphans, processErr := mp.orphansPool.processOrphansAfterAcceptedTransaction(mempoolTransaction.Transaction())
+		if processErr != nil {
+			log.Warnf("Failed to process the orphans of transaction %s of a transaction package: %s",
+				mempoolTransaction.TransactionID(), processErr)
+			continue
+		}
+		acceptedTransactions = append(acceptedTransactions, acceptedOrphans...)
+	}
+
+	return acceptedTransactions, nil
+}
+
+// insertPackageTransaction validates a transaction of a package and adds it to the transaction
+// pool. The minimum relay fee and the mempool size limit are checked once the whole package is
+// in.
+func (mp *mempool) insertPackageTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+	*model.MempoolTransaction, error) {
+
+	mp.consensusReference.Consensus().PopulateMass(transaction)
+
+	err := mp.validateTransactionPreUTXOEntry(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	parentsInPool, missingOutpoints, err := mp.fillInputsAndGetMissingParents(transaction)
+	if err != nil {
+		return nil, err
+	}
+	if len(missingOutpoints) > 0 {
+		return nil, transactionRuleError(RejectBadOrphan, fmt.Sprintf(
+			"package transaction %s spends missing outpoints %s",
+			consensushashing.TransactionID(transaction), summarizeMissingOutpoints(missingOutpoints, 3)))
+	}
+
+	err = mp.validateTransactionInContextIgnoringRelayFee(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return mp.transactionsPool.addTransaction(transaction, parentsInPool, isHighPriority)
+}
+
+// checkPackageTransactionRelayFee makes sure that a transaction of a package pays the minimum
+// relay fee, either by itself or together with its ancestors and one of its descendants
+func (mp *mempool) checkPackageTransactionRelayFee(mempoolTransaction *model.MempoolTransaction) error {
+	transaction := mempoolTransaction.Transaction()
+	minimumFee := mp.minimumRequiredTransactionRelayFee(transaction.Mass)
+	if transaction.Fee >= minimumFee {
+		return nil
+	}
+	packageFee := mp.transactionsPool.packageFeeRate(mempoolTransaction) * float64(transaction.Mass)
+	if packageFee >= float64(minimumFee) {
+		return nil
+	}
+	return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+		"transaction %s has %d fees which is under the required amount of %d, "+
+			"and no descendant in its package pays for it",
+		mempoolTransaction.TransactionID(), transaction.Fee, minimumFee))
+}
+
+// checkTransactionPackageTopology makes sure that the package isn't empty or too large, holds
+// no duplicates, and that no transaction spends from a transaction that comes after it
+func checkTransactionPackageTopology(transactions []*externalapi.DomainTransaction) error {
+	if len(transactions) == 0 {
+		return transactionRuleError(RejectInvalid, "transaction package is empty")
+	}
+	if len(transactions) > miningmanagermodel.MaxTransactionPackageTransactions {
+		return transactionRuleError(RejectInvalid, fmt.Sprintf(
+			"transaction package has %d transactions, which is more than the maximum of %d",
+			len(transactions), miningmanagermodel.MaxTransactionPackageTransactions))
+	}
+
+	positions := make(map[externalapi.DomainTransactionID]int, len(transactions))
+	for i, transaction := range transactions {
+		transactionID := consensushashing.TransactionID(transaction)
+		if _, ok := positions[*transactionID]; ok {
+			return transactionRuleError(RejectInvalid, fmt.Sprintf(
+				"transaction %s appears more than once in the package", transactionID))
+		}
+		positions[*transactionID] = i
+	}
+	for i, transaction := range transactions {
+		for _, input := range transaction.Inputs {
+			if parentPosition, ok := positions[input.PreviousOutpoint.TransactionID]; ok && parentPosition >= i {
+				return transactionRuleError(RejectInvalid, fmt.Sprintf(
+					"transaction package is not topologically sorted: %s spends from %s which comes after it",
+					consensushashing.TransactionID(transaction), input.PreviousOutpoint.TransactionID))
+			}
+		}
+	}
+	return nil
+}
@@ -45,6 +45,21 @@ func (mp *mempool) validateTransactionInIsolation(transaction *externalapi.Domai
 }
 
 func (mp *mempool) validateTransactionInContext(transaction *externalapi.DomainTransaction) error {
+	err := mp.validateTransactionInContextIgnoringRelayFee(transaction)
+	if err != nil {
+		return err
+	}
+
+	if !mp.config.AcceptNonStandard {
+		return mp.checkTransactionRelayFee(transaction)
+	}
+
+	return nil
+}
+
+// validateTransactionInContextIgnoringRelayFee runs all the checks of validateTransactionInContext
+// other than the minimum relay fee, which a transaction package checks for the package as a whole
+func (mp *mempool) validateTransactionInContextIgnoringRelayFee(transaction *externalapi.DomainTransaction) error {
 	hasCoinbaseInput := false
 	for _, input := range transaction.Inputs {
 		if input.UTXOEntry.IsCoinbase() {
@@ -30,19 +30,28 @@ type MiningManager interface {
 		transactionPoolTransactions []*externalapi.DomainTransaction,
 		orphanPoolTransactions []*externalapi.DomainTransaction)
 	TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int
+	TransactionsByShortID(shortIDs map[uint64]struct{},
+		shortID func(transactionHash *externalapi.DomainHash) uint64) []*externalapi.DomainTransaction
+	TransactionStatus(transactionID *externalapi.DomainTransactionID) (miningmanagermodel.TransactionStatus, error)
+	GetFeeEstimate() FeeEstimate
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	HandleAcceptedTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, err error)
 	RevalidateOrphanTransactions() (acceptedTransactions []*externalapi.DomainTransaction, err error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
 	ExpireLowPriorityTransactions() (expiredTransactions int, expiredOrphans int, err error)
+	SaveMempool(path string) (savedTransactions int, err error)
+	LoadMempool(path string) (loadedTransactions int, err error)
 }
 
 type miningManager struct {
 	consensusReference   consensusreference.ConsensusReference
 	mempool              miningmanagermodel.Mempool
 	blockTemplateBuilder miningmanagermodel.BlockTemplateBuilder
+	feeEstimator         *feeEstimator
 	cachedBlockTemplate  *externalapi.DomainBlockTemplate
 	cachingTime          time.Time
 	cacheLock            *sync.Mutex
@@ -81,6 +90,7 @@ func (mm *miningManager) GetBlockTemplate(coinbaseData *externalapi.DomainCoinba
 	}
 	// Cache the built template
 	mm.setImmutableCachedTemplate(blockTemplate)
+	mm.feeEstimator.observeBlockTemplate(blockTemplate.Block)
 	if payloadCount := countTemplatePayloadTransactions(blockTemplate.Block); payloadCount > 0 {
 		log.Debugf("Built new block template with payload/CAT transactions: payload_txs=%d total_txs=%d is_nearly_synced=%t",
 			payloadCount, len(blockTemplate.Block.Transactions), blockTemplate.IsNearlySynced)
@@ -145,6 +155,15 @@ func (mm *miningManager) ValidateAndInsertTransaction(transaction *externalapi.D
 	return mm.mempool.ValidateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+// ValidateAndInsertTransactionPackage validates the given topologically sorted
+// transactions together, and adds them to the set of known transactions that
+// have not yet been added to any block. Either all of them are added or none.
+func (mm *miningManager) ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return mm.mempool.ValidateAndInsertTransactionPackage(transactions, isHighPriority)
+}
+
 func (mm *miningManager) RevalidateOrphanTransactions() (
 	acceptedTransactions []*externalapi.DomainTransaction, err error) {
 
@@ -179,10 +198,32 @@ func (mm *miningManager) GetTransactionsByAddresses(includeTransactionPool bool,
 	return mm.mempool.GetTransactionsByAddresses(includeTransactionPool, includeOrphanPool)
 }
 
+// TransactionsByShortID returns the mempool and orphan pool transactions whose short ID, as computed
+// by the given function out of the transaction hash, is one of the given short IDs
+func (mm *miningManager) TransactionsByShortID(shortIDs map[uint64]struct{},
+	shortID func(transactionHash *externalapi.DomainHash) uint64) []*externalapi.DomainTransaction {
+
+	return mm.mempool.TransactionsByShortID(shortIDs, shortID)
+}
+
 func (mm *miningManager) TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int {
 	return mm.mempool.TransactionCount(includeTransactionPool, includeOrphanPool)
 }
 
+// TransactionStatus returns where the given transaction is in the mempool, or the reason it
+// was last rejected or removed from it
+func (mm *miningManager) TransactionStatus(transactionID *externalapi.DomainTransactionID) (
+	miningmanagermodel.TransactionStatus, error) {
+
+	return mm.mempool.TransactionStatus(transactionID)
+}
+
+// GetFeeEstimate estimates the fee rates required for a transaction to be
+// included in the DAG within various time frames
+func (mm *miningManager) GetFeeEstimate() FeeEstimate {
+	return mm.feeEstimator.estimate(mm.mempool.TransactionFeeRates())
+}
+
 func (mm *miningManager) RevalidateHighPriorityTransactions() (
 	validTransactions []*externalapi.DomainTransaction, err error) {
 
@@ -194,3 +235,14 @@ func (mm *miningManager) ExpireLowPriorityTransactions() (
 
 	return mm.mempool.ExpireLowPriorityTransactions()
 }
+
+// SaveMempool writes all the transactions of the mempool to the file at the given path
+func (mm *miningManager) SaveMempool(path string) (savedTransactions int, err error) {
+	return mm.mempool.SaveToFile(path)
+}
+
+// LoadMempool revalidates the transactions saved by SaveMempool into the mempool, and removes
+// the file. It's a no-op if the file doesn't exist.
+func (mm *miningManager) LoadMempool(path string) (loadedTransactions int, err error) {
+	return mm.mempool.LoadFromFile(path)
+}
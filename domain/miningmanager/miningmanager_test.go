@@ -2,6 +2,8 @@ package miningmanager_test
 
 import (
 	"encoding/binary"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -83,6 +85,51 @@ func TestValidateAndInsertTransaction(t *testing.T) {
 	})
 }
 
+func TestTransactionsByShortID(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestTransactionsByShortID")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+
+		// Short IDs are assigned by insertion order
+		shortIDs := make(map[externalapi.DomainHash]uint64)
+		transactionsToInsert := make([]*externalapi.DomainTransaction, 3)
+		for i := range transactionsToInsert {
+			transactionsToInsert[i] = createTransactionWithUTXOEntry(t, i, 0)
+			shortIDs[*consensushashing.TransactionHash(transactionsToInsert[i])] = uint64(i)
+			_, err = miningManager.ValidateAndInsertTransaction(transactionsToInsert[i], false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+		}
+		shortID := func(transactionHash *externalapi.DomainHash) uint64 {
+			return shortIDs[*transactionHash]
+		}
+
+		transactions := miningManager.TransactionsByShortID(map[uint64]struct{}{1: {}, 5: {}}, shortID)
+		if len(transactions) != 1 {
+			t.Fatalf("Expected a single transaction to match, got %d", len(transactions))
+		}
+		if !consensushashing.TransactionID(transactions[0]).Equal(consensushashing.TransactionID(transactionsToInsert[1])) {
+			t.Fatalf("Expected transaction %s to match, got %s",
+				consensushashing.TransactionID(transactionsToInsert[1]), consensushashing.TransactionID(transactions[0]))
+		}
+		if transactions := miningManager.TransactionsByShortID(map[uint64]struct{}{5: {}}, shortID); len(transactions) != 0 {
+			t.Fatalf("Expected no transaction to match, got %d", len(transactions))
+		}
+	})
+}
+
 func TestPayloadTransactionTemplateBuild(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
 		consensusConfig.BlockCoinbaseMaturity = 0
@@ -1884,6 +1931,402 @@ func TestRevalidateHighPriorityTransactionsWithChain(t *testing.T) {
 }
 
 // TestModifyBlockTemplate verifies that modifying a block template changes coinbase data correctly.
+func TestSaveAndLoadMempool(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		consensusConfig.PayloadHfActivationDAAScore = 0
+
+		miningManager, tc := newTestMiningManagerWithConfig(t, consensusConfig, "TestSaveAndLoadMempool", nil)
+
+		chain, err := createTxChain(tc, 3)
+		if err != nil {
+			t.Fatalf("createTxChain: %v", err)
+		}
+		orphanChain, err := createTxChain(tc, 2)
+		if err != nil {
+			t.Fatalf("createTxChain: %v", err)
+		}
+		orphanTransaction := orphanChain[1]
+
+		// A CAT nonce chain whose transactions are spent from each other, so the
+		// nonce order and the UTXO order of the chain agree
+		var assetID [externalapi.DomainHashSize]byte
+		assetID[0] = 0x40
+		catFunding, err := createReadyTransactionFromConsensusFunding(tc)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFunding: %v", err)
+		}
+		catChain := []*externalapi.DomainTransaction{catFunding}
+		for nonce := uint64(1); nonce <= 2; nonce++ {
+			catTransaction, err := testutils.CreateTransaction(catChain[len(catChain)-1], 1000)
+			if err != nil {
+				t.Fatalf("CreateTransaction: %v", err)
+			}
+			catTransaction.SubnetworkID = subnetworks.SubnetworkIDPayload
+			catTransaction.Payload = createCATTransferPayload(assetID, nonce)
+			catChain = append(catChain, catTransaction)
+		}
+
+		transactionsToInsert := append(append([]*externalapi.DomainTransaction{}, chain...), catChain...)
+		for _, transaction := range transactionsToInsert {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(orphanTransaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		expectedTransactions, expectedOrphans := miningManager.AllTransactions(true, true)
+		if len(expectedTransactions) != len(transactionsToInsert) || len(expectedOrphans) != 1 {
+			t.Fatalf("Expected %d transactions and 1 orphan before saving, got %d and %d",
+				len(transactionsToInsert), len(expectedTransactions), len(expectedOrphans))
+		}
+
+		path := filepath.Join(t.TempDir(), "mempool.dat")
+		savedTransactions, err := miningManager.SaveMempool(path)
+		if err != nil {
+			t.Fatalf("SaveMempool: %v", err)
+		}
+		if savedTransactions != len(transactionsToInsert)+1 {
+			t.Fatalf("Expected %d saved transactions, got %d", len(transactionsToInsert)+1, savedTransactions)
+		}
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		restartedMiningManager := miningmanager.NewFactory().NewMiningManager(
+			consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		loadedTransactions, err := restartedMiningManager.LoadMempool(path)
+		if err != nil {
+			t.Fatalf("LoadMempool: %v", err)
+		}
+		if loadedTransactions != savedTransactions {
+			t.Fatalf("Expected %d loaded transactions, got %d", savedTransactions, loadedTransactions)
+		}
+
+		transactions, orphans := restartedMiningManager.AllTransactions(true, true)
+		if len(transactions) != len(expectedTransactions) {
+			t.Fatalf("Expected %d transactions after loading, got %d", len(expectedTransactions), len(transactions))
+		}
+		for _, transaction := range expectedTransactions {
+			if !contains(transaction, transactions) {
+				t.Fatalf("Missing transaction %s after loading", consensushashing.TransactionID(transaction))
+			}
+		}
+		if len(orphans) != 1 || !contains(orphanTransaction, orphans) {
+			t.Fatalf("Expected the orphan %s after loading, got %s",
+				consensushashing.TransactionID(orphanTransaction), consensushashing.TransactionIDs(orphans))
+		}
+
+		// The file is removed once it's loaded, and a missing file is not an error
+		for _, loadedPath := range []string{path, path + ".loading"} {
+			if _, err := os.Stat(loadedPath); !os.IsNotExist(err) {
+				t.Fatalf("Expected %s to be removed after loading, got %v", loadedPath, err)
+			}
+		}
+		loadedTransactions, err = restartedMiningManager.LoadMempool(path)
+		if err != nil {
+			t.Fatalf("LoadMempool: %v", err)
+		}
+		if loadedTransactions != 0 {
+			t.Fatalf("Expected a loaded mempool file to be removed, but %d transactions were loaded again",
+				loadedTransactions)
+		}
+	})
+}
+
+func TestLoadMempoolRespectsExpiry(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+
+		configureMempool := func(config *mempool.Config) {
+			config.TransactionExpireIntervalDAAScore = 5
+			config.TransactionExpireScanIntervalDAAScore = 0
+		}
+		miningManager, tc := newTestMiningManagerWithConfig(t, consensusConfig, "TestLoadMempoolRespectsExpiry", configureMempool)
+
+		transaction, err := createReadyTransactionFromConsensusFunding(tc)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFunding: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		path := filepath.Join(t.TempDir(), "mempool.dat")
+		_, err = miningManager.SaveMempool(path)
+		if err != nil {
+			t.Fatalf("SaveMempool: %v", err)
+		}
+
+		// The node keeps moving while the transaction isn't in any mempool
+		tips, err := tc.Tips()
+		if err != nil {
+			t.Fatalf("Tips: %v", err)
+		}
+		for i := 0; i < 6; i++ {
+			tip, _, err := tc.AddBlock(tips, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %v", err)
+			}
+			tips = []*externalapi.DomainHash{tip}
+		}
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		configureMempool(mempoolConfig)
+		restartedMiningManager := miningmanager.NewFactory().NewMiningManager(
+			consensusReference, &consensusConfig.Params, mempoolConfig)
+		_, err = restartedMiningManager.LoadMempool(path)
+		if err != nil {
+			t.Fatalf("LoadMempool: %v", err)
+		}
+		if count := restartedMiningManager.TransactionCount(true, true); count != 0 {
+			t.Fatalf("Expected the expired transaction not to be restored, but the mempool has %d transactions", count)
+		}
+	})
+}
+
+func TestValidateAndInsertTransactionPackage(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		consensusConfig.PayloadHfActivationDAAScore = 0
+
+		miningManager, tc := newTestMiningManagerWithConfig(t, consensusConfig, "TestValidateAndInsertTransactionPackage", nil)
+
+		// A parent below the minimum relay fee is rejected on its own
+		parentTransaction, err := createReadyTransactionFromConsensusFundingWithFee(tc, 1)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFundingWithFee: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(parentTransaction.Clone(), false, true)
+		txRuleError := &mempool.TxRuleError{}
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInsufficientFee {
+			t.Fatalf("Expected the low-fee parent to be rejected with RejectInsufficientFee, got %v", err)
+		}
+
+		// A child that doesn't pay for its parent doesn't get the package in, and leaves nothing behind
+		lowFeeChildTransaction, err := testutils.CreateTransaction(parentTransaction, 1)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransactionPackage(
+			[]*externalapi.DomainTransaction{parentTransaction.Clone(), lowFeeChildTransaction}, false)
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInsufficientFee {
+			t.Fatalf("Expected the underpaying package to be rejected with RejectInsufficientFee, got %v", err)
+		}
+		if transactionCount := miningManager.TransactionCount(true, true); transactionCount != 0 {
+			t.Fatalf("Expected a rejected package to leave the mempool empty, got %d transactions", transactionCount)
+		}
+
+		childTransaction, err := testutils.CreateTransaction(parentTransaction, 100_000)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransactionPackage(
+			[]*externalapi.DomainTransaction{childTransaction, parentTransaction.Clone()}, false)
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInvalid {
+			t.Fatalf("Expected an unsorted package to be rejected with RejectInvalid, got %v", err)
+		}
+
+		// A grandchild relayed on its own waits in the orphan pool, and is only moved out of it
+		// once the whole package is in
+		grandchildTransaction, err := testutils.CreateTransaction(childTransaction, 100_000)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(grandchildTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		_, isOrphan, found := miningManager.GetTransaction(consensushashing.TransactionID(grandchildTransaction), true, true)
+		if !found || !isOrphan {
+			t.Fatalf("Expected the grandchild in the orphan pool")
+		}
+
+		acceptedTransactions, err := miningManager.ValidateAndInsertTransactionPackage(
+			[]*externalapi.DomainTransaction{parentTransaction, childTransaction}, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionPackage: %v", err)
+		}
+		if len(acceptedTransactions) != 3 {
+			t.Fatalf("Expected 3 accepted transactions, got %d", len(acceptedTransactions))
+		}
+		for _, transaction := range []*externalapi.DomainTransaction{parentTransaction, childTransaction, grandchildTransaction} {
+			_, isOrphan, found := miningManager.GetTransaction(consensushashing.TransactionID(transaction), true, true)
+			if !found || isOrphan {
+				t.Fatalf("Expected transaction %s in the transaction pool",
+					consensushashing.TransactionID(transaction))
+			}
+		}
+	})
+}
+
+func TestValidateAndInsertTransactionPackageWithCATNonceChain(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		consensusConfig.PayloadHfActivationDAAScore = 0
+
+		miningManager, tc := newTestMiningManagerWithConfig(t, consensusConfig,
+			"TestValidateAndInsertTransactionPackageWithCATNonceChain", nil)
+
+		var assetID [externalapi.DomainHashSize]byte
+		assetID[0] = 0x41
+		catFunding, err := createReadyTransactionFromConsensusFunding(tc)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFunding: %v", err)
+		}
+		catPackage := []*externalapi.DomainTransaction{catFunding}
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			fee := uint64(1)
+			if nonce == 3 {
+				fee = 100_000
+			}
+			catTransaction, err := testutils.CreateTransaction(catPackage[len(catPackage)-1], fee)
+			if err != nil {
+				t.Fatalf("CreateTransaction: %v", err)
+			}
+			catTransaction.SubnetworkID = subnetworks.SubnetworkIDPayload
+			catTransaction.Payload = createCATTransferPayload(assetID, nonce)
+			catPackage = append(catPackage, catTransaction)
+		}
+
+		// Relayed one by one, the later nonces would wait in the orphan pool for the low-fee
+		// nonce that is rejected
+		_, err = miningManager.ValidateAndInsertTransactionPackage(catPackage, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionPackage: %v", err)
+		}
+		transactions, orphans := miningManager.AllTransactions(true, true)
+		if len(transactions) != len(catPackage) || len(orphans) != 0 {
+			t.Fatalf("Expected %d transactions and no orphans, got %d and %d",
+				len(catPackage), len(transactions), len(orphans))
+		}
+
+		// Resubmitting a package that is already in the mempool accepts nothing new
+		acceptedTransactions, err := miningManager.ValidateAndInsertTransactionPackage(catPackage, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionPackage: %v", err)
+		}
+		if len(acceptedTransactions) != 0 {
+			t.Fatalf("Expected no newly accepted transactions, got %d", len(acceptedTransactions))
+		}
+	})
+}
+
+func TestTransactionStatus(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		consensusConfig.PayloadHfActivationDAAScore = 0
+
+		miningManager, tc := newTestMiningManagerWithConfig(t, consensusConfig, "TestTransactionStatus", nil)
+
+		lowFeeRateTransaction, err := createReadyTransactionFromConsensusFundingWithFee(tc, 1000)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFundingWithFee: %v", err)
+		}
+		highFeeRateTransaction, err := createReadyTransactionFromConsensusFundingWithFee(tc, 100_000)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFundingWithFee: %v", err)
+		}
+		for _, transaction := range []*externalapi.DomainTransaction{lowFeeRateTransaction, highFeeRateTransaction} {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+		}
+		for expectedRank, transaction := range []*externalapi.DomainTransaction{highFeeRateTransaction, lowFeeRateTransaction} {
+			status, err := miningManager.TransactionStatus(consensushashing.TransactionID(transaction))
+			if err != nil {
+				t.Fatalf("TransactionStatus: %v", err)
+			}
+			if status.State != model.TransactionStateInMempool {
+				t.Fatalf("Expected transaction %s to be in the mempool, got %s",
+					consensushashing.TransactionID(transaction), status.State)
+			}
+			if status.FeeRateRank != uint64(expectedRank+1) || status.TransactionCount != 2 {
+				t.Fatalf("Expected transaction %s to rank %d of 2, got %d of %d",
+					consensushashing.TransactionID(transaction), expectedRank+1, status.FeeRateRank, status.TransactionCount)
+			}
+		}
+
+		// A child of a transaction the mempool doesn't know waits for the parent's output
+		unknownParentTransaction, err := createReadyTransactionFromConsensusFunding(tc)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFunding: %v", err)
+		}
+		orphanTransaction, err := testutils.CreateTransaction(unknownParentTransaction, 1000)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(orphanTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		status, err := miningManager.TransactionStatus(consensushashing.TransactionID(orphanTransaction))
+		if err != nil {
+			t.Fatalf("TransactionStatus: %v", err)
+		}
+		expectedMissingOutpoint := externalapi.DomainOutpoint{
+			TransactionID: *consensushashing.TransactionID(unknownParentTransaction),
+			Index:         0,
+		}
+		if status.State != model.TransactionStateOrphan || len(status.MissingOutpoints) != 1 ||
+			!status.MissingOutpoints[0].Equal(&expectedMissingOutpoint) {
+			t.Fatalf("Expected the orphan to miss %s, got state %s with missing outpoints %v",
+				expectedMissingOutpoint, status.State, status.MissingOutpoints)
+		}
+
+		// A rejected transaction is reported with its reject code until it's accepted
+		rejectedTransaction, err := createReadyTransactionFromConsensusFundingWithFee(tc, 1)
+		if err != nil {
+			t.Fatalf("createReadyTransactionFromConsensusFundingWithFee: %v", err)
+		}
+		_, rejectErr := miningManager.ValidateAndInsertTransaction(rejectedTransaction, false, true)
+		if rejectErr == nil {
+			t.Fatalf("Expected the low-fee transaction to be rejected")
+		}
+		status, err = miningManager.TransactionStatus(consensushashing.TransactionID(rejectedTransaction))
+		if err != nil {
+			t.Fatalf("TransactionStatus: %v", err)
+		}
+		if status.State != model.TransactionStateRejected ||
+			status.RejectCode != mempool.RejectInsufficientFee.String() || status.RejectReason != rejectErr.Error() {
+			t.Fatalf("Expected the low-fee transaction to be rejected with %s, got state %s with %s: %s",
+				mempool.RejectInsufficientFee, status.State, status.RejectCode, status.RejectReason)
+		}
+
+		// A transaction that is removed as a double spend of an accepted transaction is
+		// reported as rejected
+		doubleSpendTransaction := createTransactionWithUTXOEntry(t, 0, 0)
+		doubleSpendTransaction.Inputs[0].PreviousOutpoint = lowFeeRateTransaction.Inputs[0].PreviousOutpoint
+		_, err = miningManager.HandleAcceptedTransactions([]*externalapi.DomainTransaction{doubleSpendTransaction})
+		if err != nil {
+			t.Fatalf("HandleAcceptedTransactions: %v", err)
+		}
+		status, err = miningManager.TransactionStatus(consensushashing.TransactionID(lowFeeRateTransaction))
+		if err != nil {
+			t.Fatalf("TransactionStatus: %v", err)
+		}
+		if status.State != model.TransactionStateRejected || status.RejectCode != mempool.RejectDuplicate.String() {
+			t.Fatalf("Expected the double spent transaction to be rejected with %s, got state %s with %s",
+				mempool.RejectDuplicate, status.State, status.RejectCode)
+		}
+
+		status, err = miningManager.TransactionStatus(&externalapi.DomainTransactionID{})
+		if err != nil {
+			t.Fatalf("TransactionStatus: %v", err)
+		}
+		if status.State != model.TransactionStateUnknown {
+			t.Fatalf("Expected an unknown transaction, got state %s", status.State)
+		}
+	})
+}
+
 func TestModifyBlockTemplate(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
 		consensusConfig.BlockCoinbaseMaturity = 0
@@ -0,0 +1,8 @@
+package model
+
+// TransactionFeeRate describes the fee rate and mass of a single transaction
+// in the mempool. FeeRate is measured in sompi/gram.
+type TransactionFeeRate struct {
+	FeeRate float64
+	Mass    uint64
+}
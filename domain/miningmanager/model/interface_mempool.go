@@ -11,8 +11,11 @@ type Mempool interface {
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	HandleAcceptedTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	BlockCandidateTransactions() []*externalapi.DomainTransaction
+	BlockCandidatePackageFeeRates() map[externalapi.DomainTransactionID]float64
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, err error)
 	RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransactionsInNewBlock) error
 	GetTransaction(
 		transactionID *externalapi.DomainTransactionID,
@@ -39,8 +42,16 @@ type Mempool interface {
 	TransactionCount(
 		includeTransactionPool bool,
 		includeOrphanPool bool) int
+	TransactionsByShortID(
+		shortIDs map[uint64]struct{},
+		shortID func(transactionHash *externalapi.DomainHash) uint64,
+	) []*externalapi.DomainTransaction
+	TransactionFeeRates() []TransactionFeeRate
+	TransactionStatus(transactionID *externalapi.DomainTransactionID) (TransactionStatus, error)
 	RevalidateOrphanTransactions() (acceptedTransactions []*externalapi.DomainTransaction, err error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
 	ExpireLowPriorityTransactions() (expiredTransactions int, expiredOrphans int, err error)
 	IsTransactionOutputDust(output *externalapi.DomainTransactionOutput) bool
+	SaveToFile(path string) (savedTransactions int, err error)
+	LoadFromFile(path string) (loadedTransactions int, err error)
 }
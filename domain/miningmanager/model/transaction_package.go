@@ -0,0 +1,5 @@
+package model
+
+// MaxTransactionPackageTransactions is the maximum number of transactions in a
+// transaction package the mempool accepts
+const MaxTransactionPackageTransactions = 25
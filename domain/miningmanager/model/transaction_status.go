@@ -0,0 +1,64 @@
+package model
+
+import "github.com/cryptix-network/cryptixd/domain/consensus/model/externalapi"
+
+// TransactionState is the state of a transaction as seen by the mempool
+type TransactionState byte
+
+const (
+	// TransactionStateUnknown means that the mempool neither holds the transaction nor
+	// remembers rejecting it
+	TransactionStateUnknown TransactionState = iota
+
+	// TransactionStateInMempool means that the transaction is in the transaction pool
+	TransactionStateInMempool
+
+	// TransactionStateOrphan means that the transaction is in the orphan pool, waiting for
+	// the outpoints it spends
+	TransactionStateOrphan
+
+	// TransactionStateRejected means that the transaction was recently rejected by the
+	// mempool, or removed from it without being accepted by the DAG
+	TransactionStateRejected
+)
+
+var transactionStateStrings = map[TransactionState]string{
+	TransactionStateUnknown:   "unknown",
+	TransactionStateInMempool: "in_mempool",
+	TransactionStateOrphan:    "orphan",
+	TransactionStateRejected:  "rejected",
+}
+
+// String returns the TransactionState in human-readable form
+func (state TransactionState) String() string {
+	if s, ok := transactionStateStrings[state]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// TransactionStatus describes where a transaction is in the mempool. Only the fields
+// relevant to State are set.
+type TransactionStatus struct {
+	State TransactionState
+
+	// FeeRateRank is the 1-based position of the transaction among the transactions of the
+	// pool, ordered by fee rate from the highest. FeeRate and PackageFeeRate are measured in
+	// sompi/gram, and AtomicSlots are the CAT nonce and liquidity pool slots the transaction
+	// holds.
+	FeeRate          float64
+	PackageFeeRate   float64
+	FeeRateRank      uint64
+	TransactionCount uint64
+	AtomicSlots      []string
+
+	// MissingOutpoints are the outpoints an orphan spends that are neither in the UTXO set nor
+	// created by a transaction in the pool
+	MissingOutpoints []*externalapi.DomainOutpoint
+
+	// RejectCode, RejectReason and RejectedAtMs describe the last time the transaction was
+	// rejected or removed
+	RejectCode   string
+	RejectReason string
+	RejectedAtMs int64
+}
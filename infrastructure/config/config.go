@@ -7,6 +7,7 @@ package config
 import (
 	// _ "embed" is necessary for the go:embed feature.
 	_ "embed"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"github.com/cryptix-network/cryptixd/util"
 	"github.com/cryptix-network/cryptixd/util/network"
 	"github.com/cryptix-network/cryptixd/version"
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 )
@@ -54,7 +56,12 @@ const (
 	defaultSigCacheMaxSize  = 100_000
 	sampleConfigFilename    = "sample-cryptixd.conf"
 	defaultMaxUTXOCacheSize = 5_000_000_000
-	defaultProtocolVersion  = 18
+	defaultProtocolVersion  = 20
+
+	// defaultTxStatusAcceptanceDepth and txStatusAcceptanceDepthMax bound the number of
+	// chain blocks getTransactionStatus reads per call to find an accepted transaction
+	defaultTxStatusAcceptanceDepth = 100
+	txStatusAcceptanceDepthMax     = 1000
 )
 
 var (
@@ -78,69 +85,84 @@ var RunServiceCommand func(string) error
 //
 // See loadConfig for details on the configuration load process.
 type Flags struct {
-	ShowVersion                         bool          `short:"V" long:"version" description:"Display version information and exit"`
-	ConfigFile                          string        `short:"C" long:"configfile" description:"Path to configuration file"`
-	AppDir                              string        `short:"b" long:"appdir" description:"Directory to store data"`
-	LogDir                              string        `long:"logdir" description:"Directory to log output."`
-	AddPeers                            []string      `short:"a" long:"addpeer" description:"Add a peer to connect with at startup"`
-	ConnectPeers                        []string      `long:"connect" description:"Connect only to the specified peers at startup"`
-	DisableListen                       bool          `long:"nolisten" description:"Disable listening for incoming connections -- NOTE: Listening is automatically disabled if the --connect or --proxy options are used without also specifying listen interfaces via --listen"`
-	Listeners                           []string      `long:"listen" description:"Add an interface/port to listen for connections (default all interfaces port: 19101, testnet: 19102)"`
-	TargetOutboundPeers                 int           `long:"outpeers" description:"Target number of outbound peers"`
-	MaxInboundPeers                     int           `long:"maxinpeers" description:"Max number of inbound peers"`
-	EnableBanning                       bool          `long:"enablebanning" description:"Enable banning of misbehaving peers"`
-	BanDuration                         time.Duration `long:"banduration" description:"How long to ban misbehaving peers. Valid time units are {s, m, h}. Minimum 1 second"`
-	BanThreshold                        uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
-	EnableExternalBanlist               bool          `long:"external-banlist" description:"Enable external antifraud connection banlist seed synchronization (IP and node ID)"`
-	EnableBanserver                     bool          `long:"banserver" description:"Enable the primary AntiFraud seed endpoint for the signed connection banlist"`
-	DisableExternalBanlist              bool          `long:"no-external-banlist" description:"Disable the AntiFraud seed endpoint and use peer-majority snapshots only"`
-	DisableBanserver                    bool          `long:"no-banserver" description:"Disable the AntiFraud seed endpoint and use peer-majority snapshots only"`
-	AntiFraudNoSeed                     bool          `long:"antifraud-no-seed" description:"Disable the AntiFraud seed endpoint and use peer-majority snapshots only"`
-	Whitelists                          []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned. (eg. 192.168.1.0/24 or ::1)"`
-	RPCListeners                        []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 19201, testnet: 19202)"`
-	RPCCert                             string        `long:"rpccert" description:"File containing the certificate file"`
-	RPCKey                              string        `long:"rpckey" description:"File containing the certificate key"`
-	RPCMaxClients                       int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
-	RPCMaxWebsockets                    int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
-	RPCMaxConcurrentReqs                int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
-	DisableRPC                          bool          `long:"norpc" description:"Disable built-in RPC server"`
-	SafeRPC                             bool          `long:"saferpc" description:"Disable RPC commands which affect the state of the node"`
-	AllowRPCOrphans                     bool          `long:"allow-rpc-orphans" hidden:"true" description:"Allow RPC-submitted transactions to enter the orphan transaction pool"`
-	DisableDNSSeed                      bool          `long:"nodnsseed" description:"Disable DNS seeding for peers"`
-	DNSSeed                             string        `long:"dnsseed" description:"Override DNS seeds with specified hostname (Only 1 hostname allowed)"`
-	GRPCSeed                            string        `long:"grpcseed" description:"Hostname of gRPC server for seeding peers"`
-	AtomicBootstrapPeers                []string      `long:"atomic-bootstrap-peer" description:"Optional Atomic bootstrap RPC peer source. Accepted for CLI compatibility; P2P Atomic audit does not require RPC."`
-	AtomicBootstrapAllowPeerFallback    bool          `long:"atomic-bootstrap-allow-peer-fallback" description:"Allow peer-only Atomic P2P bootstrap/audit when DNS seed bootstrap is disabled"`
-	AtomicBootstrapPeerQuorumMinSources uint          `long:"atomic-bootstrap-peer-quorum-min-sources" description:"Minimum independent peer/non-seed sources required for Atomic P2P bootstrap/audit"`
-	DisableAtomicHealthAudit            bool          `long:"disable-atomic-health-audit" description:"Disable the periodic Atomic P2P healthy-state/token audit while keeping normal Atomic indexing and P2P sync enabled"`
-	AtomicHealthAuditIntervalMinutes    uint          `long:"atomic-health-audit-interval-minutes" description:"Interval in minutes for the periodic Atomic P2P healthy-state/token audit"`
-	ExternalIPs                         []string      `long:"externalip" description:"Add an ip to the list of local addresses we claim to listen on to peers"`
-	Proxy                               string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
-	ProxyUser                           string        `long:"proxyuser" description:"Username for proxy server"`
-	ProxyPass                           string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
-	DbType                              string        `long:"dbtype" description:"Database backend to use for the Block DAG"`
-	Profile                             string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
-	LogLevel                            string        `short:"d" long:"loglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
-	Upnp                                bool          `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
-	MinRelayTxFee                       float64       `long:"minrelaytxfee" description:"The minimum transaction fee in CPAY/kB to be considered a non-zero fee."`
-	MaxOrphanTxs                        uint64        `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
-	BlockMaxMass                        uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
-	UserAgentComments                   []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
-	NoPeerBloomFilters                  bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
-	SigCacheMaxSize                     uint          `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
-	BlocksOnly                          bool          `long:"blocksonly" description:"Do not accept transactions from remote peers."`
-	RelayNonStd                         bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
-	RejectNonStd                        bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
-	ResetDatabase                       bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
-	StartupRepairPlan                   string        `long:"startup-repair-plan" description:"Apply the given JSON startup database repair plan before networking starts"`
-	MaxUTXOCacheSize                    uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
-	UTXOIndex                           bool          `long:"utxoindex" description:"Enable the UTXO index (default)"`
-	NoUTXOIndex                         bool          `long:"no-utxoindex" description:"Disable the UTXO index"`
-	IsArchivalNode                      bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
-	AllowSubmitBlockWhenNotSynced       bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
-	EnableSanityCheckPruningUTXOSet     bool          `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
-	ProtocolVersion                     uint32        `long:"protocol-version" description:"Use non default p2p protocol version"`
-	PayloadHfActivationDAAScore         *uint64       `long:"payload-hf-activation-daa-score" description:"Override payload hardfork activation DAA score for this node instance"`
+	ShowVersion                          bool          `short:"V" long:"version" description:"Display version information and exit"`
+	ConfigFile                           string        `short:"C" long:"configfile" description:"Path to configuration file"`
+	AppDir                               string        `short:"b" long:"appdir" description:"Directory to store data"`
+	LogDir                               string        `long:"logdir" description:"Directory to log output."`
+	AddPeers                             []string      `short:"a" long:"addpeer" description:"Add a peer to connect with at startup -- Use <node-id>@<host>:<port> to connect over the encrypted transport and only accept that node ID"`
+	ConnectPeers                         []string      `long:"connect" description:"Connect only to the specified peers at startup -- Use <node-id>@<host>:<port> to connect over the encrypted transport and only accept that node ID"`
+	DisableListen                        bool          `long:"nolisten" description:"Disable listening for incoming connections -- NOTE: Listening is automatically disabled if the --connect or --proxy options are used without also specifying listen interfaces via --listen"`
+	Listeners                            []string      `long:"listen" description:"Add an interface/port to listen for connections (default all interfaces port: 19101, testnet: 19102)"`
+	TargetOutboundPeers                  int           `long:"outpeers" description:"Target number of outbound peers"`
+	MaxInboundPeers                      int           `long:"maxinpeers" description:"Max number of inbound peers"`
+	EnableBanning                        bool          `long:"enablebanning" description:"Enable banning of misbehaving peers"`
+	BanDuration                          time.Duration `long:"banduration" description:"How long to ban misbehaving peers. Valid time units are {s, m, h}. Minimum 1 second"`
+	BanThreshold                         uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers. Scores are kept per IP and per node ID, and decay over time"`
+	EnableExternalBanlist                bool          `long:"external-banlist" description:"Enable external antifraud connection banlist seed synchronization (IP and node ID)"`
+	EnableBanserver                      bool          `long:"banserver" description:"Enable the primary AntiFraud seed endpoint for the signed connection banlist"`
+	DisableExternalBanlist               bool          `long:"no-external-banlist" description:"Disable the AntiFraud seed endpoint and use peer-majority snapshots only"`
+	DisableBanserver                     bool          `long:"no-banserver" description:"Disable the AntiFraud seed endpoint and use peer-majority snapshots only"`
+	AntiFraudNoSeed                      bool          `long:"antifraud-no-seed" description:"Disable the AntiFraud seed endpoint and use peer-majority snapshots only"`
+	AntiFraudSeedURL                     string        `long:"antifraud-seed-url" description:"Fetch the signed connection banlist from this AntiFraud seed endpoint instead of the primary one (eg. a self-hosted antifraudseed server)"`
+	AntiFraudOperatorKeys                []string      `long:"antifraud-operator-key" description:"Pin an extra AntiFraud snapshot signing key as <key-id>:<x-only public key hex>. Key IDs 0 and 1 are reserved, and extra keys are not allowed on mainnet"`
+	EncryptP2P                           bool          `long:"encrypt-p2p" description:"Make all outbound P2P connections over the transport encrypted and authenticated with the unified node identity -- Inbound encrypted connections are always accepted"`
+	RequireEncryptedP2P                  bool          `long:"require-encrypted-p2p" description:"Refuse plaintext P2P connections, inbound and outbound"`
+	Whitelists                           []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned. (eg. 192.168.1.0/24 or ::1)"`
+	RPCListeners                         []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 19201, testnet: 19202)"`
+	RPCCert                              string        `long:"rpccert" description:"File containing the certificate file"`
+	RPCKey                               string        `long:"rpckey" description:"File containing the certificate key"`
+	RPCMaxClients                        int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
+	RPCMaxWebsockets                     int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
+	RPCMaxConcurrentReqs                 int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
+	TxStatusAcceptanceDepth              uint64        `long:"txstatus-acceptance-depth" description:"Number of selected chain blocks whose acceptance data getTransactionStatus searches for the transaction, each costing a block info and acceptance data read -- 0 disables the search"`
+	DisableRPC                           bool          `long:"norpc" description:"Disable built-in RPC server"`
+	SafeRPC                              bool          `long:"saferpc" description:"Disable RPC commands which affect the state of the node"`
+	AllowRPCOrphans                      bool          `long:"allow-rpc-orphans" hidden:"true" description:"Allow RPC-submitted transactions to enter the orphan transaction pool"`
+	DisableDNSSeed                       bool          `long:"nodnsseed" description:"Disable DNS seeding for peers"`
+	DNSSeed                              string        `long:"dnsseed" description:"Override DNS seeds with specified hostname (Only 1 hostname allowed)"`
+	GRPCSeed                             string        `long:"grpcseed" description:"Hostname of gRPC server for seeding peers"`
+	AtomicBootstrapPeers                 []string      `long:"atomic-bootstrap-peer" description:"Optional Atomic bootstrap RPC peer source. Accepted for CLI compatibility; P2P Atomic audit does not require RPC."`
+	AtomicBootstrapAllowPeerFallback     bool          `long:"atomic-bootstrap-allow-peer-fallback" description:"Allow peer-only Atomic P2P bootstrap/audit when DNS seed bootstrap is disabled"`
+	AtomicBootstrapPeerQuorumMinSources  uint          `long:"atomic-bootstrap-peer-quorum-min-sources" description:"Minimum independent peer/non-seed sources required for Atomic P2P bootstrap/audit"`
+	DisableAtomicHealthAudit             bool          `long:"disable-atomic-health-audit" description:"Disable the periodic Atomic P2P healthy-state/token audit while keeping normal Atomic indexing and P2P sync enabled"`
+	AtomicHealthAuditIntervalMinutes     uint          `long:"atomic-health-audit-interval-minutes" description:"Interval in minutes for the periodic Atomic P2P healthy-state/token audit"`
+	ExternalIPs                          []string      `long:"externalip" description:"Add an ip to the list of local addresses we claim to listen on to peers"`
+	Proxy                                string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
+	ProxyUser                            string        `long:"proxyuser" description:"Username for proxy server"`
+	ProxyPass                            string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
+	Onion                                string        `long:"onion" description:"Connect to Tor v3 onion peers via this SOCKS5 proxy (eg. 127.0.0.1:9050) -- Defaults to --proxy if it is set"`
+	OnionUser                            string        `long:"onionuser" description:"Username for the onion proxy server"`
+	OnionPass                            string        `long:"onionpass" default-mask:"-" description:"Password for the onion proxy server"`
+	TorControl                           string        `long:"torcontrol" description:"Publish a Tor v3 onion service for the P2P listener through the Tor control port at this address (eg. 127.0.0.1:9051)"`
+	TorPassword                          string        `long:"torpassword" default-mask:"-" description:"Password for the Tor control port, if it requires one"`
+	OnionListener                        string        `long:"onionlisten" description:"Accept the connections forwarded by the onion service on this local address (default: 127.0.0.1 on a random port)"`
+	DbType                               string        `long:"dbtype" description:"Database backend to use for the Block DAG"`
+	Profile                              string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	Metrics                              string        `long:"metrics" description:"Serve Prometheus metrics on /metrics at the given interface/port (eg. 127.0.0.1:9090)"`
+	LogLevel                             string        `short:"d" long:"loglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
+	Upnp                                 bool          `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
+	MinRelayTxFee                        float64       `long:"minrelaytxfee" description:"The minimum transaction fee in CPAY/kB to be considered a non-zero fee."`
+	MaxOrphanTxs                         uint64        `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
+	BlockMaxMass                         uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
+	UserAgentComments                    []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
+	NoPeerBloomFilters                   bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
+	SigCacheMaxSize                      uint          `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
+	BlocksOnly                           bool          `long:"blocksonly" description:"Do not accept transactions from remote peers."`
+	Fastchain                            bool          `long:"fastchain" description:"Advertise fastchain support, and relay fast intents and microblocks to peers that advertise it"`
+	RelayNonStd                          bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
+	RejectNonStd                         bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
+	ResetDatabase                        bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
+	StartupRepairPlan                    string        `long:"startup-repair-plan" description:"Apply the given JSON startup database repair plan before networking starts"`
+	MaxUTXOCacheSize                     uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
+	UTXOIndex                            bool          `long:"utxoindex" description:"Enable the UTXO index (default)"`
+	NoUTXOIndex                          bool          `long:"no-utxoindex" description:"Disable the UTXO index"`
+	AtomicIndex                          bool          `long:"atomicindex" description:"Enable the CAT token history index, which records token events by owner and asset"`
+	IsArchivalNode                       bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
+	AllowSubmitBlockWhenNotSynced        bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
+	EnableSanityCheckPruningUTXOSet      bool          `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
+	ProtocolVersion                      uint32        `long:"protocol-version" description:"Use non default p2p protocol version"`
+	PayloadHfActivationDAAScore          *uint64       `long:"payload-hf-activation-daa-score" description:"Override payload hardfork activation DAA score for this node instance"`
+	AtomicMerkleRootHfActivationDAAScore *uint64       `long:"atomic-merkle-root-hf-activation-daa-score" description:"Override Atomic Merkle root hardfork activation DAA score for this node instance"`
 	NetworkFlags
 	ServiceOptions *ServiceOptions
 }
@@ -152,10 +174,12 @@ type Config struct {
 	*Flags
 	Lookup        func(string) ([]net.IP, error)
 	Dial          func(string, string, time.Duration) (net.Conn, error)
+	OnionDial     func(string, string, time.Duration) (net.Conn, error) // nil if onion peers are unreachable
 	MiningAddrs   []util.Address
 	MinRelayTxFee util.Amount
 	Whitelists    []*net.IPNet
 	SubnetworkID  *externalapi.DomainSubnetworkID // nil in full nodes
+	AntiFraudKeys map[uint8][32]byte              // extra AntiFraud snapshot signing keys by key ID
 }
 
 // ServiceOptions defines the configuration options for the daemon as a service on
@@ -195,6 +219,32 @@ func commandLineBoolFlagValue(name string) (bool, bool) {
 	return false, false
 }
 
+// parseAntiFraudOperatorKey parses an AntiFraud operator key in the
+// <key-id>:<x-only public key hex> format.
+func parseAntiFraudOperatorKey(operatorKey string) (uint8, [32]byte, error) {
+	keyIDString, publicKeyHex, ok := strings.Cut(strings.TrimSpace(operatorKey), ":")
+	if !ok {
+		return 0, [32]byte{}, errors.New("expected <key-id>:<x-only public key hex>")
+	}
+	keyID, err := strconv.ParseUint(keyIDString, 10, 8)
+	if err != nil {
+		return 0, [32]byte{}, errors.Wrap(err, "invalid key ID")
+	}
+	if keyID <= 1 {
+		return 0, [32]byte{}, errors.Errorf("key ID %d is reserved for the built-in keys", keyID)
+	}
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	if err != nil {
+		return 0, [32]byte{}, errors.Wrap(err, "invalid public key hex")
+	}
+	if _, err := secp256k1.DeserializeSchnorrPubKey(publicKeyBytes); err != nil {
+		return 0, [32]byte{}, errors.Wrap(err, "invalid public key")
+	}
+	var publicKey [32]byte
+	copy(publicKey[:], publicKeyBytes)
+	return uint8(keyID), publicKey, nil
+}
+
 // newConfigParser returns a new command line flags parser.
 func newConfigParser(cfgFlags *Flags, options flags.Options) *flags.Parser {
 	parser := flags.NewParser(cfgFlags, options)
@@ -216,6 +266,7 @@ func defaultFlags() *Flags {
 		RPCMaxClients:                       DefaultMaxRPCClients,
 		RPCMaxWebsockets:                    defaultMaxRPCWebsockets,
 		RPCMaxConcurrentReqs:                defaultMaxRPCConcurrentReqs,
+		TxStatusAcceptanceDepth:             defaultTxStatusAcceptanceDepth,
 		AppDir:                              defaultDataDir,
 		RPCKey:                              defaultRPCKeyFile,
 		RPCCert:                             defaultRPCCertFile,
@@ -363,6 +414,9 @@ func LoadConfig() (*Config, error) {
 	if cfg.PayloadHfActivationDAAScore != nil {
 		cfg.NetParams().PayloadHfActivationDAAScore = *cfg.PayloadHfActivationDAAScore
 	}
+	if cfg.AtomicMerkleRootHfActivationDAAScore != nil {
+		cfg.NetParams().AtomicMerkleRootHfActivationDAAScore = *cfg.AtomicMerkleRootHfActivationDAAScore
+	}
 
 	// Set the default policy for relaying non-standard transactions
 	// according to the default of the active network. The set
@@ -431,6 +485,18 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// Validate the metrics listen address
+	if cfg.Metrics != "" {
+		_, _, err := net.SplitHostPort(cfg.Metrics)
+		if err != nil {
+			str := "%s: The metrics option must be an interface/port -- parsed [%s]"
+			err := errors.Errorf(str, funcName, cfg.Metrics)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
 	// Don't allow ban durations that are too short.
 	if cfg.BanDuration < time.Second {
 		str := "%s: The banduration option may not be less than 1s -- parsed [%s]"
@@ -472,6 +538,36 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// Validate any given extra AntiFraud operator keys.
+	if len(cfg.AntiFraudOperatorKeys) > 0 {
+		if cfg.NetParams().Net == dagconfig.MainnetParams.Net {
+			str := "%s: the --antifraud-operator-key option is not allowed on mainnet"
+			err := errors.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+		cfg.AntiFraudKeys = make(map[uint8][32]byte, len(cfg.AntiFraudOperatorKeys))
+		for _, operatorKey := range cfg.AntiFraudOperatorKeys {
+			keyID, publicKey, err := parseAntiFraudOperatorKey(operatorKey)
+			if err != nil {
+				str := "%s: The antifraud-operator-key value of '%s' is invalid: %s"
+				err := errors.Errorf(str, funcName, operatorKey, err)
+				fmt.Fprintln(os.Stderr, err)
+				fmt.Fprintln(os.Stderr, usageMessage)
+				return nil, err
+			}
+			if _, exists := cfg.AntiFraudKeys[keyID]; exists {
+				str := "%s: AntiFraud operator key ID %d is specified more than once"
+				err := errors.Errorf(str, funcName, keyID)
+				fmt.Fprintln(os.Stderr, err)
+				fmt.Fprintln(os.Stderr, usageMessage)
+				return nil, err
+			}
+			cfg.AntiFraudKeys[keyID] = publicKey
+		}
+	}
+
 	// --addPeer and --connect do not mix.
 	if len(cfg.AddPeers) > 0 && len(cfg.ConnectPeers) > 0 {
 		str := "%s: the --addpeer and --connect options can not be " +
@@ -581,6 +677,15 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// Limit the work a single getTransactionStatus call may cause
+	if cfg.TxStatusAcceptanceDepth > txStatusAcceptanceDepthMax {
+		str := "%s: The txstatus-acceptance-depth option must be at most %d -- parsed [%d]"
+		err := errors.Errorf(str, funcName, txStatusAcceptanceDepthMax, cfg.TxStatusAcceptanceDepth)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Look for illegal characters in the user agent comments.
 	for _, uaComment := range cfg.UserAgentComments {
 		if strings.ContainsAny(uaComment, "/:()") {
@@ -655,6 +760,54 @@ func LoadConfig() (*Config, error) {
 			Password: cfg.ProxyPass,
 		}
 		cfg.Dial = proxy.DialTimeout
+		cfg.OnionDial = proxy.DialTimeout
+	}
+
+	// Onion peers are dialed through --onion if it's set, and otherwise
+	// through --proxy, which is assumed to be Tor.
+	if cfg.Onion != "" {
+		_, _, err := net.SplitHostPort(cfg.Onion)
+		if err != nil {
+			str := "%s: Onion proxy address '%s' is invalid: %s"
+			err := errors.Errorf(str, funcName, cfg.Onion, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+
+		onionProxy := &socks.Proxy{
+			Addr:     cfg.Onion,
+			Username: cfg.OnionUser,
+			Password: cfg.OnionPass,
+		}
+		cfg.OnionDial = onionProxy.DialTimeout
+	}
+
+	if cfg.TorControl != "" {
+		_, _, err := net.SplitHostPort(cfg.TorControl)
+		if err != nil {
+			str := "%s: Tor control port address '%s' is invalid: %s"
+			err := errors.Errorf(str, funcName, cfg.TorControl, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+
+		// The onion service forwards to a listener of its own, so that
+		// onion inbound connections can be told apart from direct ones
+		if cfg.OnionListener == "" {
+			cfg.OnionListener = net.JoinHostPort("127.0.0.1", "0")
+		}
+	}
+	if cfg.OnionListener != "" {
+		_, _, err := net.SplitHostPort(cfg.OnionListener)
+		if err != nil {
+			str := "%s: onion listener address '%s' is invalid: %s"
+			err := errors.Errorf(str, funcName, cfg.OnionListener, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
 	}
 
 	// Warn about missing config file only after all other configuration is
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -65,6 +66,31 @@ func TestDefaultAntiFraudSeedEnabled(t *testing.T) {
 	}
 }
 
+func TestParseAntiFraudOperatorKey(t *testing.T) {
+	const publicKeyHex = "c93b4ed533a76866a3c3ea1cc0bc3e70c0dbe32a945057b5dff95b88ce9280dd"
+
+	keyID, publicKey, err := parseAntiFraudOperatorKey("2:" + publicKeyHex)
+	if err != nil {
+		t.Fatalf("parseAntiFraudOperatorKey: %s", err)
+	}
+	if keyID != 2 || hex.EncodeToString(publicKey[:]) != publicKeyHex {
+		t.Fatalf("unexpected operator key %d:%x", keyID, publicKey)
+	}
+
+	invalidKeys := []string{
+		publicKeyHex,
+		"1:" + publicKeyHex,
+		"256:" + publicKeyHex,
+		"2:" + publicKeyHex[:62],
+		"2:zz" + publicKeyHex[2:],
+	}
+	for _, invalidKey := range invalidKeys {
+		if _, _, err := parseAntiFraudOperatorKey(invalidKey); err == nil {
+			t.Errorf("expected %q to be rejected", invalidKey)
+		}
+	}
+}
+
 func TestDefaultUTXOIndexEnabled(t *testing.T) {
 	cfg := DefaultConfig()
 	if !cfg.UTXOIndex {
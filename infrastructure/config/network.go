@@ -51,6 +51,7 @@ type overrideDAGParamsConfig struct {
 	SkipProofOfWork                         *bool              `json:"skipProofOfWork"`
 	HardForkOmitGenesisFromParentsDAAScore  *uint64            `json:"hardForkOmitGenesisFromParentsDaaScore"`
 	PayloadHfActivationDAAScore             *uint64            `json:"payloadHfActivationDaaScore"`
+	AtomicMerkleRootHfActivationDAAScore    *uint64            `json:"atomicMerkleRootHfActivationDaaScore"`
 	PayloadMaxLengthConsensus               *uint64            `json:"payloadMaxLengthConsensus"`
 	PayloadMaxLengthStandard                *uint64            `json:"payloadMaxLengthStandard"`
 }
@@ -225,6 +226,10 @@ func (networkFlags *NetworkFlags) overrideDAGParams() error {
 		networkFlags.ActiveNetParams.PayloadHfActivationDAAScore = *config.PayloadHfActivationDAAScore
 	}
 
+	if config.AtomicMerkleRootHfActivationDAAScore != nil {
+		networkFlags.ActiveNetParams.AtomicMerkleRootHfActivationDAAScore = *config.AtomicMerkleRootHfActivationDAAScore
+	}
+
 	if config.PayloadMaxLengthConsensus != nil {
 		networkFlags.ActiveNetParams.PayloadMaxLengthConsensus = *config.PayloadMaxLengthConsensus
 	}
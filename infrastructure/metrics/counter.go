@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"bufio"
+	"sync/atomic"
+)
+
+// Counter is a value that only goes up, like the number of processed
+// requests
+type Counter struct {
+	value atomic.Uint64
+}
+
+// NewCounter creates a Counter and registers it on the default registry
+func NewCounter(name string, help string) *Counter {
+	return defaultRegistry.NewCounter(name, help)
+}
+
+// NewCounter creates a Counter and registers it on the registry
+func (r *Registry) NewCounter(name string, help string) *Counter {
+	counter := &Counter{}
+	r.register(name, help, counterType, nil, counter)
+	return counter
+}
+
+// Inc increments the counter by 1
+func (c *Counter) Inc() {
+	c.value.Add(1)
+}
+
+// Add increments the counter by the given delta
+func (c *Counter) Add(delta uint64) {
+	c.value.Add(delta)
+}
+
+// Value returns the current value of the counter
+func (c *Counter) Value() uint64 {
+	return c.value.Load()
+}
+
+func (c *Counter) writeSamples(w *bufio.Writer, name string) {
+	writeCounter(w, name, c, nil, nil)
+}
+
+func writeCounter(w *bufio.Writer, name string, c *Counter, labelNames, labelValues []string) {
+	writeSample(w, name, labelNames, labelValues, "", "", float64(c.Value()))
+}
+
+// CounterVec is a set of counters that differ by their label values
+type CounterVec struct {
+	*vec[*Counter]
+}
+
+// NewCounterVec creates a CounterVec with the given label names and registers
+// it on the default registry
+func NewCounterVec(name string, help string, labelNames ...string) *CounterVec {
+	return defaultRegistry.NewCounterVec(name, help, labelNames...)
+}
+
+// NewCounterVec creates a CounterVec with the given label names and registers
+// it on the registry
+func (r *Registry) NewCounterVec(name string, help string, labelNames ...string) *CounterVec {
+	counterVec := &CounterVec{newVec(labelNames, func() *Counter { return &Counter{} }, writeCounter)}
+	r.register(name, help, counterType, labelNames, counterVec)
+	return counterVec
+}
+
+// WithLabelValues returns the counter of the given label values, in the
+// order of the label names
+func (v *CounterVec) WithLabelValues(labelValues ...string) *Counter {
+	return v.withLabelValues(labelValues...)
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"sync/atomic"
+)
+
+// Gauge is a value that can go up and down, like the number of connected
+// peers
+type Gauge struct {
+	bits atomic.Uint64
+}
+
+// NewGauge creates a Gauge and registers it on the default registry
+func NewGauge(name string, help string) *Gauge {
+	return defaultRegistry.NewGauge(name, help)
+}
+
+// NewGauge creates a Gauge and registers it on the registry
+func (r *Registry) NewGauge(name string, help string) *Gauge {
+	gauge := &Gauge{}
+	r.register(name, help, gaugeType, nil, gauge)
+	return gauge
+}
+
+// Set sets the gauge to the given value
+func (g *Gauge) Set(value float64) {
+	g.bits.Store(math.Float64bits(value))
+}
+
+// Add adds the given delta, which may be negative, to the gauge
+func (g *Gauge) Add(delta float64) {
+	for {
+		oldBits := g.bits.Load()
+		newBits := math.Float64bits(math.Float64frombits(oldBits) + delta)
+		if g.bits.CompareAndSwap(oldBits, newBits) {
+			return
+		}
+	}
+}
+
+// Inc increments the gauge by 1
+func (g *Gauge) Inc() {
+	g.Add(1)
+}
+
+// Dec decrements the gauge by 1
+func (g *Gauge) Dec() {
+	g.Add(-1)
+}
+
+// Value returns the current value of the gauge
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(g.bits.Load())
+}
+
+func (g *Gauge) writeSamples(w *bufio.Writer, name string) {
+	writeGauge(w, name, g, nil, nil)
+}
+
+func writeGauge(w *bufio.Writer, name string, g *Gauge, labelNames, labelValues []string) {
+	writeSample(w, name, labelNames, labelValues, "", "", g.Value())
+}
+
+// GaugeVec is a set of gauges that differ by their label values
+type GaugeVec struct {
+	*vec[*Gauge]
+}
+
+// NewGaugeVec creates a GaugeVec with the given label names and registers it
+// on the default registry
+func NewGaugeVec(name string, help string, labelNames ...string) *GaugeVec {
+	return defaultRegistry.NewGaugeVec(name, help, labelNames...)
+}
+
+// NewGaugeVec creates a GaugeVec with the given label names and registers it
+// on the registry
+func (r *Registry) NewGaugeVec(name string, help string, labelNames ...string) *GaugeVec {
+	gaugeVec := &GaugeVec{newVec(labelNames, func() *Gauge { return &Gauge{} }, writeGauge)}
+	r.register(name, help, gaugeType, labelNames, gaugeVec)
+	return gaugeVec
+}
+
+// WithLabelValues returns the gauge of the given label values, in the order
+// of the label names
+func (v *GaugeVec) WithLabelValues(labelValues ...string) *Gauge {
+	return v.withLabelValues(labelValues...)
+}
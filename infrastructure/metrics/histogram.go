@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// DurationBuckets are histogram buckets, in seconds, that fit the latencies
+// of most operations of the node
+var DurationBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Histogram counts observations, like request latencies, in buckets
+type Histogram struct {
+	upperBounds  []float64
+	bucketCounts []atomic.Uint64
+	count        atomic.Uint64
+	sumBits      atomic.Uint64
+}
+
+func newHistogram(buckets []float64) *Histogram {
+	upperBounds := append([]float64(nil), buckets...)
+	sort.Float64s(upperBounds)
+	return &Histogram{
+		upperBounds:  upperBounds,
+		bucketCounts: make([]atomic.Uint64, len(upperBounds)),
+	}
+}
+
+// NewHistogram creates a Histogram with the given bucket upper bounds and
+// registers it on the default registry
+func NewHistogram(name string, help string, buckets []float64) *Histogram {
+	return defaultRegistry.NewHistogram(name, help, buckets)
+}
+
+// NewHistogram creates a Histogram with the given bucket upper bounds and
+// registers it on the registry
+func (r *Registry) NewHistogram(name string, help string, buckets []float64) *Histogram {
+	histogram := newHistogram(buckets)
+	r.register(name, help, histogramType, nil, histogram)
+	return histogram
+}
+
+// Observe adds an observation to the histogram
+func (h *Histogram) Observe(value float64) {
+	index := sort.SearchFloat64s(h.upperBounds, value)
+	if index < len(h.bucketCounts) {
+		h.bucketCounts[index].Add(1)
+	}
+	h.count.Add(1)
+	for {
+		oldBits := h.sumBits.Load()
+		newBits := math.Float64bits(math.Float64frombits(oldBits) + value)
+		if h.sumBits.CompareAndSwap(oldBits, newBits) {
+			return
+		}
+	}
+}
+
+// ObserveSince observes the number of seconds that passed since start
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// Count returns the number of observations
+func (h *Histogram) Count() uint64 {
+	return h.count.Load()
+}
+
+func (h *Histogram) writeSamples(w *bufio.Writer, name string) {
+	writeHistogram(w, name, h, nil, nil)
+}
+
+func writeHistogram(w *bufio.Writer, name string, h *Histogram, labelNames, labelValues []string) {
+	// Buckets are cumulative in the exposition format
+	cumulativeCount := uint64(0)
+	for i, upperBound := range h.upperBounds {
+		cumulativeCount += h.bucketCounts[i].Load()
+		writeSample(w, name+"_bucket", labelNames, labelValues, "le", formatFloat(upperBound), float64(cumulativeCount))
+	}
+	// An observation may be counted in its bucket before it's counted in
+	// total, so the total is never reported below the buckets
+	count := max(h.count.Load(), cumulativeCount)
+	writeSample(w, name+"_bucket", labelNames, labelValues, "le", "+Inf", float64(count))
+	writeSample(w, name+"_sum", labelNames, labelValues, "", "", math.Float64frombits(h.sumBits.Load()))
+	writeSample(w, name+"_count", labelNames, labelValues, "", "", float64(count))
+}
+
+// HistogramVec is a set of histograms that differ by their label values
+type HistogramVec struct {
+	*vec[*Histogram]
+}
+
+// NewHistogramVec creates a HistogramVec with the given bucket upper bounds
+// and label names, and registers it on the default registry
+func NewHistogramVec(name string, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	return defaultRegistry.NewHistogramVec(name, help, buckets, labelNames...)
+}
+
+// NewHistogramVec creates a HistogramVec with the given bucket upper bounds
+// and label names, and registers it on the registry
+func (r *Registry) NewHistogramVec(name string, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	histogramVec := &HistogramVec{newVec(labelNames, func() *Histogram { return newHistogram(buckets) }, writeHistogram)}
+	r.register(name, help, histogramType, labelNames, histogramVec)
+	return histogramVec
+}
+
+// WithLabelValues returns the histogram of the given label values, in the
+// order of the label names
+func (v *HistogramVec) WithLabelValues(labelValues ...string) *Histogram {
+	return v.withLabelValues(labelValues...)
+}
@@ -0,0 +1,178 @@
+// Package metrics implements counters, gauges and histograms, and serves them
+// in the Prometheus text exposition format.
+//
+// Metrics are declared as package-level variables of the packages they
+// measure, and are registered on the default registry when they're created:
+//
+//	var blocksProcessed = metrics.NewCounter("cryptixd_blocks_processed_total", "Number of processed blocks")
+//
+// Creating two metrics with the same name panics, so every name must be
+// declared exactly once.
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type metricType string
+
+const (
+	counterType   metricType = "counter"
+	gaugeType     metricType = "gauge"
+	histogramType metricType = "histogram"
+)
+
+var (
+	metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRegexp  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// collector is a metric, or a vector of metrics that share a name and differ
+// by their labels
+type collector interface {
+	writeSamples(w *bufio.Writer, name string)
+}
+
+type registeredCollector struct {
+	name       string
+	help       string
+	metricType metricType
+	collector  collector
+}
+
+// Registry is a set of metrics that are served together
+type Registry struct {
+	lock       sync.Mutex
+	collectors map[string]*registeredCollector
+}
+
+// NewRegistry returns a new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]*registeredCollector)}
+}
+
+var defaultRegistry = NewRegistry()
+
+func (r *Registry) register(name string, help string, metricType metricType, labelNames []string, c collector) {
+	if !metricNameRegexp.MatchString(name) {
+		panic("invalid metric name " + name)
+	}
+	for _, labelName := range labelNames {
+		if !labelNameRegexp.MatchString(labelName) || labelName == "le" {
+			panic("invalid label name " + labelName + " of metric " + name)
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.collectors[name]; ok {
+		panic("metric " + name + " is already registered")
+	}
+	r.collectors[name] = &registeredCollector{
+		name:       name,
+		help:       help,
+		metricType: metricType,
+		collector:  c,
+	}
+}
+
+// WriteTo writes all the metrics of the registry to w in the Prometheus text
+// exposition format, sorted by name
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.lock.Lock()
+	collectors := make([]*registeredCollector, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		collectors = append(collectors, c)
+	}
+	r.lock.Unlock()
+
+	sort.Slice(collectors, func(i, j int) bool { return collectors[i].name < collectors[j].name })
+
+	countingWriter := &countingWriter{writer: w}
+	bufferedWriter := bufio.NewWriter(countingWriter)
+	for _, c := range collectors {
+		bufferedWriter.WriteString("# HELP " + c.name + " " + helpEscaper.Replace(c.help) + "\n")
+		bufferedWriter.WriteString("# TYPE " + c.name + " " + string(c.metricType) + "\n")
+		c.collector.writeSamples(bufferedWriter, c.name)
+	}
+	err := bufferedWriter.Flush()
+	return countingWriter.count, err
+}
+
+// Handler returns an http.Handler that serves the metrics of the registry
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.WriteTo(w)
+	})
+}
+
+// Handler returns an http.Handler that serves the metrics of the default
+// registry
+func Handler() http.Handler {
+	return defaultRegistry.Handler()
+}
+
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.count += int64(n)
+	return n, err
+}
+
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// writeSample writes a single sample line. extraLabelName is written after
+// the other labels if it's not empty, and is used for histogram buckets.
+func writeSample(w *bufio.Writer, name string, labelNames []string, labelValues []string,
+	extraLabelName string, extraLabelValue string, value float64) {
+
+	w.WriteString(name)
+	if len(labelNames) > 0 || extraLabelName != "" {
+		w.WriteByte('{')
+		for i, labelName := range labelNames {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(labelName + `="` + labelValueEscaper.Replace(labelValues[i]) + `"`)
+		}
+		if extraLabelName != "" {
+			if len(labelNames) > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(extraLabelName + `="` + extraLabelValue + `"`)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteTo(t *testing.T) {
+	registry := NewRegistry()
+	counter := registry.NewCounter("test_requests_total", "Number of requests")
+	gaugeVec := registry.NewGaugeVec("test_peers", "Number of peers\nby direction", "direction")
+	histogram := registry.NewHistogram("test_duration_seconds", "Duration", []float64{1, 0.1})
+
+	counter.Add(3)
+	gaugeVec.WithLabelValues("outbound").Set(8)
+	gaugeVec.WithLabelValues(`in"bound`).Inc()
+	gaugeVec.WithLabelValues(`in"bound`).Inc()
+	histogram.Observe(0.05)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+
+	builder := &strings.Builder{}
+	_, err := registry.WriteTo(builder)
+	if err != nil {
+		t.Fatalf("WriteTo: %s", err)
+	}
+
+	expected := `# HELP test_duration_seconds Duration
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{le="0.1"} 1
+test_duration_seconds_bucket{le="1"} 2
+test_duration_seconds_bucket{le="+Inf"} 3
+test_duration_seconds_sum 5.55
+test_duration_seconds_count 3
+# HELP test_peers Number of peers\nby direction
+# TYPE test_peers gauge
+test_peers{direction="in\"bound"} 2
+test_peers{direction="outbound"} 8
+# HELP test_requests_total Number of requests
+# TYPE test_requests_total counter
+test_requests_total 3
+`
+	if builder.String() != expected {
+		t.Fatalf("Unexpected exposition:\n%s\nExpected:\n%s", builder.String(), expected)
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	registry := NewRegistry()
+	registry.NewCounter("test_total", "")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Registering a metric name twice didn't panic")
+		}
+	}()
+	registry.NewGauge("test_total", "")
+}
+
+func TestWrongLabelValueCount(t *testing.T) {
+	registry := NewRegistry()
+	counterVec := registry.NewCounterVec("test_total", "", "command", "result")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("WithLabelValues with a missing label value didn't panic")
+		}
+	}()
+	counterVec.WithLabelValues("getInfo")
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/logger"
+	"github.com/cryptix-network/cryptixd/util/panics"
+)
+
+// Path is the HTTP path the metrics are served on
+const Path = "/metrics"
+
+// readHeaderTimeout bounds how long a client may take to send its request
+// headers, so that slow clients can't hold connections open indefinitely
+const readHeaderTimeout = 10 * time.Second
+
+// Start serves the metrics of the default registry on Path at the given
+// listen address
+func Start(listenAddr string, log *logger.Logger) {
+	spawn := panics.GoroutineWrapperFunc(log)
+	spawn("metrics.Start", func() {
+		mux := http.NewServeMux()
+		mux.Handle(Path, Handler())
+		log.Infof("Metrics server listening on %s%s", listenAddr, Path)
+		server := &http.Server{
+			Addr:              listenAddr,
+			Handler:           mux,
+			ReadHeaderTimeout: readHeaderTimeout,
+		}
+		log.Error(server.ListenAndServe())
+	})
+}
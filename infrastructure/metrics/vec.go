@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"bufio"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// vec is a set of metrics of the same type that differ by their label values
+type vec[T any] struct {
+	lock       sync.RWMutex
+	labelNames []string
+	children   map[string]*vecChild[T]
+	newMetric  func() T
+	write      func(w *bufio.Writer, name string, metric T, labelNames, labelValues []string)
+}
+
+type vecChild[T any] struct {
+	labelValues []string
+	metric      T
+}
+
+func newVec[T any](labelNames []string, newMetric func() T,
+	write func(w *bufio.Writer, name string, metric T, labelNames, labelValues []string)) *vec[T] {
+
+	return &vec[T]{
+		labelNames: labelNames,
+		children:   make(map[string]*vecChild[T]),
+		newMetric:  newMetric,
+		write:      write,
+	}
+}
+
+func (v *vec[T]) withLabelValues(labelValues ...string) T {
+	if len(labelValues) != len(v.labelNames) {
+		panic("got a wrong number of label values")
+	}
+	key := strings.Join(labelValues, "\xff")
+
+	v.lock.RLock()
+	child, ok := v.children[key]
+	v.lock.RUnlock()
+	if ok {
+		return child.metric
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if child, ok := v.children[key]; ok {
+		return child.metric
+	}
+	child = &vecChild[T]{
+		labelValues: append([]string(nil), labelValues...),
+		metric:      v.newMetric(),
+	}
+	v.children[key] = child
+	return child.metric
+}
+
+func (v *vec[T]) writeSamples(w *bufio.Writer, name string) {
+	v.lock.RLock()
+	keys := make([]string, 0, len(v.children))
+	for key := range v.children {
+		keys = append(keys, key)
+	}
+	children := make([]*vecChild[T], len(keys))
+	sort.Strings(keys)
+	for i, key := range keys {
+		children[i] = v.children[key]
+	}
+	v.lock.RUnlock()
+
+	for _, child := range children {
+		v.write(w, name, child.metric, v.labelNames, child.labelValues)
+	}
+}
@@ -15,14 +15,15 @@ import (
 	"github.com/pkg/errors"
 )
 
-const (
-	maxAddresses                   = 4096
-	connectionFailedCountForRemove = 4
-)
+const connectionFailedCountForRemove = 4
+
+// MaxBanTime is the duration after which a banned address is unbanned
+const MaxBanTime = 24 * time.Hour
 
 // addressRandomizer is the interface for the randomizer needed for the AddressManager.
 type addressRandomizer interface {
 	RandomAddresses(addresses []*address, count int) []*appmessage.NetAddress
+	WeightedShuffle(addresses []*address) []*address
 }
 
 // addressKey represents a pair of IP and port, the IP is always in V6 representation
@@ -35,6 +36,10 @@ type address struct {
 	netAddress            *appmessage.NetAddress
 	connectionFailedCount uint64
 	verified              bool
+
+	// source is the IP of the peer we learned the address from. It's
+	// all zeros if the address announced itself or its source is unknown.
+	source ipv6
 }
 
 type ipv6 [net.IPv6len]byte
@@ -63,6 +68,7 @@ type AddressManager struct {
 	mutex          sync.Mutex
 	cfg            *Config
 	random         addressRandomizer
+	buckets        *addressBuckets
 }
 
 // New returns a new Cryptix address manager.
@@ -76,15 +82,22 @@ func New(cfg *Config, database database.Database) (*AddressManager, error) {
 		return nil, err
 	}
 
-	return &AddressManager{
+	am := &AddressManager{
 		store:          addressStore,
 		localAddresses: localAddresses,
 		random:         NewAddressRandomize(connectionFailedCountForRemove),
 		cfg:            cfg,
-	}, nil
+	}
+	err = am.initBuckets()
+	if err != nil {
+		return nil, err
+	}
+	return am, nil
 }
 
-func (am *AddressManager) addAddressNoLock(netAddress *appmessage.NetAddress, verified bool) error {
+func (am *AddressManager) addAddressNoLock(netAddress *appmessage.NetAddress, source *appmessage.NetAddress,
+	verified bool) error {
+
 	if !IsRoutable(netAddress, am.cfg.AcceptUnroutable) {
 		return nil
 	}
@@ -92,41 +105,32 @@ func (am *AddressManager) addAddressNoLock(netAddress *appmessage.NetAddress, ve
 	key := netAddressKey(netAddress)
 	if existing, ok := am.store.getNotBanned(key); ok {
 		if verified && !existing.verified {
-			existing.verified = true
-			return am.store.updateNotBanned(key, existing)
+			return am.promoteNoLock(key, existing)
 		}
 		return nil
 	}
 	// We mark `connectionFailedCount` as 0 only after first success.
 	// Addresses learned from gossip are unverified until a successful handshake.
 	address := &address{netAddress: netAddress, connectionFailedCount: 1, verified: verified}
-	err := am.store.add(key, address)
+	if source != nil && !source.IP.Equal(netAddress.IP) {
+		copy(address.source[:], source.IP.To16())
+	}
+	placed, err := am.placeNoLock(key, address)
 	if err != nil {
 		return err
 	}
-
-	if am.store.notBannedCount() > maxAddresses {
-		allAddresses := am.store.getAllNotBanned()
-
-		maxConnectionFailedCount := uint64(0)
-		toRemove := allAddresses[0]
-		for _, address := range allAddresses[1:] {
-			if address.connectionFailedCount > maxConnectionFailedCount {
-				maxConnectionFailedCount = address.connectionFailedCount
-				toRemove = address
-			}
-		}
-
-		err := am.removeAddressNoLock(toRemove.netAddress)
-		if err != nil {
-			return err
-		}
+	if !placed {
+		log.Tracef("Not adding %s: its bucket is full", netAddress)
+		return nil
 	}
-	return nil
+	return am.store.add(key, address)
 }
 
-func (am *AddressManager) removeAddressNoLock(address *appmessage.NetAddress) error {
-	key := netAddressKey(address)
+func (am *AddressManager) removeAddressNoLock(netAddress *appmessage.NetAddress) error {
+	key := netAddressKey(netAddress)
+	if existing, ok := am.store.getNotBanned(key); ok {
+		am.unplaceNoLock(key, existing)
+	}
 	return am.store.remove(key)
 }
 
@@ -135,16 +139,24 @@ func (am *AddressManager) AddAddress(address *appmessage.NetAddress) error {
 	am.mutex.Lock()
 	defer am.mutex.Unlock()
 
-	return am.addAddressNoLock(address, false)
+	return am.addAddressNoLock(address, nil, false)
 }
 
 // AddAddresses adds addresses to the address manager
 func (am *AddressManager) AddAddresses(addresses ...*appmessage.NetAddress) error {
+	return am.AddAddressesFromSource(nil, addresses...)
+}
+
+// AddAddressesFromSource adds addresses that were announced by the given
+// source peer to the address manager. The addresses a single source can
+// announce are limited to a few buckets, so that a single peer can't fill the
+// address manager.
+func (am *AddressManager) AddAddressesFromSource(source *appmessage.NetAddress, addresses ...*appmessage.NetAddress) error {
 	am.mutex.Lock()
 	defer am.mutex.Unlock()
 
 	for _, address := range addresses {
-		err := am.addAddressNoLock(address, false)
+		err := am.addAddressNoLock(address, source, false)
 		if err != nil {
 			return err
 		}
@@ -157,7 +169,7 @@ func (am *AddressManager) AddAddressVerified(address *appmessage.NetAddress) err
 	am.mutex.Lock()
 	defer am.mutex.Unlock()
 
-	return am.addAddressNoLock(address, true)
+	return am.addAddressNoLock(address, nil, true)
 }
 
 // AddAddressesVerified adds verified addresses to the address manager.
@@ -166,7 +178,7 @@ func (am *AddressManager) AddAddressesVerified(addresses ...*appmessage.NetAddre
 	defer am.mutex.Unlock()
 
 	for _, address := range addresses {
-		err := am.addAddressNoLock(address, true)
+		err := am.addAddressNoLock(address, nil, true)
 		if err != nil {
 			return err
 		}
@@ -198,7 +210,7 @@ func (am *AddressManager) MarkConnectionFailure(address *appmessage.NetAddress)
 	if entry.connectionFailedCount >= connectionFailedCountForRemove {
 		log.Debugf("Address %s has failed %d connection attempts - removing from address manager",
 			address, entry.connectionFailedCount)
-		return am.store.remove(key)
+		return am.removeAddressNoLock(address)
 	}
 	return am.store.updateNotBanned(key, entry)
 }
@@ -215,7 +227,14 @@ func (am *AddressManager) MarkConnectionSuccess(address *appmessage.NetAddress)
 		return errors.Errorf("address %s is not registered with the address manager", address.TCPAddress())
 	}
 	entry.connectionFailedCount = 0
-	entry.verified = true
+	// The timestamp of tried addresses is the time of their last
+	// successful connection, which decides which one leaves a full bucket
+	updatedNetAddress := *entry.netAddress
+	updatedNetAddress.Timestamp = mstime.Now()
+	entry.netAddress = &updatedNetAddress
+	if !entry.verified {
+		return am.promoteNoLock(key, entry)
+	}
 	return am.store.updateNotBanned(key, entry)
 }
 
@@ -257,6 +276,12 @@ func (am *AddressManager) RandomAddresses(count int, exceptions []*appmessage.Ne
 	return am.random.RandomAddresses(validAddresses, count)
 }
 
+// AddLocalAddress adds an address this node can be reached at, to be
+// advertised to peers
+func (am *AddressManager) AddLocalAddress(netAddress *appmessage.NetAddress, priority AddressPriority) error {
+	return am.localAddresses.addLocalNetAddress(netAddress, priority)
+}
+
 // BestLocalAddress returns the most appropriate local address to use
 // for the given remote address.
 func (am *AddressManager) BestLocalAddress(remoteAddress *appmessage.NetAddress) *appmessage.NetAddress {
@@ -277,6 +302,9 @@ func (am *AddressManager) Ban(addressToBan *appmessage.NetAddress) error {
 		}
 	}
 	for _, key := range keysToDelete {
+		if existing, ok := am.store.getNotBanned(key); ok {
+			am.unplaceNoLock(key, existing)
+		}
 		err := am.store.remove(key)
 		if err != nil {
 			return err
@@ -328,8 +356,7 @@ func (am *AddressManager) unbanIfOldEnough(key addressKey) error {
 		return nil
 	}
 
-	const maxBanTime = 24 * time.Hour
-	if mstime.Since(address.netAddress.Timestamp) > maxBanTime {
+	if mstime.Since(address.netAddress.Timestamp) > MaxBanTime {
 		err := am.store.removeBanned(key)
 		if err != nil {
 			return err
@@ -306,69 +306,95 @@ func TestRestoreAddressManager(t *testing.T) {
 }
 
 func TestOverfillAddressManager(t *testing.T) {
-	addressManager, teardown := newAddressManagerForTest(t, "TestAddressManager")
+	addressManager, teardown := newAddressManagerForTest(t, "TestOverfillAddressManager")
 	defer teardown()
 
-	generateTestAddresses := func(amount int) []*appmessage.NetAddress {
-		testAddresses := make([]*appmessage.NetAddress, 0, amount)
-		for i := byte(0); i < 128; i++ {
-			for j := byte(0); j < 128; j++ {
-				testAddress := &appmessage.NetAddress{IP: net.IP{1, 2, i, j}, Timestamp: mstime.Now()}
-				testAddresses = append(testAddresses, testAddress)
-				if len(testAddresses) == amount {
-					break
-				}
-			}
-			if len(testAddresses) == amount {
-				break
-			}
+	// Adding lots of addresses of a single network group must only fill the
+	// few buckets that group is limited to
+	sameGroupAddresses := make([]*appmessage.NetAddress, 0, 128*128)
+	for i := 0; i < 128; i++ {
+		for j := 0; j < 128; j++ {
+			sameGroupAddresses = append(sameGroupAddresses,
+				&appmessage.NetAddress{IP: net.IP{1, 2, byte(i), byte(j)}, Timestamp: mstime.Now()})
 		}
-		return testAddresses
+	}
+	err := addressManager.AddAddresses(sameGroupAddresses...)
+	if err != nil {
+		t.Fatalf("AddAddresses: %s", err)
+	}
+	returnedAddresses := addressManager.Addresses()
+	if len(returnedAddresses) == 0 || len(returnedAddresses) > newBucketsPerSourceGroup*bucketSize {
+		t.Fatalf("Unexpected address amount. Want between 1 and %d, got: %d",
+			newBucketsPerSourceGroup*bucketSize, len(returnedAddresses))
 	}
 
-	// Add a single test address to the address manager
+	// Fill the bucket of testAddress, in a fresh address manager so that
+	// the bucket isn't already taken by the addresses above
+	addressManager, teardown = newAddressManagerForTest(t, "TestOverfillAddressManager")
+	defer teardown()
 	testAddress := &appmessage.NetAddress{IP: net.IP{5, 6, 0, 0}, Timestamp: mstime.Now()}
-	err := addressManager.AddAddress(testAddress)
+	err = addressManager.AddAddress(testAddress)
 	if err != nil {
 		t.Fatalf("AddAddress: %s", err)
 	}
-
-	// Add `maxAddresses-1` addresses to the address manager
-	addresses := generateTestAddresses(maxAddresses - 1)
-	err = addressManager.AddAddresses(addresses...)
+	testBucket := addressManager.newBucketIndex(&address{netAddress: testAddress})
+	var extraAddresses []*appmessage.NetAddress
+	for i := 0; i < 256 && len(extraAddresses) < bucketSize; i++ {
+		for j := 1; j < 256 && len(extraAddresses) < bucketSize; j++ {
+			candidate := &appmessage.NetAddress{IP: net.IP{5, 6, byte(i), byte(j)}, Timestamp: mstime.Now()}
+			if addressManager.newBucketIndex(&address{netAddress: candidate}) == testBucket {
+				extraAddresses = append(extraAddresses, candidate)
+			}
+		}
+	}
+	if len(extraAddresses) < bucketSize {
+		t.Fatalf("Couldn't generate enough addresses for bucket %d", testBucket)
+	}
+	err = addressManager.AddAddresses(extraAddresses[:bucketSize-1]...)
 	if err != nil {
 		t.Fatalf("AddAddresses: %s", err)
 	}
+	if len(addressManager.buckets.new[testBucket]) != bucketSize {
+		t.Fatalf("Unexpected bucket size. Want: %d, got: %d",
+			bucketSize, len(addressManager.buckets.new[testBucket]))
+	}
+	addressCount := len(addressManager.Addresses())
 
-	// Make sure that it now contains exactly `maxAddresses` entries
-	returnedAddresses := addressManager.Addresses()
-	if len(returnedAddresses) != maxAddresses {
-		t.Fatalf("Unexpected address amount. Want: %d, got: %d", maxAddresses, len(returnedAddresses))
+	// A full bucket of good addresses doesn't take any more
+	lastAddress := extraAddresses[bucketSize-1]
+	err = addressManager.AddAddress(lastAddress)
+	if err != nil {
+		t.Fatalf("AddAddress: %s", err)
+	}
+	if len(addressManager.Addresses()) != addressCount {
+		t.Fatalf("Unexpected address amount. Want: %d, got: %d", addressCount, len(addressManager.Addresses()))
 	}
 
-	// Mark the first test address as a connection failure
+	// Mark the first test address as a connection failure, which makes it
+	// the one to evict
 	err = addressManager.MarkConnectionFailure(testAddress)
 	if err != nil {
 		t.Fatalf("MarkConnectionFailure: %s", err)
 	}
-
-	// Add one more address to the address manager
-	err = addressManager.AddAddress(&appmessage.NetAddress{IP: net.IP{7, 8, 0, 0}, Timestamp: mstime.Now()})
+	err = addressManager.AddAddress(lastAddress)
 	if err != nil {
 		t.Fatalf("AddAddress: %s", err)
 	}
 
-	// Make sure that it now still contains exactly `maxAddresses` entries
 	returnedAddresses = addressManager.Addresses()
-	if len(returnedAddresses) != maxAddresses {
-		t.Fatalf("Unexpected address amount. Want: %d, got: %d", maxAddresses, len(returnedAddresses))
+	if len(returnedAddresses) != addressCount {
+		t.Fatalf("Unexpected address amount. Want: %d, got: %d", addressCount, len(returnedAddresses))
 	}
-
-	// Make sure that the first address is no longer in the
-	// connection manager
+	foundLastAddress := false
 	for _, address := range returnedAddresses {
 		if address.IP.Equal(testAddress.IP) {
 			t.Fatalf("Unexpectedly found testAddress returned addresses")
 		}
+		if address.IP.Equal(lastAddress.IP) {
+			foundLastAddress = true
+		}
+	}
+	if !foundLastAddress {
+		t.Fatalf("The address that evicted testAddress wasn't returned")
 	}
 }
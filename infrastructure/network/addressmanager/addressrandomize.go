@@ -3,6 +3,7 @@ package addressmanager
 import (
 	"math"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/cryptix-network/cryptixd/app/appmessage"
@@ -61,3 +62,22 @@ func (amc *AddressRandomize) RandomAddresses(addresses []*address, count int) []
 	}
 	return result
 }
+
+// WeightedShuffle returns the given addresses in random order, where addresses
+// with fewer failed connection attempts tend to come first
+func (amc *AddressRandomize) WeightedShuffle(addresses []*address) []*address {
+	// Every address gets a random sort key that is exponentially distributed
+	// with its weight as the rate, which orders the addresses the same way as
+	// drawing them one by one with probability proportional to their weight
+	sortKeys := make(map[*address]float64, len(addresses))
+	for _, addr := range addresses {
+		weight := math.Pow(64, float64(amc.maxFailedCount-addr.connectionFailedCount))
+		sortKeys[addr] = -math.Log(1-amc.random.Float64()) / weight
+	}
+	shuffled := make([]*address, len(addresses))
+	copy(shuffled, addresses)
+	sort.Slice(shuffled, func(i, j int) bool {
+		return sortKeys[shuffled[i]] < sortKeys[shuffled[j]]
+	})
+	return shuffled
+}
@@ -0,0 +1,108 @@
+package addressmanager
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database"
+)
+
+// MaxAnchors is the maximum amount of anchor addresses kept across restarts
+const MaxAnchors = 2
+
+var anchorAddressBucket = database.MakeBucket([]byte("anchor-addresses"))
+
+// SetAnchors replaces the stored anchor addresses - the outbound peers we were
+// connected to on shutdown, and reconnect to first on the next startup so that
+// a restart doesn't give an attacker the chance to take over all our outbound
+// connections. Only the first MaxAnchors addresses are kept.
+func (am *AddressManager) SetAnchors(addresses []*appmessage.NetAddress) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	err := am.store.clearAnchors()
+	if err != nil {
+		return err
+	}
+	if len(addresses) > MaxAnchors {
+		addresses = addresses[:MaxAnchors]
+	}
+	for _, netAddress := range addresses {
+		err := am.store.addAnchor(netAddressKey(netAddress), &address{netAddress: netAddress})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TakeAnchors returns the stored anchor addresses and removes them, so that a
+// node crashing right after startup doesn't keep reconnecting to them
+func (am *AddressManager) TakeAnchors() ([]*appmessage.NetAddress, error) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	anchors, err := am.store.getAnchors()
+	if err != nil {
+		return nil, err
+	}
+	err = am.store.clearAnchors()
+	if err != nil {
+		return nil, err
+	}
+	return anchors, nil
+}
+
+func (as *addressStore) addAnchor(key addressKey, address *address) error {
+	databaseKey := anchorAddressBucket.Key(as.serializeAddressKey(key))
+	return as.database.Put(databaseKey, as.serializeAddress(address))
+}
+
+func (as *addressStore) getAnchors() ([]*appmessage.NetAddress, error) {
+	cursor, err := as.database.Cursor(anchorAddressBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var anchors []*appmessage.NetAddress
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		serializedAddress, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		if len(serializedAddress) < serializedAddressLegacySize {
+			log.Warnf("Skipping anchor address with invalid value length %d", len(serializedAddress))
+			continue
+		}
+		anchors = append(anchors, as.deserializeAddress(serializedAddress).netAddress)
+	}
+	return anchors, nil
+}
+
+func (as *addressStore) clearAnchors() error {
+	cursor, err := as.database.Cursor(anchorAddressBucket)
+	if err != nil {
+		return err
+	}
+	var keys []*database.Key
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			cursor.Close()
+			return err
+		}
+		// The key is only valid until the cursor moves, so we copy it
+		keys = append(keys, anchorAddressBucket.Key(append([]byte(nil), key.Suffix()...)))
+	}
+	err = cursor.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err := as.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
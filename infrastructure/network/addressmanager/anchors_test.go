@@ -0,0 +1,51 @@
+package addressmanager
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+)
+
+func TestAnchors(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestAnchors")
+	defer teardown()
+
+	anchors := []*appmessage.NetAddress{
+		{IP: net.ParseIP("1.2.3.4"), Port: 19111},
+		{IP: net.ParseIP("5.6.7.8"), Port: 19111},
+		{IP: net.ParseIP("9.10.11.12"), Port: 19111},
+	}
+	err := addressManager.SetAnchors(anchors)
+	if err != nil {
+		t.Fatalf("SetAnchors: %s", err)
+	}
+
+	takenAnchors, err := addressManager.TakeAnchors()
+	if err != nil {
+		t.Fatalf("TakeAnchors: %s", err)
+	}
+	if len(takenAnchors) != MaxAnchors {
+		t.Fatalf("Unexpected anchor amount. Want: %d, got: %d", MaxAnchors, len(takenAnchors))
+	}
+	for _, anchor := range anchors[:MaxAnchors] {
+		found := false
+		for _, takenAnchor := range takenAnchors {
+			if takenAnchor.IP.Equal(anchor.IP) && takenAnchor.Port == anchor.Port {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("Anchor %s wasn't returned from TakeAnchors", anchor.TCPAddress())
+		}
+	}
+
+	takenAnchors, err = addressManager.TakeAnchors()
+	if err != nil {
+		t.Fatalf("TakeAnchors: %s", err)
+	}
+	if len(takenAnchors) != 0 {
+		t.Fatalf("Anchors unexpectedly returned twice")
+	}
+}
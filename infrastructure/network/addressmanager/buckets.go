@@ -0,0 +1,315 @@
+package addressmanager
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/util/mstime"
+)
+
+// Addresses are kept in two tables of fixed-size buckets, the same way
+// Bitcoin Core does it: the "new" table holds addresses we've heard about but
+// never connected to, and the "tried" table holds addresses we had a
+// successful handshake with.
+//
+// An address can only land in a few buckets, which are chosen by a keyed hash
+// of its network group and of the network group of the peer that told us
+// about it. This way a single network group, or a single peer announcing many
+// addresses, can only ever occupy a small part of each table, and an attacker
+// can't predict which part without knowing the bucket key.
+const (
+	newBucketCount   = 256
+	triedBucketCount = 64
+	bucketSize       = 16
+
+	// newBucketsPerSourceGroup is the number of new buckets that the
+	// addresses learned from a single source network group are spread over
+	newBucketsPerSourceGroup = 16
+
+	// triedBucketsPerGroup is the number of tried buckets that the
+	// addresses of a single network group are spread over
+	triedBucketsPerGroup = 4
+
+	// staleAddressAge is the age after which an unverified address may be
+	// evicted from a full new bucket to make room for a fresh one
+	staleAddressAge = 30 * 24 * time.Hour
+)
+
+type bucket map[addressKey]*address
+
+type addressBuckets struct {
+	key   [32]byte
+	new   [newBucketCount]bucket
+	tried [triedBucketCount]bucket
+}
+
+func newAddressBuckets(key [32]byte) *addressBuckets {
+	buckets := &addressBuckets{key: key}
+	for i := range buckets.new {
+		buckets.new[i] = bucket{}
+	}
+	for i := range buckets.tried {
+		buckets.tried[i] = bucket{}
+	}
+	return buckets
+}
+
+func (ab *addressBuckets) hash(data ...[]byte) uint64 {
+	hasher := sha256.New()
+	hasher.Write(ab.key[:])
+	for _, item := range data {
+		var length [4]byte
+		binary.LittleEndian.PutUint32(length[:], uint32(len(item)))
+		hasher.Write(length[:])
+		hasher.Write(item)
+	}
+	return binary.LittleEndian.Uint64(hasher.Sum(nil)[:8])
+}
+
+// sourceGroupKey returns the network group of the peer the address was
+// learned from. Addresses that announced themselves, or whose source is
+// unknown, are their own source.
+func (am *AddressManager) sourceGroupKey(address *address) string {
+	if address.source == (ipv6{}) {
+		return am.GroupKey(address.netAddress)
+	}
+	return am.GroupKey(&appmessage.NetAddress{IP: net.IP(address.source[:])})
+}
+
+func (am *AddressManager) newBucketIndex(address *address) int {
+	group := []byte(am.GroupKey(address.netAddress))
+	sourceGroup := []byte(am.sourceGroupKey(address))
+
+	var slot [8]byte
+	binary.LittleEndian.PutUint64(slot[:], am.buckets.hash([]byte("new-slot"), group, sourceGroup)%newBucketsPerSourceGroup)
+	return int(am.buckets.hash([]byte("new"), sourceGroup, slot[:]) % newBucketCount)
+}
+
+func (am *AddressManager) triedBucketIndex(key addressKey, address *address) int {
+	group := []byte(am.GroupKey(address.netAddress))
+
+	var port [2]byte
+	binary.LittleEndian.PutUint16(port[:], key.port)
+	var slot [8]byte
+	binary.LittleEndian.PutUint64(slot[:], am.buckets.hash([]byte("tried-slot"), key.address[:], port[:])%triedBucketsPerGroup)
+	return int(am.buckets.hash([]byte("tried"), group, slot[:]) % triedBucketCount)
+}
+
+// bucketOf returns the bucket the given address belongs to, according to
+// whether it's verified or not
+func (am *AddressManager) bucketOf(key addressKey, address *address) bucket {
+	if address.verified {
+		return am.buckets.tried[am.triedBucketIndex(key, address)]
+	}
+	return am.buckets.new[am.newBucketIndex(address)]
+}
+
+func isStale(address *address) bool {
+	return address.connectionFailedCount > 1 ||
+		mstime.Since(address.netAddress.Timestamp) > staleAddressAge
+}
+
+// worstInBucket returns the entry of a new bucket that is most worth evicting:
+// the one with the most failed connection attempts, and the oldest among those
+func worstInBucket(bucket bucket) (addressKey, *address) {
+	var worstKey addressKey
+	var worst *address
+	for key, address := range bucket {
+		if worst == nil ||
+			address.connectionFailedCount > worst.connectionFailedCount ||
+			(address.connectionFailedCount == worst.connectionFailedCount &&
+				address.netAddress.Timestamp.Before(worst.netAddress.Timestamp)) {
+			worstKey, worst = key, address
+		}
+	}
+	return worstKey, worst
+}
+
+// oldestInBucket returns the entry of a tried bucket that we last connected
+// to the longest time ago
+func oldestInBucket(bucket bucket) (addressKey, *address) {
+	var oldestKey addressKey
+	var oldest *address
+	for key, address := range bucket {
+		if oldest == nil || address.netAddress.Timestamp.Before(oldest.netAddress.Timestamp) {
+			oldestKey, oldest = key, address
+		}
+	}
+	return oldestKey, oldest
+}
+
+// placeNoLock puts the given address in its bucket, making room if needed.
+// It returns false if the address' bucket is full of entries that are worth
+// more than it. Only the bucket is updated - persisting the address itself is
+// up to the caller.
+func (am *AddressManager) placeNoLock(key addressKey, address *address) (bool, error) {
+	bucket := am.bucketOf(key, address)
+	if len(bucket) < bucketSize {
+		bucket[key] = address
+		return true, nil
+	}
+
+	if !address.verified {
+		// Entries that are still good are never evicted in favor of new
+		// ones, so that a flood of fresh addresses can't flush the table
+		worstKey, worst := worstInBucket(bucket)
+		if !isStale(worst) {
+			return false, nil
+		}
+		log.Tracef("Evicting %s from a full new bucket", worst.netAddress)
+		delete(bucket, worstKey)
+		err := am.store.remove(worstKey)
+		if err != nil {
+			return false, err
+		}
+		bucket[key] = address
+		return true, nil
+	}
+
+	// A full tried bucket makes room by moving its oldest entry back to
+	// the new table
+	oldestKey, oldest := oldestInBucket(bucket)
+	log.Tracef("Moving %s from a full tried bucket back to the new table", oldest.netAddress)
+	delete(bucket, oldestKey)
+	oldest.verified = false
+	placed, err := am.placeNoLock(oldestKey, oldest)
+	if err != nil {
+		return false, err
+	}
+	if placed {
+		err = am.store.updateNotBanned(oldestKey, oldest)
+	} else {
+		err = am.store.remove(oldestKey)
+	}
+	if err != nil {
+		return false, err
+	}
+	bucket[key] = address
+	return true, nil
+}
+
+// unplaceNoLock removes the given address from its bucket
+func (am *AddressManager) unplaceNoLock(key addressKey, address *address) {
+	delete(am.bucketOf(key, address), key)
+}
+
+// promoteNoLock moves an unverified address to the tried table
+func (am *AddressManager) promoteNoLock(key addressKey, address *address) error {
+	am.unplaceNoLock(key, address)
+	address.verified = true
+	_, err := am.placeNoLock(key, address)
+	if err != nil {
+		return err
+	}
+	return am.store.updateNotBanned(key, address)
+}
+
+// initBuckets places all the addresses loaded from the database in their
+// buckets. Addresses that don't fit are dropped.
+func (am *AddressManager) initBuckets() error {
+	bucketKey, err := am.store.bucketKey()
+	if err != nil {
+		return err
+	}
+	am.buckets = newAddressBuckets(bucketKey)
+
+	type keyedAddress struct {
+		key     addressKey
+		address *address
+	}
+	addresses := make([]keyedAddress, 0, am.store.notBannedCount())
+	for key, address := range am.store.notBannedAddresses {
+		addresses = append(addresses, keyedAddress{key: key, address: address})
+	}
+	// Place verified addresses first, and otherwise go in key order, so
+	// that the same addresses survive every restart
+	sort.Slice(addresses, func(i, j int) bool {
+		if addresses[i].address.verified != addresses[j].address.verified {
+			return addresses[i].address.verified
+		}
+		return bytes.Compare(am.store.serializeAddressKey(addresses[i].key),
+			am.store.serializeAddressKey(addresses[j].key)) < 0
+	})
+
+	dropped := 0
+	for _, entry := range addresses {
+		bucket := am.bucketOf(entry.key, entry.address)
+		if len(bucket) >= bucketSize && entry.address.verified {
+			entry.address.verified = false
+			bucket = am.bucketOf(entry.key, entry.address)
+		}
+		if len(bucket) >= bucketSize {
+			err := am.store.remove(entry.key)
+			if err != nil {
+				return err
+			}
+			dropped++
+			continue
+		}
+		bucket[entry.key] = entry.address
+	}
+	if dropped > 0 {
+		log.Infof("Dropped %d addresses that didn't fit in the address buckets", dropped)
+	}
+	return nil
+}
+
+// RandomOutboundAddresses returns up to count addresses to open outbound
+// connections to, that aren't banned and aren't in exceptions. Tried and new
+// addresses are picked alternately, and no two returned addresses share a
+// network group with each other or with any of connectedOutbound. Onion
+// addresses are only returned if they're reachable through an onion proxy.
+func (am *AddressManager) RandomOutboundAddresses(count int, exceptions []*appmessage.NetAddress,
+	connectedOutbound []*appmessage.NetAddress) []*appmessage.NetAddress {
+
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	usedGroups := make(map[string]struct{}, len(connectedOutbound)+count)
+	for _, netAddress := range connectedOutbound {
+		usedGroups[am.GroupKey(netAddress)] = struct{}{}
+	}
+
+	var triedAddresses, newAddresses []*address
+	for _, address := range am.store.getAllNotBannedNetAddressesWithout(exceptions) {
+		if address.verified {
+			triedAddresses = append(triedAddresses, address)
+		} else {
+			newAddresses = append(newAddresses, address)
+		}
+	}
+	triedAddresses = am.random.WeightedShuffle(triedAddresses)
+	newAddresses = am.random.WeightedShuffle(newAddresses)
+
+	result := make([]*appmessage.NetAddress, 0, count)
+	pickTried := true
+	for len(result) < count && (len(triedAddresses) > 0 || len(newAddresses) > 0) {
+		var candidate *address
+		if (pickTried && len(triedAddresses) > 0) || len(newAddresses) == 0 {
+			candidate, triedAddresses = triedAddresses[0], triedAddresses[1:]
+		} else {
+			candidate, newAddresses = newAddresses[0], newAddresses[1:]
+		}
+
+		if candidate.netAddress.IsOnionV3() && !am.cfg.OnionReachable {
+			continue
+		}
+		group := am.GroupKey(candidate.netAddress)
+		// Local and unroutable addresses only show up on test and
+		// private networks, where diversity doesn't matter
+		if group != groupKeyLocal && group != groupKeyUnroutable {
+			if _, ok := usedGroups[group]; ok {
+				continue
+			}
+			usedGroups[group] = struct{}{}
+		}
+		result = append(result, candidate.netAddress)
+		pickTried = !pickTried
+	}
+	return result
+}
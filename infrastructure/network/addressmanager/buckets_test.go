@@ -0,0 +1,154 @@
+package addressmanager
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/infrastructure/config"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database/ldb"
+	"github.com/cryptix-network/cryptixd/util/mstime"
+)
+
+func TestAddressesFromSourceAreLimited(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestAddressesFromSourceAreLimited")
+	defer teardown()
+
+	// A single peer announcing addresses from many network groups can only
+	// fill the new buckets of its own source group
+	source := &appmessage.NetAddress{IP: net.ParseIP("203.1.1.1")}
+	addresses := make([]*appmessage.NetAddress, 0, 200*20)
+	for i := 0; i < 200; i++ {
+		for j := 0; j < 20; j++ {
+			addresses = append(addresses,
+				&appmessage.NetAddress{IP: net.IP{11, byte(i), byte(j), 1}, Timestamp: mstime.Now()})
+		}
+	}
+	err := addressManager.AddAddressesFromSource(source, addresses...)
+	if err != nil {
+		t.Fatalf("AddAddressesFromSource: %s", err)
+	}
+
+	usedBuckets := 0
+	for _, bucket := range addressManager.buckets.new {
+		if len(bucket) > 0 {
+			usedBuckets++
+		}
+	}
+	if usedBuckets > newBucketsPerSourceGroup {
+		t.Fatalf("Addresses from a single source use %d buckets, want at most %d",
+			usedBuckets, newBucketsPerSourceGroup)
+	}
+	if len(addressManager.Addresses()) > newBucketsPerSourceGroup*bucketSize {
+		t.Fatalf("Addresses from a single source take %d entries, want at most %d",
+			len(addressManager.Addresses()), newBucketsPerSourceGroup*bucketSize)
+	}
+}
+
+func TestMarkConnectionSuccessMovesToTried(t *testing.T) {
+	cfg := config.DefaultConfig()
+	datadir := t.TempDir()
+	database, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+
+	addressManager, err := New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+
+	source := &appmessage.NetAddress{IP: net.ParseIP("203.1.1.1")}
+	testAddress := &appmessage.NetAddress{IP: net.ParseIP("1.2.3.4"), Port: 19111, Timestamp: mstime.Now()}
+	err = addressManager.AddAddressesFromSource(source, testAddress)
+	if err != nil {
+		t.Fatalf("AddAddressesFromSource: %s", err)
+	}
+	err = addressManager.MarkConnectionSuccess(testAddress)
+	if err != nil {
+		t.Fatalf("MarkConnectionSuccess: %s", err)
+	}
+
+	key := netAddressKey(testAddress)
+	entry, ok := addressManager.store.getNotBanned(key)
+	if !ok || !entry.verified {
+		t.Fatalf("Address unexpectedly not verified after a successful connection")
+	}
+	if _, ok := addressManager.buckets.tried[addressManager.triedBucketIndex(key, entry)][key]; !ok {
+		t.Fatalf("Address not found in its tried bucket")
+	}
+	newBucket := addressManager.newBucketIndex(entry)
+	if _, ok := addressManager.buckets.new[newBucket][key]; ok {
+		t.Fatalf("Address unexpectedly still in its new bucket")
+	}
+
+	// Reopen and make sure the address comes back in the same bucket
+	bucketKey := addressManager.buckets.key
+	err = database.Close()
+	if err != nil {
+		t.Fatalf("Close() failed: %s", err)
+	}
+	database, err = ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+
+	if addressManager.buckets.key != bucketKey {
+		t.Fatalf("Bucket key changed across restarts")
+	}
+	entry, ok = addressManager.store.getNotBanned(key)
+	if !ok || !entry.verified {
+		t.Fatalf("Address unexpectedly not verified after restart")
+	}
+	if !entry.source.equal(netAddressKey(source).address) {
+		t.Fatalf("Address source wasn't restored")
+	}
+	if _, ok := addressManager.buckets.tried[addressManager.triedBucketIndex(key, entry)][key]; !ok {
+		t.Fatalf("Address not found in its tried bucket after restart")
+	}
+}
+
+func TestRandomOutboundAddressesDiversity(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestRandomOutboundAddressesDiversity")
+	defer teardown()
+
+	var addresses []*appmessage.NetAddress
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			addresses = append(addresses,
+				&appmessage.NetAddress{IP: net.IP{21, byte(i), byte(j), 1}, Timestamp: mstime.Now()})
+		}
+	}
+	err := addressManager.AddAddresses(addresses...)
+	if err != nil {
+		t.Fatalf("AddAddresses: %s", err)
+	}
+	for _, address := range addresses[:20] {
+		err := addressManager.MarkConnectionSuccess(address)
+		if err != nil {
+			t.Fatalf("MarkConnectionSuccess: %s", err)
+		}
+	}
+
+	connectedOutbound := []*appmessage.NetAddress{{IP: net.IP{21, 0, 9, 9}}}
+	outboundAddresses := addressManager.RandomOutboundAddresses(100, nil, connectedOutbound)
+
+	// There are 10 groups, one of them already taken
+	if len(outboundAddresses) != 9 {
+		t.Fatalf("Unexpected outbound address amount. Want: %d, got: %d", 9, len(outboundAddresses))
+	}
+	groups := map[string]struct{}{addressManager.GroupKey(connectedOutbound[0]): {}}
+	for _, address := range outboundAddresses {
+		group := addressManager.GroupKey(address)
+		if _, ok := groups[group]; ok {
+			t.Fatalf("Group %s was returned more than once", group)
+		}
+		groups[group] = struct{}{}
+	}
+}
@@ -9,6 +9,7 @@ import (
 // Config is a descriptor which specifies the AddressManager instance configuration.
 type Config struct {
 	AcceptUnroutable bool
+	OnionReachable   bool
 	DefaultPort      string
 	ExternalIPs      []string
 	Listeners        []string
@@ -19,6 +20,7 @@ type Config struct {
 func NewConfig(cfg *config.Config) *Config {
 	return &Config{
 		AcceptUnroutable: cfg.NetParams().AcceptUnroutable,
+		OnionReachable:   cfg.OnionDial != nil,
 		DefaultPort:      cfg.NetParams().DefaultPort,
 		ExternalIPs:      cfg.ExternalIPs,
 		Listeners:        cfg.Listeners,
@@ -24,6 +24,12 @@ generally helps provide greater peer diversity, and perhaps more importantly,
 drastically reduces the chances an attacker is able to coerce your peer into
 only connecting to nodes they control.
 
+Addresses we've had a successful connection with are kept apart from the ones
+we've only heard about, so that an attacker flooding us with fresh addresses
+can't push the known good ones out. The outbound peers we were connected to on
+shutdown are kept as anchors, and are the first ones we reconnect to on the
+next startup.
+
 The address manager also understands routability and tries hard to only return
 routable addresses. In addition, it uses the information provided by the caller
 about connected, known good, and attempted addresses to periodically purge
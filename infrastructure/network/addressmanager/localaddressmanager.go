@@ -312,6 +312,9 @@ func reachabilityFrom(localAddress, remoteAddress *appmessage.NetAddress, accept
 	)
 
 	IsRoutable := func(na *appmessage.NetAddress) bool {
+		if na.IsOnionV3() {
+			return true
+		}
 		if acceptUnroutable {
 			return !IsLocal(na)
 		}
@@ -326,6 +329,18 @@ func reachabilityFrom(localAddress, remoteAddress *appmessage.NetAddress, accept
 		return Unreachable
 	}
 
+	// Our onion address can be reached by anyone with Tor, but it's only
+	// preferred for peers that are onion services themselves
+	if localAddress.IsOnionV3() {
+		if remoteAddress.IsOnionV3() {
+			return Private
+		}
+		return Default
+	}
+	if remoteAddress.IsOnionV3() {
+		return Default
+	}
+
 	if IsRFC4380(remoteAddress) {
 		if !IsRoutable(localAddress) {
 			return Default
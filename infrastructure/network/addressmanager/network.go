@@ -5,6 +5,7 @@
 package addressmanager
 
 import (
+	"fmt"
 	"net"
 
 	"github.com/cryptix-network/cryptixd/app/appmessage"
@@ -208,6 +209,9 @@ func IsValid(na *appmessage.NetAddress) bool {
 // the public internet. This is true as long as the address is valid and is not
 // in any reserved ranges.
 func IsRoutable(na *appmessage.NetAddress, acceptUnroutable bool) bool {
+	if na.IsOnionV3() {
+		return true
+	}
 	if acceptUnroutable {
 		return !IsLocal(na)
 	}
@@ -218,16 +222,26 @@ func IsRoutable(na *appmessage.NetAddress, acceptUnroutable bool) bool {
 		IsLocal(na) || (IsRFC4193(na)))
 }
 
+const (
+	groupKeyLocal      = "local"
+	groupKeyUnroutable = "unroutable"
+)
+
 // GroupKey returns a string representing the network group an address is part
-// of. This is the /16 for IPv4, the /32 (/36 for he.net) for IPv6, the string
-// "local" for a local address, and the string "unroutable" for an unroutable
-// address.
+// of. This is the /16 for IPv4, the /32 (/36 for he.net) for IPv6, one of 16
+// groups for Tor v3 onion addresses, the string "local" for a local address,
+// and the string "unroutable" for an unroutable address.
 func (am *AddressManager) GroupKey(na *appmessage.NetAddress) string {
+	if na.IsOnionV3() {
+		// Onion addresses cost nothing to create, so they're only split
+		// by the first 4 bits of their key
+		return fmt.Sprintf("onion:%x", na.OnionV3[0]>>4)
+	}
 	if IsLocal(na) {
-		return "local"
+		return groupKeyLocal
 	}
 	if !IsRoutable(na, am.cfg.AcceptUnroutable) {
-		return "unroutable"
+		return groupKeyUnroutable
 	}
 	if IsIPv4(na) {
 		return na.IP.Mask(net.CIDRMask(16, 32)).String()
@@ -203,4 +203,14 @@ func TestGroupKey(t *testing.T) {
 				key, test.expected)
 		}
 	}
+
+	// Tor v3 onion addresses are routable and grouped by the first 4 bits
+	// of their key
+	onionAddress := appmessage.NewNetAddressOnionV3([appmessage.OnionV3PubKeySize]byte{0xab, 0xcd}, 8333)
+	if !IsRoutable(onionAddress, false) {
+		t.Errorf("Onion address %s is unexpectedly unroutable", onionAddress)
+	}
+	if key := amgr.GroupKey(onionAddress); key != "onion:a" {
+		t.Errorf("TestGroupKey (onion): unexpected group key - got '%s', want 'onion:a'", key)
+	}
 }
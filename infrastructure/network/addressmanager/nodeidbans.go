@@ -0,0 +1,191 @@
+package addressmanager
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database"
+	"github.com/cryptix-network/cryptixd/util/mstime"
+	"github.com/pkg/errors"
+)
+
+var bannedNodeIDBucket = database.MakeBucket([]byte("banned-node-ids"))
+
+const (
+	nodeIDSize = 32
+
+	// bannedAt + expiresAt, followed by the reason
+	serializedBannedNodeIDHeaderSize = 8 + 8
+)
+
+// ErrNodeIDNotFound is an error returned from some functions when a
+// given node ID is not banned
+var ErrNodeIDNotFound = errors.New("node ID not found")
+
+// BannedNodeID is an operator or protocol ban of a unified node ID
+type BannedNodeID struct {
+	NodeID   [nodeIDSize]byte
+	Reason   string
+	BannedAt mstime.Time
+
+	// ExpiresAt is zero for bans that never expire
+	ExpiresAt mstime.Time
+}
+
+// NeverExpires returns whether the ban is permanent
+func (b *BannedNodeID) NeverExpires() bool {
+	return b.ExpiresAt.IsZero()
+}
+
+func (b *BannedNodeID) isExpired(now mstime.Time) bool {
+	return !b.NeverExpires() && !b.ExpiresAt.After(now)
+}
+
+// BanNodeID bans the given unified node ID for the given duration and
+// replaces any previous ban of it. A zero duration bans the node ID until
+// it's explicitly unbanned.
+func (am *AddressManager) BanNodeID(nodeID [nodeIDSize]byte, reason string, duration time.Duration) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	now := mstime.Now()
+	bannedNodeID := &BannedNodeID{
+		NodeID:   nodeID,
+		Reason:   reason,
+		BannedAt: now,
+	}
+	if duration > 0 {
+		bannedNodeID.ExpiresAt = now.Add(duration.Truncate(time.Millisecond))
+	}
+	return am.store.addBannedNodeID(bannedNodeID)
+}
+
+// UnbanNodeID removes the ban of the given unified node ID
+func (am *AddressManager) UnbanNodeID(nodeID [nodeIDSize]byte) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	if _, ok := am.store.bannedNodeIDs[nodeID]; !ok {
+		return errors.Wrapf(ErrNodeIDNotFound, "node ID %s is not banned", hex.EncodeToString(nodeID[:]))
+	}
+	return am.store.removeBannedNodeID(nodeID)
+}
+
+// IsNodeIDBanned returns true if the given unified node ID has a ban that
+// did not expire yet
+func (am *AddressManager) IsNodeIDBanned(nodeID [nodeIDSize]byte) (bool, error) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	bannedNodeID, ok := am.store.bannedNodeIDs[nodeID]
+	if !ok {
+		return false, nil
+	}
+	if bannedNodeID.isExpired(mstime.Now()) {
+		return false, am.store.removeBannedNodeID(nodeID)
+	}
+	return true, nil
+}
+
+// BannedNodeIDs returns all the unexpired node ID bans, ordered by the time
+// they were made
+func (am *AddressManager) BannedNodeIDs() ([]*BannedNodeID, error) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	now := mstime.Now()
+	bannedNodeIDs := make([]*BannedNodeID, 0, len(am.store.bannedNodeIDs))
+	for nodeID, bannedNodeID := range am.store.bannedNodeIDs {
+		if bannedNodeID.isExpired(now) {
+			err := am.store.removeBannedNodeID(nodeID)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		bannedNodeIDCopy := *bannedNodeID
+		bannedNodeIDs = append(bannedNodeIDs, &bannedNodeIDCopy)
+	}
+	sort.Slice(bannedNodeIDs, func(i, j int) bool {
+		if bannedNodeIDs[i].BannedAt.UnixMilliseconds() == bannedNodeIDs[j].BannedAt.UnixMilliseconds() {
+			return bytes.Compare(bannedNodeIDs[i].NodeID[:], bannedNodeIDs[j].NodeID[:]) < 0
+		}
+		return bannedNodeIDs[i].BannedAt.Before(bannedNodeIDs[j].BannedAt)
+	})
+	return bannedNodeIDs, nil
+}
+
+func (as *addressStore) restoreBannedNodeIDs() error {
+	cursor, err := as.database.Cursor(bannedNodeIDBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		databaseKey, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+		serializedKey := databaseKey.Suffix()
+		if len(serializedKey) != nodeIDSize {
+			log.Warnf("Skipping banned node ID with invalid key length %d", len(serializedKey))
+			continue
+		}
+		var nodeID [nodeIDSize]byte
+		copy(nodeID[:], serializedKey)
+
+		serializedBannedNodeID, err := cursor.Value()
+		if err != nil {
+			return err
+		}
+		if len(serializedBannedNodeID) < serializedBannedNodeIDHeaderSize {
+			log.Warnf("Skipping banned node ID %x with invalid value length %d", serializedKey, len(serializedBannedNodeID))
+			continue
+		}
+		as.bannedNodeIDs[nodeID] = deserializeBannedNodeID(nodeID, serializedBannedNodeID)
+	}
+	return nil
+}
+
+func (as *addressStore) addBannedNodeID(bannedNodeID *BannedNodeID) error {
+	as.bannedNodeIDs[bannedNodeID.NodeID] = bannedNodeID
+
+	databaseKey := bannedNodeIDBucket.Key(bannedNodeID.NodeID[:])
+	return as.database.Put(databaseKey, serializeBannedNodeID(bannedNodeID))
+}
+
+func (as *addressStore) removeBannedNodeID(nodeID [nodeIDSize]byte) error {
+	delete(as.bannedNodeIDs, nodeID)
+
+	databaseKey := bannedNodeIDBucket.Key(nodeID[:])
+	return as.database.Delete(databaseKey)
+}
+
+// serializeBannedNodeID serializes the ban as bannedAt, expiresAt and reason.
+// Bans that never expire are serialized with a zero expiresAt.
+func serializeBannedNodeID(bannedNodeID *BannedNodeID) []byte {
+	var expiresAt uint64
+	if !bannedNodeID.NeverExpires() {
+		expiresAt = uint64(bannedNodeID.ExpiresAt.UnixMilliseconds())
+	}
+	serializedBannedNodeID := make([]byte, serializedBannedNodeIDHeaderSize+len(bannedNodeID.Reason))
+	binary.LittleEndian.PutUint64(serializedBannedNodeID[0:8], uint64(bannedNodeID.BannedAt.UnixMilliseconds()))
+	binary.LittleEndian.PutUint64(serializedBannedNodeID[8:16], expiresAt)
+	copy(serializedBannedNodeID[serializedBannedNodeIDHeaderSize:], bannedNodeID.Reason)
+	return serializedBannedNodeID
+}
+
+func deserializeBannedNodeID(nodeID [nodeIDSize]byte, serializedBannedNodeID []byte) *BannedNodeID {
+	bannedNodeID := &BannedNodeID{
+		NodeID:   nodeID,
+		BannedAt: mstime.UnixMilliseconds(int64(binary.LittleEndian.Uint64(serializedBannedNodeID[0:8]))),
+		Reason:   string(serializedBannedNodeID[serializedBannedNodeIDHeaderSize:]),
+	}
+	if expiresAt := binary.LittleEndian.Uint64(serializedBannedNodeID[8:16]); expiresAt != 0 {
+		bannedNodeID.ExpiresAt = mstime.UnixMilliseconds(int64(expiresAt))
+	}
+	return bannedNodeID
+}
@@ -0,0 +1,117 @@
+package addressmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/config"
+	"github.com/cryptix-network/cryptixd/infrastructure/db/database/ldb"
+	"github.com/cryptix-network/cryptixd/util/mstime"
+	"github.com/pkg/errors"
+)
+
+func TestNodeIDBans(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	datadir := t.TempDir()
+	database, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+
+	addressManager, err := New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+
+	permanentNodeID := [nodeIDSize]byte{1}
+	temporaryNodeID := [nodeIDSize]byte{2}
+	expiredNodeID := [nodeIDSize]byte{3}
+	err = addressManager.BanNodeID(permanentNodeID, "rotates IPs", 0)
+	if err != nil {
+		t.Fatalf("BanNodeID() failed: %s", err)
+	}
+	err = addressManager.BanNodeID(temporaryNodeID, "", time.Hour)
+	if err != nil {
+		t.Fatalf("BanNodeID() failed: %s", err)
+	}
+	err = addressManager.store.addBannedNodeID(&BannedNodeID{
+		NodeID:    expiredNodeID,
+		BannedAt:  mstime.Now().Add(-2 * time.Hour),
+		ExpiresAt: mstime.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("addBannedNodeID() failed: %s", err)
+	}
+
+	// Close and reopen the database to make sure the bans are persisted
+	err = database.Close()
+	if err != nil {
+		t.Fatalf("Close() failed: %s", err)
+	}
+	database, err = ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+
+	for _, nodeID := range [][nodeIDSize]byte{permanentNodeID, temporaryNodeID} {
+		isBanned, err := addressManager.IsNodeIDBanned(nodeID)
+		if err != nil {
+			t.Fatalf("IsNodeIDBanned() failed: %s", err)
+		}
+		if !isBanned {
+			t.Fatalf("Node ID %x is unexpectedly not banned", nodeID)
+		}
+	}
+	isBanned, err := addressManager.IsNodeIDBanned(expiredNodeID)
+	if err != nil {
+		t.Fatalf("IsNodeIDBanned() failed: %s", err)
+	}
+	if isBanned {
+		t.Fatalf("Expired node ID ban is unexpectedly active")
+	}
+
+	bannedNodeIDs, err := addressManager.BannedNodeIDs()
+	if err != nil {
+		t.Fatalf("BannedNodeIDs() failed: %s", err)
+	}
+	if len(bannedNodeIDs) != 2 {
+		t.Fatalf("Unexpected amount of banned node IDs. Want: 2, got: %d", len(bannedNodeIDs))
+	}
+	for _, bannedNodeID := range bannedNodeIDs {
+		switch bannedNodeID.NodeID {
+		case permanentNodeID:
+			if !bannedNodeID.NeverExpires() || bannedNodeID.Reason != "rotates IPs" {
+				t.Fatalf("Unexpected permanent ban %+v", bannedNodeID)
+			}
+		case temporaryNodeID:
+			if bannedNodeID.NeverExpires() || bannedNodeID.ExpiresAt.Sub(bannedNodeID.BannedAt) != time.Hour {
+				t.Fatalf("Unexpected temporary ban %+v", bannedNodeID)
+			}
+		default:
+			t.Fatalf("Unexpected banned node ID %x", bannedNodeID.NodeID)
+		}
+	}
+
+	err = addressManager.UnbanNodeID(permanentNodeID)
+	if err != nil {
+		t.Fatalf("UnbanNodeID() failed: %s", err)
+	}
+	isBanned, err = addressManager.IsNodeIDBanned(permanentNodeID)
+	if err != nil {
+		t.Fatalf("IsNodeIDBanned() failed: %s", err)
+	}
+	if isBanned {
+		t.Fatalf("Unbanned node ID is unexpectedly banned")
+	}
+	err = addressManager.UnbanNodeID(permanentNodeID)
+	if !errors.Is(err, ErrNodeIDNotFound) {
+		t.Fatalf("Expected ErrNodeIDNotFound when unbanning twice, got: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package addressmanager
 
 import (
+	"crypto/rand"
 	"encoding/binary"
 	"github.com/cryptix-network/cryptixd/app/appmessage"
 	"github.com/cryptix-network/cryptixd/infrastructure/db/database"
@@ -11,18 +12,22 @@ import (
 
 var notBannedAddressBucket = database.MakeBucket([]byte("not-banned-addresses"))
 var bannedAddressBucket = database.MakeBucket([]byte("banned-addresses"))
+var bucketKeyDatabaseKey = database.MakeBucket([]byte("address-buckets")).Key([]byte("key"))
 
 const (
 	serializedAddressKeySize    = net.IPv6len + 2
 	serializedBannedKeySize     = net.IPv6len
 	serializedAddressLegacySize = net.IPv6len + 2 + 8 + 8
-	serializedAddressSize       = serializedAddressLegacySize + 1
+	serializedAddressNoSource   = serializedAddressLegacySize + 1
+	serializedAddressSize       = serializedAddressNoSource + net.IPv6len
+	serializedOnionAddressSize  = serializedAddressSize + appmessage.OnionV3PubKeySize
 )
 
 type addressStore struct {
 	database           database.Database
 	notBannedAddresses map[addressKey]*address
 	bannedAddresses    map[ipv6]*address
+	bannedNodeIDs      map[[nodeIDSize]byte]*BannedNodeID
 }
 
 func newAddressStore(database database.Database) (*addressStore, error) {
@@ -30,6 +35,7 @@ func newAddressStore(database database.Database) (*addressStore, error) {
 		database:           database,
 		notBannedAddresses: map[addressKey]*address{},
 		bannedAddresses:    map[ipv6]*address{},
+		bannedNodeIDs:      map[[nodeIDSize]byte]*BannedNodeID{},
 	}
 	err := addressStore.restoreNotBannedAddresses()
 	if err != nil {
@@ -39,9 +45,13 @@ func newAddressStore(database database.Database) (*addressStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = addressStore.restoreBannedNodeIDs()
+	if err != nil {
+		return nil, err
+	}
 
-	log.Infof("Loaded %d addresses and %d banned addresses",
-		len(addressStore.notBannedAddresses), len(addressStore.bannedAddresses))
+	log.Infof("Loaded %d addresses, %d banned addresses and %d banned node IDs",
+		len(addressStore.notBannedAddresses), len(addressStore.bannedAddresses), len(addressStore.bannedNodeIDs))
 
 	return addressStore, nil
 }
@@ -152,14 +162,6 @@ func (as *addressStore) remove(key addressKey) error {
 	return as.database.Delete(databaseKey)
 }
 
-func (as *addressStore) getAllNotBanned() []*address {
-	addresses := make([]*address, 0, len(as.notBannedAddresses))
-	for _, address := range as.notBannedAddresses {
-		addresses = append(addresses, address)
-	}
-	return addresses
-}
-
 func (as *addressStore) getAllNotBannedNetAddresses() []*appmessage.NetAddress {
 	addresses := make([]*appmessage.NetAddress, 0, len(as.notBannedAddresses))
 	for _, address := range as.notBannedAddresses {
@@ -278,8 +280,31 @@ func (as *addressStore) deserializeAddressKey(serializedKey []byte) addressKey {
 	}
 }
 
+// bucketKey returns the secret key that addresses are assigned to buckets by,
+// generating it on first use
+func (as *addressStore) bucketKey() ([32]byte, error) {
+	var key [32]byte
+	serializedKey, err := as.database.Get(bucketKeyDatabaseKey)
+	if err == nil && len(serializedKey) == len(key) {
+		copy(key[:], serializedKey)
+		return key, nil
+	}
+	if err != nil && !database.IsNotFoundError(err) {
+		return key, err
+	}
+
+	_, err = rand.Read(key[:])
+	if err != nil {
+		return key, errors.WithStack(err)
+	}
+	return key, as.database.Put(bucketKeyDatabaseKey, key[:])
+}
+
 func (as *addressStore) serializeAddress(address *address) []byte {
-	serializedSize := serializedAddressSize // ipv6 + port + timestamp + connectionFailedCount + verified
+	serializedSize := serializedAddressSize // ipv6 + port + timestamp + connectionFailedCount + verified + source
+	if address.netAddress.IsOnionV3() {
+		serializedSize = serializedOnionAddressSize // + onion v3 public key
+	}
 	serializedNetAddress := make([]byte, serializedSize)
 
 	copy(serializedNetAddress[:], address.netAddress.IP.To16()[:])
@@ -289,6 +314,10 @@ func (as *addressStore) serializeAddress(address *address) []byte {
 	if address.verified {
 		serializedNetAddress[34] = 1
 	}
+	copy(serializedNetAddress[serializedAddressNoSource:], address.source[:])
+	if address.netAddress.IsOnionV3() {
+		copy(serializedNetAddress[serializedAddressSize:], address.netAddress.OnionV3[:])
+	}
 
 	return serializedNetAddress
 }
@@ -314,15 +343,26 @@ func (as *addressStore) deserializeAddress(serializedAddress []byte) *address {
 		connectionFailedCount = binary.LittleEndian.Uint64(serializedAddress[26:34])
 	}
 
-	verified := len(serializedAddress) >= serializedAddressSize && serializedAddress[34] == 1
+	verified := len(serializedAddress) >= serializedAddressNoSource && serializedAddress[34] == 1
+
+	var source ipv6
+	if len(serializedAddress) >= serializedAddressSize {
+		copy(source[:], serializedAddress[serializedAddressNoSource:serializedAddressSize])
+	}
+
+	netAddress := &appmessage.NetAddress{
+		IP:        ip,
+		Port:      port,
+		Timestamp: timestamp,
+	}
+	if len(serializedAddress) >= serializedOnionAddressSize {
+		copy(netAddress.OnionV3[:], serializedAddress[serializedAddressSize:serializedOnionAddressSize])
+	}
 
 	return &address{
-		netAddress: &appmessage.NetAddress{
-			IP:        ip,
-			Port:      port,
-			Timestamp: timestamp,
-		},
+		netAddress:            netAddress,
 		connectionFailedCount: connectionFailedCount,
 		verified:              verified,
+		source:                source,
 	}
 }
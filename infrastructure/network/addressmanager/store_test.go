@@ -47,6 +47,35 @@ func TestAddressSerialization(t *testing.T) {
 	}
 }
 
+func TestOnionAddressSerialization(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestOnionAddressSerialization")
+	defer teardown()
+	addressStore := addressManager.store
+
+	var pubKey [appmessage.OnionV3PubKeySize]byte
+	for i := range pubKey {
+		pubKey[i] = byte(i + 1)
+	}
+	netAddress := appmessage.NewNetAddressOnionV3(pubKey, 12345)
+	netAddress.Timestamp = mstime.Now()
+	testAddress := &address{
+		netAddress:            netAddress,
+		connectionFailedCount: 3,
+		verified:              true,
+	}
+
+	serializedTestAddress := addressStore.serializeAddress(testAddress)
+	if len(serializedTestAddress) != serializedOnionAddressSize {
+		t.Fatalf("Unexpected serialized onion address length. Want: %d, got: %d",
+			serializedOnionAddressSize, len(serializedTestAddress))
+	}
+	deserializedTestAddress := addressStore.deserializeAddress(serializedTestAddress)
+	if !reflect.DeepEqual(testAddress, deserializedTestAddress) {
+		t.Fatalf("testAddress and deserializedTestAddress are not equal\n"+
+			"testAddress:%+v\ndeserializedTestAddress:%+v", testAddress, deserializedTestAddress)
+	}
+}
+
 func TestDeserializeAddressLegacySerialization(t *testing.T) {
 	addressManager, teardown := newAddressManagerForTest(t, "TestDeserializeAddressLegacySerialization")
 	defer teardown()
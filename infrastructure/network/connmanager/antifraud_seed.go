@@ -0,0 +1,147 @@
+package connmanager
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"strings"
+	"sync"
+
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
+	"github.com/pkg/errors"
+)
+
+var (
+	antiFraudOperatorKeysLock sync.RWMutex
+	antiFraudOperatorKeys     = map[uint8]map[uint8][32]byte{}
+)
+
+// setAntiFraudOperatorKeys pins extra AntiFraud snapshot signing keys for
+// the given network, on top of the built-in ones. This lets operators of
+// private networks run their own AntiFraud seed.
+func setAntiFraudOperatorKeys(network uint8, keys map[uint8][32]byte) error {
+	if network == 0 && len(keys) > 0 {
+		return errors.New("extra AntiFraud operator keys are not allowed on mainnet")
+	}
+	for keyID := range keys {
+		if keyID <= 1 {
+			return errors.Errorf("AntiFraud operator key ID %d is reserved for the built-in keys", keyID)
+		}
+	}
+
+	copied := make(map[uint8][32]byte, len(keys))
+	for keyID, key := range keys {
+		copied[keyID] = key
+	}
+
+	antiFraudOperatorKeysLock.Lock()
+	defer antiFraudOperatorKeysLock.Unlock()
+	antiFraudOperatorKeys[network] = copied
+	return nil
+}
+
+func antiFraudOperatorKey(network uint8, signingKeyID uint8) ([32]byte, bool) {
+	antiFraudOperatorKeysLock.RLock()
+	defer antiFraudOperatorKeysLock.RUnlock()
+	key, ok := antiFraudOperatorKeys[network][signingKeyID]
+	return key, ok
+}
+
+// AntiFraudSnapshotContent is the content of an AntiFraud snapshot before it's signed
+type AntiFraudSnapshotContent struct {
+	NetworkName      string
+	SnapshotSeq      uint64
+	GeneratedAtMs    uint64
+	SigningKeyID     uint8
+	AntiFraudEnabled bool
+	BannedIPs        []string
+	BannedNodeIDs    []string
+}
+
+type signedAntiFraudSnapshotJSON struct {
+	Status             string   `json:"status"`
+	SchemaVersion      uint8    `json:"schema_version"`
+	Network            uint8    `json:"network"`
+	SnapshotSeq        uint64   `json:"snapshot_seq"`
+	GeneratedAtMs      uint64   `json:"generated_at_ms"`
+	SigningKeyID       uint8    `json:"signing_key_id"`
+	AntiFraudEnabled   bool     `json:"antifraud_enabled"`
+	BannedIPs          []string `json:"banned_ips"`
+	BannedIPsCount     int      `json:"banned_ips_count"`
+	BannedNodeIDs      []string `json:"banned_node_ids"`
+	BannedNodeIDsCount int      `json:"banned_node_ids_count"`
+	RootHash           string   `json:"root_hash"`
+	Signature          string   `json:"signature"`
+}
+
+// SignAntiFraudSnapshot builds the canonical payload of the given snapshot
+// content the same way nodes do when verifying it, signs its root hash with
+// keyPair and returns the JSON document an AntiFraud seed serves.
+func SignAntiFraudSnapshot(content *AntiFraudSnapshotContent, keyPair *secp256k1.SchnorrKeyPair) ([]byte, error) {
+	network, err := antiFraudNetworkFromName(content.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	for _, bannedIP := range content.BannedIPs {
+		if net.ParseIP(strings.TrimSpace(bannedIP)) == nil {
+			return nil, errors.Errorf("invalid banned IP %q", bannedIP)
+		}
+	}
+	for _, bannedNodeID := range content.BannedNodeIDs {
+		decoded, err := hex.DecodeString(strings.TrimSpace(bannedNodeID))
+		if err != nil || len(decoded) != 32 {
+			return nil, errors.Errorf("invalid banned node ID %q", bannedNodeID)
+		}
+	}
+
+	ipEntries, _ := normalizeExternalBanlistIPEntries(content.BannedIPs)
+	nodeIDEntries, _ := normalizeExternalBanlistNodeIDEntries(content.BannedNodeIDs)
+	normalized := normalizedAntiFraudSnapshot{
+		schemaVersion:    antiFraudSchemaVersion,
+		network:          network,
+		snapshotSeq:      content.SnapshotSeq,
+		generatedAtMs:    content.GeneratedAtMs,
+		signingKeyID:     content.SigningKeyID,
+		antiFraudEnabled: content.AntiFraudEnabled,
+		ipEntries:        ipEntries,
+		nodeIDEntries:    nodeIDEntries,
+	}
+	rootHash, err := normalized.computeRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	var secpHash secp256k1.Hash
+	copy(secpHash[:], rootHash[:])
+	signature, err := keyPair.SchnorrSign(&secpHash)
+	if err != nil {
+		return nil, err
+	}
+
+	bannedIPs := make([]string, 0, len(ipEntries))
+	for _, entry := range ipEntries {
+		if canonical, ok := canonicalIPFromEntry(entry); ok {
+			bannedIPs = append(bannedIPs, canonical)
+		}
+	}
+	bannedNodeIDs := make([]string, len(nodeIDEntries))
+	for i, entry := range nodeIDEntries {
+		bannedNodeIDs[i] = hex.EncodeToString(entry[:])
+	}
+
+	return json.Marshal(&signedAntiFraudSnapshotJSON{
+		Status:             "success",
+		SchemaVersion:      antiFraudSchemaVersion,
+		Network:            network,
+		SnapshotSeq:        content.SnapshotSeq,
+		GeneratedAtMs:      content.GeneratedAtMs,
+		SigningKeyID:       content.SigningKeyID,
+		AntiFraudEnabled:   content.AntiFraudEnabled,
+		BannedIPs:          bannedIPs,
+		BannedIPsCount:     len(bannedIPs),
+		BannedNodeIDs:      bannedNodeIDs,
+		BannedNodeIDsCount: len(bannedNodeIDs),
+		RootHash:           hex.EncodeToString(rootHash[:]),
+		Signature:          hex.EncodeToString(signature.Serialize()[:]),
+	})
+}
@@ -0,0 +1,86 @@
+package connmanager
+
+import (
+	"testing"
+
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
+)
+
+func TestSignAntiFraudSnapshotWithOperatorKey(t *testing.T) {
+	const devnet = 2
+
+	keyPair, err := secp256k1.GenerateSchnorrKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateSchnorrKeyPair: %s", err)
+	}
+	publicKey, err := keyPair.SchnorrPublicKey()
+	if err != nil {
+		t.Fatalf("SchnorrPublicKey: %s", err)
+	}
+	serializedPublicKey, err := publicKey.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %s", err)
+	}
+
+	content := &AntiFraudSnapshotContent{
+		NetworkName:      "cryptix-devnet",
+		SnapshotSeq:      7,
+		GeneratedAtMs:    1700000000000,
+		SigningKeyID:     2,
+		AntiFraudEnabled: true,
+		BannedIPs:        []string{"203.0.113.7", "2001:db8::1", "203.0.113.7"},
+		BannedNodeIDs:    []string{"AB00000000000000000000000000000000000000000000000000000000000001"},
+	}
+	payload, err := SignAntiFraudSnapshot(content, keyPair)
+	if err != nil {
+		t.Fatalf("SignAntiFraudSnapshot: %s", err)
+	}
+
+	_, err = decodeExternalBanlistPayload(payload, devnet)
+	if err == nil {
+		t.Fatalf("expected a snapshot signed by an unpinned key to be rejected")
+	}
+
+	err = setAntiFraudOperatorKeys(devnet, map[uint8][32]byte{2: *serializedPublicKey})
+	if err != nil {
+		t.Fatalf("setAntiFraudOperatorKeys: %s", err)
+	}
+	defer func() {
+		_ = setAntiFraudOperatorKeys(devnet, nil)
+	}()
+
+	snapshot, err := decodeExternalBanlistPayload(payload, devnet)
+	if err != nil {
+		t.Fatalf("decodeExternalBanlistPayload: %s", err)
+	}
+	if snapshot.SnapshotSeq != 7 || snapshot.SigningKeyID != 2 || !snapshot.AntiFraudEnabled {
+		t.Fatalf("unexpected snapshot header: %+v", snapshot)
+	}
+	if len(snapshot.IPs) != 2 || len(snapshot.NodeIDs) != 1 {
+		t.Fatalf("unexpected snapshot entries: ips=%v node_ids=%v", snapshot.IPs, snapshot.NodeIDs)
+	}
+	if _, ok := snapshot.NodeIDs["ab00000000000000000000000000000000000000000000000000000000000001"]; !ok {
+		t.Fatalf("expected the banned node ID to be normalized to lowercase hex")
+	}
+
+	// Operator keys are pinned per network
+	_, err = decodeExternalBanlistPayload(payload, 3)
+	if err == nil {
+		t.Fatalf("expected a devnet snapshot to be rejected on simnet")
+	}
+
+	err = setAntiFraudOperatorKeys(0, map[uint8][32]byte{2: *serializedPublicKey})
+	if err == nil {
+		t.Fatalf("expected operator keys to be rejected on mainnet")
+	}
+	err = setAntiFraudOperatorKeys(devnet, map[uint8][32]byte{1: *serializedPublicKey})
+	if err == nil {
+		t.Fatalf("expected reserved key IDs to be rejected")
+	}
+
+	content.BannedIPs = []string{"not-an-ip"}
+	_, err = SignAntiFraudSnapshot(content, keyPair)
+	if err == nil {
+		t.Fatalf("expected an invalid banned IP to be rejected")
+	}
+}
@@ -0,0 +1,200 @@
+package connmanager
+
+import (
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter"
+)
+
+// The sources an AntiFraud snapshot can be applied from
+const (
+	AntiFraudSourceSeed         = "seed"
+	AntiFraudSourcePeerMajority = "peerMajority"
+	AntiFraudSourceDisk         = "disk"
+)
+
+// AntiFraudPeerVote is a signed AntiFraud snapshot offered by a peer while
+// peer fallback is active
+type AntiFraudPeerVote struct {
+	PeerID      string
+	SnapshotSeq uint64
+	RootHash    [32]byte
+	ReceivedAt  time.Time
+}
+
+// AntiFraudEntryMatch is an entry of the active AntiFraud snapshot together
+// with the currently connected peers it cuts
+type AntiFraudEntryMatch struct {
+	Entry       string
+	IsNodeID    bool
+	Connections []string
+}
+
+// AntiFraudSnapshotDiff lists the entries that changed between the previous
+// and the active AntiFraud snapshots
+type AntiFraudSnapshotDiff struct {
+	PreviousSnapshotSeq uint64
+	PreviousRootHash    [32]byte
+	AddedIPs            []string
+	RemovedIPs          []string
+	AddedNodeIDs        []string
+	RemovedNodeIDs      []string
+}
+
+// AntiFraudState is a point-in-time view of the AntiFraud runtime
+type AntiFraudState struct {
+	RuntimeEnabled bool
+	PeerFallback   bool
+	SeedEnabled    bool
+
+	// The fields below are only set if HasSnapshot is true
+	HasSnapshot   bool
+	Source        string
+	SnapshotSeq   uint64
+	GeneratedAtMs uint64
+	SigningKeyID  uint8
+	RootHash      [32]byte
+	BannedIPs     []string
+	BannedNodeIDs []string
+	Matches       []*AntiFraudEntryMatch
+
+	// Diff is nil if there's no previous snapshot
+	Diff *AntiFraudSnapshotDiff
+
+	HashWindow [][32]byte
+	PeerVotes  []*AntiFraudPeerVote
+}
+
+// AntiFraudState returns the current state of the AntiFraud runtime, including
+// which connected peers the active snapshot cuts, whether or not the runtime
+// is enabled
+func (c *ConnectionManager) AntiFraudState() *AntiFraudState {
+	var connections []*netadapter.NetConnection
+	if c.netAdapter != nil {
+		connections = c.netAdapter.P2PConnections()
+	}
+
+	c.externalBanlistLock.RLock()
+	defer c.externalBanlistLock.RUnlock()
+
+	state := &AntiFraudState{
+		RuntimeEnabled: c.antiFraudRuntimeEnabled,
+		PeerFallback:   c.antiFraudPeerFallback,
+		SeedEnabled:    c.externalBanlistEnabled(),
+	}
+
+	for _, hash := range c.antiFraudHashWindow {
+		if hash != ([32]byte{}) {
+			state.HashWindow = append(state.HashWindow, hash)
+		}
+	}
+
+	now := time.Now()
+	for peerID, vote := range c.antiFraudPeerVotes {
+		if vote == nil || vote.snapshot == nil || now.Sub(vote.receivedAt) > externalBanlistPeerVoteMaxAge {
+			continue
+		}
+		state.PeerVotes = append(state.PeerVotes, &AntiFraudPeerVote{
+			PeerID:      peerID,
+			SnapshotSeq: vote.snapshot.SnapshotSeq,
+			RootHash:    vote.snapshot.RootHash,
+			ReceivedAt:  vote.receivedAt,
+		})
+	}
+	sort.Slice(state.PeerVotes, func(i, j int) bool {
+		return state.PeerVotes[i].PeerID < state.PeerVotes[j].PeerID
+	})
+
+	active := c.antiFraudActiveSnapshot
+	if active == nil {
+		return state
+	}
+	state.HasSnapshot = true
+	state.Source = c.antiFraudActiveSource
+	state.SnapshotSeq = active.SnapshotSeq
+	state.GeneratedAtMs = active.GeneratedAtMs
+	state.SigningKeyID = active.SigningKeyID
+	state.RootHash = active.RootHash
+	state.BannedIPs = sortedSetKeys(active.IPs)
+	state.BannedNodeIDs = sortedSetKeys(active.NodeIDs)
+	state.Matches = antiFraudEntryMatches(active, connections)
+
+	if previous := c.antiFraudPreviousSnapshot; previous != nil {
+		state.Diff = &AntiFraudSnapshotDiff{
+			PreviousSnapshotSeq: previous.SnapshotSeq,
+			PreviousRootHash:    previous.RootHash,
+			AddedIPs:            setDifference(active.IPs, previous.IPs),
+			RemovedIPs:          setDifference(previous.IPs, active.IPs),
+			AddedNodeIDs:        setDifference(active.NodeIDs, previous.NodeIDs),
+			RemovedNodeIDs:      setDifference(previous.NodeIDs, active.NodeIDs),
+		}
+	}
+
+	return state
+}
+
+func antiFraudEntryMatches(snapshot *externalBanlistSnapshot, connections []*netadapter.NetConnection) []*AntiFraudEntryMatch {
+	matchesByEntry := make(map[string]*AntiFraudEntryMatch)
+	addMatch := func(entry string, isNodeID bool, connection *netadapter.NetConnection) {
+		match, ok := matchesByEntry[entry]
+		if !ok {
+			match = &AntiFraudEntryMatch{Entry: entry, IsNodeID: isNodeID}
+			matchesByEntry[entry] = match
+		}
+		match.Connections = append(match.Connections, connection.String())
+	}
+
+	for _, connection := range connections {
+		if connection == nil {
+			continue
+		}
+		if !connection.IsOnionInbound() {
+			ip := canonicalIPString(connection.NetAddress().IP)
+			if _, ok := snapshot.IPs[ip]; ok {
+				addMatch(ip, false, connection)
+			}
+		}
+		if nodeID, ok := connection.UnifiedNodeID(); ok {
+			nodeIDHex := hex.EncodeToString(nodeID[:])
+			if _, ok := snapshot.NodeIDs[nodeIDHex]; ok {
+				addMatch(nodeIDHex, true, connection)
+			}
+		}
+	}
+
+	matches := make([]*AntiFraudEntryMatch, 0, len(matchesByEntry))
+	for _, match := range matchesByEntry {
+		sort.Strings(match.Connections)
+		matches = append(matches, match)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].IsNodeID != matches[j].IsNodeID {
+			return !matches[i].IsNodeID
+		}
+		return matches[i].Entry < matches[j].Entry
+	})
+	return matches
+}
+
+func sortedSetKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// setDifference returns the sorted keys of a that are not in b
+func setDifference(a map[string]struct{}, b map[string]struct{}) []string {
+	difference := make([]string, 0)
+	for key := range a {
+		if _, ok := b[key]; !ok {
+			difference = append(difference, key)
+		}
+	}
+	sort.Strings(difference)
+	return difference
+}
@@ -0,0 +1,106 @@
+package connmanager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
+)
+
+func TestAntiFraudStateDiff(t *testing.T) {
+	const devnet = 2
+
+	keyPair, err := secp256k1.GenerateSchnorrKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateSchnorrKeyPair: %s", err)
+	}
+	publicKey, err := keyPair.SchnorrPublicKey()
+	if err != nil {
+		t.Fatalf("SchnorrPublicKey: %s", err)
+	}
+	serializedPublicKey, err := publicKey.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %s", err)
+	}
+	err = setAntiFraudOperatorKeys(devnet, map[uint8][32]byte{2: *serializedPublicKey})
+	if err != nil {
+		t.Fatalf("setAntiFraudOperatorKeys: %s", err)
+	}
+	defer func() {
+		_ = setAntiFraudOperatorKeys(devnet, nil)
+	}()
+
+	const nodeID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	buildSnapshot := func(snapshotSeq uint64, bannedIPs []string, bannedNodeIDs []string) *externalBanlistSnapshot {
+		payload, err := SignAntiFraudSnapshot(&AntiFraudSnapshotContent{
+			NetworkName:      "devnet",
+			SnapshotSeq:      snapshotSeq,
+			GeneratedAtMs:    snapshotSeq,
+			SigningKeyID:     2,
+			AntiFraudEnabled: true,
+			BannedIPs:        bannedIPs,
+			BannedNodeIDs:    bannedNodeIDs,
+		}, keyPair)
+		if err != nil {
+			t.Fatalf("SignAntiFraudSnapshot: %s", err)
+		}
+		snapshot, err := decodeExternalBanlistPayload(payload, devnet)
+		if err != nil {
+			t.Fatalf("decodeExternalBanlistPayload: %s", err)
+		}
+		return snapshot
+	}
+
+	manager := &ConnectionManager{
+		antiFraudRuntimeEnabled: true,
+		antiFraudPeerVotes:      map[string]*peerAntiFraudVote{},
+	}
+	state := manager.AntiFraudState()
+	if state.HasSnapshot || state.Diff != nil {
+		t.Fatalf("expected no snapshot before one is applied")
+	}
+
+	first := buildSnapshot(1, []string{"203.0.113.1", "203.0.113.2"}, nil)
+	if _, err := manager.tryApplyAntiFraudSnapshot(first, AntiFraudSourceSeed); err != nil {
+		t.Fatalf("tryApplyAntiFraudSnapshot: %s", err)
+	}
+	state = manager.AntiFraudState()
+	if !state.HasSnapshot || state.Source != AntiFraudSourceSeed || state.SnapshotSeq != 1 {
+		t.Fatalf("unexpected state after the first snapshot: %+v", state)
+	}
+	if state.Diff != nil {
+		t.Fatalf("expected no diff without a previous snapshot")
+	}
+
+	second := buildSnapshot(2, []string{"203.0.113.2", "203.0.113.3"}, []string{nodeID})
+	manager.antiFraudPeerVotes["peer"] = &peerAntiFraudVote{snapshot: second, receivedAt: time.Now()}
+	if _, err := manager.tryApplyAntiFraudSnapshotLocked(second, AntiFraudSourcePeerMajority); err != nil {
+		t.Fatalf("tryApplyAntiFraudSnapshotLocked: %s", err)
+	}
+	state = manager.AntiFraudState()
+	if state.Source != AntiFraudSourcePeerMajority || state.SnapshotSeq != 2 || state.RootHash != second.RootHash {
+		t.Fatalf("unexpected state after the second snapshot: %+v", state)
+	}
+	if !reflect.DeepEqual(state.BannedIPs, []string{"203.0.113.2", "203.0.113.3"}) {
+		t.Fatalf("unexpected banned IPs: %v", state.BannedIPs)
+	}
+	if len(state.HashWindow) != 2 || state.HashWindow[0] != second.RootHash || state.HashWindow[1] != first.RootHash {
+		t.Fatalf("unexpected hash window: %x", state.HashWindow)
+	}
+	if len(state.PeerVotes) != 1 || state.PeerVotes[0].PeerID != "peer" || state.PeerVotes[0].SnapshotSeq != 2 {
+		t.Fatalf("unexpected peer votes: %+v", state.PeerVotes)
+	}
+
+	expectedDiff := &AntiFraudSnapshotDiff{
+		PreviousSnapshotSeq: 1,
+		PreviousRootHash:    first.RootHash,
+		AddedIPs:            []string{"203.0.113.3"},
+		RemovedIPs:          []string{"203.0.113.1"},
+		AddedNodeIDs:        []string{nodeID},
+		RemovedNodeIDs:      []string{},
+	}
+	if !reflect.DeepEqual(state.Diff, expectedDiff) {
+		t.Fatalf("unexpected diff: got %+v expected %+v", state.Diff, expectedDiff)
+	}
+}
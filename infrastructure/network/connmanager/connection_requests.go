@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/securetransport"
 )
 
 const (
@@ -91,7 +92,24 @@ func (c *ConnectionManager) checkRequestedConnections(connSet connectionSet) {
 // findRequestedConnection locates an active connection that satisfies requestedAddress.
 // It first tries exact address match, and then falls back to host-IP match (ignoring port)
 // to prevent reconnect loops when the matching connection is inbound with an ephemeral port.
+// A requested address that pins a node ID is only satisfied by a connection whose encrypted
+// transport is authenticated as that node, whatever its address.
 func (c *ConnectionManager) findRequestedConnection(connSet connectionSet, requestedAddress string) (*netadapter.NetConnection, bool) {
+	requestedNodeID, _, err := securetransport.SplitNodeIDAddress(requestedAddress)
+	if err != nil {
+		log.Debugf("Couldn't parse requested peer address %s while matching active connections: %s", requestedAddress, err)
+		return nil, false
+	}
+	if requestedNodeID != nil {
+		for _, connection := range connSet {
+			pubKeyXOnly, ok := connection.TransportPubKeyXOnly()
+			if ok && securetransport.NodeID(pubKeyXOnly) == *requestedNodeID {
+				return connection, true
+			}
+		}
+		return nil, false
+	}
+
 	if connection, ok := connSet.get(requestedAddress); ok {
 		return connection, true
 	}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cryptix-network/cryptixd/app/appmessage"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/dnsseed"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/securetransport"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/torcontrol"
 	"github.com/pkg/errors"
 
 	"github.com/cryptix-network/cryptixd/infrastructure/network/addressmanager"
@@ -18,7 +20,9 @@ import (
 	"github.com/cryptix-network/cryptixd/infrastructure/config"
 )
 
-const localUnifiedNodeBanDuration = 3 * time.Hour
+// ProtocolViolationBanDuration is the duration of unified node ID bans
+// caused by protocol violations
+const ProtocolViolationBanDuration = 3 * time.Hour
 
 // connectionRequest represents a user request (either through CLI or RPC) to connect to a certain node
 type connectionRequest struct {
@@ -39,6 +43,7 @@ type ConnectionManager struct {
 	pendingRequested map[string]*connectionRequest
 	activeOutgoing   map[string]struct{}
 	pendingOutgoing  map[string]*appmessage.NetAddress
+	anchors          []*appmessage.NetAddress
 	targetOutgoing   int
 	activeIncoming   map[string]struct{}
 	maxIncoming      int
@@ -49,19 +54,23 @@ type ConnectionManager struct {
 	externalBanlistLock         sync.RWMutex
 	externallyBannedIPs         map[string]struct{}
 	externallyBannedNodeIDs     map[string]struct{}
-	localUnifiedBanLock         sync.RWMutex
-	locallyBannedUnifiedNodeIDs map[string]time.Time
 	nextExternalBanlistFetch    time.Time
 	externalSnapshotSeq         uint64
 	externalSnapshotRootHash    [32]byte
 	hasExternalSnapshot         bool
 	antiFraudHashWindow         [3][32]byte
 	antiFraudCurrentSnapshot    *appmessage.MsgAntiFraudSnapshotV1
+	antiFraudActiveSnapshot     *externalBanlistSnapshot
+	antiFraudPreviousSnapshot   *externalBanlistSnapshot
+	antiFraudActiveSource       string
 	antiFraudRuntimeEnabled     bool
 	antiFraudPeerFallback       bool
 	externalBanlistRetryPending bool
 	antiFraudPeerVotes          map[string]*peerAntiFraudVote
 
+	torControl     *torcontrol.Client
+	onionServiceID string
+
 	resetLoopChan chan struct{}
 	loopTicker    *time.Ticker
 }
@@ -79,7 +88,6 @@ func New(cfg *config.Config, netAdapter *netadapter.NetAdapter, addressManager *
 		activeIncoming:              map[string]struct{}{},
 		externallyBannedIPs:         map[string]struct{}{},
 		externallyBannedNodeIDs:     map[string]struct{}{},
-		locallyBannedUnifiedNodeIDs: map[string]time.Time{},
 		antiFraudRuntimeEnabled:     false,
 		antiFraudPeerFallback:       false,
 		externalBanlistRetryPending: false,
@@ -103,6 +111,27 @@ func New(cfg *config.Config, netAdapter *netadapter.NetAdapter, addressManager *
 		}
 	}
 
+	if len(cfg.AntiFraudKeys) > 0 {
+		network, err := antiFraudNetworkFromName(cfg.NetParams().Name)
+		if err != nil {
+			return nil, err
+		}
+		err = setAntiFraudOperatorKeys(network, cfg.AntiFraudKeys)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Pinned %d extra AntiFraud operator key(s)", len(cfg.AntiFraudKeys))
+	}
+
+	anchors, err := addressManager.TakeAnchors()
+	if err != nil {
+		return nil, err
+	}
+	if len(anchors) > 0 {
+		log.Infof("Reconnecting to %d anchor peer(s) from the previous run", len(anchors))
+	}
+	c.anchors = anchors
+
 	c.tryLoadPersistedAntiFraudSnapshot()
 
 	return c, nil
@@ -110,6 +139,10 @@ func New(cfg *config.Config, netAdapter *netadapter.NetAdapter, addressManager *
 
 // Start begins the operation of the ConnectionManager
 func (c *ConnectionManager) Start() {
+	err := c.startOnionService()
+	if err != nil {
+		log.Errorf("Could not start the onion service: %s", err)
+	}
 	spawn("ConnectionManager.connectionsLoop", c.connectionsLoop)
 }
 
@@ -117,6 +150,9 @@ func (c *ConnectionManager) Start() {
 func (c *ConnectionManager) Stop() {
 	atomic.StoreUint32(&c.stop, 1)
 
+	c.saveAnchors()
+	c.stopOnionService()
+
 	for _, connection := range c.netAdapter.P2PConnections() {
 		connection.Disconnect()
 	}
@@ -131,7 +167,11 @@ func (c *ConnectionManager) run() {
 }
 
 func (c *ConnectionManager) initiateConnection(address string) error {
-	isAddressBanned, err := c.isAddressExternallyBanned(address)
+	_, hostPort, err := securetransport.SplitNodeIDAddress(address)
+	if err != nil {
+		return err
+	}
+	isAddressBanned, err := c.isAddressExternallyBanned(hostPort)
 	if err != nil {
 		log.Warnf("Skipping external banlist address check for %s: %s", address, err)
 	} else if isAddressBanned {
@@ -175,8 +215,18 @@ func (c *ConnectionManager) ConnectionCount() int {
 // ErrCannotBanPermanent is the error returned when trying to ban a permanent peer.
 var ErrCannotBanPermanent = errors.New("ErrCannotBanPermanent")
 
-// Ban marks the given netConnection as banned
+// Ban marks the given netConnection as banned. Onion inbound connections all
+// share the address of the local Tor daemon, so they are banned by their
+// unified node ID instead, or only disconnected if they don't have one.
 func (c *ConnectionManager) Ban(netConnection *netadapter.NetConnection) error {
+	if netConnection.IsOnionInbound() {
+		if unifiedNodeID, hasNodeID := netConnection.UnifiedNodeID(); hasNodeID {
+			return c.BanByUnifiedNodeID(unifiedNodeID, "onion inbound peer misbehaved", ProtocolViolationBanDuration)
+		}
+		netConnection.Disconnect()
+		return nil
+	}
+
 	if c.isPermanent(netConnection.Address()) {
 		return errors.Wrapf(ErrCannotBanPermanent, "Cannot ban %s because it's a permanent connection", netConnection.Address())
 	}
@@ -205,8 +255,10 @@ func (c *ConnectionManager) BanByIP(ip net.IP) error {
 	return c.addressManager.Ban(appmessage.NewNetAddressIPPort(ip, 0))
 }
 
-// BanByUnifiedNodeID bans the given unified node ID and disconnects all active matching peers.
-func (c *ConnectionManager) BanByUnifiedNodeID(nodeID [32]byte) error {
+// BanByUnifiedNodeID bans the given unified node ID for the given duration and disconnects
+// all active matching peers. A zero duration bans the node ID until it's explicitly unbanned.
+// The ban is persisted, so it survives restarts.
+func (c *ConnectionManager) BanByUnifiedNodeID(nodeID [32]byte, reason string, duration time.Duration) error {
 	nodeIDHex := hex.EncodeToString(nodeID[:])
 	connections := c.netAdapter.P2PConnections()
 	for _, conn := range connections {
@@ -219,15 +271,10 @@ func (c *ConnectionManager) BanByUnifiedNodeID(nodeID [32]byte) error {
 		}
 	}
 
-	c.localUnifiedBanLock.Lock()
-	now := time.Now()
-	for knownNodeID, expiresAt := range c.locallyBannedUnifiedNodeIDs {
-		if !expiresAt.After(now) {
-			delete(c.locallyBannedUnifiedNodeIDs, knownNodeID)
-		}
+	err := c.addressManager.BanNodeID(nodeID, reason, duration)
+	if err != nil {
+		return err
 	}
-	c.locallyBannedUnifiedNodeIDs[nodeIDHex] = now.Add(localUnifiedNodeBanDuration)
-	c.localUnifiedBanLock.Unlock()
 
 	for _, conn := range connections {
 		connNodeID, hasNodeID := conn.UnifiedNodeID()
@@ -250,25 +297,20 @@ func (c *ConnectionManager) IsBanned(netConnection *netadapter.NetConnection) (b
 		}
 	}
 
-	if c.isPermanent(netConnection.Address()) {
+	if netConnection.IsOnionInbound() || c.isPermanent(netConnection.Address()) {
 		return false, nil
 	}
 
 	return c.addressManager.IsBanned(netConnection.NetAddress())
 }
 
-// IsUnifiedNodeIDLocallyBanned returns true if the given unified node ID is present in the local autoban list.
+// IsUnifiedNodeIDLocallyBanned returns true if the given unified node ID is present in the local ban list.
 func (c *ConnectionManager) IsUnifiedNodeIDLocallyBanned(nodeID [32]byte) bool {
-	now := time.Now()
-	c.localUnifiedBanLock.Lock()
-	defer c.localUnifiedBanLock.Unlock()
-	for knownNodeID, expiresAt := range c.locallyBannedUnifiedNodeIDs {
-		if !expiresAt.After(now) {
-			delete(c.locallyBannedUnifiedNodeIDs, knownNodeID)
-		}
+	isBanned, err := c.addressManager.IsNodeIDBanned(nodeID)
+	if err != nil {
+		log.Warnf("Failed checking the local ban list for unified node ID %s: %s", hex.EncodeToString(nodeID[:]), err)
 	}
-	expiresAt, ok := c.locallyBannedUnifiedNodeIDs[hex.EncodeToString(nodeID[:])]
-	return ok && expiresAt.After(now)
+	return isBanned
 }
 
 func (c *ConnectionManager) waitTillNextIteration() {
@@ -294,6 +336,15 @@ func (c *ConnectionManager) isPermanent(addressString string) bool {
 	return false
 }
 
+func (c *ConnectionManager) isRequested(addressString string) bool {
+	c.connectionRequestsLock.RLock()
+	defer c.connectionRequestsLock.RUnlock()
+
+	_, isActive := c.activeRequested[addressString]
+	_, isPending := c.pendingRequested[addressString]
+	return isActive || isPending
+}
+
 func (c *ConnectionManager) ipHasPermanentConnection(ip net.IP) (bool, error) {
 	c.connectionRequestsLock.RLock()
 	defer c.connectionRequestsLock.RUnlock()
@@ -341,6 +392,14 @@ func (c *ConnectionManager) extractAddressIPs(address string) ([]net.IP, error)
 		return nil, err
 	}
 
+	if appmessage.IsOnionHost(host) {
+		pubKey, err := appmessage.ParseOnionV3Host(host)
+		if err != nil {
+			return nil, err
+		}
+		return []net.IP{appmessage.OnionV3IP(pubKey)}, nil
+	}
+
 	ip := net.ParseIP(host)
 	if ip == nil {
 		return c.cfg.Lookup(host)
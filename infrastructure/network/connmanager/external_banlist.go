@@ -187,7 +187,7 @@ func (c *ConnectionManager) refreshExternalBanlistIfNeeded(now time.Time) {
 		return
 	}
 
-	applied, applyErr := c.tryApplyAntiFraudSnapshot(snapshot, "banserver")
+	applied, applyErr := c.tryApplyAntiFraudSnapshot(snapshot, AntiFraudSourceSeed)
 	if applyErr != nil {
 		c.handleExternalBanlistRefreshFailure(now, errors.Wrap(applyErr, "snapshot rejected"))
 		return
@@ -272,7 +272,11 @@ func (c *ConnectionManager) fetchExternalBanlist() (*externalBanlistSnapshot, ex
 		return nil, externalBanlistFetchUnavailable, err
 	}
 
-	primaryResult, primaryErr := c.fetchExternalBanlistFromEndpoint("primary", defaultExternalBanlistPrimaryURL, expectedNetwork)
+	primaryURL := defaultExternalBanlistPrimaryURL
+	if c.cfg.AntiFraudSeedURL != "" {
+		primaryURL = c.cfg.AntiFraudSeedURL
+	}
+	primaryResult, primaryErr := c.fetchExternalBanlistFromEndpoint("primary", primaryURL, expectedNetwork)
 	if primaryErr != nil {
 		log.Warnf("External antifraud primary seed unavailable: %s", primaryErr)
 		return nil, externalBanlistFetchUnavailable, nil
@@ -723,14 +727,14 @@ func verifyAntiFraudSnapshotSignature(network uint8, signingKeyID uint8, rootHas
 	return pubKey.SchnorrVerify(&secpHash, signatureObj)
 }
 
-func antiFraudPinnedPubKey(_network uint8, signingKeyID uint8) ([32]byte, bool) {
+func antiFraudPinnedPubKey(network uint8, signingKeyID uint8) ([32]byte, bool) {
 	switch signingKeyID {
 	case 0:
 		return decodeHex32(antiFraudPubKeyCurrentHex)
 	case 1:
 		return decodeHex32(antiFraudPubKeyNextHex)
 	default:
-		return [32]byte{}, false
+		return antiFraudOperatorKey(network, signingKeyID)
 	}
 }
 
@@ -1081,7 +1085,7 @@ func (c *ConnectionManager) IngestPeerAntiFraudSnapshot(peerID string, message *
 		log.Warnf("Ignoring peer anti-fraud snapshot majority with antifraud_enabled=false")
 		return result, nil
 	}
-	applied, err := c.tryApplyAntiFraudSnapshotLocked(winner, "peer")
+	applied, err := c.tryApplyAntiFraudSnapshotLocked(winner, AntiFraudSourcePeerMajority)
 	c.externalBanlistLock.Unlock()
 	result.Applied = applied
 	return result, err
@@ -1319,9 +1323,13 @@ func (c *ConnectionManager) tryApplyAntiFraudSnapshotLocked(snapshot *externalBa
 	c.externallyBannedIPs = snapshot.IPs
 	c.externallyBannedNodeIDs = snapshot.NodeIDs
 	c.externalSnapshotSeq = snapshot.SnapshotSeq
+	antiFraudSnapshotSeqMetric.Set(float64(snapshot.SnapshotSeq))
 	c.externalSnapshotRootHash = snapshot.RootHash
 	c.hasExternalSnapshot = true
 	c.antiFraudCurrentSnapshot = snapshot.toAppMessage()
+	c.antiFraudPreviousSnapshot = c.antiFraudActiveSnapshot
+	c.antiFraudActiveSnapshot = snapshot
+	c.antiFraudActiveSource = source
 	c.antiFraudHashWindow = advanceAntiFraudHashWindow(c.antiFraudHashWindow, snapshot.RootHash)
 	_ = c.persistAntiFraudSnapshotsLocked(previousMessage, c.antiFraudCurrentSnapshot)
 
@@ -1411,8 +1419,21 @@ func (c *ConnectionManager) tryLoadPersistedAntiFraudSnapshot() {
 		log.Warnf("Ignoring persisted anti-fraud snapshot with antifraud_enabled=false")
 		return
 	}
-	if _, err := c.tryApplyAntiFraudSnapshot(loaded, "disk"); err != nil {
+	if _, err := c.tryApplyAntiFraudSnapshot(loaded, AntiFraudSourceDisk); err != nil {
 		log.Warnf("Ignoring persisted anti-fraud snapshot: %s", err)
+		return
+	}
+
+	// Keep the persisted previous snapshot around so that it can be diffed
+	// against the current one
+	previous := c.loadPersistedSnapshotFile(previousPath)
+	if previous == nil || previous.SnapshotSeq >= loaded.SnapshotSeq {
+		return
+	}
+	c.externalBanlistLock.Lock()
+	defer c.externalBanlistLock.Unlock()
+	if c.antiFraudPreviousSnapshot == nil {
+		c.antiFraudPreviousSnapshot = previous
 	}
 }
 
@@ -1634,6 +1655,12 @@ func (c *ConnectionManager) isAddressExternallyBanned(address string) (bool, err
 		return false, errors.Wrapf(err, "could not split host and port from %s", address)
 	}
 
+	// AntiFraud snapshots only list IPs, and onion hosts must never be
+	// resolved through DNS
+	if appmessage.IsOnionHost(host) {
+		return false, nil
+	}
+
 	if parsedIP := net.ParseIP(host); parsedIP != nil {
 		return c.isIPExternallyBanned(parsedIP), nil
 	}
@@ -1694,8 +1721,8 @@ func (c *ConnectionManager) isNetConnectionExternallyBanned(netConnection *netad
 	unifiedNodeID, hasUnifiedNodeID := netConnection.UnifiedNodeID()
 	unifiedNodeIDBanned := hasUnifiedNodeID && c.IsUnifiedNodeIDBanned(unifiedNodeID)
 
-	return c.isIPExternallyBanned(netConnection.NetAddress().IP) ||
-		unifiedNodeIDBanned
+	ipBanned := !netConnection.IsOnionInbound() && c.isIPExternallyBanned(netConnection.NetAddress().IP)
+	return ipBanned || unifiedNodeIDBanned
 }
 
 func (c *ConnectionManager) disconnectExternallyBannedConnections(connections []*netadapter.NetConnection) {
@@ -1709,7 +1736,7 @@ func (c *ConnectionManager) disconnectExternallyBannedConnections(connections []
 		}
 
 		ipAddress := connection.NetAddress().IP
-		ipBanned := c.isIPExternallyBanned(ipAddress)
+		ipBanned := !connection.IsOnionInbound() && c.isIPExternallyBanned(ipAddress)
 		unifiedNodeID, hasUnifiedNodeID := connection.UnifiedNodeID()
 		unifiedNodeIDBanned := hasUnifiedNodeID && c.IsUnifiedNodeIDBanned(unifiedNodeID)
 		if !ipBanned && !unifiedNodeIDBanned {
@@ -425,7 +425,6 @@ func TestHandleExternalBanlistRefreshFailureKeepsExistingPeerFallback(t *testing
 		antiFraudPeerVotes:          nil,
 		externallyBannedIPs:         nil,
 		externallyBannedNodeIDs:     nil,
-		locallyBannedUnifiedNodeIDs: nil,
 	}
 	now := time.Unix(1_700_000_500, 0)
 
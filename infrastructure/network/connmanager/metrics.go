@@ -0,0 +1,6 @@
+package connmanager
+
+import "github.com/cryptix-network/cryptixd/infrastructure/metrics"
+
+var antiFraudSnapshotSeqMetric = metrics.NewGauge("cryptixd_antifraud_snapshot_seq",
+	"Sequence number of the applied anti-fraud snapshot")
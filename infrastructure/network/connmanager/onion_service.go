@@ -0,0 +1,113 @@
+package connmanager
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/addressmanager"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/torcontrol"
+	"github.com/pkg/errors"
+)
+
+const (
+	onionServiceKeyFile      = "onion_v3_service_key"
+	torControlConnectTimeout = 10 * time.Second
+)
+
+// startOnionService publishes the P2P onion listener as a Tor onion service
+// through the Tor control port, and advertises its onion address to peers.
+// The onion address uses the port of the first P2P listener. The service key
+// is kept in the app directory, so that the onion address stays the same
+// across restarts.
+func (c *ConnectionManager) startOnionService() error {
+	if c.cfg.TorControl == "" {
+		return nil
+	}
+	onionListenerAddress := c.netAdapter.P2POnionListenerAddress()
+	if onionListenerAddress == nil {
+		return errors.New("an onion service requires an onion listener")
+	}
+	portString := c.cfg.NetParams().DefaultPort
+	if len(c.cfg.Listeners) > 0 {
+		var err error
+		_, portString, err = net.SplitHostPort(c.cfg.Listeners[0])
+		if err != nil {
+			return errors.Wrapf(err, "invalid P2P listener %s", c.cfg.Listeners[0])
+		}
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return errors.Wrapf(err, "invalid P2P listener port %s", portString)
+	}
+
+	client, err := torcontrol.Dial(c.cfg.TorControl, torControlConnectTimeout)
+	if err != nil {
+		return err
+	}
+	err = client.Authenticate(c.cfg.TorPassword)
+	if err != nil {
+		client.Close()
+		return err
+	}
+
+	keyPath := filepath.Join(c.cfg.AppDir, onionServiceKeyFile)
+	privateKey := torcontrol.NewOnionV3Key
+	serializedKey, err := os.ReadFile(keyPath)
+	if err == nil {
+		privateKey = strings.TrimSpace(string(serializedKey))
+	} else if !os.IsNotExist(err) {
+		client.Close()
+		return errors.Wrapf(err, "could not read the onion service key")
+	}
+
+	serviceID, newPrivateKey, err := client.AddOnion(privateKey, uint16(port), onionListenerAddress.String())
+	if err != nil {
+		client.Close()
+		return err
+	}
+	if newPrivateKey != "" {
+		err = os.WriteFile(keyPath, []byte(newPrivateKey), 0o600)
+		if err != nil {
+			client.Close()
+			return errors.Wrapf(err, "could not save the onion service key")
+		}
+	}
+
+	pubKey, err := appmessage.ParseOnionV3Host(serviceID + ".onion")
+	if err != nil {
+		client.Close()
+		return err
+	}
+	netAddress := appmessage.NewNetAddressOnionV3(pubKey, uint16(port))
+	err = c.addressManager.AddLocalAddress(netAddress, addressmanager.ManualPrio)
+	if err != nil {
+		client.Close()
+		return err
+	}
+
+	c.torControl = client
+	c.onionServiceID = serviceID
+	log.Infof("Listening on onion service %s", netAddress)
+	return nil
+}
+
+// stopOnionService removes the onion service published by startOnionService
+func (c *ConnectionManager) stopOnionService() {
+	if c.torControl == nil {
+		return
+	}
+	err := c.torControl.DelOnion(c.onionServiceID)
+	if err != nil {
+		log.Warnf("Could not remove onion service %s: %s", c.onionServiceID, err)
+	}
+	err = c.torControl.Close()
+	if err != nil {
+		log.Warnf("Could not close the Tor control connection: %s", err)
+	}
+	c.torControl = nil
+}
@@ -1,6 +1,9 @@
 package connmanager
 
-import "github.com/cryptix-network/cryptixd/app/appmessage"
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/addressmanager"
+)
 
 // checkOutgoingConnections goes over all activeOutgoing and makes sure they are still active.
 // Then it opens connections so that we have targetOutgoing active connections
@@ -30,8 +33,12 @@ func (c *ConnectionManager) checkOutgoingConnections(connSet connectionSet) {
 
 	connections := c.netAdapter.P2PConnections()
 	connectedAddresses := make([]*appmessage.NetAddress, len(connections))
+	outboundAddresses := make([]*appmessage.NetAddress, 0, len(connections))
 	for i, connection := range connections {
 		connectedAddresses[i] = connection.NetAddress()
+		if connection.IsOutbound() {
+			outboundAddresses = append(outboundAddresses, connection.NetAddress())
+		}
 	}
 
 	liveConnections := len(c.activeOutgoing)
@@ -43,10 +50,16 @@ func (c *ConnectionManager) checkOutgoingConnections(connSet connectionSet) {
 		liveConnections, c.targetOutgoing, c.targetOutgoing-liveConnections)
 
 	connectionsNeededCount := c.targetOutgoing - len(c.activeOutgoing)
-	netAddresses := c.addressManager.RandomAddresses(connectionsNeededCount, connectedAddresses)
+	netAddresses := c.takeAnchors(connectionsNeededCount, connectedAddresses)
+	if len(netAddresses) < connectionsNeededCount {
+		netAddresses = append(netAddresses, c.addressManager.RandomOutboundAddresses(
+			connectionsNeededCount-len(netAddresses),
+			append(connectedAddresses, netAddresses...),
+			append(outboundAddresses, netAddresses...))...)
+	}
 
 	for _, netAddress := range netAddresses {
-		addressString := netAddress.TCPAddress().String()
+		addressString := netAddress.String()
 
 		log.Debugf("Connecting to %s because we have %d outgoing connections and the target is "+
 			"%d", addressString, len(c.activeOutgoing), c.targetOutgoing)
@@ -71,3 +84,47 @@ func (c *ConnectionManager) checkOutgoingConnections(connSet connectionSet) {
 		c.seedFromDNS()
 	}
 }
+
+// takeAnchors returns up to count of the anchor addresses loaded on startup
+// that we aren't connected to yet. Anchors are only tried once.
+func (c *ConnectionManager) takeAnchors(count int, connectedAddresses []*appmessage.NetAddress) []*appmessage.NetAddress {
+	if len(c.anchors) == 0 {
+		return nil
+	}
+
+	connected := make(map[string]struct{}, len(connectedAddresses))
+	for _, connectedAddress := range connectedAddresses {
+		connected[connectedAddress.String()] = struct{}{}
+	}
+
+	anchors := make([]*appmessage.NetAddress, 0, count)
+	for len(c.anchors) > 0 && len(anchors) < count {
+		anchor := c.anchors[0]
+		c.anchors = c.anchors[1:]
+		if _, ok := connected[anchor.String()]; ok {
+			continue
+		}
+		anchors = append(anchors, anchor)
+	}
+	return anchors
+}
+
+// saveAnchors stores the addresses of our current outbound peers, so that we
+// reconnect to them first on the next startup
+func (c *ConnectionManager) saveAnchors() {
+	anchors := make([]*appmessage.NetAddress, 0, addressmanager.MaxAnchors)
+	for _, connection := range c.netAdapter.P2PConnections() {
+		if len(anchors) == addressmanager.MaxAnchors {
+			break
+		}
+		if !connection.IsOutbound() || c.isRequested(connection.Address()) {
+			continue
+		}
+		anchors = append(anchors, connection.NetAddress())
+	}
+
+	err := c.addressManager.SetAnchors(anchors)
+	if err != nil {
+		log.Warnf("Couldn't save anchor peers: %s", err)
+	}
+}
@@ -1,6 +1,7 @@
 package netadapter
 
 import (
+	"net"
 	"sync"
 	"sync/atomic"
 
@@ -47,7 +48,23 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed loading persistent unified node identity")
 	}
-	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners)
+	// IP peers are only dialed through cfg.Dial when it's a proxy, so that
+	// direct connections keep their real remote address
+	var dial grpcserver.DialFunc
+	if cfg.Proxy != "" {
+		dial = cfg.Dial
+	}
+	transport, err := newSecureTransportConfig(unifiedNodeIdentity, cfg.ActiveNetParams.Name)
+	if err != nil {
+		return nil, err
+	}
+	encryptionPolicy := grpcserver.EncryptionOptional
+	if cfg.RequireEncryptedP2P {
+		encryptionPolicy = grpcserver.EncryptionRequired
+	} else if cfg.EncryptP2P {
+		encryptionPolicy = grpcserver.EncryptionOutbound
+	}
+	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners, cfg.OnionListener, dial, cfg.OnionDial, transport, encryptionPolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +128,12 @@ func (na *NetAdapter) P2PConnect(address string) error {
 	return err
 }
 
+// P2POnionListenerAddress returns the address the P2P server accepts
+// connections forwarded by the onion service on, or nil if it doesn't
+func (na *NetAdapter) P2POnionListenerAddress() net.Addr {
+	return na.p2pServer.OnionListenerAddress()
+}
+
 // P2PConnections returns a list of p2p connections currently connected and active
 func (na *NetAdapter) P2PConnections() []*NetConnection {
 	na.p2pConnectionsLock.RLock()
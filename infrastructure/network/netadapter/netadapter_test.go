@@ -8,6 +8,7 @@ import (
 
 	"github.com/cryptix-network/cryptixd/app/appmessage"
 
+	"github.com/cryptix-network/cryptixd/domain/dagconfig"
 	"github.com/cryptix-network/cryptixd/infrastructure/config"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
 )
@@ -164,3 +165,77 @@ func TestNetAdapter(t *testing.T) {
 		t.Fatalf("TestNetAdapter: error expected at attempt to stop adapter second time, but got nothing")
 	}
 }
+
+func TestNetAdapterEncryptedTransport(t *testing.T) {
+	const (
+		host  = "127.0.0.1"
+		portA = 3010
+		portB = 3011
+		portC = 3012
+	)
+
+	newAdapter := func(port int, configure func(cfg *config.Config)) *NetAdapter {
+		cfg := config.DefaultConfig()
+		cfg.ActiveNetParams = &dagconfig.SimnetParams
+		cfg.AppDir = t.TempDir()
+		cfg.Listeners = []string{fmt.Sprintf("%s:%d", host, port)}
+		configure(cfg)
+
+		adapter, err := NewNetAdapter(cfg)
+		if err != nil {
+			t.Fatalf("NetAdapter instantiation failed: %+v", err)
+		}
+		adapter.SetP2PRouterInitializer(func(router *router.Router, connection *NetConnection) {})
+		adapter.SetRPCRouterInitializer(func(router *router.Router, connection *NetConnection) {})
+		err = adapter.Start()
+		if err != nil {
+			t.Fatalf("Start() failed: %+v", err)
+		}
+		t.Cleanup(func() { adapter.Stop() })
+		return adapter
+	}
+	adapterA := newAdapter(portA, func(cfg *config.Config) { cfg.EncryptP2P = true })
+	adapterB := newAdapter(portB, func(cfg *config.Config) {})
+	adapterC := newAdapter(portC, func(cfg *config.Config) { cfg.RequireEncryptedP2P = true })
+	addressB := fmt.Sprintf("%s:%d", host, portB)
+	addressC := fmt.Sprintf("%s:%d", host, portC)
+
+	// A encrypts all its outbound connections, and B accepts them
+	err := adapterA.P2PConnect(addressB)
+	if err != nil {
+		t.Fatalf("Connecting A to B failed: %+v", err)
+	}
+	pubKey, ok := adapterA.P2PConnections()[0].TransportPubKeyXOnly()
+	if !ok || pubKey != adapterB.UnifiedNodePubKeyXOnly() {
+		t.Fatalf("A's connection to B isn't authenticated as B")
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for adapterB.P2PConnectionCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if adapterB.P2PConnectionCount() != 1 {
+		t.Fatalf("B didn't register the inbound connection from A")
+	}
+	pubKey, ok = adapterB.P2PConnections()[0].TransportPubKeyXOnly()
+	if !ok || pubKey != adapterA.UnifiedNodePubKeyXOnly() {
+		t.Fatalf("B's connection from A isn't authenticated as A")
+	}
+
+	// A pinned node ID has to match
+	nodeIDC := adapterC.UnifiedNodeID()
+	err = adapterA.P2PConnect(fmt.Sprintf("%x@%s", nodeIDC, addressB))
+	if err == nil {
+		t.Fatalf("Connecting to B under C's node ID unexpectedly succeeded")
+	}
+
+	// C refuses plaintext connections, unless B pins C's node ID and so
+	// encrypts its connection
+	err = adapterB.P2PConnect(addressC)
+	if err == nil {
+		t.Fatalf("Plaintext connection to C unexpectedly succeeded")
+	}
+	err = adapterB.P2PConnect(fmt.Sprintf("%x@%s", nodeIDC, addressC))
+	if err != nil {
+		t.Fatalf("Connecting B to C with a pinned node ID failed: %+v", err)
+	}
+}
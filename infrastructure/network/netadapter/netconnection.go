@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/cryptix-network/cryptixd/app/appmessage"
 	routerpkg "github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/cryptix-network/cryptixd/util/mstime"
 	"github.com/pkg/errors"
 	"sync"
 	"sync/atomic"
@@ -175,9 +176,21 @@ func (c *NetConnection) SetRemotePQMLKEM1024PublicKey(publicKey []byte) {
 	c.hasRemotePQMLKEM1024PublicKey = true
 }
 
+// TransportPubKeyXOnly returns the unified node x-only pubkey the peer authenticated the
+// encrypted transport with. It returns false for plaintext connections.
+func (c *NetConnection) TransportPubKeyXOnly() ([32]byte, bool) {
+	return c.connection.TransportPubKeyXOnly()
+}
+
+// IsEncrypted returns whether the connection runs over the encrypted transport
+func (c *NetConnection) IsEncrypted() bool {
+	_, ok := c.connection.TransportPubKeyXOnly()
+	return ok
+}
+
 // Address returns the address associated with this connection
 func (c *NetConnection) Address() string {
-	return c.connection.Address().String()
+	return c.connection.NetAddress().String()
 }
 
 // IsOutbound returns whether the connection is outbound
@@ -185,6 +198,13 @@ func (c *NetConnection) IsOutbound() bool {
 	return c.connection.IsOutbound()
 }
 
+// IsOnionInbound returns whether the connection came in through the onion
+// service. Its address is then that of the local Tor daemon, which all onion
+// inbound connections share, so it mustn't be used to tell peers apart.
+func (c *NetConnection) IsOnionInbound() bool {
+	return c.connection.IsOnionInbound()
+}
+
 // IsConnected returns whether the underlying connection is still open and this
 // connection's protocol router has not been closed by the application layer.
 func (c *NetConnection) IsConnected() bool {
@@ -193,7 +213,9 @@ func (c *NetConnection) IsConnected() bool {
 
 // NetAddress returns the NetAddress associated with this connection
 func (c *NetConnection) NetAddress() *appmessage.NetAddress {
-	return appmessage.NewNetAddress(c.connection.Address())
+	netAddress := *c.connection.NetAddress()
+	netAddress.Timestamp = mstime.Now()
+	return &netAddress
 }
 
 func (c *NetConnection) setOnDisconnectedHandler(onDisconnectedHandler server.OnDisconnectedHandler) {
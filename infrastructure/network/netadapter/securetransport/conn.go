@@ -0,0 +1,139 @@
+package securetransport
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// maxFramePayloadSize is the maximum amount of plaintext sealed in a
+	// single frame
+	maxFramePayloadSize = 64 * 1024
+
+	frameHeaderSize    = 4
+	maxFrameSealedSize = maxFramePayloadSize + chacha20poly1305.Overhead
+)
+
+// Conn is an encrypted connection to an authenticated peer. Data is sent in
+// frames, each holding a big endian uint32 length followed by that many bytes
+// of ChaCha20-Poly1305 sealed data. Every direction has its own key, and the
+// nonce of a frame is its index within its direction.
+type Conn struct {
+	net.Conn
+	peerPubKeyXOnly [32]byte
+
+	readLock   sync.Mutex
+	readAEAD   cipher.AEAD
+	readNonce  uint64
+	readBuffer []byte
+
+	writeLock  sync.Mutex
+	writeAEAD  cipher.AEAD
+	writeNonce uint64
+}
+
+func newConn(conn net.Conn, peerPubKeyXOnly [32]byte, writeKey []byte, readKey []byte) (*Conn, error) {
+	writeAEAD, err := chacha20poly1305.New(writeKey)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	readAEAD, err := chacha20poly1305.New(readKey)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Conn{
+		Conn:            conn,
+		peerPubKeyXOnly: peerPubKeyXOnly,
+		readAEAD:        readAEAD,
+		writeAEAD:       writeAEAD,
+	}, nil
+}
+
+// PeerPubKeyXOnly returns the identity public key the peer authenticated with
+func (c *Conn) PeerPubKeyXOnly() [32]byte {
+	return c.peerPubKeyXOnly
+}
+
+// PeerNodeID returns the node ID of the peer
+func (c *Conn) PeerNodeID() [32]byte {
+	return NodeID(c.peerPubKeyXOnly)
+}
+
+func frameNonce(counter uint64) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.LittleEndian.PutUint64(nonce[chacha20poly1305.NonceSize-8:], counter)
+	return nonce
+}
+
+// Read reads decrypted data from the connection
+func (c *Conn) Read(b []byte) (int, error) {
+	c.readLock.Lock()
+	defer c.readLock.Unlock()
+
+	if len(c.readBuffer) == 0 {
+		err := c.readFrame()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, c.readBuffer)
+	c.readBuffer = c.readBuffer[n:]
+	return n, nil
+}
+
+func (c *Conn) readFrame() error {
+	var header [frameHeaderSize]byte
+	_, err := io.ReadFull(c.Conn, header[:])
+	if err != nil {
+		return err
+	}
+	sealedSize := binary.BigEndian.Uint32(header[:])
+	if sealedSize < chacha20poly1305.Overhead || sealedSize > maxFrameSealedSize {
+		return errors.Errorf("invalid frame size %d", sealedSize)
+	}
+	sealed := make([]byte, sealedSize)
+	_, err = io.ReadFull(c.Conn, sealed)
+	if err != nil {
+		return err
+	}
+
+	payload, err := c.readAEAD.Open(sealed[:0], frameNonce(c.readNonce), sealed, header[:])
+	if err != nil {
+		return errors.New("frame authentication failed")
+	}
+	c.readNonce++
+	c.readBuffer = payload
+	return nil
+}
+
+// Write encrypts and writes data to the connection
+func (c *Conn) Write(b []byte) (int, error) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	written := 0
+	for written < len(b) {
+		payloadSize := len(b) - written
+		if payloadSize > maxFramePayloadSize {
+			payloadSize = maxFramePayloadSize
+		}
+
+		frame := make([]byte, frameHeaderSize, frameHeaderSize+payloadSize+chacha20poly1305.Overhead)
+		binary.BigEndian.PutUint32(frame, uint32(payloadSize+chacha20poly1305.Overhead))
+		frame = c.writeAEAD.Seal(frame, frameNonce(c.writeNonce), b[written:written+payloadSize], frame[:frameHeaderSize])
+		c.writeNonce++
+
+		_, err := c.Conn.Write(frame)
+		if err != nil {
+			return written, err
+		}
+		written += payloadSize
+	}
+	return written, nil
+}
@@ -0,0 +1,270 @@
+package securetransport
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"net"
+
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// The handshake is made of three messages:
+//
+//	initiator -> responder: magic || initiator ephemeral key
+//	responder -> initiator: responder ephemeral key || AEAD(responder identity proof)
+//	initiator -> responder: AEAD(initiator identity proof)
+//
+// An identity proof is the sender's x-only public key, its proof of work
+// nonce and a Schnorr signature over the handshake transcript. The transcript
+// covers both ephemeral keys and the network, and the initiator's signature
+// covers the responder's public key too.
+const (
+	protocolName = "cryptix-p2p-secure-transport-v1"
+
+	ephemeralKeySize  = 32
+	identityProofSize = 32 + 8 + 64
+	sealedProofSize   = identityProofSize + chacha20poly1305.Overhead
+)
+
+// magic opens every connection that uses the transport. It can't be mistaken
+// for the HTTP/2 connection preface ("PRI * HTTP/2.0...") that plaintext gRPC
+// connections start with.
+var magic = []byte("CPXST/1\n")
+
+type handshakeKeys struct {
+	transcript           [32]byte
+	responderProofKey    []byte
+	initiatorProofKey    []byte
+	initiatorToResponder []byte
+	responderToInitiator []byte
+}
+
+// Client runs the initiator side of the handshake over conn, and returns the
+// encrypted connection. If expectedNodeID isn't nil, the handshake fails
+// unless the remote node ID matches it.
+func Client(conn net.Conn, config *Config, expectedNodeID *[32]byte) (*Conn, error) {
+	ephemeralKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	initiatorEphemeral := ephemeralKey.PublicKey().Bytes()
+	_, err = conn.Write(append(append([]byte(nil), magic...), initiatorEphemeral...))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	response := make([]byte, ephemeralKeySize+sealedProofSize)
+	_, err = io.ReadFull(conn, response)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the handshake response")
+	}
+	responderEphemeral := response[:ephemeralKeySize]
+	keys, err := deriveHandshakeKeys(config.NetworkCode, ephemeralKey, responderEphemeral,
+		initiatorEphemeral, responderEphemeral)
+	if err != nil {
+		return nil, err
+	}
+
+	responderPubKey, err := openIdentityProof(config, keys.responderProofKey, keys.transcript,
+		response[ephemeralKeySize:], responderSignatureHash(keys.transcript))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid responder identity proof")
+	}
+	if expectedNodeID != nil && NodeID(responderPubKey) != *expectedNodeID {
+		return nil, errors.Errorf("remote node ID %x doesn't match the expected node ID %x",
+			NodeID(responderPubKey), *expectedNodeID)
+	}
+
+	sealedProof, err := sealIdentityProof(config.Identity, keys.initiatorProofKey, keys.transcript,
+		initiatorSignatureHash(keys.transcript, responderPubKey))
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.Write(sealedProof)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return newConn(conn, responderPubKey, keys.initiatorToResponder, keys.responderToInitiator)
+}
+
+// Accept reads the first bytes sent on conn. If they start a handshake, it
+// runs the responder side of it and returns the encrypted connection as a
+// *Conn. Otherwise it returns a connection that replays the bytes it read
+// and then carries on with conn as is.
+func Accept(conn net.Conn, config *Config) (net.Conn, error) {
+	prefix := make([]byte, len(magic))
+	n, err := io.ReadFull(conn, prefix)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, errors.WithStack(err)
+	}
+	if n < len(magic) || !bytes.Equal(prefix, magic) {
+		return &prefixedConn{Conn: conn, prefix: prefix[:n]}, nil
+	}
+	return server(conn, config)
+}
+
+func server(conn net.Conn, config *Config) (*Conn, error) {
+	initiatorEphemeral := make([]byte, ephemeralKeySize)
+	_, err := io.ReadFull(conn, initiatorEphemeral)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the handshake request")
+	}
+
+	ephemeralKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	responderEphemeral := ephemeralKey.PublicKey().Bytes()
+	keys, err := deriveHandshakeKeys(config.NetworkCode, ephemeralKey, initiatorEphemeral,
+		initiatorEphemeral, responderEphemeral)
+	if err != nil {
+		return nil, err
+	}
+
+	sealedProof, err := sealIdentityProof(config.Identity, keys.responderProofKey, keys.transcript,
+		responderSignatureHash(keys.transcript))
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.Write(append(responderEphemeral, sealedProof...))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sealedInitiatorProof := make([]byte, sealedProofSize)
+	_, err = io.ReadFull(conn, sealedInitiatorProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the initiator identity proof")
+	}
+	initiatorPubKey, err := openIdentityProof(config, keys.initiatorProofKey, keys.transcript,
+		sealedInitiatorProof, initiatorSignatureHash(keys.transcript, config.Identity.PubKeyXOnly))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid initiator identity proof")
+	}
+
+	return newConn(conn, initiatorPubKey, keys.responderToInitiator, keys.initiatorToResponder)
+}
+
+func deriveHandshakeKeys(networkCode uint8, localKey *ecdh.PrivateKey, remoteEphemeral []byte,
+	initiatorEphemeral []byte, responderEphemeral []byte) (*handshakeKeys, error) {
+
+	remoteKey, err := ecdh.X25519().NewPublicKey(remoteEphemeral)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ephemeral key")
+	}
+	sharedSecret, err := localKey.ECDH(remoteKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ephemeral key")
+	}
+
+	hasher := sha256.New()
+	hasher.Write([]byte(protocolName))
+	hasher.Write([]byte{networkCode})
+	hasher.Write(initiatorEphemeral)
+	hasher.Write(responderEphemeral)
+	keys := &handshakeKeys{}
+	copy(keys.transcript[:], hasher.Sum(nil))
+
+	reader := hkdf.New(sha256.New, sharedSecret, keys.transcript[:], []byte(protocolName))
+	for _, key := range []*[]byte{&keys.responderProofKey, &keys.initiatorProofKey,
+		&keys.initiatorToResponder, &keys.responderToInitiator} {
+
+		*key = make([]byte, chacha20poly1305.KeySize)
+		_, err := io.ReadFull(reader, *key)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return keys, nil
+}
+
+func responderSignatureHash(transcript [32]byte) [32]byte {
+	return sha256.Sum256(append([]byte(protocolName+"/responder"), transcript[:]...))
+}
+
+func initiatorSignatureHash(transcript [32]byte, responderPubKey [32]byte) [32]byte {
+	data := append([]byte(protocolName+"/initiator"), transcript[:]...)
+	return sha256.Sum256(append(data, responderPubKey[:]...))
+}
+
+func sealIdentityProof(identity *Identity, key []byte, transcript [32]byte, signatureHash [32]byte) ([]byte, error) {
+	keyPair, err := secp256k1.DeserializeSchnorrPrivateKeyFromSlice(identity.PrivateKey[:])
+	if err != nil {
+		return nil, err
+	}
+	secpHash := secp256k1.Hash(signatureHash)
+	signature, err := keyPair.SchnorrSign(&secpHash)
+	if err != nil {
+		return nil, err
+	}
+	serializedSignature := signature.Serialize()
+
+	proof := make([]byte, 0, identityProofSize)
+	proof = append(proof, identity.PubKeyXOnly[:]...)
+	proof = binary.BigEndian.AppendUint64(proof, identity.PowNonce)
+	proof = append(proof, serializedSignature[:]...)
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return aead.Seal(nil, make([]byte, chacha20poly1305.NonceSize), proof, transcript[:]), nil
+}
+
+func openIdentityProof(config *Config, key []byte, transcript [32]byte, sealedProof []byte,
+	signatureHash [32]byte) ([32]byte, error) {
+
+	var pubKeyXOnly [32]byte
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return pubKeyXOnly, errors.WithStack(err)
+	}
+	proof, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), sealedProof, transcript[:])
+	if err != nil {
+		return pubKeyXOnly, errors.New("identity proof decryption failed")
+	}
+
+	copy(pubKeyXOnly[:], proof[:32])
+	powNonce := binary.BigEndian.Uint64(proof[32:40])
+	if config.VerifyIdentity != nil && !config.VerifyIdentity(pubKeyXOnly, powNonce) {
+		return pubKeyXOnly, errors.New("identity proof of work is invalid")
+	}
+
+	pubKey, err := secp256k1.DeserializeSchnorrPubKey(pubKeyXOnly[:])
+	if err != nil {
+		return pubKeyXOnly, errors.Wrap(err, "invalid identity public key")
+	}
+	signature, err := secp256k1.DeserializeSchnorrSignatureFromSlice(proof[40:])
+	if err != nil {
+		return pubKeyXOnly, errors.Wrap(err, "invalid identity signature")
+	}
+	secpHash := secp256k1.Hash(signatureHash)
+	if !pubKey.SchnorrVerify(&secpHash, signature) {
+		return pubKeyXOnly, errors.New("identity signature is invalid")
+	}
+	return pubKeyXOnly, nil
+}
+
+// prefixedConn is a connection whose first bytes were already read, and are
+// returned again before the rest of the stream
+type prefixedConn struct {
+	net.Conn
+	prefix []byte
+}
+
+func (c *prefixedConn) Read(b []byte) (int, error) {
+	if len(c.prefix) > 0 {
+		n := copy(b, c.prefix)
+		c.prefix = c.prefix[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
@@ -0,0 +1,67 @@
+// Package securetransport implements the encrypted and authenticated P2P
+// transport.
+//
+// The transport runs under gRPC, directly on top of the TCP connection. Its
+// handshake agrees on a key through an ephemeral X25519 exchange, and each
+// side then proves that it owns its unified node identity by signing the
+// handshake transcript with its identity key. A peer that completed the
+// handshake is therefore known by its node ID before any P2P message is
+// exchanged, and a man in the middle can't read or rewrite the traffic
+// without the identity keys of both ends.
+//
+// Inbound connections start with a fixed magic when they use the transport,
+// so a listener can serve encrypted and plaintext peers side by side.
+package securetransport
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/zeebo/blake3"
+)
+
+// Identity is the long-term key a node authenticates its transport with. It
+// is the same key as the node's unified node identity.
+type Identity struct {
+	PrivateKey  [32]byte
+	PubKeyXOnly [32]byte
+	PowNonce    uint64
+}
+
+// IdentityVerifier returns whether a remote identity is acceptable, normally
+// by checking its proof of work
+type IdentityVerifier func(pubKeyXOnly [32]byte, powNonce uint64) bool
+
+// Config is the configuration of the transport on both sides of a connection
+type Config struct {
+	Identity       *Identity
+	NetworkCode    uint8
+	VerifyIdentity IdentityVerifier
+}
+
+// NodeID returns the node ID of the given identity public key, which is the
+// same as its unified node ID
+func NodeID(pubKeyXOnly [32]byte) [32]byte {
+	return blake3.Sum256(pubKeyXOnly[:])
+}
+
+// SplitNodeIDAddress splits an address in the "<node-id>@<host>:<port>" form,
+// used to pin the node ID of a peer, into its node ID and its host and port.
+// Addresses without a node ID are returned as is, with a nil node ID.
+func SplitNodeIDAddress(address string) (*[32]byte, string, error) {
+	at := strings.LastIndex(address, "@")
+	if at < 0 {
+		return nil, address, nil
+	}
+	nodeIDString, hostPort := address[:at], address[at+1:]
+	if len(nodeIDString) != 2*len([32]byte{}) {
+		return nil, "", errors.Errorf("node ID %s must be %d hex characters", nodeIDString, 2*len([32]byte{}))
+	}
+	var nodeID [32]byte
+	_, err := hex.Decode(nodeID[:], []byte(nodeIDString))
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "invalid node ID %s", nodeIDString)
+	}
+	return &nodeID, hostPort, nil
+}
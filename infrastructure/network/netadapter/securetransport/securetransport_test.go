@@ -0,0 +1,222 @@
+package securetransport
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	secp256k1 "github.com/cryptix-network/go-secp256k1"
+)
+
+func newTestConfig(t *testing.T) *Config {
+	keyPair, err := secp256k1.GenerateSchnorrKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateSchnorrKeyPair: %s", err)
+	}
+	pubKey, err := keyPair.SchnorrPublicKey()
+	if err != nil {
+		t.Fatalf("SchnorrPublicKey: %s", err)
+	}
+	serializedPubKey, err := pubKey.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %s", err)
+	}
+	identity := &Identity{PrivateKey: *keyPair.SerializePrivateKey(), PowNonce: 7}
+	copy(identity.PubKeyXOnly[:], serializedPubKey[:])
+	return &Config{
+		Identity:    identity,
+		NetworkCode: 3,
+		VerifyIdentity: func(_ [32]byte, powNonce uint64) bool {
+			return powNonce == 7
+		},
+	}
+}
+
+type handshakeResult struct {
+	conn net.Conn
+	err  error
+}
+
+func handshake(t *testing.T, clientConfig, serverConfig *Config, expectedNodeID *[32]byte) (
+	client *Conn, clientErr error, server net.Conn, serverErr error) {
+
+	clientSide, serverSide := net.Pipe()
+	t.Cleanup(func() {
+		clientSide.Close()
+		serverSide.Close()
+	})
+
+	serverResult := make(chan handshakeResult, 1)
+	go func() {
+		conn, err := Accept(serverSide, serverConfig)
+		if err != nil {
+			serverSide.Close()
+		}
+		serverResult <- handshakeResult{conn: conn, err: err}
+	}()
+	client, clientErr = Client(clientSide, clientConfig, expectedNodeID)
+	if clientErr != nil {
+		clientSide.Close()
+	}
+	result := <-serverResult
+	return client, clientErr, result.conn, result.err
+}
+
+func TestHandshake(t *testing.T) {
+	clientConfig, serverConfig := newTestConfig(t), newTestConfig(t)
+	serverNodeID := NodeID(serverConfig.Identity.PubKeyXOnly)
+
+	client, err, serverConn, serverErr := handshake(t, clientConfig, serverConfig, &serverNodeID)
+	if err != nil {
+		t.Fatalf("Client: %s", err)
+	}
+	if serverErr != nil {
+		t.Fatalf("Accept: %s", serverErr)
+	}
+	server, ok := serverConn.(*Conn)
+	if !ok {
+		t.Fatalf("Accept didn't return an encrypted connection")
+	}
+	if client.PeerPubKeyXOnly() != serverConfig.Identity.PubKeyXOnly {
+		t.Fatalf("Client authenticated the wrong server identity")
+	}
+	if server.PeerNodeID() != NodeID(clientConfig.Identity.PubKeyXOnly) {
+		t.Fatalf("Server authenticated the wrong client identity")
+	}
+
+	// Send more than a single frame both ways
+	message := bytes.Repeat([]byte("cryptix"), maxFramePayloadSize/3)
+	go func() {
+		client.Write(message)
+	}()
+	received := make([]byte, len(message))
+	_, err = io.ReadFull(server, received)
+	if err != nil {
+		t.Fatalf("ReadFull: %s", err)
+	}
+	if !bytes.Equal(received, message) {
+		t.Fatalf("Server received a different message than the client sent")
+	}
+
+	go func() {
+		server.Write([]byte("pong"))
+	}()
+	received = make([]byte, 4)
+	_, err = io.ReadFull(client, received)
+	if err != nil {
+		t.Fatalf("ReadFull: %s", err)
+	}
+	if string(received) != "pong" {
+		t.Fatalf("Client received %q instead of %q", received, "pong")
+	}
+}
+
+func TestHandshakeWrongNodeID(t *testing.T) {
+	clientConfig, serverConfig := newTestConfig(t), newTestConfig(t)
+	otherNodeID := NodeID(clientConfig.Identity.PubKeyXOnly)
+
+	_, err, _, _ := handshake(t, clientConfig, serverConfig, &otherNodeID)
+	if err == nil || !strings.Contains(err.Error(), "doesn't match the expected node ID") {
+		t.Fatalf("Unexpected error for a mismatching node ID: %v", err)
+	}
+}
+
+func TestHandshakeInvalidIdentity(t *testing.T) {
+	clientConfig, serverConfig := newTestConfig(t), newTestConfig(t)
+	clientConfig.Identity.PowNonce = 8
+
+	_, err, _, serverErr := handshake(t, clientConfig, serverConfig, nil)
+	if serverErr == nil || !strings.Contains(serverErr.Error(), "proof of work is invalid") {
+		t.Fatalf("Unexpected server error for an invalid client identity: %v", serverErr)
+	}
+	if err != nil {
+		t.Fatalf("Client: %s", err)
+	}
+
+	// A different network makes the transcripts differ
+	clientConfig, serverConfig = newTestConfig(t), newTestConfig(t)
+	clientConfig.NetworkCode = 1
+	_, err, _, _ = handshake(t, clientConfig, serverConfig, nil)
+	if err == nil {
+		t.Fatalf("Handshake across networks unexpectedly succeeded")
+	}
+}
+
+func TestAcceptPlaintext(t *testing.T) {
+	clientSide, serverSide := net.Pipe()
+	defer clientSide.Close()
+	defer serverSide.Close()
+
+	preface := []byte("PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n")
+	go func() {
+		clientSide.Write(preface)
+	}()
+	conn, err := Accept(serverSide, newTestConfig(t))
+	if err != nil {
+		t.Fatalf("Accept: %s", err)
+	}
+	if _, ok := conn.(*Conn); ok {
+		t.Fatalf("Accept unexpectedly returned an encrypted connection")
+	}
+	received := make([]byte, len(preface))
+	_, err = io.ReadFull(conn, received)
+	if err != nil {
+		t.Fatalf("ReadFull: %s", err)
+	}
+	if !bytes.Equal(received, preface) {
+		t.Fatalf("Plaintext connection lost its first bytes: %q", received)
+	}
+}
+
+func TestTamperedFrame(t *testing.T) {
+	clientConfig, serverConfig := newTestConfig(t), newTestConfig(t)
+	client, err, serverConn, serverErr := handshake(t, clientConfig, serverConfig, nil)
+	if err != nil || serverErr != nil {
+		t.Fatalf("Handshake failed: %v, %v", err, serverErr)
+	}
+
+	// Write a frame sealed with the right key, with a single bit flipped
+	// on the way
+	tamperedConn := &bitFlippingConn{Conn: client.Conn}
+	client.Conn = tamperedConn
+	go func() {
+		client.Write([]byte("hello"))
+	}()
+	_, err = serverConn.Read(make([]byte, 5))
+	if err == nil {
+		t.Fatalf("Tampered frame was unexpectedly accepted")
+	}
+}
+
+type bitFlippingConn struct {
+	net.Conn
+}
+
+func (c *bitFlippingConn) Write(b []byte) (int, error) {
+	tampered := append([]byte(nil), b...)
+	tampered[len(tampered)-1] ^= 1
+	return c.Conn.Write(tampered)
+}
+
+func TestSplitNodeIDAddress(t *testing.T) {
+	nodeIDHex := strings.Repeat("ab", 32)
+	nodeID, hostPort, err := SplitNodeIDAddress(nodeIDHex + "@[::1]:19111")
+	if err != nil {
+		t.Fatalf("SplitNodeIDAddress: %s", err)
+	}
+	if nodeID == nil || nodeID[0] != 0xab || hostPort != "[::1]:19111" {
+		t.Fatalf("Unexpected split: %x, %s", nodeID, hostPort)
+	}
+
+	nodeID, hostPort, err = SplitNodeIDAddress("1.2.3.4:19111")
+	if err != nil || nodeID != nil || hostPort != "1.2.3.4:19111" {
+		t.Fatalf("Unexpected split of an address without a node ID: %x, %s, %v", nodeID, hostPort, err)
+	}
+
+	_, _, err = SplitNodeIDAddress("abcd@1.2.3.4:19111")
+	if err == nil {
+		t.Fatalf("Short node ID unexpectedly accepted")
+	}
+}
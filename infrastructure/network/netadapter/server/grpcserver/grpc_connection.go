@@ -1,10 +1,10 @@
 package grpcserver
 
 import (
-	"net"
 	"sync"
 	"sync/atomic"
 
+	"github.com/cryptix-network/cryptixd/app/appmessage"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
@@ -17,10 +17,12 @@ import (
 
 type gRPCConnection struct {
 	server                   *gRPCServer
-	address                  *net.TCPAddr
+	address                  *appmessage.NetAddress
+	transportPubKeyXOnly     *[32]byte
 	stream                   grpcStream
 	router                   *router.Router
 	lowLevelClientConnection *grpc.ClientConn
+	isOnionInbound           bool
 
 	// streamLock protects concurrent access to stream.
 	// Note that it's an RWMutex. Despite what the name
@@ -41,11 +43,12 @@ type grpcStream interface {
 	Recv() (*protowire.CryptixdMessage, error)
 }
 
-func newConnection(server *gRPCServer, address *net.TCPAddr, stream grpcStream,
-	lowLevelClientConnection *grpc.ClientConn) *gRPCConnection {
+func newConnection(server *gRPCServer, address *appmessage.NetAddress, transportPubKeyXOnly *[32]byte,
+	stream grpcStream, lowLevelClientConnection *grpc.ClientConn) *gRPCConnection {
 	connection := &gRPCConnection{
 		server:                   server,
 		address:                  address,
+		transportPubKeyXOnly:     transportPubKeyXOnly,
 		stream:                   stream,
 		stopChan:                 make(chan struct{}),
 		isConnected:              1,
@@ -81,7 +84,7 @@ func (c *gRPCConnection) Start(router *router.Router) {
 }
 
 func (c *gRPCConnection) String() string {
-	return c.Address().String()
+	return c.address.String()
 }
 
 func (c *gRPCConnection) IsConnected() bool {
@@ -100,6 +103,12 @@ func (c *gRPCConnection) IsOutbound() bool {
 	return c.lowLevelClientConnection != nil
 }
 
+// IsOnionInbound returns whether the connection was accepted on the onion
+// listener, in which case its address is that of the local Tor daemon
+func (c *gRPCConnection) IsOnionInbound() bool {
+	return c.isOnionInbound
+}
+
 // Disconnect disconnects the connection
 // Calling this function a second time doesn't do anything
 //
@@ -123,10 +132,19 @@ func (c *gRPCConnection) Disconnect() {
 	}
 }
 
-func (c *gRPCConnection) Address() *net.TCPAddr {
+func (c *gRPCConnection) NetAddress() *appmessage.NetAddress {
 	return c.address
 }
 
+// TransportPubKeyXOnly returns the identity public key the peer authenticated
+// the encrypted transport with. It returns false for plaintext connections.
+func (c *gRPCConnection) TransportPubKeyXOnly() ([32]byte, bool) {
+	if c.transportPubKeyXOnly == nil {
+		return [32]byte{}, false
+	}
+	return *c.transportPubKeyXOnly, true
+}
+
 func (c *gRPCConnection) receive() (*protowire.CryptixdMessage, error) {
 	// We use RLock here and in send() because they can work
 	// in parallel. closeSend(), however, must not have either
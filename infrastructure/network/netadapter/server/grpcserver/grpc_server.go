@@ -3,6 +3,7 @@ package grpcserver
 import (
 	"context"
 	"fmt"
+	"github.com/cryptix-network/cryptixd/app/appmessage"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/server"
 	"github.com/cryptix-network/cryptixd/util/panics"
 	"github.com/pkg/errors"
@@ -19,16 +20,22 @@ type gRPCServer struct {
 	server             *grpc.Server
 	name               string
 
+	onionListeningAddress string
+	onionListenerAddress  net.Addr
+
 	maxInboundConnections      int
 	inboundConnectionCount     int
 	inboundConnectionCountLock *sync.Mutex
 }
 
 // newGRPCServer creates a gRPC server
-func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string) *gRPCServer {
+func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string,
+	options ...grpc.ServerOption) *gRPCServer {
+
 	log.Debugf("Created new %s GRPC server with maxMessageSize %d and maxInboundConnections %d", name, maxMessageSize, maxInboundConnections)
+	options = append(options, grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize))
 	return &gRPCServer{
-		server:                     grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)),
+		server:                     grpc.NewServer(options...),
 		listeningAddresses:         listeningAddresses,
 		name:                       name,
 		maxInboundConnections:      maxInboundConnections,
@@ -48,6 +55,12 @@ func (s *gRPCServer) Start() error {
 			return err
 		}
 	}
+	if s.onionListeningAddress != "" {
+		err := s.listenOnOnion(s.onionListeningAddress)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -69,6 +82,32 @@ func (s *gRPCServer) listenOn(listenAddr string) error {
 	return nil
 }
 
+// listenOnOnion listens on the address the onion service forwards its
+// connections to. Connections accepted there are tagged as onion inbound.
+func (s *gRPCServer) listenOnOnion(listenAddr string) error {
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return errors.Wrapf(err, "%s error listening for onion connections on %s", s.name, listenAddr)
+	}
+	s.onionListenerAddress = listener.Addr()
+
+	spawn(fmt.Sprintf("%s.gRPCServer.listenOnOnion-Serve", s.name), func() {
+		err := s.server.Serve(&onionInboundListener{Listener: listener})
+		if err != nil {
+			panics.Exit(log, fmt.Sprintf("error serving %s onion connections on %s: %+v", s.name, listenAddr, err))
+		}
+	})
+
+	log.Infof("%s Server listening for onion connections on %s", s.name, listener.Addr())
+	return nil
+}
+
+// OnionListenerAddress returns the address the server accepts onion
+// connections on, or nil if it doesn't
+func (s *gRPCServer) OnionListenerAddress() net.Addr {
+	return s.onionListenerAddress
+}
+
 func (s *gRPCServer) Stop() error {
 	const stopTimeout = 2 * time.Second
 
@@ -104,12 +143,20 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 	if !ok {
 		return errors.Errorf("Error getting stream peer info from context")
 	}
-	tcpAddress, ok := peerInfo.Addr.(*net.TCPAddr)
-	if !ok {
+	var tcpAddress *net.TCPAddr
+	isOnionInbound := false
+	switch address := peerInfo.Addr.(type) {
+	case *net.TCPAddr:
+		tcpAddress = address
+	case *onionInboundAddr:
+		tcpAddress = address.TCPAddr
+		isOnionInbound = true
+	default:
 		return errors.Errorf("non-tcp connections are not supported")
 	}
 
-	connection := newConnection(s, tcpAddress, stream, nil)
+	connection := newConnection(s, appmessage.NewNetAddress(tcpAddress), transportPubKeyXOnly(peerInfo), stream, nil)
+	connection.isOnionInbound = isOnionInbound
 
 	err = s.onConnectedHandler(connection)
 	if err != nil {
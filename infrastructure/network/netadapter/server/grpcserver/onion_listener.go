@@ -0,0 +1,39 @@
+package grpcserver
+
+import (
+	"net"
+)
+
+// onionInboundAddr is the remote address of connections accepted on the onion
+// listener. These all come from the local Tor daemon, so their TCP address
+// says nothing about the peer behind them.
+type onionInboundAddr struct {
+	*net.TCPAddr
+}
+
+// onionInboundConn is a connection accepted on the onion listener
+type onionInboundConn struct {
+	net.Conn
+}
+
+func (c *onionInboundConn) RemoteAddr() net.Addr {
+	tcpAddress, ok := c.Conn.RemoteAddr().(*net.TCPAddr)
+	if !ok {
+		return c.Conn.RemoteAddr()
+	}
+	return &onionInboundAddr{TCPAddr: tcpAddress}
+}
+
+// onionInboundListener tags the connections it accepts as onion inbound, so
+// that they can be told apart from direct connections to the P2P listeners
+type onionInboundListener struct {
+	net.Listener
+}
+
+func (l *onionInboundListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &onionInboundConn{Conn: conn}, nil
+}
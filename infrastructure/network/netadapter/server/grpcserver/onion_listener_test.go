@@ -0,0 +1,35 @@
+package grpcserver
+
+import (
+	"net"
+	"testing"
+)
+
+func TestOnionInboundListenerTagsConnections(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %s", err)
+	}
+	onionListener := &onionInboundListener{Listener: listener}
+	defer onionListener.Close()
+
+	dialed, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	defer dialed.Close()
+
+	accepted, err := onionListener.Accept()
+	if err != nil {
+		t.Fatalf("Accept: %s", err)
+	}
+	defer accepted.Close()
+
+	remoteAddress, ok := accepted.RemoteAddr().(*onionInboundAddr)
+	if !ok {
+		t.Fatalf("expected an onion inbound remote address, got %T", accepted.RemoteAddr())
+	}
+	if remoteAddress.String() != dialed.LocalAddr().String() {
+		t.Fatalf("expected remote address %s, got %s", dialed.LocalAddr(), remoteAddress)
+	}
+}
@@ -2,6 +2,8 @@ package grpcserver
 
 import (
 	"context"
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/securetransport"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/server"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/cryptix-network/cryptixd/util/panics"
@@ -10,14 +12,39 @@ import (
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/peer"
 	"net"
+	"strconv"
 	"time"
 )
 
 type p2pServer struct {
 	protowire.UnimplementedP2PServer
 	gRPCServer
+
+	dial             DialFunc
+	onionDial        DialFunc
+	transport        *securetransport.Config
+	encryptionPolicy EncryptionPolicy
 }
 
+// DialFunc connects to the given address within timeout
+type DialFunc func(network, address string, timeout time.Duration) (net.Conn, error)
+
+// EncryptionPolicy defines which P2P connections use the encrypted transport
+type EncryptionPolicy int
+
+const (
+	// EncryptionOptional accepts both encrypted and plaintext inbound
+	// connections, and only encrypts outbound connections that pin a node ID
+	EncryptionOptional EncryptionPolicy = iota
+
+	// EncryptionOutbound accepts both encrypted and plaintext inbound
+	// connections, and encrypts all outbound connections
+	EncryptionOutbound
+
+	// EncryptionRequired refuses plaintext connections altogether
+	EncryptionRequired
+)
+
 const p2pMaxMessageSize = 1024 * 1024 * 1024 // 1GB
 
 // p2pMaxInboundConnections is the max amount of inbound connections for the P2P server.
@@ -26,10 +53,46 @@ const p2pMaxMessageSize = 1024 * 1024 * 1024 // 1GB
 // is handled in the ConnectionManager instead.
 const p2pMaxInboundConnections = 0
 
-// NewP2PServer creates a new P2PServer
-func NewP2PServer(listeningAddresses []string) (server.P2PServer, error) {
-	gRPCServer := newGRPCServer(listeningAddresses, p2pMaxMessageSize, p2pMaxInboundConnections, "P2P")
-	p2pServer := &p2pServer{gRPCServer: *gRPCServer}
+// proxiedDialTimeout is the dial timeout for connections made through a
+// proxy. These take a lot longer to set up than direct ones, especially over
+// Tor.
+const proxiedDialTimeout = 15 * time.Second
+
+// encryptedDialTimeout is the dial timeout for direct connections over the
+// encrypted transport, whose handshake takes a couple more round trips
+const encryptedDialTimeout = 3 * time.Second
+
+// NewP2PServer creates a new P2PServer. Outbound connections are made through
+// dial, or directly if it's nil. Onion addresses are dialed through onionDial,
+// and can't be connected to if it's nil.
+//
+// Connections forwarded by the onion service are accepted on
+// onionListeningAddress, if it's set, and are tagged as onion inbound.
+//
+// If transport is set, connections may use the encrypted transport according
+// to encryptionPolicy. Connections to addresses that pin a node ID always use
+// it.
+func NewP2PServer(listeningAddresses []string, onionListeningAddress string, dial DialFunc, onionDial DialFunc,
+	transport *securetransport.Config, encryptionPolicy EncryptionPolicy) (server.P2PServer, error) {
+
+	var serverOptions []grpc.ServerOption
+	if transport != nil {
+		serverOptions = append(serverOptions, grpc.Creds(&secureTransportCredentials{
+			config:            transport,
+			requireEncryption: encryptionPolicy == EncryptionRequired,
+		}))
+	} else if encryptionPolicy != EncryptionOptional {
+		return nil, errors.New("the encrypted transport can't be required without a transport identity")
+	}
+	gRPCServer := newGRPCServer(listeningAddresses, p2pMaxMessageSize, p2pMaxInboundConnections, "P2P", serverOptions...)
+	gRPCServer.onionListeningAddress = onionListeningAddress
+	p2pServer := &p2pServer{
+		gRPCServer:       *gRPCServer,
+		dial:             dial,
+		onionDial:        onionDial,
+		transport:        transport,
+		encryptionPolicy: encryptionPolicy,
+	}
 	protowire.RegisterP2PServer(gRPCServer.server, p2pServer)
 	return p2pServer, nil
 }
@@ -40,16 +103,55 @@ func (p *p2pServer) MessageStream(stream protowire.P2P_MessageStreamServer) erro
 	return p.handleInboundConnection(stream.Context(), stream)
 }
 
-// Connect connects to the given address
+// Connect connects to the given address, which may pin the node ID of the
+// peer in the "<node-id>@<host>:<port>" form
 // This is part of the P2PServer interface
 func (p *p2pServer) Connect(address string) (server.Connection, error) {
 	log.Debugf("%s Dialing to %s", p.name, address)
 
-	const dialTimeout = 1 * time.Second
+	expectedNodeID, hostPort, err := securetransport.SplitNodeIDAddress(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s error parsing address %s", p.name, address)
+	}
+	address = hostPort
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s error parsing address %s", p.name, address)
+	}
+	dial := p.dial
+	if appmessage.IsOnionHost(host) {
+		if p.onionDial == nil {
+			return nil, errors.Errorf("%s can't connect to %s: no onion proxy is configured", p.name, address)
+		}
+		dial = p.onionDial
+	}
+
+	dialTimeout := 1 * time.Second
+	dialOptions := []grpc.DialOption{grpc.WithBlock()}
+	if expectedNodeID != nil || p.encryptionPolicy != EncryptionOptional {
+		if p.transport == nil {
+			return nil, errors.Errorf("%s can't connect to %s: the encrypted transport is unavailable", p.name, address)
+		}
+		dialTimeout = encryptedDialTimeout
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(
+			&secureTransportCredentials{config: p.transport, expectedNodeID: expectedNodeID}))
+	} else {
+		dialOptions = append(dialOptions, grpc.WithInsecure())
+	}
+	if dial != nil {
+		dialTimeout = proxiedDialTimeout
+		dialOptions = append(dialOptions, grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			timeout := dialTimeout
+			if deadline, ok := ctx.Deadline(); ok {
+				timeout = time.Until(deadline)
+			}
+			return dial("tcp", address, timeout)
+		}))
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
 	defer cancel()
 
-	gRPCClientConnection, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	gRPCClientConnection, err := grpc.DialContext(ctx, address, dialOptions...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "%s error connecting to %s", p.name, address)
 	}
@@ -65,12 +167,19 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 	if !ok {
 		return nil, errors.Errorf("%s error getting stream peer info from context for %s", p.name, address)
 	}
-	tcpAddress, ok := peerInfo.Addr.(*net.TCPAddr)
-	if !ok {
-		return nil, errors.Errorf("non-tcp addresses are not supported")
+	var netAddress *appmessage.NetAddress
+	if tcpAddress, ok := peerInfo.Addr.(*net.TCPAddr); ok && dial == nil {
+		netAddress = appmessage.NewNetAddress(tcpAddress)
+	} else {
+		// The remote address of a proxied connection is the proxy's, so
+		// we go by the address we dialed instead
+		netAddress, err = dialedNetAddress(address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s error getting the address of %s", p.name, address)
+		}
 	}
 
-	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection)
+	connection := newConnection(&p.gRPCServer, netAddress, transportPubKeyXOnly(peerInfo), stream, gRPCClientConnection)
 
 	err = p.onConnectedHandler(connection)
 	if err != nil {
@@ -81,3 +190,28 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 
 	return connection, nil
 }
+
+// dialedNetAddress returns the NetAddress of the given IP or onion address
+func dialedNetAddress(address string) (*appmessage.NetAddress, error) {
+	host, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if appmessage.IsOnionHost(host) {
+		pubKey, err := appmessage.ParseOnionV3Host(host)
+		if err != nil {
+			return nil, err
+		}
+		return appmessage.NewNetAddressOnionV3(pubKey, uint16(port)), nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, errors.Errorf("%s is neither an IP nor an onion host", host)
+	}
+	return appmessage.NewNetAddressIPPort(ip, uint16(port)), nil
+}
@@ -103,6 +103,16 @@ func (x *NetAddress) toAppMessage() (*appmessage.NetAddress, error) {
 	if x.Port > math.MaxUint16 {
 		return nil, errors.Errorf("port number is larger than %d", math.MaxUint16)
 	}
+	if len(x.OnionV3) != 0 {
+		if len(x.OnionV3) != appmessage.OnionV3PubKeySize {
+			return nil, errors.Errorf("invalid onion v3 public key length %d", len(x.OnionV3))
+		}
+		var pubKey [appmessage.OnionV3PubKeySize]byte
+		copy(pubKey[:], x.OnionV3)
+		address := appmessage.NewNetAddressOnionV3(pubKey, uint16(x.Port))
+		address.Timestamp = mstime.UnixMilliseconds(x.Timestamp)
+		return address, nil
+	}
 	return &appmessage.NetAddress{
 		Timestamp: mstime.UnixMilliseconds(x.Timestamp),
 		IP:        x.Ip,
@@ -111,6 +121,15 @@ func (x *NetAddress) toAppMessage() (*appmessage.NetAddress, error) {
 }
 
 func appMessageNetAddressToProto(address *appmessage.NetAddress) *NetAddress {
+	if address.IsOnionV3() {
+		// Onion addresses are sent without an IP, which nodes that don't
+		// know about onion addresses reject as invalid
+		return &NetAddress{
+			Timestamp: address.Timestamp.UnixMilliseconds(),
+			Port:      uint32(address.Port),
+			OnionV3:   address.OnionV3[:],
+		}
+	}
 	return &NetAddress{
 		Timestamp: address.Timestamp.UnixMilliseconds(),
 		Ip:        address.IP,
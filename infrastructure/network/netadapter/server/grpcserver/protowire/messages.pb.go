@@ -82,6 +82,10 @@ type CryptixdMessage struct {
 	//	*CryptixdMessage_ConsensusAtomicStateHash
 	//	*CryptixdMessage_RequestAtomicTokenStateHash
 	//	*CryptixdMessage_AtomicTokenStateHash
+	//	*CryptixdMessage_CompactBlock
+	//	*CryptixdMessage_RequestBlockTransactions
+	//	*CryptixdMessage_BlockTransactions
+	//	*CryptixdMessage_TransactionPackage
 	//	*CryptixdMessage_GetCurrentNetworkRequest
 	//	*CryptixdMessage_GetCurrentNetworkResponse
 	//	*CryptixdMessage_SubmitBlockRequest
@@ -191,6 +195,50 @@ type CryptixdMessage struct {
 	//	*CryptixdMessage_GetFeeEstimateResponse
 	//	*CryptixdMessage_GetFeeEstimateExperimentalResponse
 	//	*CryptixdMessage_GetCurrentBlockColorResponse
+	//	*CryptixdMessage_GetAtomicAssetRequest
+	//	*CryptixdMessage_GetAtomicAssetResponse
+	//	*CryptixdMessage_GetAtomicAssetsRequest
+	//	*CryptixdMessage_GetAtomicAssetsResponse
+	//	*CryptixdMessage_GetAtomicBalancesByOwnerRequest
+	//	*CryptixdMessage_GetAtomicBalancesByOwnerResponse
+	//	*CryptixdMessage_GetAtomicNonceRequest
+	//	*CryptixdMessage_GetAtomicNonceResponse
+	//	*CryptixdMessage_GetLiquidityPoolRequest
+	//	*CryptixdMessage_GetLiquidityPoolResponse
+	//	*CryptixdMessage_NotifyAtomicStateChangedRequest
+	//	*CryptixdMessage_NotifyAtomicStateChangedResponse
+	//	*CryptixdMessage_AtomicStateChangedNotification
+	//	*CryptixdMessage_StopNotifyingAtomicStateChangedRequest
+	//	*CryptixdMessage_StopNotifyingAtomicStateChangedResponse
+	//	*CryptixdMessage_SubmitFastIntentRequest
+	//	*CryptixdMessage_SubmitFastIntentResponse
+	//	*CryptixdMessage_GetFastIntentStatusRequest
+	//	*CryptixdMessage_GetFastIntentStatusResponse
+	//	*CryptixdMessage_GetAtomicHistoryByOwnerRequest
+	//	*CryptixdMessage_GetAtomicHistoryByOwnerResponse
+	//	*CryptixdMessage_GetAtomicHistoryByAssetRequest
+	//	*CryptixdMessage_GetAtomicHistoryByAssetResponse
+	//	*CryptixdMessage_SimulateAtomicTransactionRequest
+	//	*CryptixdMessage_SimulateAtomicTransactionResponse
+	//	*CryptixdMessage_GetAtomicBalanceProofRequest
+	//	*CryptixdMessage_GetAtomicBalanceProofResponse
+	//	*CryptixdMessage_GetStrongNodeClaimsRequest
+	//	*CryptixdMessage_GetStrongNodeClaimsResponse
+	//	*CryptixdMessage_NotifyBlockProducerClaimWinnerRequest
+	//	*CryptixdMessage_NotifyBlockProducerClaimWinnerResponse
+	//	*CryptixdMessage_BlockProducerClaimWinnerNotification
+	//	*CryptixdMessage_BanNodeIDRequest
+	//	*CryptixdMessage_BanNodeIDResponse
+	//	*CryptixdMessage_UnbanNodeIDRequest
+	//	*CryptixdMessage_UnbanNodeIDResponse
+	//	*CryptixdMessage_ListBansRequest
+	//	*CryptixdMessage_ListBansResponse
+	//	*CryptixdMessage_GetAntiFraudStateRequest
+	//	*CryptixdMessage_GetAntiFraudStateResponse
+	//	*CryptixdMessage_SubmitTransactionPackageRequest
+	//	*CryptixdMessage_SubmitTransactionPackageResponse
+	//	*CryptixdMessage_GetTransactionStatusRequest
+	//	*CryptixdMessage_GetTransactionStatusResponse
 	Payload       isCryptixdMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -742,6 +790,42 @@ func (x *CryptixdMessage) GetAtomicTokenStateHash() *AtomicTokenStateHashMessage
 	return nil
 }
 
+func (x *CryptixdMessage) GetCompactBlock() *CompactBlockMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_CompactBlock); ok {
+			return x.CompactBlock
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetRequestBlockTransactions() *RequestBlockTransactionsMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_RequestBlockTransactions); ok {
+			return x.RequestBlockTransactions
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetBlockTransactions() *BlockTransactionsMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_BlockTransactions); ok {
+			return x.BlockTransactions
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetTransactionPackage() *TransactionPackageMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_TransactionPackage); ok {
+			return x.TransactionPackage
+		}
+	}
+	return nil
+}
+
 func (x *CryptixdMessage) GetGetCurrentNetworkRequest() *GetCurrentNetworkRequestMessage {
 	if x != nil {
 		if x, ok := x.Payload.(*CryptixdMessage_GetCurrentNetworkRequest); ok {
@@ -1723,6 +1807,402 @@ func (x *CryptixdMessage) GetGetCurrentBlockColorResponse() *GetCurrentBlockColo
 	return nil
 }
 
+func (x *CryptixdMessage) GetGetAtomicAssetRequest() *GetAtomicAssetRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicAssetRequest); ok {
+			return x.GetAtomicAssetRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicAssetResponse() *GetAtomicAssetResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicAssetResponse); ok {
+			return x.GetAtomicAssetResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicAssetsRequest() *GetAtomicAssetsRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicAssetsRequest); ok {
+			return x.GetAtomicAssetsRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicAssetsResponse() *GetAtomicAssetsResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicAssetsResponse); ok {
+			return x.GetAtomicAssetsResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicBalancesByOwnerRequest() *GetAtomicBalancesByOwnerRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicBalancesByOwnerRequest); ok {
+			return x.GetAtomicBalancesByOwnerRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicBalancesByOwnerResponse() *GetAtomicBalancesByOwnerResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicBalancesByOwnerResponse); ok {
+			return x.GetAtomicBalancesByOwnerResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicNonceRequest() *GetAtomicNonceRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicNonceRequest); ok {
+			return x.GetAtomicNonceRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicNonceResponse() *GetAtomicNonceResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicNonceResponse); ok {
+			return x.GetAtomicNonceResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetLiquidityPoolRequest() *GetLiquidityPoolRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetLiquidityPoolRequest); ok {
+			return x.GetLiquidityPoolRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetLiquidityPoolResponse() *GetLiquidityPoolResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetLiquidityPoolResponse); ok {
+			return x.GetLiquidityPoolResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetNotifyAtomicStateChangedRequest() *NotifyAtomicStateChangedRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_NotifyAtomicStateChangedRequest); ok {
+			return x.NotifyAtomicStateChangedRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetNotifyAtomicStateChangedResponse() *NotifyAtomicStateChangedResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_NotifyAtomicStateChangedResponse); ok {
+			return x.NotifyAtomicStateChangedResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetAtomicStateChangedNotification() *AtomicStateChangedNotificationMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_AtomicStateChangedNotification); ok {
+			return x.AtomicStateChangedNotification
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetStopNotifyingAtomicStateChangedRequest() *StopNotifyingAtomicStateChangedRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_StopNotifyingAtomicStateChangedRequest); ok {
+			return x.StopNotifyingAtomicStateChangedRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetStopNotifyingAtomicStateChangedResponse() *StopNotifyingAtomicStateChangedResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_StopNotifyingAtomicStateChangedResponse); ok {
+			return x.StopNotifyingAtomicStateChangedResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetSubmitFastIntentRequest() *SubmitFastIntentRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_SubmitFastIntentRequest); ok {
+			return x.SubmitFastIntentRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetSubmitFastIntentResponse() *SubmitFastIntentResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_SubmitFastIntentResponse); ok {
+			return x.SubmitFastIntentResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetFastIntentStatusRequest() *GetFastIntentStatusRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetFastIntentStatusRequest); ok {
+			return x.GetFastIntentStatusRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetFastIntentStatusResponse() *GetFastIntentStatusResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetFastIntentStatusResponse); ok {
+			return x.GetFastIntentStatusResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicHistoryByOwnerRequest() *GetAtomicHistoryByOwnerRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicHistoryByOwnerRequest); ok {
+			return x.GetAtomicHistoryByOwnerRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicHistoryByOwnerResponse() *GetAtomicHistoryByOwnerResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicHistoryByOwnerResponse); ok {
+			return x.GetAtomicHistoryByOwnerResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicHistoryByAssetRequest() *GetAtomicHistoryByAssetRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicHistoryByAssetRequest); ok {
+			return x.GetAtomicHistoryByAssetRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicHistoryByAssetResponse() *GetAtomicHistoryByAssetResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicHistoryByAssetResponse); ok {
+			return x.GetAtomicHistoryByAssetResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetSimulateAtomicTransactionRequest() *SimulateAtomicTransactionRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_SimulateAtomicTransactionRequest); ok {
+			return x.SimulateAtomicTransactionRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetSimulateAtomicTransactionResponse() *SimulateAtomicTransactionResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_SimulateAtomicTransactionResponse); ok {
+			return x.SimulateAtomicTransactionResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicBalanceProofRequest() *GetAtomicBalanceProofRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicBalanceProofRequest); ok {
+			return x.GetAtomicBalanceProofRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAtomicBalanceProofResponse() *GetAtomicBalanceProofResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAtomicBalanceProofResponse); ok {
+			return x.GetAtomicBalanceProofResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetStrongNodeClaimsRequest() *GetStrongNodeClaimsRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetStrongNodeClaimsRequest); ok {
+			return x.GetStrongNodeClaimsRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetStrongNodeClaimsResponse() *GetStrongNodeClaimsResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetStrongNodeClaimsResponse); ok {
+			return x.GetStrongNodeClaimsResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetNotifyBlockProducerClaimWinnerRequest() *NotifyBlockProducerClaimWinnerRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_NotifyBlockProducerClaimWinnerRequest); ok {
+			return x.NotifyBlockProducerClaimWinnerRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetNotifyBlockProducerClaimWinnerResponse() *NotifyBlockProducerClaimWinnerResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_NotifyBlockProducerClaimWinnerResponse); ok {
+			return x.NotifyBlockProducerClaimWinnerResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetBlockProducerClaimWinnerNotification() *BlockProducerClaimWinnerNotificationMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_BlockProducerClaimWinnerNotification); ok {
+			return x.BlockProducerClaimWinnerNotification
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetBanNodeIDRequest() *BanNodeIDRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_BanNodeIDRequest); ok {
+			return x.BanNodeIDRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetBanNodeIDResponse() *BanNodeIDResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_BanNodeIDResponse); ok {
+			return x.BanNodeIDResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetUnbanNodeIDRequest() *UnbanNodeIDRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_UnbanNodeIDRequest); ok {
+			return x.UnbanNodeIDRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetUnbanNodeIDResponse() *UnbanNodeIDResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_UnbanNodeIDResponse); ok {
+			return x.UnbanNodeIDResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetListBansRequest() *ListBansRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_ListBansRequest); ok {
+			return x.ListBansRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetListBansResponse() *ListBansResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_ListBansResponse); ok {
+			return x.ListBansResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAntiFraudStateRequest() *GetAntiFraudStateRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAntiFraudStateRequest); ok {
+			return x.GetAntiFraudStateRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetAntiFraudStateResponse() *GetAntiFraudStateResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetAntiFraudStateResponse); ok {
+			return x.GetAntiFraudStateResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetSubmitTransactionPackageRequest() *SubmitTransactionPackageRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_SubmitTransactionPackageRequest); ok {
+			return x.SubmitTransactionPackageRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetSubmitTransactionPackageResponse() *SubmitTransactionPackageResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_SubmitTransactionPackageResponse); ok {
+			return x.SubmitTransactionPackageResponse
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetTransactionStatusRequest() *GetTransactionStatusRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetTransactionStatusRequest); ok {
+			return x.GetTransactionStatusRequest
+		}
+	}
+	return nil
+}
+
+func (x *CryptixdMessage) GetGetTransactionStatusResponse() *GetTransactionStatusResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*CryptixdMessage_GetTransactionStatusResponse); ok {
+			return x.GetTransactionStatusResponse
+		}
+	}
+	return nil
+}
+
 type isCryptixdMessage_Payload interface {
 	isCryptixdMessage_Payload()
 }
@@ -1947,6 +2427,22 @@ type CryptixdMessage_AtomicTokenStateHash struct {
 	AtomicTokenStateHash *AtomicTokenStateHashMessage `protobuf:"bytes,68,opt,name=atomicTokenStateHash,proto3,oneof"`
 }
 
+type CryptixdMessage_CompactBlock struct {
+	CompactBlock *CompactBlockMessage `protobuf:"bytes,69,opt,name=compactBlock,proto3,oneof"`
+}
+
+type CryptixdMessage_RequestBlockTransactions struct {
+	RequestBlockTransactions *RequestBlockTransactionsMessage `protobuf:"bytes,70,opt,name=requestBlockTransactions,proto3,oneof"`
+}
+
+type CryptixdMessage_BlockTransactions struct {
+	BlockTransactions *BlockTransactionsMessage `protobuf:"bytes,71,opt,name=blockTransactions,proto3,oneof"`
+}
+
+type CryptixdMessage_TransactionPackage struct {
+	TransactionPackage *TransactionPackageMessage `protobuf:"bytes,72,opt,name=transactionPackage,proto3,oneof"`
+}
+
 type CryptixdMessage_GetCurrentNetworkRequest struct {
 	GetCurrentNetworkRequest *GetCurrentNetworkRequestMessage `protobuf:"bytes,1001,opt,name=getCurrentNetworkRequest,proto3,oneof"`
 }
@@ -2383,6 +2879,182 @@ type CryptixdMessage_GetCurrentBlockColorResponse struct {
 	GetCurrentBlockColorResponse *GetCurrentBlockColorResponseMessage `protobuf:"bytes,1111,opt,name=getCurrentBlockColorResponse,proto3,oneof"`
 }
 
+type CryptixdMessage_GetAtomicAssetRequest struct {
+	GetAtomicAssetRequest *GetAtomicAssetRequestMessage `protobuf:"bytes,1112,opt,name=getAtomicAssetRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicAssetResponse struct {
+	GetAtomicAssetResponse *GetAtomicAssetResponseMessage `protobuf:"bytes,1113,opt,name=getAtomicAssetResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicAssetsRequest struct {
+	GetAtomicAssetsRequest *GetAtomicAssetsRequestMessage `protobuf:"bytes,1114,opt,name=getAtomicAssetsRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicAssetsResponse struct {
+	GetAtomicAssetsResponse *GetAtomicAssetsResponseMessage `protobuf:"bytes,1115,opt,name=getAtomicAssetsResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicBalancesByOwnerRequest struct {
+	GetAtomicBalancesByOwnerRequest *GetAtomicBalancesByOwnerRequestMessage `protobuf:"bytes,1116,opt,name=getAtomicBalancesByOwnerRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicBalancesByOwnerResponse struct {
+	GetAtomicBalancesByOwnerResponse *GetAtomicBalancesByOwnerResponseMessage `protobuf:"bytes,1117,opt,name=getAtomicBalancesByOwnerResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicNonceRequest struct {
+	GetAtomicNonceRequest *GetAtomicNonceRequestMessage `protobuf:"bytes,1118,opt,name=getAtomicNonceRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicNonceResponse struct {
+	GetAtomicNonceResponse *GetAtomicNonceResponseMessage `protobuf:"bytes,1119,opt,name=getAtomicNonceResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetLiquidityPoolRequest struct {
+	GetLiquidityPoolRequest *GetLiquidityPoolRequestMessage `protobuf:"bytes,1120,opt,name=getLiquidityPoolRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetLiquidityPoolResponse struct {
+	GetLiquidityPoolResponse *GetLiquidityPoolResponseMessage `protobuf:"bytes,1121,opt,name=getLiquidityPoolResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_NotifyAtomicStateChangedRequest struct {
+	NotifyAtomicStateChangedRequest *NotifyAtomicStateChangedRequestMessage `protobuf:"bytes,1122,opt,name=notifyAtomicStateChangedRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_NotifyAtomicStateChangedResponse struct {
+	NotifyAtomicStateChangedResponse *NotifyAtomicStateChangedResponseMessage `protobuf:"bytes,1123,opt,name=notifyAtomicStateChangedResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_AtomicStateChangedNotification struct {
+	AtomicStateChangedNotification *AtomicStateChangedNotificationMessage `protobuf:"bytes,1124,opt,name=atomicStateChangedNotification,proto3,oneof"`
+}
+
+type CryptixdMessage_StopNotifyingAtomicStateChangedRequest struct {
+	StopNotifyingAtomicStateChangedRequest *StopNotifyingAtomicStateChangedRequestMessage `protobuf:"bytes,1125,opt,name=stopNotifyingAtomicStateChangedRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_StopNotifyingAtomicStateChangedResponse struct {
+	StopNotifyingAtomicStateChangedResponse *StopNotifyingAtomicStateChangedResponseMessage `protobuf:"bytes,1126,opt,name=stopNotifyingAtomicStateChangedResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_SubmitFastIntentRequest struct {
+	SubmitFastIntentRequest *SubmitFastIntentRequestMessage `protobuf:"bytes,1127,opt,name=submitFastIntentRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_SubmitFastIntentResponse struct {
+	SubmitFastIntentResponse *SubmitFastIntentResponseMessage `protobuf:"bytes,1128,opt,name=submitFastIntentResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetFastIntentStatusRequest struct {
+	GetFastIntentStatusRequest *GetFastIntentStatusRequestMessage `protobuf:"bytes,1129,opt,name=getFastIntentStatusRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetFastIntentStatusResponse struct {
+	GetFastIntentStatusResponse *GetFastIntentStatusResponseMessage `protobuf:"bytes,1130,opt,name=getFastIntentStatusResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicHistoryByOwnerRequest struct {
+	GetAtomicHistoryByOwnerRequest *GetAtomicHistoryByOwnerRequestMessage `protobuf:"bytes,1131,opt,name=getAtomicHistoryByOwnerRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicHistoryByOwnerResponse struct {
+	GetAtomicHistoryByOwnerResponse *GetAtomicHistoryByOwnerResponseMessage `protobuf:"bytes,1132,opt,name=getAtomicHistoryByOwnerResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicHistoryByAssetRequest struct {
+	GetAtomicHistoryByAssetRequest *GetAtomicHistoryByAssetRequestMessage `protobuf:"bytes,1133,opt,name=getAtomicHistoryByAssetRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicHistoryByAssetResponse struct {
+	GetAtomicHistoryByAssetResponse *GetAtomicHistoryByAssetResponseMessage `protobuf:"bytes,1134,opt,name=getAtomicHistoryByAssetResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_SimulateAtomicTransactionRequest struct {
+	SimulateAtomicTransactionRequest *SimulateAtomicTransactionRequestMessage `protobuf:"bytes,1135,opt,name=simulateAtomicTransactionRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_SimulateAtomicTransactionResponse struct {
+	SimulateAtomicTransactionResponse *SimulateAtomicTransactionResponseMessage `protobuf:"bytes,1136,opt,name=simulateAtomicTransactionResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicBalanceProofRequest struct {
+	GetAtomicBalanceProofRequest *GetAtomicBalanceProofRequestMessage `protobuf:"bytes,1137,opt,name=getAtomicBalanceProofRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAtomicBalanceProofResponse struct {
+	GetAtomicBalanceProofResponse *GetAtomicBalanceProofResponseMessage `protobuf:"bytes,1138,opt,name=getAtomicBalanceProofResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetStrongNodeClaimsRequest struct {
+	GetStrongNodeClaimsRequest *GetStrongNodeClaimsRequestMessage `protobuf:"bytes,1139,opt,name=getStrongNodeClaimsRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetStrongNodeClaimsResponse struct {
+	GetStrongNodeClaimsResponse *GetStrongNodeClaimsResponseMessage `protobuf:"bytes,1140,opt,name=getStrongNodeClaimsResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_NotifyBlockProducerClaimWinnerRequest struct {
+	NotifyBlockProducerClaimWinnerRequest *NotifyBlockProducerClaimWinnerRequestMessage `protobuf:"bytes,1141,opt,name=notifyBlockProducerClaimWinnerRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_NotifyBlockProducerClaimWinnerResponse struct {
+	NotifyBlockProducerClaimWinnerResponse *NotifyBlockProducerClaimWinnerResponseMessage `protobuf:"bytes,1142,opt,name=notifyBlockProducerClaimWinnerResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_BlockProducerClaimWinnerNotification struct {
+	BlockProducerClaimWinnerNotification *BlockProducerClaimWinnerNotificationMessage `protobuf:"bytes,1143,opt,name=blockProducerClaimWinnerNotification,proto3,oneof"`
+}
+
+type CryptixdMessage_BanNodeIDRequest struct {
+	BanNodeIDRequest *BanNodeIDRequestMessage `protobuf:"bytes,1144,opt,name=banNodeIDRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_BanNodeIDResponse struct {
+	BanNodeIDResponse *BanNodeIDResponseMessage `protobuf:"bytes,1145,opt,name=banNodeIDResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_UnbanNodeIDRequest struct {
+	UnbanNodeIDRequest *UnbanNodeIDRequestMessage `protobuf:"bytes,1146,opt,name=unbanNodeIDRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_UnbanNodeIDResponse struct {
+	UnbanNodeIDResponse *UnbanNodeIDResponseMessage `protobuf:"bytes,1147,opt,name=unbanNodeIDResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_ListBansRequest struct {
+	ListBansRequest *ListBansRequestMessage `protobuf:"bytes,1148,opt,name=listBansRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_ListBansResponse struct {
+	ListBansResponse *ListBansResponseMessage `protobuf:"bytes,1149,opt,name=listBansResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAntiFraudStateRequest struct {
+	GetAntiFraudStateRequest *GetAntiFraudStateRequestMessage `protobuf:"bytes,1150,opt,name=getAntiFraudStateRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetAntiFraudStateResponse struct {
+	GetAntiFraudStateResponse *GetAntiFraudStateResponseMessage `protobuf:"bytes,1151,opt,name=getAntiFraudStateResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_SubmitTransactionPackageRequest struct {
+	SubmitTransactionPackageRequest *SubmitTransactionPackageRequestMessage `protobuf:"bytes,1152,opt,name=submitTransactionPackageRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_SubmitTransactionPackageResponse struct {
+	SubmitTransactionPackageResponse *SubmitTransactionPackageResponseMessage `protobuf:"bytes,1153,opt,name=submitTransactionPackageResponse,proto3,oneof"`
+}
+
+type CryptixdMessage_GetTransactionStatusRequest struct {
+	GetTransactionStatusRequest *GetTransactionStatusRequestMessage `protobuf:"bytes,1154,opt,name=getTransactionStatusRequest,proto3,oneof"`
+}
+
+type CryptixdMessage_GetTransactionStatusResponse struct {
+	GetTransactionStatusResponse *GetTransactionStatusResponseMessage `protobuf:"bytes,1155,opt,name=getTransactionStatusResponse,proto3,oneof"`
+}
+
 func (*CryptixdMessage_Addresses) isCryptixdMessage_Payload() {}
 
 func (*CryptixdMessage_Block) isCryptixdMessage_Payload() {}
@@ -2493,6 +3165,14 @@ func (*CryptixdMessage_RequestAtomicTokenStateHash) isCryptixdMessage_Payload()
 
 func (*CryptixdMessage_AtomicTokenStateHash) isCryptixdMessage_Payload() {}
 
+func (*CryptixdMessage_CompactBlock) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_RequestBlockTransactions) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_BlockTransactions) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_TransactionPackage) isCryptixdMessage_Payload() {}
+
 func (*CryptixdMessage_GetCurrentNetworkRequest) isCryptixdMessage_Payload() {}
 
 func (*CryptixdMessage_GetCurrentNetworkResponse) isCryptixdMessage_Payload() {}
@@ -2715,11 +3395,99 @@ func (*CryptixdMessage_GetFeeEstimateExperimentalResponse) isCryptixdMessage_Pay
 
 func (*CryptixdMessage_GetCurrentBlockColorResponse) isCryptixdMessage_Payload() {}
 
+func (*CryptixdMessage_GetAtomicAssetRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicAssetResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicAssetsRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicAssetsResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicBalancesByOwnerRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicBalancesByOwnerResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicNonceRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicNonceResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetLiquidityPoolRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetLiquidityPoolResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_NotifyAtomicStateChangedRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_NotifyAtomicStateChangedResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_AtomicStateChangedNotification) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_StopNotifyingAtomicStateChangedRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_StopNotifyingAtomicStateChangedResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_SubmitFastIntentRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_SubmitFastIntentResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetFastIntentStatusRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetFastIntentStatusResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicHistoryByOwnerRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicHistoryByOwnerResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicHistoryByAssetRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicHistoryByAssetResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_SimulateAtomicTransactionRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_SimulateAtomicTransactionResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicBalanceProofRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAtomicBalanceProofResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetStrongNodeClaimsRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetStrongNodeClaimsResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_NotifyBlockProducerClaimWinnerRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_NotifyBlockProducerClaimWinnerResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_BlockProducerClaimWinnerNotification) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_BanNodeIDRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_BanNodeIDResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_UnbanNodeIDRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_UnbanNodeIDResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_ListBansRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_ListBansResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAntiFraudStateRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetAntiFraudStateResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_SubmitTransactionPackageRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_SubmitTransactionPackageResponse) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetTransactionStatusRequest) isCryptixdMessage_Payload() {}
+
+func (*CryptixdMessage_GetTransactionStatusResponse) isCryptixdMessage_Payload() {}
+
 var File_messages_proto protoreflect.FileDescriptor
 
 const file_messages_proto_rawDesc = "" +
 	"\n" +
-	"\x0emessages.proto\x12\tprotowire\x1a\tp2p.proto\x1a\trpc.proto\"\x88\x8a\x01\n" +
+	"\x0emessages.proto\x12\tprotowire\x1a\tp2p.proto\x1a\trpc.proto\"ܴ\x01\n" +
 	"\x0fCryptixdMessage\x12\x1f\n" +
 	"\vresponse_id\x18e \x01(\rR\n" +
 	"responseId\x12\x1d\n" +
@@ -2782,7 +3550,11 @@ const file_messages_proto_rawDesc = "" +
 	"\x1frequestConsensusAtomicStateHash\x18A \x01(\v21.protowire.RequestConsensusAtomicStateHashMessageH\x00R\x1frequestConsensusAtomicStateHash\x12h\n" +
 	"\x18consensusAtomicStateHash\x18B \x01(\v2*.protowire.ConsensusAtomicStateHashMessageH\x00R\x18consensusAtomicStateHash\x12q\n" +
 	"\x1brequestAtomicTokenStateHash\x18C \x01(\v2-.protowire.RequestAtomicTokenStateHashMessageH\x00R\x1brequestAtomicTokenStateHash\x12\\\n" +
-	"\x14atomicTokenStateHash\x18D \x01(\v2&.protowire.AtomicTokenStateHashMessageH\x00R\x14atomicTokenStateHash\x12i\n" +
+	"\x14atomicTokenStateHash\x18D \x01(\v2&.protowire.AtomicTokenStateHashMessageH\x00R\x14atomicTokenStateHash\x12D\n" +
+	"\fcompactBlock\x18E \x01(\v2\x1e.protowire.CompactBlockMessageH\x00R\fcompactBlock\x12h\n" +
+	"\x18requestBlockTransactions\x18F \x01(\v2*.protowire.RequestBlockTransactionsMessageH\x00R\x18requestBlockTransactions\x12S\n" +
+	"\x11blockTransactions\x18G \x01(\v2#.protowire.BlockTransactionsMessageH\x00R\x11blockTransactions\x12V\n" +
+	"\x12transactionPackage\x18H \x01(\v2$.protowire.TransactionPackageMessageH\x00R\x12transactionPackage\x12i\n" +
 	"\x18getCurrentNetworkRequest\x18\xe9\a \x01(\v2*.protowire.GetCurrentNetworkRequestMessageH\x00R\x18getCurrentNetworkRequest\x12l\n" +
 	"\x19getCurrentNetworkResponse\x18\xea\a \x01(\v2+.protowire.GetCurrentNetworkResponseMessageH\x00R\x19getCurrentNetworkResponse\x12W\n" +
 	"\x12submitBlockRequest\x18\xeb\a \x01(\v2$.protowire.SubmitBlockRequestMessageH\x00R\x12submitBlockRequest\x12Z\n" +
@@ -2893,7 +3665,51 @@ const file_messages_proto_rawDesc = "" +
 	"\x15getSystemInfoResponse\x18\xd1\b \x01(\v2'.protowire.GetSystemInfoResponseMessageH\x00R\x15getSystemInfoResponse\x12c\n" +
 	"\x16getFeeEstimateResponse\x18\xd3\b \x01(\v2(.protowire.GetFeeEstimateResponseMessageH\x00R\x16getFeeEstimateResponse\x12\x87\x01\n" +
 	"\"getFeeEstimateExperimentalResponse\x18\xd5\b \x01(\v24.protowire.GetFeeEstimateExperimentalResponseMessageH\x00R\"getFeeEstimateExperimentalResponse\x12u\n" +
-	"\x1cgetCurrentBlockColorResponse\x18\xd7\b \x01(\v2..protowire.GetCurrentBlockColorResponseMessageH\x00R\x1cgetCurrentBlockColorResponseB\t\n" +
+	"\x1cgetCurrentBlockColorResponse\x18\xd7\b \x01(\v2..protowire.GetCurrentBlockColorResponseMessageH\x00R\x1cgetCurrentBlockColorResponse\x12`\n" +
+	"\x15getAtomicAssetRequest\x18\xd8\b \x01(\v2'.protowire.GetAtomicAssetRequestMessageH\x00R\x15getAtomicAssetRequest\x12c\n" +
+	"\x16getAtomicAssetResponse\x18\xd9\b \x01(\v2(.protowire.GetAtomicAssetResponseMessageH\x00R\x16getAtomicAssetResponse\x12c\n" +
+	"\x16getAtomicAssetsRequest\x18\xda\b \x01(\v2(.protowire.GetAtomicAssetsRequestMessageH\x00R\x16getAtomicAssetsRequest\x12f\n" +
+	"\x17getAtomicAssetsResponse\x18\xdb\b \x01(\v2).protowire.GetAtomicAssetsResponseMessageH\x00R\x17getAtomicAssetsResponse\x12~\n" +
+	"\x1fgetAtomicBalancesByOwnerRequest\x18\xdc\b \x01(\v21.protowire.GetAtomicBalancesByOwnerRequestMessageH\x00R\x1fgetAtomicBalancesByOwnerRequest\x12\x81\x01\n" +
+	" getAtomicBalancesByOwnerResponse\x18\xdd\b \x01(\v22.protowire.GetAtomicBalancesByOwnerResponseMessageH\x00R getAtomicBalancesByOwnerResponse\x12`\n" +
+	"\x15getAtomicNonceRequest\x18\xde\b \x01(\v2'.protowire.GetAtomicNonceRequestMessageH\x00R\x15getAtomicNonceRequest\x12c\n" +
+	"\x16getAtomicNonceResponse\x18\xdf\b \x01(\v2(.protowire.GetAtomicNonceResponseMessageH\x00R\x16getAtomicNonceResponse\x12f\n" +
+	"\x17getLiquidityPoolRequest\x18\xe0\b \x01(\v2).protowire.GetLiquidityPoolRequestMessageH\x00R\x17getLiquidityPoolRequest\x12i\n" +
+	"\x18getLiquidityPoolResponse\x18\xe1\b \x01(\v2*.protowire.GetLiquidityPoolResponseMessageH\x00R\x18getLiquidityPoolResponse\x12~\n" +
+	"\x1fnotifyAtomicStateChangedRequest\x18\xe2\b \x01(\v21.protowire.NotifyAtomicStateChangedRequestMessageH\x00R\x1fnotifyAtomicStateChangedRequest\x12\x81\x01\n" +
+	" notifyAtomicStateChangedResponse\x18\xe3\b \x01(\v22.protowire.NotifyAtomicStateChangedResponseMessageH\x00R notifyAtomicStateChangedResponse\x12{\n" +
+	"\x1eatomicStateChangedNotification\x18\xe4\b \x01(\v20.protowire.AtomicStateChangedNotificationMessageH\x00R\x1eatomicStateChangedNotification\x12\x93\x01\n" +
+	"&stopNotifyingAtomicStateChangedRequest\x18\xe5\b \x01(\v28.protowire.StopNotifyingAtomicStateChangedRequestMessageH\x00R&stopNotifyingAtomicStateChangedRequest\x12\x96\x01\n" +
+	"'stopNotifyingAtomicStateChangedResponse\x18\xe6\b \x01(\v29.protowire.StopNotifyingAtomicStateChangedResponseMessageH\x00R'stopNotifyingAtomicStateChangedResponse\x12f\n" +
+	"\x17submitFastIntentRequest\x18\xe7\b \x01(\v2).protowire.SubmitFastIntentRequestMessageH\x00R\x17submitFastIntentRequest\x12i\n" +
+	"\x18submitFastIntentResponse\x18\xe8\b \x01(\v2*.protowire.SubmitFastIntentResponseMessageH\x00R\x18submitFastIntentResponse\x12o\n" +
+	"\x1agetFastIntentStatusRequest\x18\xe9\b \x01(\v2,.protowire.GetFastIntentStatusRequestMessageH\x00R\x1agetFastIntentStatusRequest\x12r\n" +
+	"\x1bgetFastIntentStatusResponse\x18\xea\b \x01(\v2-.protowire.GetFastIntentStatusResponseMessageH\x00R\x1bgetFastIntentStatusResponse\x12{\n" +
+	"\x1egetAtomicHistoryByOwnerRequest\x18\xeb\b \x01(\v20.protowire.GetAtomicHistoryByOwnerRequestMessageH\x00R\x1egetAtomicHistoryByOwnerRequest\x12~\n" +
+	"\x1fgetAtomicHistoryByOwnerResponse\x18\xec\b \x01(\v21.protowire.GetAtomicHistoryByOwnerResponseMessageH\x00R\x1fgetAtomicHistoryByOwnerResponse\x12{\n" +
+	"\x1egetAtomicHistoryByAssetRequest\x18\xed\b \x01(\v20.protowire.GetAtomicHistoryByAssetRequestMessageH\x00R\x1egetAtomicHistoryByAssetRequest\x12~\n" +
+	"\x1fgetAtomicHistoryByAssetResponse\x18\xee\b \x01(\v21.protowire.GetAtomicHistoryByAssetResponseMessageH\x00R\x1fgetAtomicHistoryByAssetResponse\x12\x81\x01\n" +
+	" simulateAtomicTransactionRequest\x18\xef\b \x01(\v22.protowire.SimulateAtomicTransactionRequestMessageH\x00R simulateAtomicTransactionRequest\x12\x84\x01\n" +
+	"!simulateAtomicTransactionResponse\x18\xf0\b \x01(\v23.protowire.SimulateAtomicTransactionResponseMessageH\x00R!simulateAtomicTransactionResponse\x12u\n" +
+	"\x1cgetAtomicBalanceProofRequest\x18\xf1\b \x01(\v2..protowire.GetAtomicBalanceProofRequestMessageH\x00R\x1cgetAtomicBalanceProofRequest\x12x\n" +
+	"\x1dgetAtomicBalanceProofResponse\x18\xf2\b \x01(\v2/.protowire.GetAtomicBalanceProofResponseMessageH\x00R\x1dgetAtomicBalanceProofResponse\x12o\n" +
+	"\x1agetStrongNodeClaimsRequest\x18\xf3\b \x01(\v2,.protowire.GetStrongNodeClaimsRequestMessageH\x00R\x1agetStrongNodeClaimsRequest\x12r\n" +
+	"\x1bgetStrongNodeClaimsResponse\x18\xf4\b \x01(\v2-.protowire.GetStrongNodeClaimsResponseMessageH\x00R\x1bgetStrongNodeClaimsResponse\x12\x90\x01\n" +
+	"%notifyBlockProducerClaimWinnerRequest\x18\xf5\b \x01(\v27.protowire.NotifyBlockProducerClaimWinnerRequestMessageH\x00R%notifyBlockProducerClaimWinnerRequest\x12\x93\x01\n" +
+	"&notifyBlockProducerClaimWinnerResponse\x18\xf6\b \x01(\v28.protowire.NotifyBlockProducerClaimWinnerResponseMessageH\x00R&notifyBlockProducerClaimWinnerResponse\x12\x8d\x01\n" +
+	"$blockProducerClaimWinnerNotification\x18\xf7\b \x01(\v26.protowire.BlockProducerClaimWinnerNotificationMessageH\x00R$blockProducerClaimWinnerNotification\x12Q\n" +
+	"\x10banNodeIDRequest\x18\xf8\b \x01(\v2\".protowire.BanNodeIDRequestMessageH\x00R\x10banNodeIDRequest\x12T\n" +
+	"\x11banNodeIDResponse\x18\xf9\b \x01(\v2#.protowire.BanNodeIDResponseMessageH\x00R\x11banNodeIDResponse\x12W\n" +
+	"\x12unbanNodeIDRequest\x18\xfa\b \x01(\v2$.protowire.UnbanNodeIDRequestMessageH\x00R\x12unbanNodeIDRequest\x12Z\n" +
+	"\x13unbanNodeIDResponse\x18\xfb\b \x01(\v2%.protowire.UnbanNodeIDResponseMessageH\x00R\x13unbanNodeIDResponse\x12N\n" +
+	"\x0flistBansRequest\x18\xfc\b \x01(\v2!.protowire.ListBansRequestMessageH\x00R\x0flistBansRequest\x12Q\n" +
+	"\x10listBansResponse\x18\xfd\b \x01(\v2\".protowire.ListBansResponseMessageH\x00R\x10listBansResponse\x12i\n" +
+	"\x18getAntiFraudStateRequest\x18\xfe\b \x01(\v2*.protowire.GetAntiFraudStateRequestMessageH\x00R\x18getAntiFraudStateRequest\x12l\n" +
+	"\x19getAntiFraudStateResponse\x18\xff\b \x01(\v2+.protowire.GetAntiFraudStateResponseMessageH\x00R\x19getAntiFraudStateResponse\x12~\n" +
+	"\x1fsubmitTransactionPackageRequest\x18\x80\t \x01(\v21.protowire.SubmitTransactionPackageRequestMessageH\x00R\x1fsubmitTransactionPackageRequest\x12\x81\x01\n" +
+	" submitTransactionPackageResponse\x18\x81\t \x01(\v22.protowire.SubmitTransactionPackageResponseMessageH\x00R submitTransactionPackageResponse\x12r\n" +
+	"\x1bgetTransactionStatusRequest\x18\x82\t \x01(\v2-.protowire.GetTransactionStatusRequestMessageH\x00R\x1bgetTransactionStatusRequest\x12u\n" +
+	"\x1cgetTransactionStatusResponse\x18\x83\t \x01(\v2..protowire.GetTransactionStatusResponseMessageH\x00R\x1cgetTransactionStatusResponseB\t\n" +
 	"\apayload2T\n" +
 	"\x03P2P\x12M\n" +
 	"\rMessageStream\x12\x1a.protowire.CryptixdMessage\x1a\x1a.protowire.CryptixdMessage\"\x00(\x010\x012T\n" +
@@ -2969,115 +3785,163 @@ var file_messages_proto_goTypes = []any{
 	(*ConsensusAtomicStateHashMessage)(nil),                            // 52: protowire.ConsensusAtomicStateHashMessage
 	(*RequestAtomicTokenStateHashMessage)(nil),                         // 53: protowire.RequestAtomicTokenStateHashMessage
 	(*AtomicTokenStateHashMessage)(nil),                                // 54: protowire.AtomicTokenStateHashMessage
-	(*GetCurrentNetworkRequestMessage)(nil),                            // 55: protowire.GetCurrentNetworkRequestMessage
-	(*GetCurrentNetworkResponseMessage)(nil),                           // 56: protowire.GetCurrentNetworkResponseMessage
-	(*SubmitBlockRequestMessage)(nil),                                  // 57: protowire.SubmitBlockRequestMessage
-	(*SubmitBlockResponseMessage)(nil),                                 // 58: protowire.SubmitBlockResponseMessage
-	(*GetBlockTemplateRequestMessage)(nil),                             // 59: protowire.GetBlockTemplateRequestMessage
-	(*GetBlockTemplateResponseMessage)(nil),                            // 60: protowire.GetBlockTemplateResponseMessage
-	(*NotifyBlockAddedRequestMessage)(nil),                             // 61: protowire.NotifyBlockAddedRequestMessage
-	(*NotifyBlockAddedResponseMessage)(nil),                            // 62: protowire.NotifyBlockAddedResponseMessage
-	(*BlockAddedNotificationMessage)(nil),                              // 63: protowire.BlockAddedNotificationMessage
-	(*GetPeerAddressesRequestMessage)(nil),                             // 64: protowire.GetPeerAddressesRequestMessage
-	(*GetPeerAddressesResponseMessage)(nil),                            // 65: protowire.GetPeerAddressesResponseMessage
-	(*GetSelectedTipHashRequestMessage)(nil),                           // 66: protowire.GetSelectedTipHashRequestMessage
-	(*GetSelectedTipHashResponseMessage)(nil),                          // 67: protowire.GetSelectedTipHashResponseMessage
-	(*GetMempoolEntryRequestMessage)(nil),                              // 68: protowire.GetMempoolEntryRequestMessage
-	(*GetMempoolEntryResponseMessage)(nil),                             // 69: protowire.GetMempoolEntryResponseMessage
-	(*GetConnectedPeerInfoRequestMessage)(nil),                         // 70: protowire.GetConnectedPeerInfoRequestMessage
-	(*GetConnectedPeerInfoResponseMessage)(nil),                        // 71: protowire.GetConnectedPeerInfoResponseMessage
-	(*AddPeerRequestMessage)(nil),                                      // 72: protowire.AddPeerRequestMessage
-	(*AddPeerResponseMessage)(nil),                                     // 73: protowire.AddPeerResponseMessage
-	(*SubmitTransactionRequestMessage)(nil),                            // 74: protowire.SubmitTransactionRequestMessage
-	(*SubmitTransactionResponseMessage)(nil),                           // 75: protowire.SubmitTransactionResponseMessage
-	(*NotifyVirtualSelectedParentChainChangedRequestMessage)(nil),      // 76: protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
-	(*NotifyVirtualSelectedParentChainChangedResponseMessage)(nil),     // 77: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
-	(*VirtualSelectedParentChainChangedNotificationMessage)(nil),       // 78: protowire.VirtualSelectedParentChainChangedNotificationMessage
-	(*GetBlockRequestMessage)(nil),                                     // 79: protowire.GetBlockRequestMessage
-	(*GetBlockResponseMessage)(nil),                                    // 80: protowire.GetBlockResponseMessage
-	(*GetSubnetworkRequestMessage)(nil),                                // 81: protowire.GetSubnetworkRequestMessage
-	(*GetSubnetworkResponseMessage)(nil),                               // 82: protowire.GetSubnetworkResponseMessage
-	(*GetVirtualSelectedParentChainFromBlockRequestMessage)(nil),       // 83: protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
-	(*GetVirtualSelectedParentChainFromBlockResponseMessage)(nil),      // 84: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
-	(*GetBlocksRequestMessage)(nil),                                    // 85: protowire.GetBlocksRequestMessage
-	(*GetBlocksResponseMessage)(nil),                                   // 86: protowire.GetBlocksResponseMessage
-	(*GetBlockCountRequestMessage)(nil),                                // 87: protowire.GetBlockCountRequestMessage
-	(*GetBlockCountResponseMessage)(nil),                               // 88: protowire.GetBlockCountResponseMessage
-	(*GetBlockDagInfoRequestMessage)(nil),                              // 89: protowire.GetBlockDagInfoRequestMessage
-	(*GetBlockDagInfoResponseMessage)(nil),                             // 90: protowire.GetBlockDagInfoResponseMessage
-	(*ResolveFinalityConflictRequestMessage)(nil),                      // 91: protowire.ResolveFinalityConflictRequestMessage
-	(*ResolveFinalityConflictResponseMessage)(nil),                     // 92: protowire.ResolveFinalityConflictResponseMessage
-	(*NotifyFinalityConflictsRequestMessage)(nil),                      // 93: protowire.NotifyFinalityConflictsRequestMessage
-	(*NotifyFinalityConflictsResponseMessage)(nil),                     // 94: protowire.NotifyFinalityConflictsResponseMessage
-	(*FinalityConflictNotificationMessage)(nil),                        // 95: protowire.FinalityConflictNotificationMessage
-	(*FinalityConflictResolvedNotificationMessage)(nil),                // 96: protowire.FinalityConflictResolvedNotificationMessage
-	(*GetMempoolEntriesRequestMessage)(nil),                            // 97: protowire.GetMempoolEntriesRequestMessage
-	(*GetMempoolEntriesResponseMessage)(nil),                           // 98: protowire.GetMempoolEntriesResponseMessage
-	(*ShutDownRequestMessage)(nil),                                     // 99: protowire.ShutDownRequestMessage
-	(*ShutDownResponseMessage)(nil),                                    // 100: protowire.ShutDownResponseMessage
-	(*GetHeadersRequestMessage)(nil),                                   // 101: protowire.GetHeadersRequestMessage
-	(*GetHeadersResponseMessage)(nil),                                  // 102: protowire.GetHeadersResponseMessage
-	(*NotifyUtxosChangedRequestMessage)(nil),                           // 103: protowire.NotifyUtxosChangedRequestMessage
-	(*NotifyUtxosChangedResponseMessage)(nil),                          // 104: protowire.NotifyUtxosChangedResponseMessage
-	(*UtxosChangedNotificationMessage)(nil),                            // 105: protowire.UtxosChangedNotificationMessage
-	(*GetUtxosByAddressesRequestMessage)(nil),                          // 106: protowire.GetUtxosByAddressesRequestMessage
-	(*GetUtxosByAddressesResponseMessage)(nil),                         // 107: protowire.GetUtxosByAddressesResponseMessage
-	(*GetVirtualSelectedParentBlueScoreRequestMessage)(nil),            // 108: protowire.GetVirtualSelectedParentBlueScoreRequestMessage
-	(*GetVirtualSelectedParentBlueScoreResponseMessage)(nil),           // 109: protowire.GetVirtualSelectedParentBlueScoreResponseMessage
-	(*NotifyVirtualSelectedParentBlueScoreChangedRequestMessage)(nil),  // 110: protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
-	(*NotifyVirtualSelectedParentBlueScoreChangedResponseMessage)(nil), // 111: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
-	(*VirtualSelectedParentBlueScoreChangedNotificationMessage)(nil),   // 112: protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
-	(*BanRequestMessage)(nil),                                          // 113: protowire.BanRequestMessage
-	(*BanResponseMessage)(nil),                                         // 114: protowire.BanResponseMessage
-	(*UnbanRequestMessage)(nil),                                        // 115: protowire.UnbanRequestMessage
-	(*UnbanResponseMessage)(nil),                                       // 116: protowire.UnbanResponseMessage
-	(*GetInfoRequestMessage)(nil),                                      // 117: protowire.GetInfoRequestMessage
-	(*GetInfoResponseMessage)(nil),                                     // 118: protowire.GetInfoResponseMessage
-	(*StopNotifyingUtxosChangedRequestMessage)(nil),                    // 119: protowire.StopNotifyingUtxosChangedRequestMessage
-	(*StopNotifyingUtxosChangedResponseMessage)(nil),                   // 120: protowire.StopNotifyingUtxosChangedResponseMessage
-	(*NotifyPruningPointUTXOSetOverrideRequestMessage)(nil),            // 121: protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
-	(*NotifyPruningPointUTXOSetOverrideResponseMessage)(nil),           // 122: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
-	(*PruningPointUTXOSetOverrideNotificationMessage)(nil),             // 123: protowire.PruningPointUTXOSetOverrideNotificationMessage
-	(*StopNotifyingPruningPointUTXOSetOverrideRequestMessage)(nil),     // 124: protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
-	(*StopNotifyingPruningPointUTXOSetOverrideResponseMessage)(nil),    // 125: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
-	(*EstimateNetworkHashesPerSecondRequestMessage)(nil),               // 126: protowire.EstimateNetworkHashesPerSecondRequestMessage
-	(*EstimateNetworkHashesPerSecondResponseMessage)(nil),              // 127: protowire.EstimateNetworkHashesPerSecondResponseMessage
-	(*NotifyVirtualDaaScoreChangedRequestMessage)(nil),                 // 128: protowire.NotifyVirtualDaaScoreChangedRequestMessage
-	(*NotifyVirtualDaaScoreChangedResponseMessage)(nil),                // 129: protowire.NotifyVirtualDaaScoreChangedResponseMessage
-	(*VirtualDaaScoreChangedNotificationMessage)(nil),                  // 130: protowire.VirtualDaaScoreChangedNotificationMessage
-	(*GetBalanceByAddressRequestMessage)(nil),                          // 131: protowire.GetBalanceByAddressRequestMessage
-	(*GetBalanceByAddressResponseMessage)(nil),                         // 132: protowire.GetBalanceByAddressResponseMessage
-	(*GetBalancesByAddressesRequestMessage)(nil),                       // 133: protowire.GetBalancesByAddressesRequestMessage
-	(*GetBalancesByAddressesResponseMessage)(nil),                      // 134: protowire.GetBalancesByAddressesResponseMessage
-	(*NotifyNewBlockTemplateRequestMessage)(nil),                       // 135: protowire.NotifyNewBlockTemplateRequestMessage
-	(*NotifyNewBlockTemplateResponseMessage)(nil),                      // 136: protowire.NotifyNewBlockTemplateResponseMessage
-	(*NewBlockTemplateNotificationMessage)(nil),                        // 137: protowire.NewBlockTemplateNotificationMessage
-	(*GetMempoolEntriesByAddressesRequestMessage)(nil),                 // 138: protowire.GetMempoolEntriesByAddressesRequestMessage
-	(*GetMempoolEntriesByAddressesResponseMessage)(nil),                // 139: protowire.GetMempoolEntriesByAddressesResponseMessage
-	(*GetCoinSupplyRequestMessage)(nil),                                // 140: protowire.GetCoinSupplyRequestMessage
-	(*GetCoinSupplyResponseMessage)(nil),                               // 141: protowire.GetCoinSupplyResponseMessage
-	(*PingRequestMessage)(nil),                                         // 142: protowire.PingRequestMessage
-	(*GetMetricsRequestMessage)(nil),                                   // 143: protowire.GetMetricsRequestMessage
-	(*GetServerInfoRequestMessage)(nil),                                // 144: protowire.GetServerInfoRequestMessage
-	(*GetSyncStatusRequestMessage)(nil),                                // 145: protowire.GetSyncStatusRequestMessage
-	(*GetDaaScoreTimestampEstimateRequestMessage)(nil),                 // 146: protowire.GetDaaScoreTimestampEstimateRequestMessage
-	(*SubmitTransactionReplacementRequestMessage)(nil),                 // 147: protowire.SubmitTransactionReplacementRequestMessage
-	(*GetConnectionsRequestMessage)(nil),                               // 148: protowire.GetConnectionsRequestMessage
-	(*GetSystemInfoRequestMessage)(nil),                                // 149: protowire.GetSystemInfoRequestMessage
-	(*GetFeeEstimateRequestMessage)(nil),                               // 150: protowire.GetFeeEstimateRequestMessage
-	(*GetFeeEstimateExperimentalRequestMessage)(nil),                   // 151: protowire.GetFeeEstimateExperimentalRequestMessage
-	(*GetCurrentBlockColorRequestMessage)(nil),                         // 152: protowire.GetCurrentBlockColorRequestMessage
-	(*PingResponseMessage)(nil),                                        // 153: protowire.PingResponseMessage
-	(*GetMetricsResponseMessage)(nil),                                  // 154: protowire.GetMetricsResponseMessage
-	(*GetServerInfoResponseMessage)(nil),                               // 155: protowire.GetServerInfoResponseMessage
-	(*GetSyncStatusResponseMessage)(nil),                               // 156: protowire.GetSyncStatusResponseMessage
-	(*GetDaaScoreTimestampEstimateResponseMessage)(nil),                // 157: protowire.GetDaaScoreTimestampEstimateResponseMessage
-	(*SubmitTransactionReplacementResponseMessage)(nil),                // 158: protowire.SubmitTransactionReplacementResponseMessage
-	(*GetConnectionsResponseMessage)(nil),                              // 159: protowire.GetConnectionsResponseMessage
-	(*GetSystemInfoResponseMessage)(nil),                               // 160: protowire.GetSystemInfoResponseMessage
-	(*GetFeeEstimateResponseMessage)(nil),                              // 161: protowire.GetFeeEstimateResponseMessage
-	(*GetFeeEstimateExperimentalResponseMessage)(nil),                  // 162: protowire.GetFeeEstimateExperimentalResponseMessage
-	(*GetCurrentBlockColorResponseMessage)(nil),                        // 163: protowire.GetCurrentBlockColorResponseMessage
+	(*CompactBlockMessage)(nil),                                        // 55: protowire.CompactBlockMessage
+	(*RequestBlockTransactionsMessage)(nil),                            // 56: protowire.RequestBlockTransactionsMessage
+	(*BlockTransactionsMessage)(nil),                                   // 57: protowire.BlockTransactionsMessage
+	(*TransactionPackageMessage)(nil),                                  // 58: protowire.TransactionPackageMessage
+	(*GetCurrentNetworkRequestMessage)(nil),                            // 59: protowire.GetCurrentNetworkRequestMessage
+	(*GetCurrentNetworkResponseMessage)(nil),                           // 60: protowire.GetCurrentNetworkResponseMessage
+	(*SubmitBlockRequestMessage)(nil),                                  // 61: protowire.SubmitBlockRequestMessage
+	(*SubmitBlockResponseMessage)(nil),                                 // 62: protowire.SubmitBlockResponseMessage
+	(*GetBlockTemplateRequestMessage)(nil),                             // 63: protowire.GetBlockTemplateRequestMessage
+	(*GetBlockTemplateResponseMessage)(nil),                            // 64: protowire.GetBlockTemplateResponseMessage
+	(*NotifyBlockAddedRequestMessage)(nil),                             // 65: protowire.NotifyBlockAddedRequestMessage
+	(*NotifyBlockAddedResponseMessage)(nil),                            // 66: protowire.NotifyBlockAddedResponseMessage
+	(*BlockAddedNotificationMessage)(nil),                              // 67: protowire.BlockAddedNotificationMessage
+	(*GetPeerAddressesRequestMessage)(nil),                             // 68: protowire.GetPeerAddressesRequestMessage
+	(*GetPeerAddressesResponseMessage)(nil),                            // 69: protowire.GetPeerAddressesResponseMessage
+	(*GetSelectedTipHashRequestMessage)(nil),                           // 70: protowire.GetSelectedTipHashRequestMessage
+	(*GetSelectedTipHashResponseMessage)(nil),                          // 71: protowire.GetSelectedTipHashResponseMessage
+	(*GetMempoolEntryRequestMessage)(nil),                              // 72: protowire.GetMempoolEntryRequestMessage
+	(*GetMempoolEntryResponseMessage)(nil),                             // 73: protowire.GetMempoolEntryResponseMessage
+	(*GetConnectedPeerInfoRequestMessage)(nil),                         // 74: protowire.GetConnectedPeerInfoRequestMessage
+	(*GetConnectedPeerInfoResponseMessage)(nil),                        // 75: protowire.GetConnectedPeerInfoResponseMessage
+	(*AddPeerRequestMessage)(nil),                                      // 76: protowire.AddPeerRequestMessage
+	(*AddPeerResponseMessage)(nil),                                     // 77: protowire.AddPeerResponseMessage
+	(*SubmitTransactionRequestMessage)(nil),                            // 78: protowire.SubmitTransactionRequestMessage
+	(*SubmitTransactionResponseMessage)(nil),                           // 79: protowire.SubmitTransactionResponseMessage
+	(*NotifyVirtualSelectedParentChainChangedRequestMessage)(nil),      // 80: protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
+	(*NotifyVirtualSelectedParentChainChangedResponseMessage)(nil),     // 81: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
+	(*VirtualSelectedParentChainChangedNotificationMessage)(nil),       // 82: protowire.VirtualSelectedParentChainChangedNotificationMessage
+	(*GetBlockRequestMessage)(nil),                                     // 83: protowire.GetBlockRequestMessage
+	(*GetBlockResponseMessage)(nil),                                    // 84: protowire.GetBlockResponseMessage
+	(*GetSubnetworkRequestMessage)(nil),                                // 85: protowire.GetSubnetworkRequestMessage
+	(*GetSubnetworkResponseMessage)(nil),                               // 86: protowire.GetSubnetworkResponseMessage
+	(*GetVirtualSelectedParentChainFromBlockRequestMessage)(nil),       // 87: protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
+	(*GetVirtualSelectedParentChainFromBlockResponseMessage)(nil),      // 88: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
+	(*GetBlocksRequestMessage)(nil),                                    // 89: protowire.GetBlocksRequestMessage
+	(*GetBlocksResponseMessage)(nil),                                   // 90: protowire.GetBlocksResponseMessage
+	(*GetBlockCountRequestMessage)(nil),                                // 91: protowire.GetBlockCountRequestMessage
+	(*GetBlockCountResponseMessage)(nil),                               // 92: protowire.GetBlockCountResponseMessage
+	(*GetBlockDagInfoRequestMessage)(nil),                              // 93: protowire.GetBlockDagInfoRequestMessage
+	(*GetBlockDagInfoResponseMessage)(nil),                             // 94: protowire.GetBlockDagInfoResponseMessage
+	(*ResolveFinalityConflictRequestMessage)(nil),                      // 95: protowire.ResolveFinalityConflictRequestMessage
+	(*ResolveFinalityConflictResponseMessage)(nil),                     // 96: protowire.ResolveFinalityConflictResponseMessage
+	(*NotifyFinalityConflictsRequestMessage)(nil),                      // 97: protowire.NotifyFinalityConflictsRequestMessage
+	(*NotifyFinalityConflictsResponseMessage)(nil),                     // 98: protowire.NotifyFinalityConflictsResponseMessage
+	(*FinalityConflictNotificationMessage)(nil),                        // 99: protowire.FinalityConflictNotificationMessage
+	(*FinalityConflictResolvedNotificationMessage)(nil),                // 100: protowire.FinalityConflictResolvedNotificationMessage
+	(*GetMempoolEntriesRequestMessage)(nil),                            // 101: protowire.GetMempoolEntriesRequestMessage
+	(*GetMempoolEntriesResponseMessage)(nil),                           // 102: protowire.GetMempoolEntriesResponseMessage
+	(*ShutDownRequestMessage)(nil),                                     // 103: protowire.ShutDownRequestMessage
+	(*ShutDownResponseMessage)(nil),                                    // 104: protowire.ShutDownResponseMessage
+	(*GetHeadersRequestMessage)(nil),                                   // 105: protowire.GetHeadersRequestMessage
+	(*GetHeadersResponseMessage)(nil),                                  // 106: protowire.GetHeadersResponseMessage
+	(*NotifyUtxosChangedRequestMessage)(nil),                           // 107: protowire.NotifyUtxosChangedRequestMessage
+	(*NotifyUtxosChangedResponseMessage)(nil),                          // 108: protowire.NotifyUtxosChangedResponseMessage
+	(*UtxosChangedNotificationMessage)(nil),                            // 109: protowire.UtxosChangedNotificationMessage
+	(*GetUtxosByAddressesRequestMessage)(nil),                          // 110: protowire.GetUtxosByAddressesRequestMessage
+	(*GetUtxosByAddressesResponseMessage)(nil),                         // 111: protowire.GetUtxosByAddressesResponseMessage
+	(*GetVirtualSelectedParentBlueScoreRequestMessage)(nil),            // 112: protowire.GetVirtualSelectedParentBlueScoreRequestMessage
+	(*GetVirtualSelectedParentBlueScoreResponseMessage)(nil),           // 113: protowire.GetVirtualSelectedParentBlueScoreResponseMessage
+	(*NotifyVirtualSelectedParentBlueScoreChangedRequestMessage)(nil),  // 114: protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
+	(*NotifyVirtualSelectedParentBlueScoreChangedResponseMessage)(nil), // 115: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
+	(*VirtualSelectedParentBlueScoreChangedNotificationMessage)(nil),   // 116: protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
+	(*BanRequestMessage)(nil),                                          // 117: protowire.BanRequestMessage
+	(*BanResponseMessage)(nil),                                         // 118: protowire.BanResponseMessage
+	(*UnbanRequestMessage)(nil),                                        // 119: protowire.UnbanRequestMessage
+	(*UnbanResponseMessage)(nil),                                       // 120: protowire.UnbanResponseMessage
+	(*GetInfoRequestMessage)(nil),                                      // 121: protowire.GetInfoRequestMessage
+	(*GetInfoResponseMessage)(nil),                                     // 122: protowire.GetInfoResponseMessage
+	(*StopNotifyingUtxosChangedRequestMessage)(nil),                    // 123: protowire.StopNotifyingUtxosChangedRequestMessage
+	(*StopNotifyingUtxosChangedResponseMessage)(nil),                   // 124: protowire.StopNotifyingUtxosChangedResponseMessage
+	(*NotifyPruningPointUTXOSetOverrideRequestMessage)(nil),            // 125: protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
+	(*NotifyPruningPointUTXOSetOverrideResponseMessage)(nil),           // 126: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
+	(*PruningPointUTXOSetOverrideNotificationMessage)(nil),             // 127: protowire.PruningPointUTXOSetOverrideNotificationMessage
+	(*StopNotifyingPruningPointUTXOSetOverrideRequestMessage)(nil),     // 128: protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
+	(*StopNotifyingPruningPointUTXOSetOverrideResponseMessage)(nil),    // 129: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
+	(*EstimateNetworkHashesPerSecondRequestMessage)(nil),               // 130: protowire.EstimateNetworkHashesPerSecondRequestMessage
+	(*EstimateNetworkHashesPerSecondResponseMessage)(nil),              // 131: protowire.EstimateNetworkHashesPerSecondResponseMessage
+	(*NotifyVirtualDaaScoreChangedRequestMessage)(nil),                 // 132: protowire.NotifyVirtualDaaScoreChangedRequestMessage
+	(*NotifyVirtualDaaScoreChangedResponseMessage)(nil),                // 133: protowire.NotifyVirtualDaaScoreChangedResponseMessage
+	(*VirtualDaaScoreChangedNotificationMessage)(nil),                  // 134: protowire.VirtualDaaScoreChangedNotificationMessage
+	(*GetBalanceByAddressRequestMessage)(nil),                          // 135: protowire.GetBalanceByAddressRequestMessage
+	(*GetBalanceByAddressResponseMessage)(nil),                         // 136: protowire.GetBalanceByAddressResponseMessage
+	(*GetBalancesByAddressesRequestMessage)(nil),                       // 137: protowire.GetBalancesByAddressesRequestMessage
+	(*GetBalancesByAddressesResponseMessage)(nil),                      // 138: protowire.GetBalancesByAddressesResponseMessage
+	(*NotifyNewBlockTemplateRequestMessage)(nil),                       // 139: protowire.NotifyNewBlockTemplateRequestMessage
+	(*NotifyNewBlockTemplateResponseMessage)(nil),                      // 140: protowire.NotifyNewBlockTemplateResponseMessage
+	(*NewBlockTemplateNotificationMessage)(nil),                        // 141: protowire.NewBlockTemplateNotificationMessage
+	(*GetMempoolEntriesByAddressesRequestMessage)(nil),                 // 142: protowire.GetMempoolEntriesByAddressesRequestMessage
+	(*GetMempoolEntriesByAddressesResponseMessage)(nil),                // 143: protowire.GetMempoolEntriesByAddressesResponseMessage
+	(*GetCoinSupplyRequestMessage)(nil),                                // 144: protowire.GetCoinSupplyRequestMessage
+	(*GetCoinSupplyResponseMessage)(nil),                               // 145: protowire.GetCoinSupplyResponseMessage
+	(*PingRequestMessage)(nil),                                         // 146: protowire.PingRequestMessage
+	(*GetMetricsRequestMessage)(nil),                                   // 147: protowire.GetMetricsRequestMessage
+	(*GetServerInfoRequestMessage)(nil),                                // 148: protowire.GetServerInfoRequestMessage
+	(*GetSyncStatusRequestMessage)(nil),                                // 149: protowire.GetSyncStatusRequestMessage
+	(*GetDaaScoreTimestampEstimateRequestMessage)(nil),                 // 150: protowire.GetDaaScoreTimestampEstimateRequestMessage
+	(*SubmitTransactionReplacementRequestMessage)(nil),                 // 151: protowire.SubmitTransactionReplacementRequestMessage
+	(*GetConnectionsRequestMessage)(nil),                               // 152: protowire.GetConnectionsRequestMessage
+	(*GetSystemInfoRequestMessage)(nil),                                // 153: protowire.GetSystemInfoRequestMessage
+	(*GetFeeEstimateRequestMessage)(nil),                               // 154: protowire.GetFeeEstimateRequestMessage
+	(*GetFeeEstimateExperimentalRequestMessage)(nil),                   // 155: protowire.GetFeeEstimateExperimentalRequestMessage
+	(*GetCurrentBlockColorRequestMessage)(nil),                         // 156: protowire.GetCurrentBlockColorRequestMessage
+	(*PingResponseMessage)(nil),                                        // 157: protowire.PingResponseMessage
+	(*GetMetricsResponseMessage)(nil),                                  // 158: protowire.GetMetricsResponseMessage
+	(*GetServerInfoResponseMessage)(nil),                               // 159: protowire.GetServerInfoResponseMessage
+	(*GetSyncStatusResponseMessage)(nil),                               // 160: protowire.GetSyncStatusResponseMessage
+	(*GetDaaScoreTimestampEstimateResponseMessage)(nil),                // 161: protowire.GetDaaScoreTimestampEstimateResponseMessage
+	(*SubmitTransactionReplacementResponseMessage)(nil),                // 162: protowire.SubmitTransactionReplacementResponseMessage
+	(*GetConnectionsResponseMessage)(nil),                              // 163: protowire.GetConnectionsResponseMessage
+	(*GetSystemInfoResponseMessage)(nil),                               // 164: protowire.GetSystemInfoResponseMessage
+	(*GetFeeEstimateResponseMessage)(nil),                              // 165: protowire.GetFeeEstimateResponseMessage
+	(*GetFeeEstimateExperimentalResponseMessage)(nil),                  // 166: protowire.GetFeeEstimateExperimentalResponseMessage
+	(*GetCurrentBlockColorResponseMessage)(nil),                        // 167: protowire.GetCurrentBlockColorResponseMessage
+	(*GetAtomicAssetRequestMessage)(nil),                               // 168: protowire.GetAtomicAssetRequestMessage
+	(*GetAtomicAssetResponseMessage)(nil),                              // 169: protowire.GetAtomicAssetResponseMessage
+	(*GetAtomicAssetsRequestMessage)(nil),                              // 170: protowire.GetAtomicAssetsRequestMessage
+	(*GetAtomicAssetsResponseMessage)(nil),                             // 171: protowire.GetAtomicAssetsResponseMessage
+	(*GetAtomicBalancesByOwnerRequestMessage)(nil),                     // 172: protowire.GetAtomicBalancesByOwnerRequestMessage
+	(*GetAtomicBalancesByOwnerResponseMessage)(nil),                    // 173: protowire.GetAtomicBalancesByOwnerResponseMessage
+	(*GetAtomicNonceRequestMessage)(nil),                               // 174: protowire.GetAtomicNonceRequestMessage
+	(*GetAtomicNonceResponseMessage)(nil),                              // 175: protowire.GetAtomicNonceResponseMessage
+	(*GetLiquidityPoolRequestMessage)(nil),                             // 176: protowire.GetLiquidityPoolRequestMessage
+	(*GetLiquidityPoolResponseMessage)(nil),                            // 177: protowire.GetLiquidityPoolResponseMessage
+	(*NotifyAtomicStateChangedRequestMessage)(nil),                     // 178: protowire.NotifyAtomicStateChangedRequestMessage
+	(*NotifyAtomicStateChangedResponseMessage)(nil),                    // 179: protowire.NotifyAtomicStateChangedResponseMessage
+	(*AtomicStateChangedNotificationMessage)(nil),                      // 180: protowire.AtomicStateChangedNotificationMessage
+	(*StopNotifyingAtomicStateChangedRequestMessage)(nil),              // 181: protowire.StopNotifyingAtomicStateChangedRequestMessage
+	(*StopNotifyingAtomicStateChangedResponseMessage)(nil),             // 182: protowire.StopNotifyingAtomicStateChangedResponseMessage
+	(*SubmitFastIntentRequestMessage)(nil),                             // 183: protowire.SubmitFastIntentRequestMessage
+	(*SubmitFastIntentResponseMessage)(nil),                            // 184: protowire.SubmitFastIntentResponseMessage
+	(*GetFastIntentStatusRequestMessage)(nil),                          // 185: protowire.GetFastIntentStatusRequestMessage
+	(*GetFastIntentStatusResponseMessage)(nil),                         // 186: protowire.GetFastIntentStatusResponseMessage
+	(*GetAtomicHistoryByOwnerRequestMessage)(nil),                      // 187: protowire.GetAtomicHistoryByOwnerRequestMessage
+	(*GetAtomicHistoryByOwnerResponseMessage)(nil),                     // 188: protowire.GetAtomicHistoryByOwnerResponseMessage
+	(*GetAtomicHistoryByAssetRequestMessage)(nil),                      // 189: protowire.GetAtomicHistoryByAssetRequestMessage
+	(*GetAtomicHistoryByAssetResponseMessage)(nil),                     // 190: protowire.GetAtomicHistoryByAssetResponseMessage
+	(*SimulateAtomicTransactionRequestMessage)(nil),                    // 191: protowire.SimulateAtomicTransactionRequestMessage
+	(*SimulateAtomicTransactionResponseMessage)(nil),                   // 192: protowire.SimulateAtomicTransactionResponseMessage
+	(*GetAtomicBalanceProofRequestMessage)(nil),                        // 193: protowire.GetAtomicBalanceProofRequestMessage
+	(*GetAtomicBalanceProofResponseMessage)(nil),                       // 194: protowire.GetAtomicBalanceProofResponseMessage
+	(*GetStrongNodeClaimsRequestMessage)(nil),                          // 195: protowire.GetStrongNodeClaimsRequestMessage
+	(*GetStrongNodeClaimsResponseMessage)(nil),                         // 196: protowire.GetStrongNodeClaimsResponseMessage
+	(*NotifyBlockProducerClaimWinnerRequestMessage)(nil),               // 197: protowire.NotifyBlockProducerClaimWinnerRequestMessage
+	(*NotifyBlockProducerClaimWinnerResponseMessage)(nil),              // 198: protowire.NotifyBlockProducerClaimWinnerResponseMessage
+	(*BlockProducerClaimWinnerNotificationMessage)(nil),                // 199: protowire.BlockProducerClaimWinnerNotificationMessage
+	(*BanNodeIDRequestMessage)(nil),                                    // 200: protowire.BanNodeIDRequestMessage
+	(*BanNodeIDResponseMessage)(nil),                                   // 201: protowire.BanNodeIDResponseMessage
+	(*UnbanNodeIDRequestMessage)(nil),                                  // 202: protowire.UnbanNodeIDRequestMessage
+	(*UnbanNodeIDResponseMessage)(nil),                                 // 203: protowire.UnbanNodeIDResponseMessage
+	(*ListBansRequestMessage)(nil),                                     // 204: protowire.ListBansRequestMessage
+	(*ListBansResponseMessage)(nil),                                    // 205: protowire.ListBansResponseMessage
+	(*GetAntiFraudStateRequestMessage)(nil),                            // 206: protowire.GetAntiFraudStateRequestMessage
+	(*GetAntiFraudStateResponseMessage)(nil),                           // 207: protowire.GetAntiFraudStateResponseMessage
+	(*SubmitTransactionPackageRequestMessage)(nil),                     // 208: protowire.SubmitTransactionPackageRequestMessage
+	(*SubmitTransactionPackageResponseMessage)(nil),                    // 209: protowire.SubmitTransactionPackageResponseMessage
+	(*GetTransactionStatusRequestMessage)(nil),                         // 210: protowire.GetTransactionStatusRequestMessage
+	(*GetTransactionStatusResponseMessage)(nil),                        // 211: protowire.GetTransactionStatusResponseMessage
 }
 var file_messages_proto_depIdxs = []int32{
 	1,   // 0: protowire.CryptixdMessage.addresses:type_name -> protowire.AddressesMessage
@@ -3135,124 +3999,172 @@ var file_messages_proto_depIdxs = []int32{
 	52,  // 52: protowire.CryptixdMessage.consensusAtomicStateHash:type_name -> protowire.ConsensusAtomicStateHashMessage
 	53,  // 53: protowire.CryptixdMessage.requestAtomicTokenStateHash:type_name -> protowire.RequestAtomicTokenStateHashMessage
 	54,  // 54: protowire.CryptixdMessage.atomicTokenStateHash:type_name -> protowire.AtomicTokenStateHashMessage
-	55,  // 55: protowire.CryptixdMessage.getCurrentNetworkRequest:type_name -> protowire.GetCurrentNetworkRequestMessage
-	56,  // 56: protowire.CryptixdMessage.getCurrentNetworkResponse:type_name -> protowire.GetCurrentNetworkResponseMessage
-	57,  // 57: protowire.CryptixdMessage.submitBlockRequest:type_name -> protowire.SubmitBlockRequestMessage
-	58,  // 58: protowire.CryptixdMessage.submitBlockResponse:type_name -> protowire.SubmitBlockResponseMessage
-	59,  // 59: protowire.CryptixdMessage.getBlockTemplateRequest:type_name -> protowire.GetBlockTemplateRequestMessage
-	60,  // 60: protowire.CryptixdMessage.getBlockTemplateResponse:type_name -> protowire.GetBlockTemplateResponseMessage
-	61,  // 61: protowire.CryptixdMessage.notifyBlockAddedRequest:type_name -> protowire.NotifyBlockAddedRequestMessage
-	62,  // 62: protowire.CryptixdMessage.notifyBlockAddedResponse:type_name -> protowire.NotifyBlockAddedResponseMessage
-	63,  // 63: protowire.CryptixdMessage.blockAddedNotification:type_name -> protowire.BlockAddedNotificationMessage
-	64,  // 64: protowire.CryptixdMessage.getPeerAddressesRequest:type_name -> protowire.GetPeerAddressesRequestMessage
-	65,  // 65: protowire.CryptixdMessage.getPeerAddressesResponse:type_name -> protowire.GetPeerAddressesResponseMessage
-	66,  // 66: protowire.CryptixdMessage.getSelectedTipHashRequest:type_name -> protowire.GetSelectedTipHashRequestMessage
-	67,  // 67: protowire.CryptixdMessage.getSelectedTipHashResponse:type_name -> protowire.GetSelectedTipHashResponseMessage
-	68,  // 68: protowire.CryptixdMessage.getMempoolEntryRequest:type_name -> protowire.GetMempoolEntryRequestMessage
-	69,  // 69: protowire.CryptixdMessage.getMempoolEntryResponse:type_name -> protowire.GetMempoolEntryResponseMessage
-	70,  // 70: protowire.CryptixdMessage.getConnectedPeerInfoRequest:type_name -> protowire.GetConnectedPeerInfoRequestMessage
-	71,  // 71: protowire.CryptixdMessage.getConnectedPeerInfoResponse:type_name -> protowire.GetConnectedPeerInfoResponseMessage
-	72,  // 72: protowire.CryptixdMessage.addPeerRequest:type_name -> protowire.AddPeerRequestMessage
-	73,  // 73: protowire.CryptixdMessage.addPeerResponse:type_name -> protowire.AddPeerResponseMessage
-	74,  // 74: protowire.CryptixdMessage.submitTransactionRequest:type_name -> protowire.SubmitTransactionRequestMessage
-	75,  // 75: protowire.CryptixdMessage.submitTransactionResponse:type_name -> protowire.SubmitTransactionResponseMessage
-	76,  // 76: protowire.CryptixdMessage.notifyVirtualSelectedParentChainChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
-	77,  // 77: protowire.CryptixdMessage.notifyVirtualSelectedParentChainChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
-	78,  // 78: protowire.CryptixdMessage.virtualSelectedParentChainChangedNotification:type_name -> protowire.VirtualSelectedParentChainChangedNotificationMessage
-	79,  // 79: protowire.CryptixdMessage.getBlockRequest:type_name -> protowire.GetBlockRequestMessage
-	80,  // 80: protowire.CryptixdMessage.getBlockResponse:type_name -> protowire.GetBlockResponseMessage
-	81,  // 81: protowire.CryptixdMessage.getSubnetworkRequest:type_name -> protowire.GetSubnetworkRequestMessage
-	82,  // 82: protowire.CryptixdMessage.getSubnetworkResponse:type_name -> protowire.GetSubnetworkResponseMessage
-	83,  // 83: protowire.CryptixdMessage.getVirtualSelectedParentChainFromBlockRequest:type_name -> protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
-	84,  // 84: protowire.CryptixdMessage.getVirtualSelectedParentChainFromBlockResponse:type_name -> protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
-	85,  // 85: protowire.CryptixdMessage.getBlocksRequest:type_name -> protowire.GetBlocksRequestMessage
-	86,  // 86: protowire.CryptixdMessage.getBlocksResponse:type_name -> protowire.GetBlocksResponseMessage
-	87,  // 87: protowire.CryptixdMessage.getBlockCountRequest:type_name -> protowire.GetBlockCountRequestMessage
-	88,  // 88: protowire.CryptixdMessage.getBlockCountResponse:type_name -> protowire.GetBlockCountResponseMessage
-	89,  // 89: protowire.CryptixdMessage.getBlockDagInfoRequest:type_name -> protowire.GetBlockDagInfoRequestMessage
-	90,  // 90: protowire.CryptixdMessage.getBlockDagInfoResponse:type_name -> protowire.GetBlockDagInfoResponseMessage
-	91,  // 91: protowire.CryptixdMessage.resolveFinalityConflictRequest:type_name -> protowire.ResolveFinalityConflictRequestMessage
-	92,  // 92: protowire.CryptixdMessage.resolveFinalityConflictResponse:type_name -> protowire.ResolveFinalityConflictResponseMessage
-	93,  // 93: protowire.CryptixdMessage.notifyFinalityConflictsRequest:type_name -> protowire.NotifyFinalityConflictsRequestMessage
-	94,  // 94: protowire.CryptixdMessage.notifyFinalityConflictsResponse:type_name -> protowire.NotifyFinalityConflictsResponseMessage
-	95,  // 95: protowire.CryptixdMessage.finalityConflictNotification:type_name -> protowire.FinalityConflictNotificationMessage
-	96,  // 96: protowire.CryptixdMessage.finalityConflictResolvedNotification:type_name -> protowire.FinalityConflictResolvedNotificationMessage
-	97,  // 97: protowire.CryptixdMessage.getMempoolEntriesRequest:type_name -> protowire.GetMempoolEntriesRequestMessage
-	98,  // 98: protowire.CryptixdMessage.getMempoolEntriesResponse:type_name -> protowire.GetMempoolEntriesResponseMessage
-	99,  // 99: protowire.CryptixdMessage.shutDownRequest:type_name -> protowire.ShutDownRequestMessage
-	100, // 100: protowire.CryptixdMessage.shutDownResponse:type_name -> protowire.ShutDownResponseMessage
-	101, // 101: protowire.CryptixdMessage.getHeadersRequest:type_name -> protowire.GetHeadersRequestMessage
-	102, // 102: protowire.CryptixdMessage.getHeadersResponse:type_name -> protowire.GetHeadersResponseMessage
-	103, // 103: protowire.CryptixdMessage.notifyUtxosChangedRequest:type_name -> protowire.NotifyUtxosChangedRequestMessage
-	104, // 104: protowire.CryptixdMessage.notifyUtxosChangedResponse:type_name -> protowire.NotifyUtxosChangedResponseMessage
-	105, // 105: protowire.CryptixdMessage.utxosChangedNotification:type_name -> protowire.UtxosChangedNotificationMessage
-	106, // 106: protowire.CryptixdMessage.getUtxosByAddressesRequest:type_name -> protowire.GetUtxosByAddressesRequestMessage
-	107, // 107: protowire.CryptixdMessage.getUtxosByAddressesResponse:type_name -> protowire.GetUtxosByAddressesResponseMessage
-	108, // 108: protowire.CryptixdMessage.getVirtualSelectedParentBlueScoreRequest:type_name -> protowire.GetVirtualSelectedParentBlueScoreRequestMessage
-	109, // 109: protowire.CryptixdMessage.getVirtualSelectedParentBlueScoreResponse:type_name -> protowire.GetVirtualSelectedParentBlueScoreResponseMessage
-	110, // 110: protowire.CryptixdMessage.notifyVirtualSelectedParentBlueScoreChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
-	111, // 111: protowire.CryptixdMessage.notifyVirtualSelectedParentBlueScoreChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
-	112, // 112: protowire.CryptixdMessage.virtualSelectedParentBlueScoreChangedNotification:type_name -> protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
-	113, // 113: protowire.CryptixdMessage.banRequest:type_name -> protowire.BanRequestMessage
-	114, // 114: protowire.CryptixdMessage.banResponse:type_name -> protowire.BanResponseMessage
-	115, // 115: protowire.CryptixdMessage.unbanRequest:type_name -> protowire.UnbanRequestMessage
-	116, // 116: protowire.CryptixdMessage.unbanResponse:type_name -> protowire.UnbanResponseMessage
-	117, // 117: protowire.CryptixdMessage.getInfoRequest:type_name -> protowire.GetInfoRequestMessage
-	118, // 118: protowire.CryptixdMessage.getInfoResponse:type_name -> protowire.GetInfoResponseMessage
-	119, // 119: protowire.CryptixdMessage.stopNotifyingUtxosChangedRequest:type_name -> protowire.StopNotifyingUtxosChangedRequestMessage
-	120, // 120: protowire.CryptixdMessage.stopNotifyingUtxosChangedResponse:type_name -> protowire.StopNotifyingUtxosChangedResponseMessage
-	121, // 121: protowire.CryptixdMessage.notifyPruningPointUTXOSetOverrideRequest:type_name -> protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
-	122, // 122: protowire.CryptixdMessage.notifyPruningPointUTXOSetOverrideResponse:type_name -> protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
-	123, // 123: protowire.CryptixdMessage.pruningPointUTXOSetOverrideNotification:type_name -> protowire.PruningPointUTXOSetOverrideNotificationMessage
-	124, // 124: protowire.CryptixdMessage.stopNotifyingPruningPointUTXOSetOverrideRequest:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
-	125, // 125: protowire.CryptixdMessage.stopNotifyingPruningPointUTXOSetOverrideResponse:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
-	126, // 126: protowire.CryptixdMessage.estimateNetworkHashesPerSecondRequest:type_name -> protowire.EstimateNetworkHashesPerSecondRequestMessage
-	127, // 127: protowire.CryptixdMessage.estimateNetworkHashesPerSecondResponse:type_name -> protowire.EstimateNetworkHashesPerSecondResponseMessage
-	128, // 128: protowire.CryptixdMessage.notifyVirtualDaaScoreChangedRequest:type_name -> protowire.NotifyVirtualDaaScoreChangedRequestMessage
-	129, // 129: protowire.CryptixdMessage.notifyVirtualDaaScoreChangedResponse:type_name -> protowire.NotifyVirtualDaaScoreChangedResponseMessage
-	130, // 130: protowire.CryptixdMessage.virtualDaaScoreChangedNotification:type_name -> protowire.VirtualDaaScoreChangedNotificationMessage
-	131, // 131: protowire.CryptixdMessage.getBalanceByAddressRequest:type_name -> protowire.GetBalanceByAddressRequestMessage
-	132, // 132: protowire.CryptixdMessage.getBalanceByAddressResponse:type_name -> protowire.GetBalanceByAddressResponseMessage
-	133, // 133: protowire.CryptixdMessage.getBalancesByAddressesRequest:type_name -> protowire.GetBalancesByAddressesRequestMessage
-	134, // 134: protowire.CryptixdMessage.getBalancesByAddressesResponse:type_name -> protowire.GetBalancesByAddressesResponseMessage
-	135, // 135: protowire.CryptixdMessage.notifyNewBlockTemplateRequest:type_name -> protowire.NotifyNewBlockTemplateRequestMessage
-	136, // 136: protowire.CryptixdMessage.notifyNewBlockTemplateResponse:type_name -> protowire.NotifyNewBlockTemplateResponseMessage
-	137, // 137: protowire.CryptixdMessage.newBlockTemplateNotification:type_name -> protowire.NewBlockTemplateNotificationMessage
-	138, // 138: protowire.CryptixdMessage.getMempoolEntriesByAddressesRequest:type_name -> protowire.GetMempoolEntriesByAddressesRequestMessage
-	139, // 139: protowire.CryptixdMessage.getMempoolEntriesByAddressesResponse:type_name -> protowire.GetMempoolEntriesByAddressesResponseMessage
-	140, // 140: protowire.CryptixdMessage.getCoinSupplyRequest:type_name -> protowire.GetCoinSupplyRequestMessage
-	141, // 141: protowire.CryptixdMessage.getCoinSupplyResponse:type_name -> protowire.GetCoinSupplyResponseMessage
-	142, // 142: protowire.CryptixdMessage.pingRequest:type_name -> protowire.PingRequestMessage
-	143, // 143: protowire.CryptixdMessage.getMetricsRequest:type_name -> protowire.GetMetricsRequestMessage
-	144, // 144: protowire.CryptixdMessage.getServerInfoRequest:type_name -> protowire.GetServerInfoRequestMessage
-	145, // 145: protowire.CryptixdMessage.getSyncStatusRequest:type_name -> protowire.GetSyncStatusRequestMessage
-	146, // 146: protowire.CryptixdMessage.getDaaScoreTimestampEstimateRequest:type_name -> protowire.GetDaaScoreTimestampEstimateRequestMessage
-	147, // 147: protowire.CryptixdMessage.submitTransactionReplacementRequest:type_name -> protowire.SubmitTransactionReplacementRequestMessage
-	148, // 148: protowire.CryptixdMessage.getConnectionsRequest:type_name -> protowire.GetConnectionsRequestMessage
-	149, // 149: protowire.CryptixdMessage.getSystemInfoRequest:type_name -> protowire.GetSystemInfoRequestMessage
-	150, // 150: protowire.CryptixdMessage.getFeeEstimateRequest:type_name -> protowire.GetFeeEstimateRequestMessage
-	151, // 151: protowire.CryptixdMessage.getFeeEstimateExperimentalRequest:type_name -> protowire.GetFeeEstimateExperimentalRequestMessage
-	152, // 152: protowire.CryptixdMessage.getCurrentBlockColorRequest:type_name -> protowire.GetCurrentBlockColorRequestMessage
-	153, // 153: protowire.CryptixdMessage.pingResponse:type_name -> protowire.PingResponseMessage
-	154, // 154: protowire.CryptixdMessage.getMetricsResponse:type_name -> protowire.GetMetricsResponseMessage
-	155, // 155: protowire.CryptixdMessage.getServerInfoResponse:type_name -> protowire.GetServerInfoResponseMessage
-	156, // 156: protowire.CryptixdMessage.getSyncStatusResponse:type_name -> protowire.GetSyncStatusResponseMessage
-	157, // 157: protowire.CryptixdMessage.getDaaScoreTimestampEstimateResponse:type_name -> protowire.GetDaaScoreTimestampEstimateResponseMessage
-	158, // 158: protowire.CryptixdMessage.submitTransactionReplacementResponse:type_name -> protowire.SubmitTransactionReplacementResponseMessage
-	159, // 159: protowire.CryptixdMessage.getConnectionsResponse:type_name -> protowire.GetConnectionsResponseMessage
-	160, // 160: protowire.CryptixdMessage.getSystemInfoResponse:type_name -> protowire.GetSystemInfoResponseMessage
-	161, // 161: protowire.CryptixdMessage.getFeeEstimateResponse:type_name -> protowire.GetFeeEstimateResponseMessage
-	162, // 162: protowire.CryptixdMessage.getFeeEstimateExperimentalResponse:type_name -> protowire.GetFeeEstimateExperimentalResponseMessage
-	163, // 163: protowire.CryptixdMessage.getCurrentBlockColorResponse:type_name -> protowire.GetCurrentBlockColorResponseMessage
-	0,   // 164: protowire.P2P.MessageStream:input_type -> protowire.CryptixdMessage
-	0,   // 165: protowire.RPC.MessageStream:input_type -> protowire.CryptixdMessage
-	0,   // 166: protowire.P2P.MessageStream:output_type -> protowire.CryptixdMessage
-	0,   // 167: protowire.RPC.MessageStream:output_type -> protowire.CryptixdMessage
-	166, // [166:168] is the sub-list for method output_type
-	164, // [164:166] is the sub-list for method input_type
-	164, // [164:164] is the sub-list for extension type_name
-	164, // [164:164] is the sub-list for extension extendee
-	0,   // [0:164] is the sub-list for field type_name
+	55,  // 55: protowire.CryptixdMessage.compactBlock:type_name -> protowire.CompactBlockMessage
+	56,  // 56: protowire.CryptixdMessage.requestBlockTransactions:type_name -> protowire.RequestBlockTransactionsMessage
+	57,  // 57: protowire.CryptixdMessage.blockTransactions:type_name -> protowire.BlockTransactionsMessage
+	58,  // 58: protowire.CryptixdMessage.transactionPackage:type_name -> protowire.TransactionPackageMessage
+	59,  // 59: protowire.CryptixdMessage.getCurrentNetworkRequest:type_name -> protowire.GetCurrentNetworkRequestMessage
+	60,  // 60: protowire.CryptixdMessage.getCurrentNetworkResponse:type_name -> protowire.GetCurrentNetworkResponseMessage
+	61,  // 61: protowire.CryptixdMessage.submitBlockRequest:type_name -> protowire.SubmitBlockRequestMessage
+	62,  // 62: protowire.CryptixdMessage.submitBlockResponse:type_name -> protowire.SubmitBlockResponseMessage
+	63,  // 63: protowire.CryptixdMessage.getBlockTemplateRequest:type_name -> protowire.GetBlockTemplateRequestMessage
+	64,  // 64: protowire.CryptixdMessage.getBlockTemplateResponse:type_name -> protowire.GetBlockTemplateResponseMessage
+	65,  // 65: protowire.CryptixdMessage.notifyBlockAddedRequest:type_name -> protowire.NotifyBlockAddedRequestMessage
+	66,  // 66: protowire.CryptixdMessage.notifyBlockAddedResponse:type_name -> protowire.NotifyBlockAddedResponseMessage
+	67,  // 67: protowire.CryptixdMessage.blockAddedNotification:type_name -> protowire.BlockAddedNotificationMessage
+	68,  // 68: protowire.CryptixdMessage.getPeerAddressesRequest:type_name -> protowire.GetPeerAddressesRequestMessage
+	69,  // 69: protowire.CryptixdMessage.getPeerAddressesResponse:type_name -> protowire.GetPeerAddressesResponseMessage
+	70,  // 70: protowire.CryptixdMessage.getSelectedTipHashRequest:type_name -> protowire.GetSelectedTipHashRequestMessage
+	71,  // 71: protowire.CryptixdMessage.getSelectedTipHashResponse:type_name -> protowire.GetSelectedTipHashResponseMessage
+	72,  // 72: protowire.CryptixdMessage.getMempoolEntryRequest:type_name -> protowire.GetMempoolEntryRequestMessage
+	73,  // 73: protowire.CryptixdMessage.getMempoolEntryResponse:type_name -> protowire.GetMempoolEntryResponseMessage
+	74,  // 74: protowire.CryptixdMessage.getConnectedPeerInfoRequest:type_name -> protowire.GetConnectedPeerInfoRequestMessage
+	75,  // 75: protowire.CryptixdMessage.getConnectedPeerInfoResponse:type_name -> protowire.GetConnectedPeerInfoResponseMessage
+	76,  // 76: protowire.CryptixdMessage.addPeerRequest:type_name -> protowire.AddPeerRequestMessage
+	77,  // 77: protowire.CryptixdMessage.addPeerResponse:type_name -> protowire.AddPeerResponseMessage
+	78,  // 78: protowire.CryptixdMessage.submitTransactionRequest:type_name -> protowire.SubmitTransactionRequestMessage
+	79,  // 79: protowire.CryptixdMessage.submitTransactionResponse:type_name -> protowire.SubmitTransactionResponseMessage
+	80,  // 80: protowire.CryptixdMessage.notifyVirtualSelectedParentChainChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
+	81,  // 81: protowire.CryptixdMessage.notifyVirtualSelectedParentChainChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
+	82,  // 82: protowire.CryptixdMessage.virtualSelectedParentChainChangedNotification:type_name -> protowire.VirtualSelectedParentChainChangedNotificationMessage
+	83,  // 83: protowire.CryptixdMessage.getBlockRequest:type_name -> protowire.GetBlockRequestMessage
+	84,  // 84: protowire.CryptixdMessage.getBlockResponse:type_name -> protowire.GetBlockResponseMessage
+	85,  // 85: protowire.CryptixdMessage.getSubnetworkRequest:type_name -> protowire.GetSubnetworkRequestMessage
+	86,  // 86: protowire.CryptixdMessage.getSubnetworkResponse:type_name -> protowire.GetSubnetworkResponseMessage
+	87,  // 87: protowire.CryptixdMessage.getVirtualSelectedParentChainFromBlockRequest:type_name -> protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
+	88,  // 88: protowire.CryptixdMessage.getVirtualSelectedParentChainFromBlockResponse:type_name -> protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
+	89,  // 89: protowire.CryptixdMessage.getBlocksRequest:type_name -> protowire.GetBlocksRequestMessage
+	90,  // 90: protowire.CryptixdMessage.getBlocksResponse:type_name -> protowire.GetBlocksResponseMessage
+	91,  // 91: protowire.CryptixdMessage.getBlockCountRequest:type_name -> protowire.GetBlockCountRequestMessage
+	92,  // 92: protowire.CryptixdMessage.getBlockCountResponse:type_name -> protowire.GetBlockCountResponseMessage
+	93,  // 93: protowire.CryptixdMessage.getBlockDagInfoRequest:type_name -> protowire.GetBlockDagInfoRequestMessage
+	94,  // 94: protowire.CryptixdMessage.getBlockDagInfoResponse:type_name -> protowire.GetBlockDagInfoResponseMessage
+	95,  // 95: protowire.CryptixdMessage.resolveFinalityConflictRequest:type_name -> protowire.ResolveFinalityConflictRequestMessage
+	96,  // 96: protowire.CryptixdMessage.resolveFinalityConflictResponse:type_name -> protowire.ResolveFinalityConflictResponseMessage
+	97,  // 97: protowire.CryptixdMessage.notifyFinalityConflictsRequest:type_name -> protowire.NotifyFinalityConflictsRequestMessage
+	98,  // 98: protowire.CryptixdMessage.notifyFinalityConflictsResponse:type_name -> protowire.NotifyFinalityConflictsResponseMessage
+	99,  // 99: protowire.CryptixdMessage.finalityConflictNotification:type_name -> protowire.FinalityConflictNotificationMessage
+	100, // 100: protowire.CryptixdMessage.finalityConflictResolvedNotification:type_name -> protowire.FinalityConflictResolvedNotificationMessage
+	101, // 101: protowire.CryptixdMessage.getMempoolEntriesRequest:type_name -> protowire.GetMempoolEntriesRequestMessage
+	102, // 102: protowire.CryptixdMessage.getMempoolEntriesResponse:type_name -> protowire.GetMempoolEntriesResponseMessage
+	103, // 103: protowire.CryptixdMessage.shutDownRequest:type_name -> protowire.ShutDownRequestMessage
+	104, // 104: protowire.CryptixdMessage.shutDownResponse:type_name -> protowire.ShutDownResponseMessage
+	105, // 105: protowire.CryptixdMessage.getHeadersRequest:type_name -> protowire.GetHeadersRequestMessage
+	106, // 106: protowire.CryptixdMessage.getHeadersResponse:type_name -> protowire.GetHeadersResponseMessage
+	107, // 107: protowire.CryptixdMessage.notifyUtxosChangedRequest:type_name -> protowire.NotifyUtxosChangedRequestMessage
+	108, // 108: protowire.CryptixdMessage.notifyUtxosChangedResponse:type_name -> protowire.NotifyUtxosChangedResponseMessage
+	109, // 109: protowire.CryptixdMessage.utxosChangedNotification:type_name -> protowire.UtxosChangedNotificationMessage
+	110, // 110: protowire.CryptixdMessage.getUtxosByAddressesRequest:type_name -> protowire.GetUtxosByAddressesRequestMessage
+	111, // 111: protowire.CryptixdMessage.getUtxosByAddressesResponse:type_name -> protowire.GetUtxosByAddressesResponseMessage
+	112, // 112: protowire.CryptixdMessage.getVirtualSelectedParentBlueScoreRequest:type_name -> protowire.GetVirtualSelectedParentBlueScoreRequestMessage
+	113, // 113: protowire.CryptixdMessage.getVirtualSelectedParentBlueScoreResponse:type_name -> protowire.GetVirtualSelectedParentBlueScoreResponseMessage
+	114, // 114: protowire.CryptixdMessage.notifyVirtualSelectedParentBlueScoreChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
+	115, // 115: protowire.CryptixdMessage.notifyVirtualSelectedParentBlueScoreChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
+	116, // 116: protowire.CryptixdMessage.virtualSelectedParentBlueScoreChangedNotification:type_name -> protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
+	117, // 117: protowire.CryptixdMessage.banRequest:type_name -> protowire.BanRequestMessage
+	118, // 118: protowire.CryptixdMessage.banResponse:type_name -> protowire.BanResponseMessage
+	119, // 119: protowire.CryptixdMessage.unbanRequest:type_name -> protowire.UnbanRequestMessage
+	120, // 120: protowire.CryptixdMessage.unbanResponse:type_name -> protowire.UnbanResponseMessage
+	121, // 121: protowire.CryptixdMessage.getInfoRequest:type_name -> protowire.GetInfoRequestMessage
+	122, // 122: protowire.CryptixdMessage.getInfoResponse:type_name -> protowire.GetInfoResponseMessage
+	123, // 123: protowire.CryptixdMessage.stopNotifyingUtxosChangedRequest:type_name -> protowire.StopNotifyingUtxosChangedRequestMessage
+	124, // 124: protowire.CryptixdMessage.stopNotifyingUtxosChangedResponse:type_name -> protowire.StopNotifyingUtxosChangedResponseMessage
+	125, // 125: protowire.CryptixdMessage.notifyPruningPointUTXOSetOverrideRequest:type_name -> protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
+	126, // 126: protowire.CryptixdMessage.notifyPruningPointUTXOSetOverrideResponse:type_name -> protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
+	127, // 127: protowire.CryptixdMessage.pruningPointUTXOSetOverrideNotification:type_name -> protowire.PruningPointUTXOSetOverrideNotificationMessage
+	128, // 128: protowire.CryptixdMessage.stopNotifyingPruningPointUTXOSetOverrideRequest:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
+	129, // 129: protowire.CryptixdMessage.stopNotifyingPruningPointUTXOSetOverrideResponse:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
+	130, // 130: protowire.CryptixdMessage.estimateNetworkHashesPerSecondRequest:type_name -> protowire.EstimateNetworkHashesPerSecondRequestMessage
+	131, // 131: protowire.CryptixdMessage.estimateNetworkHashesPerSecondResponse:type_name -> protowire.EstimateNetworkHashesPerSecondResponseMessage
+	132, // 132: protowire.CryptixdMessage.notifyVirtualDaaScoreChangedRequest:type_name -> protowire.NotifyVirtualDaaScoreChangedRequestMessage
+	133, // 133: protowire.CryptixdMessage.notifyVirtualDaaScoreChangedResponse:type_name -> protowire.NotifyVirtualDaaScoreChangedResponseMessage
+	134, // 134: protowire.CryptixdMessage.virtualDaaScoreChangedNotification:type_name -> protowire.VirtualDaaScoreChangedNotificationMessage
+	135, // 135: protowire.CryptixdMessage.getBalanceByAddressRequest:type_name -> protowire.GetBalanceByAddressRequestMessage
+	136, // 136: protowire.CryptixdMessage.getBalanceByAddressResponse:type_name -> protowire.GetBalanceByAddressResponseMessage
+	137, // 137: protowire.CryptixdMessage.getBalancesByAddressesRequest:type_name -> protowire.GetBalancesByAddressesRequestMessage
+	138, // 138: protowire.CryptixdMessage.getBalancesByAddressesResponse:type_name -> protowire.GetBalancesByAddressesResponseMessage
+	139, // 139: protowire.CryptixdMessage.notifyNewBlockTemplateRequest:type_name -> protowire.NotifyNewBlockTemplateRequestMessage
+	140, // 140: protowire.CryptixdMessage.notifyNewBlockTemplateResponse:type_name -> protowire.NotifyNewBlockTemplateResponseMessage
+	141, // 141: protowire.CryptixdMessage.newBlockTemplateNotification:type_name -> protowire.NewBlockTemplateNotificationMessage
+	142, // 142: protowire.CryptixdMessage.getMempoolEntriesByAddressesRequest:type_name -> protowire.GetMempoolEntriesByAddressesRequestMessage
+	143, // 143: protowire.CryptixdMessage.getMempoolEntriesByAddressesResponse:type_name -> protowire.GetMempoolEntriesByAddressesResponseMessage
+	144, // 144: protowire.CryptixdMessage.getCoinSupplyRequest:type_name -> protowire.GetCoinSupplyRequestMessage
+	145, // 145: protowire.CryptixdMessage.getCoinSupplyResponse:type_name -> protowire.GetCoinSupplyResponseMessage
+	146, // 146: protowire.CryptixdMessage.pingRequest:type_name -> protowire.PingRequestMessage
+	147, // 147: protowire.CryptixdMessage.getMetricsRequest:type_name -> protowire.GetMetricsRequestMessage
+	148, // 148: protowire.CryptixdMessage.getServerInfoRequest:type_name -> protowire.GetServerInfoRequestMessage
+	149, // 149: protowire.CryptixdMessage.getSyncStatusRequest:type_name -> protowire.GetSyncStatusRequestMessage
+	150, // 150: protowire.CryptixdMessage.getDaaScoreTimestampEstimateRequest:type_name -> protowire.GetDaaScoreTimestampEstimateRequestMessage
+	151, // 151: protowire.CryptixdMessage.submitTransactionReplacementRequest:type_name -> protowire.SubmitTransactionReplacementRequestMessage
+	152, // 152: protowire.CryptixdMessage.getConnectionsRequest:type_name -> protowire.GetConnectionsRequestMessage
+	153, // 153: protowire.CryptixdMessage.getSystemInfoRequest:type_name -> protowire.GetSystemInfoRequestMessage
+	154, // 154: protowire.CryptixdMessage.getFeeEstimateRequest:type_name -> protowire.GetFeeEstimateRequestMessage
+	155, // 155: protowire.CryptixdMessage.getFeeEstimateExperimentalRequest:type_name -> protowire.GetFeeEstimateExperimentalRequestMessage
+	156, // 156: protowire.CryptixdMessage.getCurrentBlockColorRequest:type_name -> protowire.GetCurrentBlockColorRequestMessage
+	157, // 157: protowire.CryptixdMessage.pingResponse:type_name -> protowire.PingResponseMessage
+	158, // 158: protowire.CryptixdMessage.getMetricsResponse:type_name -> protowire.GetMetricsResponseMessage
+	159, // 159: protowire.CryptixdMessage.getServerInfoResponse:type_name -> protowire.GetServerInfoResponseMessage
+	160, // 160: protowire.CryptixdMessage.getSyncStatusResponse:type_name -> protowire.GetSyncStatusResponseMessage
+	161, // 161: protowire.CryptixdMessage.getDaaScoreTimestampEstimateResponse:type_name -> protowire.GetDaaScoreTimestampEstimateResponseMessage
+	162, // 162: protowire.CryptixdMessage.submitTransactionReplacementResponse:type_name -> protowire.SubmitTransactionReplacementResponseMessage
+	163, // 163: protowire.CryptixdMessage.getConnectionsResponse:type_name -> protowire.GetConnectionsResponseMessage
+	164, // 164: protowire.CryptixdMessage.getSystemInfoResponse:type_name -> protowire.GetSystemInfoResponseMessage
+	165, // 165: protowire.CryptixdMessage.getFeeEstimateResponse:type_name -> protowire.GetFeeEstimateResponseMessage
+	166, // 166: protowire.CryptixdMessage.getFeeEstimateExperimentalResponse:type_name -> protowire.GetFeeEstimateExperimentalResponseMessage
+	167, // 167: protowire.CryptixdMessage.getCurrentBlockColorResponse:type_name -> protowire.GetCurrentBlockColorResponseMessage
+	168, // 168: protowire.CryptixdMessage.getAtomicAssetRequest:type_name -> protowire.GetAtomicAssetRequestMessage
+	169, // 169: protowire.CryptixdMessage.getAtomicAssetResponse:type_name -> protowire.GetAtomicAssetResponseMessage
+	170, // 170: protowire.CryptixdMessage.getAtomicAssetsRequest:type_name -> protowire.GetAtomicAssetsRequestMessage
+	171, // 171: protowire.CryptixdMessage.getAtomicAssetsResponse:type_name -> protowire.GetAtomicAssetsResponseMessage
+	172, // 172: protowire.CryptixdMessage.getAtomicBalancesByOwnerRequest:type_name -> protowire.GetAtomicBalancesByOwnerRequestMessage
+	173, // 173: protowire.CryptixdMessage.getAtomicBalancesByOwnerResponse:type_name -> protowire.GetAtomicBalancesByOwnerResponseMessage
+	174, // 174: protowire.CryptixdMessage.getAtomicNonceRequest:type_name -> protowire.GetAtomicNonceRequestMessage
+	175, // 175: protowire.CryptixdMessage.getAtomicNonceResponse:type_name -> protowire.GetAtomicNonceResponseMessage
+	176, // 176: protowire.CryptixdMessage.getLiquidityPoolRequest:type_name -> protowire.GetLiquidityPoolRequestMessage
+	177, // 177: protowire.CryptixdMessage.getLiquidityPoolResponse:type_name -> protowire.GetLiquidityPoolResponseMessage
+	178, // 178: protowire.CryptixdMessage.notifyAtomicStateChangedRequest:type_name -> protowire.NotifyAtomicStateChangedRequestMessage
+	179, // 179: protowire.CryptixdMessage.notifyAtomicStateChangedResponse:type_name -> protowire.NotifyAtomicStateChangedResponseMessage
+	180, // 180: protowire.CryptixdMessage.atomicStateChangedNotification:type_name -> protowire.AtomicStateChangedNotificationMessage
+	181, // 181: protowire.CryptixdMessage.stopNotifyingAtomicStateChangedRequest:type_name -> protowire.StopNotifyingAtomicStateChangedRequestMessage
+	182, // 182: protowire.CryptixdMessage.stopNotifyingAtomicStateChangedResponse:type_name -> protowire.StopNotifyingAtomicStateChangedResponseMessage
+	183, // 183: protowire.CryptixdMessage.submitFastIntentRequest:type_name -> protowire.SubmitFastIntentRequestMessage
+	184, // 184: protowire.CryptixdMessage.submitFastIntentResponse:type_name -> protowire.SubmitFastIntentResponseMessage
+	185, // 185: protowire.CryptixdMessage.getFastIntentStatusRequest:type_name -> protowire.GetFastIntentStatusRequestMessage
+	186, // 186: protowire.CryptixdMessage.getFastIntentStatusResponse:type_name -> protowire.GetFastIntentStatusResponseMessage
+	187, // 187: protowire.CryptixdMessage.getAtomicHistoryByOwnerRequest:type_name -> protowire.GetAtomicHistoryByOwnerRequestMessage
+	188, // 188: protowire.CryptixdMessage.getAtomicHistoryByOwnerResponse:type_name -> protowire.GetAtomicHistoryByOwnerResponseMessage
+	189, // 189: protowire.CryptixdMessage.getAtomicHistoryByAssetRequest:type_name -> protowire.GetAtomicHistoryByAssetRequestMessage
+	190, // 190: protowire.CryptixdMessage.getAtomicHistoryByAssetResponse:type_name -> protowire.GetAtomicHistoryByAssetResponseMessage
+	191, // 191: protowire.CryptixdMessage.simulateAtomicTransactionRequest:type_name -> protowire.SimulateAtomicTransactionRequestMessage
+	192, // 192: protowire.CryptixdMessage.simulateAtomicTransactionResponse:type_name -> protowire.SimulateAtomicTransactionResponseMessage
+	193, // 193: protowire.CryptixdMessage.getAtomicBalanceProofRequest:type_name -> protowire.GetAtomicBalanceProofRequestMessage
+	194, // 194: protowire.CryptixdMessage.getAtomicBalanceProofResponse:type_name -> protowire.GetAtomicBalanceProofResponseMessage
+	195, // 195: protowire.CryptixdMessage.getStrongNodeClaimsRequest:type_name -> protowire.GetStrongNodeClaimsRequestMessage
+	196, // 196: protowire.CryptixdMessage.getStrongNodeClaimsResponse:type_name -> protowire.GetStrongNodeClaimsResponseMessage
+	197, // 197: protowire.CryptixdMessage.notifyBlockProducerClaimWinnerRequest:type_name -> protowire.NotifyBlockProducerClaimWinnerRequestMessage
+	198, // 198: protowire.CryptixdMessage.notifyBlockProducerClaimWinnerResponse:type_name -> protowire.NotifyBlockProducerClaimWinnerResponseMessage
+	199, // 199: protowire.CryptixdMessage.blockProducerClaimWinnerNotification:type_name -> protowire.BlockProducerClaimWinnerNotificationMessage
+	200, // 200: protowire.CryptixdMessage.banNodeIDRequest:type_name -> protowire.BanNodeIDRequestMessage
+	201, // 201: protowire.CryptixdMessage.banNodeIDResponse:type_name -> protowire.BanNodeIDResponseMessage
+	202, // 202: protowire.CryptixdMessage.unbanNodeIDRequest:type_name -> protowire.UnbanNodeIDRequestMessage
+	203, // 203: protowire.CryptixdMessage.unbanNodeIDResponse:type_name -> protowire.UnbanNodeIDResponseMessage
+	204, // 204: protowire.CryptixdMessage.listBansRequest:type_name -> protowire.ListBansRequestMessage
+	205, // 205: protowire.CryptixdMessage.listBansResponse:type_name -> protowire.ListBansResponseMessage
+	206, // 206: protowire.CryptixdMessage.getAntiFraudStateRequest:type_name -> protowire.GetAntiFraudStateRequestMessage
+	207, // 207: protowire.CryptixdMessage.getAntiFraudStateResponse:type_name -> protowire.GetAntiFraudStateResponseMessage
+	208, // 208: protowire.CryptixdMessage.submitTransactionPackageRequest:type_name -> protowire.SubmitTransactionPackageRequestMessage
+	209, // 209: protowire.CryptixdMessage.submitTransactionPackageResponse:type_name -> protowire.SubmitTransactionPackageResponseMessage
+	210, // 210: protowire.CryptixdMessage.getTransactionStatusRequest:type_name -> protowire.GetTransactionStatusRequestMessage
+	211, // 211: protowire.CryptixdMessage.getTransactionStatusResponse:type_name -> protowire.GetTransactionStatusResponseMessage
+	0,   // 212: protowire.P2P.MessageStream:input_type -> protowire.CryptixdMessage
+	0,   // 213: protowire.RPC.MessageStream:input_type -> protowire.CryptixdMessage
+	0,   // 214: protowire.P2P.MessageStream:output_type -> protowire.CryptixdMessage
+	0,   // 215: protowire.RPC.MessageStream:output_type -> protowire.CryptixdMessage
+	214, // [214:216] is the sub-list for method output_type
+	212, // [212:214] is the sub-list for method input_type
+	212, // [212:212] is the sub-list for extension type_name
+	212, // [212:212] is the sub-list for extension extendee
+	0,   // [0:212] is the sub-list for field type_name
 }
 
 func init() { file_messages_proto_init() }
@@ -3318,6 +4230,10 @@ func file_messages_proto_init() {
 		(*CryptixdMessage_ConsensusAtomicStateHash)(nil),
 		(*CryptixdMessage_RequestAtomicTokenStateHash)(nil),
 		(*CryptixdMessage_AtomicTokenStateHash)(nil),
+		(*CryptixdMessage_CompactBlock)(nil),
+		(*CryptixdMessage_RequestBlockTransactions)(nil),
+		(*CryptixdMessage_BlockTransactions)(nil),
+		(*CryptixdMessage_TransactionPackage)(nil),
 		(*CryptixdMessage_GetCurrentNetworkRequest)(nil),
 		(*CryptixdMessage_GetCurrentNetworkResponse)(nil),
 		(*CryptixdMessage_SubmitBlockRequest)(nil),
@@ -3427,6 +4343,50 @@ func file_messages_proto_init() {
 		(*CryptixdMessage_GetFeeEstimateResponse)(nil),
 		(*CryptixdMessage_GetFeeEstimateExperimentalResponse)(nil),
 		(*CryptixdMessage_GetCurrentBlockColorResponse)(nil),
+		(*CryptixdMessage_GetAtomicAssetRequest)(nil),
+		(*CryptixdMessage_GetAtomicAssetResponse)(nil),
+		(*CryptixdMessage_GetAtomicAssetsRequest)(nil),
+		(*CryptixdMessage_GetAtomicAssetsResponse)(nil),
+		(*CryptixdMessage_GetAtomicBalancesByOwnerRequest)(nil),
+		(*CryptixdMessage_GetAtomicBalancesByOwnerResponse)(nil),
+		(*CryptixdMessage_GetAtomicNonceRequest)(nil),
+		(*CryptixdMessage_GetAtomicNonceResponse)(nil),
+		(*CryptixdMessage_GetLiquidityPoolRequest)(nil),
+		(*CryptixdMessage_GetLiquidityPoolResponse)(nil),
+		(*CryptixdMessage_NotifyAtomicStateChangedRequest)(nil),
+		(*CryptixdMessage_NotifyAtomicStateChangedResponse)(nil),
+		(*CryptixdMessage_AtomicStateChangedNotification)(nil),
+		(*CryptixdMessage_StopNotifyingAtomicStateChangedRequest)(nil),
+		(*CryptixdMessage_StopNotifyingAtomicStateChangedResponse)(nil),
+		(*CryptixdMessage_SubmitFastIntentRequest)(nil),
+		(*CryptixdMessage_SubmitFastIntentResponse)(nil),
+		(*CryptixdMessage_GetFastIntentStatusRequest)(nil),
+		(*CryptixdMessage_GetFastIntentStatusResponse)(nil),
+		(*CryptixdMessage_GetAtomicHistoryByOwnerRequest)(nil),
+		(*CryptixdMessage_GetAtomicHistoryByOwnerResponse)(nil),
+		(*CryptixdMessage_GetAtomicHistoryByAssetRequest)(nil),
+		(*CryptixdMessage_GetAtomicHistoryByAssetResponse)(nil),
+		(*CryptixdMessage_SimulateAtomicTransactionRequest)(nil),
+		(*CryptixdMessage_SimulateAtomicTransactionResponse)(nil),
+		(*CryptixdMessage_GetAtomicBalanceProofRequest)(nil),
+		(*CryptixdMessage_GetAtomicBalanceProofResponse)(nil),
+		(*CryptixdMessage_GetStrongNodeClaimsRequest)(nil),
+		(*CryptixdMessage_GetStrongNodeClaimsResponse)(nil),
+		(*CryptixdMessage_NotifyBlockProducerClaimWinnerRequest)(nil),
+		(*CryptixdMessage_NotifyBlockProducerClaimWinnerResponse)(nil),
+		(*CryptixdMessage_BlockProducerClaimWinnerNotification)(nil),
+		(*CryptixdMessage_BanNodeIDRequest)(nil),
+		(*CryptixdMessage_BanNodeIDResponse)(nil),
+		(*CryptixdMessage_UnbanNodeIDRequest)(nil),
+		(*CryptixdMessage_UnbanNodeIDResponse)(nil),
+		(*CryptixdMessage_ListBansRequest)(nil),
+		(*CryptixdMessage_ListBansResponse)(nil),
+		(*CryptixdMessage_GetAntiFraudStateRequest)(nil),
+		(*CryptixdMessage_GetAntiFraudStateResponse)(nil),
+		(*CryptixdMessage_SubmitTransactionPackageRequest)(nil),
+		(*CryptixdMessage_SubmitTransactionPackageResponse)(nil),
+		(*CryptixdMessage_GetTransactionStatusRequest)(nil),
+		(*CryptixdMessage_GetTransactionStatusResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -25,6 +25,7 @@ type RequestAddressesMessage struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	IncludeAllSubnetworks bool                   `protobuf:"varint,1,opt,name=includeAllSubnetworks,proto3" json:"includeAllSubnetworks,omitempty"`
 	SubnetworkId          *SubnetworkId          `protobuf:"bytes,2,opt,name=subnetworkId,proto3" json:"subnetworkId,omitempty"`
+	IncludeOnionAddresses bool                   `protobuf:"varint,3,opt,name=includeOnionAddresses,proto3" json:"includeOnionAddresses,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -73,6 +74,13 @@ func (x *RequestAddressesMessage) GetSubnetworkId() *SubnetworkId {
 	return nil
 }
 
+func (x *RequestAddressesMessage) GetIncludeOnionAddresses() bool {
+	if x != nil {
+		return x.IncludeOnionAddresses
+	}
+	return false
+}
+
 type AddressesMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AddressList   []*NetAddress          `protobuf:"bytes,1,rep,name=addressList,proto3" json:"addressList,omitempty"`
@@ -122,6 +130,7 @@ type NetAddress struct {
 	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Ip            []byte                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	Port          uint32                 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	OnionV3       []byte                 `protobuf:"bytes,5,opt,name=onionV3,proto3" json:"onionV3,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -177,6 +186,13 @@ func (x *NetAddress) GetPort() uint32 {
 	return 0
 }
 
+func (x *NetAddress) GetOnionV3() []byte {
+	if x != nil {
+		return x.OnionV3
+	}
+	return nil
+}
+
 type SubnetworkId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bytes         []byte                 `protobuf:"bytes,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
@@ -1125,6 +1141,277 @@ func (x *RequestRelayBlocksMessage) GetHashes() []*Hash {
 	return nil
 }
 
+// CompactBlockMessage describes the transactions of a block by short IDs,
+// except for the ones that are prefilled. shortIds are in block order, and
+// skip the indexes of the prefilled transactions.
+type CompactBlockMessage struct {
+	state                 protoimpl.MessageState         `protogen:"open.v1"`
+	Header                *BlockHeader                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ShortIdNonce          uint64                         `protobuf:"varint,2,opt,name=shortIdNonce,proto3" json:"shortIdNonce,omitempty"`
+	ShortIds              []uint64                       `protobuf:"varint,3,rep,packed,name=shortIds,proto3" json:"shortIds,omitempty"`
+	PrefilledTransactions []*PrefilledTransactionMessage `protobuf:"bytes,4,rep,name=prefilledTransactions,proto3" json:"prefilledTransactions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CompactBlockMessage) Reset() {
+	*x = CompactBlockMessage{}
+	mi := &file_p2p_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactBlockMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactBlockMessage) ProtoMessage() {}
+
+func (x *CompactBlockMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactBlockMessage.ProtoReflect.Descriptor instead.
+func (*CompactBlockMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompactBlockMessage) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CompactBlockMessage) GetShortIdNonce() uint64 {
+	if x != nil {
+		return x.ShortIdNonce
+	}
+	return 0
+}
+
+func (x *CompactBlockMessage) GetShortIds() []uint64 {
+	if x != nil {
+		return x.ShortIds
+	}
+	return nil
+}
+
+func (x *CompactBlockMessage) GetPrefilledTransactions() []*PrefilledTransactionMessage {
+	if x != nil {
+		return x.PrefilledTransactions
+	}
+	return nil
+}
+
+type PrefilledTransactionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Transaction   *TransactionMessage    `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefilledTransactionMessage) Reset() {
+	*x = PrefilledTransactionMessage{}
+	mi := &file_p2p_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefilledTransactionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefilledTransactionMessage) ProtoMessage() {}
+
+func (x *PrefilledTransactionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefilledTransactionMessage.ProtoReflect.Descriptor instead.
+func (*PrefilledTransactionMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PrefilledTransactionMessage) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PrefilledTransactionMessage) GetTransaction() *TransactionMessage {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type RequestBlockTransactionsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     *Hash                  `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Indexes       []uint32               `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestBlockTransactionsMessage) Reset() {
+	*x = RequestBlockTransactionsMessage{}
+	mi := &file_p2p_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestBlockTransactionsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestBlockTransactionsMessage) ProtoMessage() {}
+
+func (x *RequestBlockTransactionsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestBlockTransactionsMessage.ProtoReflect.Descriptor instead.
+func (*RequestBlockTransactionsMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RequestBlockTransactionsMessage) GetBlockHash() *Hash {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *RequestBlockTransactionsMessage) GetIndexes() []uint32 {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type BlockTransactionsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     *Hash                  `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Transactions  []*TransactionMessage  `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockTransactionsMessage) Reset() {
+	*x = BlockTransactionsMessage{}
+	mi := &file_p2p_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockTransactionsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTransactionsMessage) ProtoMessage() {}
+
+func (x *BlockTransactionsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTransactionsMessage.ProtoReflect.Descriptor instead.
+func (*BlockTransactionsMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BlockTransactionsMessage) GetBlockHash() *Hash {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *BlockTransactionsMessage) GetTransactions() []*TransactionMessage {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type TransactionPackageMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*TransactionMessage  `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionPackageMessage) Reset() {
+	*x = TransactionPackageMessage{}
+	mi := &file_p2p_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionPackageMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionPackageMessage) ProtoMessage() {}
+
+func (x *TransactionPackageMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionPackageMessage.ProtoReflect.Descriptor instead.
+func (*TransactionPackageMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransactionPackageMessage) GetTransactions() []*TransactionMessage {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
 type RequestTransactionsMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []*TransactionId       `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
@@ -1134,7 +1421,7 @@ type RequestTransactionsMessage struct {
 
 func (x *RequestTransactionsMessage) Reset() {
 	*x = RequestTransactionsMessage{}
-	mi := &file_p2p_proto_msgTypes[20]
+	mi := &file_p2p_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1146,7 +1433,7 @@ func (x *RequestTransactionsMessage) String() string {
 func (*RequestTransactionsMessage) ProtoMessage() {}
 
 func (x *RequestTransactionsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[20]
+	mi := &file_p2p_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +1446,7 @@ func (x *RequestTransactionsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestTransactionsMessage.ProtoReflect.Descriptor instead.
 func (*RequestTransactionsMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{20}
+	return file_p2p_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RequestTransactionsMessage) GetIds() []*TransactionId {
@@ -1178,7 +1465,7 @@ type TransactionNotFoundMessage struct {
 
 func (x *TransactionNotFoundMessage) Reset() {
 	*x = TransactionNotFoundMessage{}
-	mi := &file_p2p_proto_msgTypes[21]
+	mi := &file_p2p_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1477,7 @@ func (x *TransactionNotFoundMessage) String() string {
 func (*TransactionNotFoundMessage) ProtoMessage() {}
 
 func (x *TransactionNotFoundMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[21]
+	mi := &file_p2p_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +1490,7 @@ func (x *TransactionNotFoundMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionNotFoundMessage.ProtoReflect.Descriptor instead.
 func (*TransactionNotFoundMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{21}
+	return file_p2p_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TransactionNotFoundMessage) GetId() *TransactionId {
@@ -1222,7 +1509,7 @@ type InvRelayBlockMessage struct {
 
 func (x *InvRelayBlockMessage) Reset() {
 	*x = InvRelayBlockMessage{}
-	mi := &file_p2p_proto_msgTypes[22]
+	mi := &file_p2p_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1234,7 +1521,7 @@ func (x *InvRelayBlockMessage) String() string {
 func (*InvRelayBlockMessage) ProtoMessage() {}
 
 func (x *InvRelayBlockMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[22]
+	mi := &file_p2p_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,7 +1534,7 @@ func (x *InvRelayBlockMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvRelayBlockMessage.ProtoReflect.Descriptor instead.
 func (*InvRelayBlockMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{22}
+	return file_p2p_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *InvRelayBlockMessage) GetHash() *Hash {
@@ -1266,7 +1553,7 @@ type InvTransactionsMessage struct {
 
 func (x *InvTransactionsMessage) Reset() {
 	*x = InvTransactionsMessage{}
-	mi := &file_p2p_proto_msgTypes[23]
+	mi := &file_p2p_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1278,7 +1565,7 @@ func (x *InvTransactionsMessage) String() string {
 func (*InvTransactionsMessage) ProtoMessage() {}
 
 func (x *InvTransactionsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[23]
+	mi := &file_p2p_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,7 +1578,7 @@ func (x *InvTransactionsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvTransactionsMessage.ProtoReflect.Descriptor instead.
 func (*InvTransactionsMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{23}
+	return file_p2p_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *InvTransactionsMessage) GetIds() []*TransactionId {
@@ -1310,7 +1597,7 @@ type PingMessage struct {
 
 func (x *PingMessage) Reset() {
 	*x = PingMessage{}
-	mi := &file_p2p_proto_msgTypes[24]
+	mi := &file_p2p_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1322,7 +1609,7 @@ func (x *PingMessage) String() string {
 func (*PingMessage) ProtoMessage() {}
 
 func (x *PingMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[24]
+	mi := &file_p2p_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1335,7 +1622,7 @@ func (x *PingMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingMessage.ProtoReflect.Descriptor instead.
 func (*PingMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{24}
+	return file_p2p_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *PingMessage) GetNonce() uint64 {
@@ -1354,7 +1641,7 @@ type PongMessage struct {
 
 func (x *PongMessage) Reset() {
 	*x = PongMessage{}
-	mi := &file_p2p_proto_msgTypes[25]
+	mi := &file_p2p_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1366,7 +1653,7 @@ func (x *PongMessage) String() string {
 func (*PongMessage) ProtoMessage() {}
 
 func (x *PongMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[25]
+	mi := &file_p2p_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,7 +1666,7 @@ func (x *PongMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PongMessage.ProtoReflect.Descriptor instead.
 func (*PongMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{25}
+	return file_p2p_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PongMessage) GetNonce() uint64 {
@@ -1397,7 +1684,7 @@ type VerackMessage struct {
 
 func (x *VerackMessage) Reset() {
 	*x = VerackMessage{}
-	mi := &file_p2p_proto_msgTypes[26]
+	mi := &file_p2p_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +1696,7 @@ func (x *VerackMessage) String() string {
 func (*VerackMessage) ProtoMessage() {}
 
 func (x *VerackMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[26]
+	mi := &file_p2p_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,7 +1709,7 @@ func (x *VerackMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerackMessage.ProtoReflect.Descriptor instead.
 func (*VerackMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{26}
+	return file_p2p_proto_rawDescGZIP(), []int{31}
 }
 
 type VersionMessage struct {
@@ -1447,7 +1734,7 @@ type VersionMessage struct {
 
 func (x *VersionMessage) Reset() {
 	*x = VersionMessage{}
-	mi := &file_p2p_proto_msgTypes[27]
+	mi := &file_p2p_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1459,7 +1746,7 @@ func (x *VersionMessage) String() string {
 func (*VersionMessage) ProtoMessage() {}
 
 func (x *VersionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[27]
+	mi := &file_p2p_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +1759,7 @@ func (x *VersionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionMessage.ProtoReflect.Descriptor instead.
 func (*VersionMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{27}
+	return file_p2p_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *VersionMessage) GetProtocolVersion() uint32 {
@@ -1581,7 +1868,7 @@ type RequestAntiFraudSnapshotV1Message struct {
 
 func (x *RequestAntiFraudSnapshotV1Message) Reset() {
 	*x = RequestAntiFraudSnapshotV1Message{}
-	mi := &file_p2p_proto_msgTypes[28]
+	mi := &file_p2p_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1593,7 +1880,7 @@ func (x *RequestAntiFraudSnapshotV1Message) String() string {
 func (*RequestAntiFraudSnapshotV1Message) ProtoMessage() {}
 
 func (x *RequestAntiFraudSnapshotV1Message) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[28]
+	mi := &file_p2p_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,7 +1893,7 @@ func (x *RequestAntiFraudSnapshotV1Message) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RequestAntiFraudSnapshotV1Message.ProtoReflect.Descriptor instead.
 func (*RequestAntiFraudSnapshotV1Message) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{28}
+	return file_p2p_proto_rawDescGZIP(), []int{33}
 }
 
 type AntiFraudSnapshotV1Message struct {
@@ -1626,7 +1913,7 @@ type AntiFraudSnapshotV1Message struct {
 
 func (x *AntiFraudSnapshotV1Message) Reset() {
 	*x = AntiFraudSnapshotV1Message{}
-	mi := &file_p2p_proto_msgTypes[29]
+	mi := &file_p2p_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1638,7 +1925,7 @@ func (x *AntiFraudSnapshotV1Message) String() string {
 func (*AntiFraudSnapshotV1Message) ProtoMessage() {}
 
 func (x *AntiFraudSnapshotV1Message) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[29]
+	mi := &file_p2p_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,7 +1938,7 @@ func (x *AntiFraudSnapshotV1Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AntiFraudSnapshotV1Message.ProtoReflect.Descriptor instead.
 func (*AntiFraudSnapshotV1Message) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{29}
+	return file_p2p_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AntiFraudSnapshotV1Message) GetSchemaVersion() uint32 {
@@ -1726,7 +2013,7 @@ type RejectMessage struct {
 
 func (x *RejectMessage) Reset() {
 	*x = RejectMessage{}
-	mi := &file_p2p_proto_msgTypes[30]
+	mi := &file_p2p_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1738,7 +2025,7 @@ func (x *RejectMessage) String() string {
 func (*RejectMessage) ProtoMessage() {}
 
 func (x *RejectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[30]
+	mi := &file_p2p_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,7 +2038,7 @@ func (x *RejectMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectMessage.ProtoReflect.Descriptor instead.
 func (*RejectMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{30}
+	return file_p2p_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RejectMessage) GetReason() string {
@@ -1770,7 +2057,7 @@ type RequestPruningPointUTXOSetMessage struct {
 
 func (x *RequestPruningPointUTXOSetMessage) Reset() {
 	*x = RequestPruningPointUTXOSetMessage{}
-	mi := &file_p2p_proto_msgTypes[31]
+	mi := &file_p2p_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1782,7 +2069,7 @@ func (x *RequestPruningPointUTXOSetMessage) String() string {
 func (*RequestPruningPointUTXOSetMessage) ProtoMessage() {}
 
 func (x *RequestPruningPointUTXOSetMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[31]
+	mi := &file_p2p_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,7 +2082,7 @@ func (x *RequestPruningPointUTXOSetMessage) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RequestPruningPointUTXOSetMessage.ProtoReflect.Descriptor instead.
 func (*RequestPruningPointUTXOSetMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{31}
+	return file_p2p_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RequestPruningPointUTXOSetMessage) GetPruningPointHash() *Hash {
@@ -1816,7 +2103,7 @@ type PruningPointUtxoSetChunkMessage struct {
 
 func (x *PruningPointUtxoSetChunkMessage) Reset() {
 	*x = PruningPointUtxoSetChunkMessage{}
-	mi := &file_p2p_proto_msgTypes[32]
+	mi := &file_p2p_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1828,7 +2115,7 @@ func (x *PruningPointUtxoSetChunkMessage) String() string {
 func (*PruningPointUtxoSetChunkMessage) ProtoMessage() {}
 
 func (x *PruningPointUtxoSetChunkMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[32]
+	mi := &file_p2p_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1841,7 +2128,7 @@ func (x *PruningPointUtxoSetChunkMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PruningPointUtxoSetChunkMessage.ProtoReflect.Descriptor instead.
 func (*PruningPointUtxoSetChunkMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{32}
+	return file_p2p_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PruningPointUtxoSetChunkMessage) GetOutpointAndUtxoEntryPairs() []*OutpointAndUtxoEntryPair {
@@ -1875,7 +2162,7 @@ type OutpointAndUtxoEntryPair struct {
 
 func (x *OutpointAndUtxoEntryPair) Reset() {
 	*x = OutpointAndUtxoEntryPair{}
-	mi := &file_p2p_proto_msgTypes[33]
+	mi := &file_p2p_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1887,7 +2174,7 @@ func (x *OutpointAndUtxoEntryPair) String() string {
 func (*OutpointAndUtxoEntryPair) ProtoMessage() {}
 
 func (x *OutpointAndUtxoEntryPair) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[33]
+	mi := &file_p2p_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,7 +2187,7 @@ func (x *OutpointAndUtxoEntryPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutpointAndUtxoEntryPair.ProtoReflect.Descriptor instead.
 func (*OutpointAndUtxoEntryPair) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{33}
+	return file_p2p_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *OutpointAndUtxoEntryPair) GetOutpoint() *Outpoint {
@@ -1929,7 +2216,7 @@ type UtxoEntry struct {
 
 func (x *UtxoEntry) Reset() {
 	*x = UtxoEntry{}
-	mi := &file_p2p_proto_msgTypes[34]
+	mi := &file_p2p_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1941,7 +2228,7 @@ func (x *UtxoEntry) String() string {
 func (*UtxoEntry) ProtoMessage() {}
 
 func (x *UtxoEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[34]
+	mi := &file_p2p_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,7 +2241,7 @@ func (x *UtxoEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UtxoEntry.ProtoReflect.Descriptor instead.
 func (*UtxoEntry) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{34}
+	return file_p2p_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UtxoEntry) GetAmount() uint64 {
@@ -1993,7 +2280,7 @@ type RequestNextPruningPointUtxoSetChunkMessage struct {
 
 func (x *RequestNextPruningPointUtxoSetChunkMessage) Reset() {
 	*x = RequestNextPruningPointUtxoSetChunkMessage{}
-	mi := &file_p2p_proto_msgTypes[35]
+	mi := &file_p2p_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2005,7 +2292,7 @@ func (x *RequestNextPruningPointUtxoSetChunkMessage) String() string {
 func (*RequestNextPruningPointUtxoSetChunkMessage) ProtoMessage() {}
 
 func (x *RequestNextPruningPointUtxoSetChunkMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[35]
+	mi := &file_p2p_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,7 +2305,7 @@ func (x *RequestNextPruningPointUtxoSetChunkMessage) ProtoReflect() protoreflect
 
 // Deprecated: Use RequestNextPruningPointUtxoSetChunkMessage.ProtoReflect.Descriptor instead.
 func (*RequestNextPruningPointUtxoSetChunkMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{35}
+	return file_p2p_proto_rawDescGZIP(), []int{40}
 }
 
 type DonePruningPointUtxoSetChunksMessage struct {
@@ -2029,7 +2316,7 @@ type DonePruningPointUtxoSetChunksMessage struct {
 
 func (x *DonePruningPointUtxoSetChunksMessage) Reset() {
 	*x = DonePruningPointUtxoSetChunksMessage{}
-	mi := &file_p2p_proto_msgTypes[36]
+	mi := &file_p2p_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2041,7 +2328,7 @@ func (x *DonePruningPointUtxoSetChunksMessage) String() string {
 func (*DonePruningPointUtxoSetChunksMessage) ProtoMessage() {}
 
 func (x *DonePruningPointUtxoSetChunksMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[36]
+	mi := &file_p2p_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,7 +2341,7 @@ func (x *DonePruningPointUtxoSetChunksMessage) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DonePruningPointUtxoSetChunksMessage.ProtoReflect.Descriptor instead.
 func (*DonePruningPointUtxoSetChunksMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{36}
+	return file_p2p_proto_rawDescGZIP(), []int{41}
 }
 
 type RequestIBDBlocksMessage struct {
@@ -2066,7 +2353,7 @@ type RequestIBDBlocksMessage struct {
 
 func (x *RequestIBDBlocksMessage) Reset() {
 	*x = RequestIBDBlocksMessage{}
-	mi := &file_p2p_proto_msgTypes[37]
+	mi := &file_p2p_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2078,7 +2365,7 @@ func (x *RequestIBDBlocksMessage) String() string {
 func (*RequestIBDBlocksMessage) ProtoMessage() {}
 
 func (x *RequestIBDBlocksMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[37]
+	mi := &file_p2p_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,7 +2378,7 @@ func (x *RequestIBDBlocksMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestIBDBlocksMessage.ProtoReflect.Descriptor instead.
 func (*RequestIBDBlocksMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{37}
+	return file_p2p_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RequestIBDBlocksMessage) GetHashes() []*Hash {
@@ -2109,7 +2396,7 @@ type UnexpectedPruningPointMessage struct {
 
 func (x *UnexpectedPruningPointMessage) Reset() {
 	*x = UnexpectedPruningPointMessage{}
-	mi := &file_p2p_proto_msgTypes[38]
+	mi := &file_p2p_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2121,7 +2408,7 @@ func (x *UnexpectedPruningPointMessage) String() string {
 func (*UnexpectedPruningPointMessage) ProtoMessage() {}
 
 func (x *UnexpectedPruningPointMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[38]
+	mi := &file_p2p_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,7 +2421,7 @@ func (x *UnexpectedPruningPointMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnexpectedPruningPointMessage.ProtoReflect.Descriptor instead.
 func (*UnexpectedPruningPointMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{38}
+	return file_p2p_proto_rawDescGZIP(), []int{43}
 }
 
 type IbdBlockLocatorMessage struct {
@@ -2147,7 +2434,7 @@ type IbdBlockLocatorMessage struct {
 
 func (x *IbdBlockLocatorMessage) Reset() {
 	*x = IbdBlockLocatorMessage{}
-	mi := &file_p2p_proto_msgTypes[39]
+	mi := &file_p2p_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2159,7 +2446,7 @@ func (x *IbdBlockLocatorMessage) String() string {
 func (*IbdBlockLocatorMessage) ProtoMessage() {}
 
 func (x *IbdBlockLocatorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[39]
+	mi := &file_p2p_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2459,7 @@ func (x *IbdBlockLocatorMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IbdBlockLocatorMessage.ProtoReflect.Descriptor instead.
 func (*IbdBlockLocatorMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{39}
+	return file_p2p_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *IbdBlockLocatorMessage) GetTargetHash() *Hash {
@@ -2199,7 +2486,7 @@ type RequestIBDChainBlockLocatorMessage struct {
 
 func (x *RequestIBDChainBlockLocatorMessage) Reset() {
 	*x = RequestIBDChainBlockLocatorMessage{}
-	mi := &file_p2p_proto_msgTypes[40]
+	mi := &file_p2p_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2498,7 @@ func (x *RequestIBDChainBlockLocatorMessage) String() string {
 func (*RequestIBDChainBlockLocatorMessage) ProtoMessage() {}
 
 func (x *RequestIBDChainBlockLocatorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[40]
+	mi := &file_p2p_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2511,7 @@ func (x *RequestIBDChainBlockLocatorMessage) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RequestIBDChainBlockLocatorMessage.ProtoReflect.Descriptor instead.
 func (*RequestIBDChainBlockLocatorMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{40}
+	return file_p2p_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *RequestIBDChainBlockLocatorMessage) GetLowHash() *Hash {
@@ -2250,7 +2537,7 @@ type IbdChainBlockLocatorMessage struct {
 
 func (x *IbdChainBlockLocatorMessage) Reset() {
 	*x = IbdChainBlockLocatorMessage{}
-	mi := &file_p2p_proto_msgTypes[41]
+	mi := &file_p2p_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2262,7 +2549,7 @@ func (x *IbdChainBlockLocatorMessage) String() string {
 func (*IbdChainBlockLocatorMessage) ProtoMessage() {}
 
 func (x *IbdChainBlockLocatorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[41]
+	mi := &file_p2p_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,7 +2562,7 @@ func (x *IbdChainBlockLocatorMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IbdChainBlockLocatorMessage.ProtoReflect.Descriptor instead.
 func (*IbdChainBlockLocatorMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{41}
+	return file_p2p_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *IbdChainBlockLocatorMessage) GetBlockLocatorHashes() []*Hash {
@@ -2295,7 +2582,7 @@ type RequestAnticoneMessage struct {
 
 func (x *RequestAnticoneMessage) Reset() {
 	*x = RequestAnticoneMessage{}
-	mi := &file_p2p_proto_msgTypes[42]
+	mi := &file_p2p_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2307,7 +2594,7 @@ func (x *RequestAnticoneMessage) String() string {
 func (*RequestAnticoneMessage) ProtoMessage() {}
 
 func (x *RequestAnticoneMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[42]
+	mi := &file_p2p_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,7 +2607,7 @@ func (x *RequestAnticoneMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestAnticoneMessage.ProtoReflect.Descriptor instead.
 func (*RequestAnticoneMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{42}
+	return file_p2p_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *RequestAnticoneMessage) GetBlockHash() *Hash {
@@ -2346,7 +2633,7 @@ type IbdBlockLocatorHighestHashMessage struct {
 
 func (x *IbdBlockLocatorHighestHashMessage) Reset() {
 	*x = IbdBlockLocatorHighestHashMessage{}
-	mi := &file_p2p_proto_msgTypes[43]
+	mi := &file_p2p_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2358,7 +2645,7 @@ func (x *IbdBlockLocatorHighestHashMessage) String() string {
 func (*IbdBlockLocatorHighestHashMessage) ProtoMessage() {}
 
 func (x *IbdBlockLocatorHighestHashMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[43]
+	mi := &file_p2p_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2371,7 +2658,7 @@ func (x *IbdBlockLocatorHighestHashMessage) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use IbdBlockLocatorHighestHashMessage.ProtoReflect.Descriptor instead.
 func (*IbdBlockLocatorHighestHashMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{43}
+	return file_p2p_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *IbdBlockLocatorHighestHashMessage) GetHighestHash() *Hash {
@@ -2389,7 +2676,7 @@ type IbdBlockLocatorHighestHashNotFoundMessage struct {
 
 func (x *IbdBlockLocatorHighestHashNotFoundMessage) Reset() {
 	*x = IbdBlockLocatorHighestHashNotFoundMessage{}
-	mi := &file_p2p_proto_msgTypes[44]
+	mi := &file_p2p_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2401,7 +2688,7 @@ func (x *IbdBlockLocatorHighestHashNotFoundMessage) String() string {
 func (*IbdBlockLocatorHighestHashNotFoundMessage) ProtoMessage() {}
 
 func (x *IbdBlockLocatorHighestHashNotFoundMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[44]
+	mi := &file_p2p_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,7 +2701,7 @@ func (x *IbdBlockLocatorHighestHashNotFoundMessage) ProtoReflect() protoreflect.
 
 // Deprecated: Use IbdBlockLocatorHighestHashNotFoundMessage.ProtoReflect.Descriptor instead.
 func (*IbdBlockLocatorHighestHashNotFoundMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{44}
+	return file_p2p_proto_rawDescGZIP(), []int{49}
 }
 
 type BlockHeadersMessage struct {
@@ -2426,7 +2713,7 @@ type BlockHeadersMessage struct {
 
 func (x *BlockHeadersMessage) Reset() {
 	*x = BlockHeadersMessage{}
-	mi := &file_p2p_proto_msgTypes[45]
+	mi := &file_p2p_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2438,7 +2725,7 @@ func (x *BlockHeadersMessage) String() string {
 func (*BlockHeadersMessage) ProtoMessage() {}
 
 func (x *BlockHeadersMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[45]
+	mi := &file_p2p_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,7 +2738,7 @@ func (x *BlockHeadersMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeadersMessage.ProtoReflect.Descriptor instead.
 func (*BlockHeadersMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{45}
+	return file_p2p_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *BlockHeadersMessage) GetBlockHeaders() []*BlockHeader {
@@ -2469,7 +2756,7 @@ type RequestPruningPointAndItsAnticoneMessage struct {
 
 func (x *RequestPruningPointAndItsAnticoneMessage) Reset() {
 	*x = RequestPruningPointAndItsAnticoneMessage{}
-	mi := &file_p2p_proto_msgTypes[46]
+	mi := &file_p2p_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2481,7 +2768,7 @@ func (x *RequestPruningPointAndItsAnticoneMessage) String() string {
 func (*RequestPruningPointAndItsAnticoneMessage) ProtoMessage() {}
 
 func (x *RequestPruningPointAndItsAnticoneMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[46]
+	mi := &file_p2p_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,7 +2781,7 @@ func (x *RequestPruningPointAndItsAnticoneMessage) ProtoReflect() protoreflect.M
 
 // Deprecated: Use RequestPruningPointAndItsAnticoneMessage.ProtoReflect.Descriptor instead.
 func (*RequestPruningPointAndItsAnticoneMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{46}
+	return file_p2p_proto_rawDescGZIP(), []int{51}
 }
 
 type RequestNextPruningPointAndItsAnticoneBlocksMessage struct {
@@ -2505,7 +2792,7 @@ type RequestNextPruningPointAndItsAnticoneBlocksMessage struct {
 
 func (x *RequestNextPruningPointAndItsAnticoneBlocksMessage) Reset() {
 	*x = RequestNextPruningPointAndItsAnticoneBlocksMessage{}
-	mi := &file_p2p_proto_msgTypes[47]
+	mi := &file_p2p_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2517,7 +2804,7 @@ func (x *RequestNextPruningPointAndItsAnticoneBlocksMessage) String() string {
 func (*RequestNextPruningPointAndItsAnticoneBlocksMessage) ProtoMessage() {}
 
 func (x *RequestNextPruningPointAndItsAnticoneBlocksMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[47]
+	mi := &file_p2p_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2530,7 +2817,7 @@ func (x *RequestNextPruningPointAndItsAnticoneBlocksMessage) ProtoReflect() prot
 
 // Deprecated: Use RequestNextPruningPointAndItsAnticoneBlocksMessage.ProtoReflect.Descriptor instead.
 func (*RequestNextPruningPointAndItsAnticoneBlocksMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{47}
+	return file_p2p_proto_rawDescGZIP(), []int{52}
 }
 
 type BlockWithTrustedDataMessage struct {
@@ -2545,7 +2832,7 @@ type BlockWithTrustedDataMessage struct {
 
 func (x *BlockWithTrustedDataMessage) Reset() {
 	*x = BlockWithTrustedDataMessage{}
-	mi := &file_p2p_proto_msgTypes[48]
+	mi := &file_p2p_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2557,7 +2844,7 @@ func (x *BlockWithTrustedDataMessage) String() string {
 func (*BlockWithTrustedDataMessage) ProtoMessage() {}
 
 func (x *BlockWithTrustedDataMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[48]
+	mi := &file_p2p_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,7 +2857,7 @@ func (x *BlockWithTrustedDataMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockWithTrustedDataMessage.ProtoReflect.Descriptor instead.
 func (*BlockWithTrustedDataMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{48}
+	return file_p2p_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *BlockWithTrustedDataMessage) GetBlock() *BlockMessage {
@@ -2611,7 +2898,7 @@ type DaaBlock struct {
 
 func (x *DaaBlock) Reset() {
 	*x = DaaBlock{}
-	mi := &file_p2p_proto_msgTypes[49]
+	mi := &file_p2p_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2623,7 +2910,7 @@ func (x *DaaBlock) String() string {
 func (*DaaBlock) ProtoMessage() {}
 
 func (x *DaaBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[49]
+	mi := &file_p2p_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2636,7 +2923,7 @@ func (x *DaaBlock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaaBlock.ProtoReflect.Descriptor instead.
 func (*DaaBlock) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{49}
+	return file_p2p_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *DaaBlock) GetBlock() *BlockMessage {
@@ -2663,7 +2950,7 @@ type DaaBlockV4 struct {
 
 func (x *DaaBlockV4) Reset() {
 	*x = DaaBlockV4{}
-	mi := &file_p2p_proto_msgTypes[50]
+	mi := &file_p2p_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2675,7 +2962,7 @@ func (x *DaaBlockV4) String() string {
 func (*DaaBlockV4) ProtoMessage() {}
 
 func (x *DaaBlockV4) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[50]
+	mi := &file_p2p_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2688,7 +2975,7 @@ func (x *DaaBlockV4) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaaBlockV4.ProtoReflect.Descriptor instead.
 func (*DaaBlockV4) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{50}
+	return file_p2p_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DaaBlockV4) GetHeader() *BlockHeader {
@@ -2715,7 +3002,7 @@ type BlockGhostdagDataHashPair struct {
 
 func (x *BlockGhostdagDataHashPair) Reset() {
 	*x = BlockGhostdagDataHashPair{}
-	mi := &file_p2p_proto_msgTypes[51]
+	mi := &file_p2p_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2727,7 +3014,7 @@ func (x *BlockGhostdagDataHashPair) String() string {
 func (*BlockGhostdagDataHashPair) ProtoMessage() {}
 
 func (x *BlockGhostdagDataHashPair) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[51]
+	mi := &file_p2p_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2740,7 +3027,7 @@ func (x *BlockGhostdagDataHashPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockGhostdagDataHashPair.ProtoReflect.Descriptor instead.
 func (*BlockGhostdagDataHashPair) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{51}
+	return file_p2p_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *BlockGhostdagDataHashPair) GetHash() *Hash {
@@ -2771,7 +3058,7 @@ type GhostdagData struct {
 
 func (x *GhostdagData) Reset() {
 	*x = GhostdagData{}
-	mi := &file_p2p_proto_msgTypes[52]
+	mi := &file_p2p_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2783,7 +3070,7 @@ func (x *GhostdagData) String() string {
 func (*GhostdagData) ProtoMessage() {}
 
 func (x *GhostdagData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[52]
+	mi := &file_p2p_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2796,7 +3083,7 @@ func (x *GhostdagData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GhostdagData.ProtoReflect.Descriptor instead.
 func (*GhostdagData) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{52}
+	return file_p2p_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GhostdagData) GetBlueScore() uint64 {
@@ -2851,7 +3138,7 @@ type BluesAnticoneSizes struct {
 
 func (x *BluesAnticoneSizes) Reset() {
 	*x = BluesAnticoneSizes{}
-	mi := &file_p2p_proto_msgTypes[53]
+	mi := &file_p2p_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2863,7 +3150,7 @@ func (x *BluesAnticoneSizes) String() string {
 func (*BluesAnticoneSizes) ProtoMessage() {}
 
 func (x *BluesAnticoneSizes) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[53]
+	mi := &file_p2p_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2876,7 +3163,7 @@ func (x *BluesAnticoneSizes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BluesAnticoneSizes.ProtoReflect.Descriptor instead.
 func (*BluesAnticoneSizes) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{53}
+	return file_p2p_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *BluesAnticoneSizes) GetBlueHash() *Hash {
@@ -2901,7 +3188,7 @@ type DoneBlocksWithTrustedDataMessage struct {
 
 func (x *DoneBlocksWithTrustedDataMessage) Reset() {
 	*x = DoneBlocksWithTrustedDataMessage{}
-	mi := &file_p2p_proto_msgTypes[54]
+	mi := &file_p2p_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2913,7 +3200,7 @@ func (x *DoneBlocksWithTrustedDataMessage) String() string {
 func (*DoneBlocksWithTrustedDataMessage) ProtoMessage() {}
 
 func (x *DoneBlocksWithTrustedDataMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[54]
+	mi := &file_p2p_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2926,7 +3213,7 @@ func (x *DoneBlocksWithTrustedDataMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoneBlocksWithTrustedDataMessage.ProtoReflect.Descriptor instead.
 func (*DoneBlocksWithTrustedDataMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{54}
+	return file_p2p_proto_rawDescGZIP(), []int{59}
 }
 
 type PruningPointsMessage struct {
@@ -2938,7 +3225,7 @@ type PruningPointsMessage struct {
 
 func (x *PruningPointsMessage) Reset() {
 	*x = PruningPointsMessage{}
-	mi := &file_p2p_proto_msgTypes[55]
+	mi := &file_p2p_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2950,7 +3237,7 @@ func (x *PruningPointsMessage) String() string {
 func (*PruningPointsMessage) ProtoMessage() {}
 
 func (x *PruningPointsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[55]
+	mi := &file_p2p_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2963,7 +3250,7 @@ func (x *PruningPointsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PruningPointsMessage.ProtoReflect.Descriptor instead.
 func (*PruningPointsMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{55}
+	return file_p2p_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *PruningPointsMessage) GetHeaders() []*BlockHeader {
@@ -2981,7 +3268,7 @@ type RequestPruningPointProofMessage struct {
 
 func (x *RequestPruningPointProofMessage) Reset() {
 	*x = RequestPruningPointProofMessage{}
-	mi := &file_p2p_proto_msgTypes[56]
+	mi := &file_p2p_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2993,7 +3280,7 @@ func (x *RequestPruningPointProofMessage) String() string {
 func (*RequestPruningPointProofMessage) ProtoMessage() {}
 
 func (x *RequestPruningPointProofMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[56]
+	mi := &file_p2p_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,7 +3293,7 @@ func (x *RequestPruningPointProofMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestPruningPointProofMessage.ProtoReflect.Descriptor instead.
 func (*RequestPruningPointProofMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{56}
+	return file_p2p_proto_rawDescGZIP(), []int{61}
 }
 
 type PruningPointProofMessage struct {
@@ -3018,7 +3305,7 @@ type PruningPointProofMessage struct {
 
 func (x *PruningPointProofMessage) Reset() {
 	*x = PruningPointProofMessage{}
-	mi := &file_p2p_proto_msgTypes[57]
+	mi := &file_p2p_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3030,7 +3317,7 @@ func (x *PruningPointProofMessage) String() string {
 func (*PruningPointProofMessage) ProtoMessage() {}
 
 func (x *PruningPointProofMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[57]
+	mi := &file_p2p_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3043,7 +3330,7 @@ func (x *PruningPointProofMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PruningPointProofMessage.ProtoReflect.Descriptor instead.
 func (*PruningPointProofMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{57}
+	return file_p2p_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *PruningPointProofMessage) GetHeaders() []*PruningPointProofHeaderArray {
@@ -3062,7 +3349,7 @@ type PruningPointProofHeaderArray struct {
 
 func (x *PruningPointProofHeaderArray) Reset() {
 	*x = PruningPointProofHeaderArray{}
-	mi := &file_p2p_proto_msgTypes[58]
+	mi := &file_p2p_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3074,7 +3361,7 @@ func (x *PruningPointProofHeaderArray) String() string {
 func (*PruningPointProofHeaderArray) ProtoMessage() {}
 
 func (x *PruningPointProofHeaderArray) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[58]
+	mi := &file_p2p_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3087,7 +3374,7 @@ func (x *PruningPointProofHeaderArray) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PruningPointProofHeaderArray.ProtoReflect.Descriptor instead.
 func (*PruningPointProofHeaderArray) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{58}
+	return file_p2p_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *PruningPointProofHeaderArray) GetHeaders() []*BlockHeader {
@@ -3108,7 +3395,7 @@ type ReadyMessage struct {
 
 func (x *ReadyMessage) Reset() {
 	*x = ReadyMessage{}
-	mi := &file_p2p_proto_msgTypes[59]
+	mi := &file_p2p_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3120,7 +3407,7 @@ func (x *ReadyMessage) String() string {
 func (*ReadyMessage) ProtoMessage() {}
 
 func (x *ReadyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[59]
+	mi := &file_p2p_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3133,7 +3420,7 @@ func (x *ReadyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadyMessage.ProtoReflect.Descriptor instead.
 func (*ReadyMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{59}
+	return file_p2p_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ReadyMessage) GetNodeAuthSignature() []byte {
@@ -3168,7 +3455,7 @@ type BlockWithTrustedDataV4Message struct {
 
 func (x *BlockWithTrustedDataV4Message) Reset() {
 	*x = BlockWithTrustedDataV4Message{}
-	mi := &file_p2p_proto_msgTypes[60]
+	mi := &file_p2p_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3180,7 +3467,7 @@ func (x *BlockWithTrustedDataV4Message) String() string {
 func (*BlockWithTrustedDataV4Message) ProtoMessage() {}
 
 func (x *BlockWithTrustedDataV4Message) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[60]
+	mi := &file_p2p_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3193,7 +3480,7 @@ func (x *BlockWithTrustedDataV4Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockWithTrustedDataV4Message.ProtoReflect.Descriptor instead.
 func (*BlockWithTrustedDataV4Message) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{60}
+	return file_p2p_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *BlockWithTrustedDataV4Message) GetBlock() *BlockMessage {
@@ -3231,7 +3518,7 @@ type TrustedDataMessage struct {
 
 func (x *TrustedDataMessage) Reset() {
 	*x = TrustedDataMessage{}
-	mi := &file_p2p_proto_msgTypes[61]
+	mi := &file_p2p_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3243,7 +3530,7 @@ func (x *TrustedDataMessage) String() string {
 func (*TrustedDataMessage) ProtoMessage() {}
 
 func (x *TrustedDataMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[61]
+	mi := &file_p2p_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3256,7 +3543,7 @@ func (x *TrustedDataMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrustedDataMessage.ProtoReflect.Descriptor instead.
 func (*TrustedDataMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{61}
+	return file_p2p_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *TrustedDataMessage) GetDaaWindow() []*DaaBlockV4 {
@@ -3314,7 +3601,7 @@ type TrustedAtomicStateChunkMessage struct {
 
 func (x *TrustedAtomicStateChunkMessage) Reset() {
 	*x = TrustedAtomicStateChunkMessage{}
-	mi := &file_p2p_proto_msgTypes[62]
+	mi := &file_p2p_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3326,7 +3613,7 @@ func (x *TrustedAtomicStateChunkMessage) String() string {
 func (*TrustedAtomicStateChunkMessage) ProtoMessage() {}
 
 func (x *TrustedAtomicStateChunkMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[62]
+	mi := &file_p2p_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3339,7 +3626,7 @@ func (x *TrustedAtomicStateChunkMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrustedAtomicStateChunkMessage.ProtoReflect.Descriptor instead.
 func (*TrustedAtomicStateChunkMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{62}
+	return file_p2p_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *TrustedAtomicStateChunkMessage) GetStateHash() []byte {
@@ -3385,7 +3672,7 @@ type RequestNextPruningPointAtomicStateChunkMessage struct {
 
 func (x *RequestNextPruningPointAtomicStateChunkMessage) Reset() {
 	*x = RequestNextPruningPointAtomicStateChunkMessage{}
-	mi := &file_p2p_proto_msgTypes[63]
+	mi := &file_p2p_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3397,7 +3684,7 @@ func (x *RequestNextPruningPointAtomicStateChunkMessage) String() string {
 func (*RequestNextPruningPointAtomicStateChunkMessage) ProtoMessage() {}
 
 func (x *RequestNextPruningPointAtomicStateChunkMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[63]
+	mi := &file_p2p_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,7 +3697,7 @@ func (x *RequestNextPruningPointAtomicStateChunkMessage) ProtoReflect() protoref
 
 // Deprecated: Use RequestNextPruningPointAtomicStateChunkMessage.ProtoReflect.Descriptor instead.
 func (*RequestNextPruningPointAtomicStateChunkMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{63}
+	return file_p2p_proto_rawDescGZIP(), []int{68}
 }
 
 type RequestConsensusAtomicStateHashMessage struct {
@@ -3423,7 +3710,7 @@ type RequestConsensusAtomicStateHashMessage struct {
 
 func (x *RequestConsensusAtomicStateHashMessage) Reset() {
 	*x = RequestConsensusAtomicStateHashMessage{}
-	mi := &file_p2p_proto_msgTypes[64]
+	mi := &file_p2p_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3435,7 +3722,7 @@ func (x *RequestConsensusAtomicStateHashMessage) String() string {
 func (*RequestConsensusAtomicStateHashMessage) ProtoMessage() {}
 
 func (x *RequestConsensusAtomicStateHashMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[64]
+	mi := &file_p2p_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3448,7 +3735,7 @@ func (x *RequestConsensusAtomicStateHashMessage) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use RequestConsensusAtomicStateHashMessage.ProtoReflect.Descriptor instead.
 func (*RequestConsensusAtomicStateHashMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{64}
+	return file_p2p_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *RequestConsensusAtomicStateHashMessage) GetBlockHash() *Hash {
@@ -3477,7 +3764,7 @@ type ConsensusAtomicStateHashMessage struct {
 
 func (x *ConsensusAtomicStateHashMessage) Reset() {
 	*x = ConsensusAtomicStateHashMessage{}
-	mi := &file_p2p_proto_msgTypes[65]
+	mi := &file_p2p_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3489,7 +3776,7 @@ func (x *ConsensusAtomicStateHashMessage) String() string {
 func (*ConsensusAtomicStateHashMessage) ProtoMessage() {}
 
 func (x *ConsensusAtomicStateHashMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[65]
+	mi := &file_p2p_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3502,7 +3789,7 @@ func (x *ConsensusAtomicStateHashMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsensusAtomicStateHashMessage.ProtoReflect.Descriptor instead.
 func (*ConsensusAtomicStateHashMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{65}
+	return file_p2p_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ConsensusAtomicStateHashMessage) GetBlockHash() *Hash {
@@ -3543,7 +3830,7 @@ type RequestAtomicTokenStateHashMessage struct {
 
 func (x *RequestAtomicTokenStateHashMessage) Reset() {
 	*x = RequestAtomicTokenStateHashMessage{}
-	mi := &file_p2p_proto_msgTypes[66]
+	mi := &file_p2p_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3555,7 +3842,7 @@ func (x *RequestAtomicTokenStateHashMessage) String() string {
 func (*RequestAtomicTokenStateHashMessage) ProtoMessage() {}
 
 func (x *RequestAtomicTokenStateHashMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[66]
+	mi := &file_p2p_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3568,7 +3855,7 @@ func (x *RequestAtomicTokenStateHashMessage) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RequestAtomicTokenStateHashMessage.ProtoReflect.Descriptor instead.
 func (*RequestAtomicTokenStateHashMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{66}
+	return file_p2p_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *RequestAtomicTokenStateHashMessage) GetBlockHash() *Hash {
@@ -3597,7 +3884,7 @@ type AtomicTokenStateHashMessage struct {
 
 func (x *AtomicTokenStateHashMessage) Reset() {
 	*x = AtomicTokenStateHashMessage{}
-	mi := &file_p2p_proto_msgTypes[67]
+	mi := &file_p2p_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3609,7 +3896,7 @@ func (x *AtomicTokenStateHashMessage) String() string {
 func (*AtomicTokenStateHashMessage) ProtoMessage() {}
 
 func (x *AtomicTokenStateHashMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[67]
+	mi := &file_p2p_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,7 +3909,7 @@ func (x *AtomicTokenStateHashMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AtomicTokenStateHashMessage.ProtoReflect.Descriptor instead.
 func (*AtomicTokenStateHashMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{67}
+	return file_p2p_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *AtomicTokenStateHashMessage) GetBlockHash() *Hash {
@@ -3662,7 +3949,7 @@ type RequestFastIntentsMessage struct {
 
 func (x *RequestFastIntentsMessage) Reset() {
 	*x = RequestFastIntentsMessage{}
-	mi := &file_p2p_proto_msgTypes[68]
+	mi := &file_p2p_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3674,7 +3961,7 @@ func (x *RequestFastIntentsMessage) String() string {
 func (*RequestFastIntentsMessage) ProtoMessage() {}
 
 func (x *RequestFastIntentsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[68]
+	mi := &file_p2p_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3687,7 +3974,7 @@ func (x *RequestFastIntentsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestFastIntentsMessage.ProtoReflect.Descriptor instead.
 func (*RequestFastIntentsMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{68}
+	return file_p2p_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *RequestFastIntentsMessage) GetIntentIds() []*Hash {
@@ -3710,7 +3997,7 @@ type FastIntentMessage struct {
 
 func (x *FastIntentMessage) Reset() {
 	*x = FastIntentMessage{}
-	mi := &file_p2p_proto_msgTypes[69]
+	mi := &file_p2p_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3722,7 +4009,7 @@ func (x *FastIntentMessage) String() string {
 func (*FastIntentMessage) ProtoMessage() {}
 
 func (x *FastIntentMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[69]
+	mi := &file_p2p_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3735,7 +4022,7 @@ func (x *FastIntentMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FastIntentMessage.ProtoReflect.Descriptor instead.
 func (*FastIntentMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{69}
+	return file_p2p_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *FastIntentMessage) GetIntentId() *Hash {
@@ -3783,7 +4070,7 @@ type FastMicroblockMessage struct {
 
 func (x *FastMicroblockMessage) Reset() {
 	*x = FastMicroblockMessage{}
-	mi := &file_p2p_proto_msgTypes[70]
+	mi := &file_p2p_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3795,7 +4082,7 @@ func (x *FastMicroblockMessage) String() string {
 func (*FastMicroblockMessage) ProtoMessage() {}
 
 func (x *FastMicroblockMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[70]
+	mi := &file_p2p_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3808,7 +4095,7 @@ func (x *FastMicroblockMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FastMicroblockMessage.ProtoReflect.Descriptor instead.
 func (*FastMicroblockMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{70}
+	return file_p2p_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *FastMicroblockMessage) GetMicroblockTimeMs() uint64 {
@@ -3839,7 +4126,7 @@ type BlockProducerClaimV1Message struct {
 
 func (x *BlockProducerClaimV1Message) Reset() {
 	*x = BlockProducerClaimV1Message{}
-	mi := &file_p2p_proto_msgTypes[71]
+	mi := &file_p2p_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3851,7 +4138,7 @@ func (x *BlockProducerClaimV1Message) String() string {
 func (*BlockProducerClaimV1Message) ProtoMessage() {}
 
 func (x *BlockProducerClaimV1Message) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[71]
+	mi := &file_p2p_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3864,7 +4151,7 @@ func (x *BlockProducerClaimV1Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockProducerClaimV1Message.ProtoReflect.Descriptor instead.
 func (*BlockProducerClaimV1Message) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{71}
+	return file_p2p_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *BlockProducerClaimV1Message) GetSchemaVersion() uint32 {
@@ -3913,17 +4200,19 @@ var File_p2p_proto protoreflect.FileDescriptor
 
 const file_p2p_proto_rawDesc = "" +
 	"\n" +
-	"\tp2p.proto\x12\tprotowire\"\x8c\x01\n" +
+	"\tp2p.proto\x12\tprotowire\"\xc2\x01\n" +
 	"\x17RequestAddressesMessage\x124\n" +
 	"\x15includeAllSubnetworks\x18\x01 \x01(\bR\x15includeAllSubnetworks\x12;\n" +
-	"\fsubnetworkId\x18\x02 \x01(\v2\x17.protowire.SubnetworkIdR\fsubnetworkId\"K\n" +
+	"\fsubnetworkId\x18\x02 \x01(\v2\x17.protowire.SubnetworkIdR\fsubnetworkId\x124\n" +
+	"\x15includeOnionAddresses\x18\x03 \x01(\bR\x15includeOnionAddresses\"K\n" +
 	"\x10AddressesMessage\x127\n" +
-	"\vaddressList\x18\x01 \x03(\v2\x15.protowire.NetAddressR\vaddressList\"N\n" +
+	"\vaddressList\x18\x01 \x03(\v2\x15.protowire.NetAddressR\vaddressList\"h\n" +
 	"\n" +
 	"NetAddress\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x0e\n" +
 	"\x02ip\x18\x03 \x01(\fR\x02ip\x12\x12\n" +
-	"\x04port\x18\x04 \x01(\rR\x04port\"$\n" +
+	"\x04port\x18\x04 \x01(\rR\x04port\x12\x18\n" +
+	"\aonionV3\x18\x05 \x01(\fR\aonionV3\"$\n" +
 	"\fSubnetworkId\x12\x14\n" +
 	"\x05bytes\x18\x01 \x01(\fR\x05bytes\"\xb4\x02\n" +
 	"\x12TransactionMessage\x12\x18\n" +
@@ -3985,7 +4274,23 @@ const file_p2p_proto_rawDesc = "" +
 	"\x19RequestNextHeadersMessage\"\x14\n" +
 	"\x12DoneHeadersMessage\"D\n" +
 	"\x19RequestRelayBlocksMessage\x12'\n" +
-	"\x06hashes\x18\x01 \x03(\v2\x0f.protowire.HashR\x06hashes\"H\n" +
+	"\x06hashes\x18\x01 \x03(\v2\x0f.protowire.HashR\x06hashes\"\xe3\x01\n" +
+	"\x13CompactBlockMessage\x12.\n" +
+	"\x06header\x18\x01 \x01(\v2\x16.protowire.BlockHeaderR\x06header\x12\"\n" +
+	"\fshortIdNonce\x18\x02 \x01(\x04R\fshortIdNonce\x12\x1a\n" +
+	"\bshortIds\x18\x03 \x03(\x04R\bshortIds\x12\\\n" +
+	"\x15prefilledTransactions\x18\x04 \x03(\v2&.protowire.PrefilledTransactionMessageR\x15prefilledTransactions\"t\n" +
+	"\x1bPrefilledTransactionMessage\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12?\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1d.protowire.TransactionMessageR\vtransaction\"j\n" +
+	"\x1fRequestBlockTransactionsMessage\x12-\n" +
+	"\tblockHash\x18\x01 \x01(\v2\x0f.protowire.HashR\tblockHash\x12\x18\n" +
+	"\aindexes\x18\x02 \x03(\rR\aindexes\"\x8c\x01\n" +
+	"\x18BlockTransactionsMessage\x12-\n" +
+	"\tblockHash\x18\x01 \x01(\v2\x0f.protowire.HashR\tblockHash\x12A\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x1d.protowire.TransactionMessageR\ftransactions\"^\n" +
+	"\x19TransactionPackageMessage\x12A\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1d.protowire.TransactionMessageR\ftransactions\"H\n" +
 	"\x1aRequestTransactionsMessage\x12*\n" +
 	"\x03ids\x18\x01 \x03(\v2\x18.protowire.TransactionIdR\x03ids\"F\n" +
 	"\x1aTransactionNotFoundMessage\x12(\n" +
@@ -4178,7 +4483,7 @@ func file_p2p_proto_rawDescGZIP() []byte {
 	return file_p2p_proto_rawDescData
 }
 
-var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
 var file_p2p_proto_goTypes = []any{
 	(*RequestAddressesMessage)(nil),                            // 0: protowire.RequestAddressesMessage
 	(*AddressesMessage)(nil),                                   // 1: protowire.AddressesMessage
@@ -4200,58 +4505,63 @@ var file_p2p_proto_goTypes = []any{
 	(*RequestNextHeadersMessage)(nil),                          // 17: protowire.RequestNextHeadersMessage
 	(*DoneHeadersMessage)(nil),                                 // 18: protowire.DoneHeadersMessage
 	(*RequestRelayBlocksMessage)(nil),                          // 19: protowire.RequestRelayBlocksMessage
-	(*RequestTransactionsMessage)(nil),                         // 20: protowire.RequestTransactionsMessage
-	(*TransactionNotFoundMessage)(nil),                         // 21: protowire.TransactionNotFoundMessage
-	(*InvRelayBlockMessage)(nil),                               // 22: protowire.InvRelayBlockMessage
-	(*InvTransactionsMessage)(nil),                             // 23: protowire.InvTransactionsMessage
-	(*PingMessage)(nil),                                        // 24: protowire.PingMessage
-	(*PongMessage)(nil),                                        // 25: protowire.PongMessage
-	(*VerackMessage)(nil),                                      // 26: protowire.VerackMessage
-	(*VersionMessage)(nil),                                     // 27: protowire.VersionMessage
-	(*RequestAntiFraudSnapshotV1Message)(nil),                  // 28: protowire.RequestAntiFraudSnapshotV1Message
-	(*AntiFraudSnapshotV1Message)(nil),                         // 29: protowire.AntiFraudSnapshotV1Message
-	(*RejectMessage)(nil),                                      // 30: protowire.RejectMessage
-	(*RequestPruningPointUTXOSetMessage)(nil),                  // 31: protowire.RequestPruningPointUTXOSetMessage
-	(*PruningPointUtxoSetChunkMessage)(nil),                    // 32: protowire.PruningPointUtxoSetChunkMessage
-	(*OutpointAndUtxoEntryPair)(nil),                           // 33: protowire.OutpointAndUtxoEntryPair
-	(*UtxoEntry)(nil),                                          // 34: protowire.UtxoEntry
-	(*RequestNextPruningPointUtxoSetChunkMessage)(nil),         // 35: protowire.RequestNextPruningPointUtxoSetChunkMessage
-	(*DonePruningPointUtxoSetChunksMessage)(nil),               // 36: protowire.DonePruningPointUtxoSetChunksMessage
-	(*RequestIBDBlocksMessage)(nil),                            // 37: protowire.RequestIBDBlocksMessage
-	(*UnexpectedPruningPointMessage)(nil),                      // 38: protowire.UnexpectedPruningPointMessage
-	(*IbdBlockLocatorMessage)(nil),                             // 39: protowire.IbdBlockLocatorMessage
-	(*RequestIBDChainBlockLocatorMessage)(nil),                 // 40: protowire.RequestIBDChainBlockLocatorMessage
-	(*IbdChainBlockLocatorMessage)(nil),                        // 41: protowire.IbdChainBlockLocatorMessage
-	(*RequestAnticoneMessage)(nil),                             // 42: protowire.RequestAnticoneMessage
-	(*IbdBlockLocatorHighestHashMessage)(nil),                  // 43: protowire.IbdBlockLocatorHighestHashMessage
-	(*IbdBlockLocatorHighestHashNotFoundMessage)(nil),          // 44: protowire.IbdBlockLocatorHighestHashNotFoundMessage
-	(*BlockHeadersMessage)(nil),                                // 45: protowire.BlockHeadersMessage
-	(*RequestPruningPointAndItsAnticoneMessage)(nil),           // 46: protowire.RequestPruningPointAndItsAnticoneMessage
-	(*RequestNextPruningPointAndItsAnticoneBlocksMessage)(nil), // 47: protowire.RequestNextPruningPointAndItsAnticoneBlocksMessage
-	(*BlockWithTrustedDataMessage)(nil),                        // 48: protowire.BlockWithTrustedDataMessage
-	(*DaaBlock)(nil),                                           // 49: protowire.DaaBlock
-	(*DaaBlockV4)(nil),                                         // 50: protowire.DaaBlockV4
-	(*BlockGhostdagDataHashPair)(nil),                          // 51: protowire.BlockGhostdagDataHashPair
-	(*GhostdagData)(nil),                                       // 52: protowire.GhostdagData
-	(*BluesAnticoneSizes)(nil),                                 // 53: protowire.BluesAnticoneSizes
-	(*DoneBlocksWithTrustedDataMessage)(nil),                   // 54: protowire.DoneBlocksWithTrustedDataMessage
-	(*PruningPointsMessage)(nil),                               // 55: protowire.PruningPointsMessage
-	(*RequestPruningPointProofMessage)(nil),                    // 56: protowire.RequestPruningPointProofMessage
-	(*PruningPointProofMessage)(nil),                           // 57: protowire.PruningPointProofMessage
-	(*PruningPointProofHeaderArray)(nil),                       // 58: protowire.PruningPointProofHeaderArray
-	(*ReadyMessage)(nil),                                       // 59: protowire.ReadyMessage
-	(*BlockWithTrustedDataV4Message)(nil),                      // 60: protowire.BlockWithTrustedDataV4Message
-	(*TrustedDataMessage)(nil),                                 // 61: protowire.TrustedDataMessage
-	(*TrustedAtomicStateChunkMessage)(nil),                     // 62: protowire.TrustedAtomicStateChunkMessage
-	(*RequestNextPruningPointAtomicStateChunkMessage)(nil),     // 63: protowire.RequestNextPruningPointAtomicStateChunkMessage
-	(*RequestConsensusAtomicStateHashMessage)(nil),             // 64: protowire.RequestConsensusAtomicStateHashMessage
-	(*ConsensusAtomicStateHashMessage)(nil),                    // 65: protowire.ConsensusAtomicStateHashMessage
-	(*RequestAtomicTokenStateHashMessage)(nil),                 // 66: protowire.RequestAtomicTokenStateHashMessage
-	(*AtomicTokenStateHashMessage)(nil),                        // 67: protowire.AtomicTokenStateHashMessage
-	(*RequestFastIntentsMessage)(nil),                          // 68: protowire.RequestFastIntentsMessage
-	(*FastIntentMessage)(nil),                                  // 69: protowire.FastIntentMessage
-	(*FastMicroblockMessage)(nil),                              // 70: protowire.FastMicroblockMessage
-	(*BlockProducerClaimV1Message)(nil),                        // 71: protowire.BlockProducerClaimV1Message
+	(*CompactBlockMessage)(nil),                                // 20: protowire.CompactBlockMessage
+	(*PrefilledTransactionMessage)(nil),                        // 21: protowire.PrefilledTransactionMessage
+	(*RequestBlockTransactionsMessage)(nil),                    // 22: protowire.RequestBlockTransactionsMessage
+	(*BlockTransactionsMessage)(nil),                           // 23: protowire.BlockTransactionsMessage
+	(*TransactionPackageMessage)(nil),                          // 24: protowire.TransactionPackageMessage
+	(*RequestTransactionsMessage)(nil),                         // 25: protowire.RequestTransactionsMessage
+	(*TransactionNotFoundMessage)(nil),                         // 26: protowire.TransactionNotFoundMessage
+	(*InvRelayBlockMessage)(nil),                               // 27: protowire.InvRelayBlockMessage
+	(*InvTransactionsMessage)(nil),                             // 28: protowire.InvTransactionsMessage
+	(*PingMessage)(nil),                                        // 29: protowire.PingMessage
+	(*PongMessage)(nil),                                        // 30: protowire.PongMessage
+	(*VerackMessage)(nil),                                      // 31: protowire.VerackMessage
+	(*VersionMessage)(nil),                                     // 32: protowire.VersionMessage
+	(*RequestAntiFraudSnapshotV1Message)(nil),                  // 33: protowire.RequestAntiFraudSnapshotV1Message
+	(*AntiFraudSnapshotV1Message)(nil),                         // 34: protowire.AntiFraudSnapshotV1Message
+	(*RejectMessage)(nil),                                      // 35: protowire.RejectMessage
+	(*RequestPruningPointUTXOSetMessage)(nil),                  // 36: protowire.RequestPruningPointUTXOSetMessage
+	(*PruningPointUtxoSetChunkMessage)(nil),                    // 37: protowire.PruningPointUtxoSetChunkMessage
+	(*OutpointAndUtxoEntryPair)(nil),                           // 38: protowire.OutpointAndUtxoEntryPair
+	(*UtxoEntry)(nil),                                          // 39: protowire.UtxoEntry
+	(*RequestNextPruningPointUtxoSetChunkMessage)(nil),         // 40: protowire.RequestNextPruningPointUtxoSetChunkMessage
+	(*DonePruningPointUtxoSetChunksMessage)(nil),               // 41: protowire.DonePruningPointUtxoSetChunksMessage
+	(*RequestIBDBlocksMessage)(nil),                            // 42: protowire.RequestIBDBlocksMessage
+	(*UnexpectedPruningPointMessage)(nil),                      // 43: protowire.UnexpectedPruningPointMessage
+	(*IbdBlockLocatorMessage)(nil),                             // 44: protowire.IbdBlockLocatorMessage
+	(*RequestIBDChainBlockLocatorMessage)(nil),                 // 45: protowire.RequestIBDChainBlockLocatorMessage
+	(*IbdChainBlockLocatorMessage)(nil),                        // 46: protowire.IbdChainBlockLocatorMessage
+	(*RequestAnticoneMessage)(nil),                             // 47: protowire.RequestAnticoneMessage
+	(*IbdBlockLocatorHighestHashMessage)(nil),                  // 48: protowire.IbdBlockLocatorHighestHashMessage
+	(*IbdBlockLocatorHighestHashNotFoundMessage)(nil),          // 49: protowire.IbdBlockLocatorHighestHashNotFoundMessage
+	(*BlockHeadersMessage)(nil),                                // 50: protowire.BlockHeadersMessage
+	(*RequestPruningPointAndItsAnticoneMessage)(nil),           // 51: protowire.RequestPruningPointAndItsAnticoneMessage
+	(*RequestNextPruningPointAndItsAnticoneBlocksMessage)(nil), // 52: protowire.RequestNextPruningPointAndItsAnticoneBlocksMessage
+	(*BlockWithTrustedDataMessage)(nil),                        // 53: protowire.BlockWithTrustedDataMessage
+	(*DaaBlock)(nil),                                           // 54: protowire.DaaBlock
+	(*DaaBlockV4)(nil),                                         // 55: protowire.DaaBlockV4
+	(*BlockGhostdagDataHashPair)(nil),                          // 56: protowire.BlockGhostdagDataHashPair
+	(*GhostdagData)(nil),                                       // 57: protowire.GhostdagData
+	(*BluesAnticoneSizes)(nil),                                 // 58: protowire.BluesAnticoneSizes
+	(*DoneBlocksWithTrustedDataMessage)(nil),                   // 59: protowire.DoneBlocksWithTrustedDataMessage
+	(*PruningPointsMessage)(nil),                               // 60: protowire.PruningPointsMessage
+	(*RequestPruningPointProofMessage)(nil),                    // 61: protowire.RequestPruningPointProofMessage
+	(*PruningPointProofMessage)(nil),                           // 62: protowire.PruningPointProofMessage
+	(*PruningPointProofHeaderArray)(nil),                       // 63: protowire.PruningPointProofHeaderArray
+	(*ReadyMessage)(nil),                                       // 64: protowire.ReadyMessage
+	(*BlockWithTrustedDataV4Message)(nil),                      // 65: protowire.BlockWithTrustedDataV4Message
+	(*TrustedDataMessage)(nil),                                 // 66: protowire.TrustedDataMessage
+	(*TrustedAtomicStateChunkMessage)(nil),                     // 67: protowire.TrustedAtomicStateChunkMessage
+	(*RequestNextPruningPointAtomicStateChunkMessage)(nil),     // 68: protowire.RequestNextPruningPointAtomicStateChunkMessage
+	(*RequestConsensusAtomicStateHashMessage)(nil),             // 69: protowire.RequestConsensusAtomicStateHashMessage
+	(*ConsensusAtomicStateHashMessage)(nil),                    // 70: protowire.ConsensusAtomicStateHashMessage
+	(*RequestAtomicTokenStateHashMessage)(nil),                 // 71: protowire.RequestAtomicTokenStateHashMessage
+	(*AtomicTokenStateHashMessage)(nil),                        // 72: protowire.AtomicTokenStateHashMessage
+	(*RequestFastIntentsMessage)(nil),                          // 73: protowire.RequestFastIntentsMessage
+	(*FastIntentMessage)(nil),                                  // 74: protowire.FastIntentMessage
+	(*FastMicroblockMessage)(nil),                              // 75: protowire.FastMicroblockMessage
+	(*BlockProducerClaimV1Message)(nil),                        // 76: protowire.BlockProducerClaimV1Message
 }
 var file_p2p_proto_depIdxs = []int32{
 	3,  // 0: protowire.RequestAddressesMessage.subnetworkId:type_name -> protowire.SubnetworkId
@@ -4275,60 +4585,67 @@ var file_p2p_proto_depIdxs = []int32{
 	13, // 18: protowire.RequestHeadersMessage.lowHash:type_name -> protowire.Hash
 	13, // 19: protowire.RequestHeadersMessage.highHash:type_name -> protowire.Hash
 	13, // 20: protowire.RequestRelayBlocksMessage.hashes:type_name -> protowire.Hash
-	7,  // 21: protowire.RequestTransactionsMessage.ids:type_name -> protowire.TransactionId
-	7,  // 22: protowire.TransactionNotFoundMessage.id:type_name -> protowire.TransactionId
-	13, // 23: protowire.InvRelayBlockMessage.hash:type_name -> protowire.Hash
-	7,  // 24: protowire.InvTransactionsMessage.ids:type_name -> protowire.TransactionId
-	2,  // 25: protowire.VersionMessage.address:type_name -> protowire.NetAddress
-	3,  // 26: protowire.VersionMessage.subnetworkId:type_name -> protowire.SubnetworkId
-	13, // 27: protowire.RequestPruningPointUTXOSetMessage.pruningPointHash:type_name -> protowire.Hash
-	33, // 28: protowire.PruningPointUtxoSetChunkMessage.outpointAndUtxoEntryPairs:type_name -> protowire.OutpointAndUtxoEntryPair
-	6,  // 29: protowire.OutpointAndUtxoEntryPair.outpoint:type_name -> protowire.Outpoint
-	34, // 30: protowire.OutpointAndUtxoEntryPair.utxoEntry:type_name -> protowire.UtxoEntry
-	8,  // 31: protowire.UtxoEntry.scriptPublicKey:type_name -> protowire.ScriptPublicKey
-	13, // 32: protowire.RequestIBDBlocksMessage.hashes:type_name -> protowire.Hash
-	13, // 33: protowire.IbdBlockLocatorMessage.targetHash:type_name -> protowire.Hash
-	13, // 34: protowire.IbdBlockLocatorMessage.blockLocatorHashes:type_name -> protowire.Hash
-	13, // 35: protowire.RequestIBDChainBlockLocatorMessage.lowHash:type_name -> protowire.Hash
-	13, // 36: protowire.RequestIBDChainBlockLocatorMessage.highHash:type_name -> protowire.Hash
-	13, // 37: protowire.IbdChainBlockLocatorMessage.blockLocatorHashes:type_name -> protowire.Hash
-	13, // 38: protowire.RequestAnticoneMessage.blockHash:type_name -> protowire.Hash
-	13, // 39: protowire.RequestAnticoneMessage.contextHash:type_name -> protowire.Hash
-	13, // 40: protowire.IbdBlockLocatorHighestHashMessage.highestHash:type_name -> protowire.Hash
-	11, // 41: protowire.BlockHeadersMessage.blockHeaders:type_name -> protowire.BlockHeader
-	10, // 42: protowire.BlockWithTrustedDataMessage.block:type_name -> protowire.BlockMessage
-	49, // 43: protowire.BlockWithTrustedDataMessage.daaWindow:type_name -> protowire.DaaBlock
-	51, // 44: protowire.BlockWithTrustedDataMessage.ghostdagData:type_name -> protowire.BlockGhostdagDataHashPair
-	10, // 45: protowire.DaaBlock.block:type_name -> protowire.BlockMessage
-	52, // 46: protowire.DaaBlock.ghostdagData:type_name -> protowire.GhostdagData
-	11, // 47: protowire.DaaBlockV4.header:type_name -> protowire.BlockHeader
-	52, // 48: protowire.DaaBlockV4.ghostdagData:type_name -> protowire.GhostdagData
-	13, // 49: protowire.BlockGhostdagDataHashPair.hash:type_name -> protowire.Hash
-	52, // 50: protowire.BlockGhostdagDataHashPair.ghostdagData:type_name -> protowire.GhostdagData
-	13, // 51: protowire.GhostdagData.selectedParent:type_name -> protowire.Hash
-	13, // 52: protowire.GhostdagData.mergeSetBlues:type_name -> protowire.Hash
-	13, // 53: protowire.GhostdagData.mergeSetReds:type_name -> protowire.Hash
-	53, // 54: protowire.GhostdagData.bluesAnticoneSizes:type_name -> protowire.BluesAnticoneSizes
-	13, // 55: protowire.BluesAnticoneSizes.blueHash:type_name -> protowire.Hash
-	11, // 56: protowire.PruningPointsMessage.headers:type_name -> protowire.BlockHeader
-	58, // 57: protowire.PruningPointProofMessage.headers:type_name -> protowire.PruningPointProofHeaderArray
-	11, // 58: protowire.PruningPointProofHeaderArray.headers:type_name -> protowire.BlockHeader
-	10, // 59: protowire.BlockWithTrustedDataV4Message.block:type_name -> protowire.BlockMessage
-	50, // 60: protowire.TrustedDataMessage.daaWindow:type_name -> protowire.DaaBlockV4
-	51, // 61: protowire.TrustedDataMessage.ghostdagData:type_name -> protowire.BlockGhostdagDataHashPair
-	13, // 62: protowire.RequestConsensusAtomicStateHashMessage.blockHash:type_name -> protowire.Hash
-	13, // 63: protowire.ConsensusAtomicStateHashMessage.blockHash:type_name -> protowire.Hash
-	13, // 64: protowire.RequestAtomicTokenStateHashMessage.blockHash:type_name -> protowire.Hash
-	13, // 65: protowire.AtomicTokenStateHashMessage.blockHash:type_name -> protowire.Hash
-	13, // 66: protowire.RequestFastIntentsMessage.intentIds:type_name -> protowire.Hash
-	13, // 67: protowire.FastIntentMessage.intentId:type_name -> protowire.Hash
-	4,  // 68: protowire.FastIntentMessage.baseTransaction:type_name -> protowire.TransactionMessage
-	13, // 69: protowire.FastMicroblockMessage.intentIds:type_name -> protowire.Hash
-	70, // [70:70] is the sub-list for method output_type
-	70, // [70:70] is the sub-list for method input_type
-	70, // [70:70] is the sub-list for extension type_name
-	70, // [70:70] is the sub-list for extension extendee
-	0,  // [0:70] is the sub-list for field type_name
+	11, // 21: protowire.CompactBlockMessage.header:type_name -> protowire.BlockHeader
+	21, // 22: protowire.CompactBlockMessage.prefilledTransactions:type_name -> protowire.PrefilledTransactionMessage
+	4,  // 23: protowire.PrefilledTransactionMessage.transaction:type_name -> protowire.TransactionMessage
+	13, // 24: protowire.RequestBlockTransactionsMessage.blockHash:type_name -> protowire.Hash
+	13, // 25: protowire.BlockTransactionsMessage.blockHash:type_name -> protowire.Hash
+	4,  // 26: protowire.BlockTransactionsMessage.transactions:type_name -> protowire.TransactionMessage
+	4,  // 27: protowire.TransactionPackageMessage.transactions:type_name -> protowire.TransactionMessage
+	7,  // 28: protowire.RequestTransactionsMessage.ids:type_name -> protowire.TransactionId
+	7,  // 29: protowire.TransactionNotFoundMessage.id:type_name -> protowire.TransactionId
+	13, // 30: protowire.InvRelayBlockMessage.hash:type_name -> protowire.Hash
+	7,  // 31: protowire.InvTransactionsMessage.ids:type_name -> protowire.TransactionId
+	2,  // 32: protowire.VersionMessage.address:type_name -> protowire.NetAddress
+	3,  // 33: protowire.VersionMessage.subnetworkId:type_name -> protowire.SubnetworkId
+	13, // 34: protowire.RequestPruningPointUTXOSetMessage.pruningPointHash:type_name -> protowire.Hash
+	38, // 35: protowire.PruningPointUtxoSetChunkMessage.outpointAndUtxoEntryPairs:type_name -> protowire.OutpointAndUtxoEntryPair
+	6,  // 36: protowire.OutpointAndUtxoEntryPair.outpoint:type_name -> protowire.Outpoint
+	39, // 37: protowire.OutpointAndUtxoEntryPair.utxoEntry:type_name -> protowire.UtxoEntry
+	8,  // 38: protowire.UtxoEntry.scriptPublicKey:type_name -> protowire.ScriptPublicKey
+	13, // 39: protowire.RequestIBDBlocksMessage.hashes:type_name -> protowire.Hash
+	13, // 40: protowire.IbdBlockLocatorMessage.targetHash:type_name -> protowire.Hash
+	13, // 41: protowire.IbdBlockLocatorMessage.blockLocatorHashes:type_name -> protowire.Hash
+	13, // 42: protowire.RequestIBDChainBlockLocatorMessage.lowHash:type_name -> protowire.Hash
+	13, // 43: protowire.RequestIBDChainBlockLocatorMessage.highHash:type_name -> protowire.Hash
+	13, // 44: protowire.IbdChainBlockLocatorMessage.blockLocatorHashes:type_name -> protowire.Hash
+	13, // 45: protowire.RequestAnticoneMessage.blockHash:type_name -> protowire.Hash
+	13, // 46: protowire.RequestAnticoneMessage.contextHash:type_name -> protowire.Hash
+	13, // 47: protowire.IbdBlockLocatorHighestHashMessage.highestHash:type_name -> protowire.Hash
+	11, // 48: protowire.BlockHeadersMessage.blockHeaders:type_name -> protowire.BlockHeader
+	10, // 49: protowire.BlockWithTrustedDataMessage.block:type_name -> protowire.BlockMessage
+	54, // 50: protowire.BlockWithTrustedDataMessage.daaWindow:type_name -> protowire.DaaBlock
+	56, // 51: protowire.BlockWithTrustedDataMessage.ghostdagData:type_name -> protowire.BlockGhostdagDataHashPair
+	10, // 52: protowire.DaaBlock.block:type_name -> protowire.BlockMessage
+	57, // 53: protowire.DaaBlock.ghostdagData:type_name -> protowire.GhostdagData
+	11, // 54: protowire.DaaBlockV4.header:type_name -> protowire.BlockHeader
+	57, // 55: protowire.DaaBlockV4.ghostdagData:type_name -> protowire.GhostdagData
+	13, // 56: protowire.BlockGhostdagDataHashPair.hash:type_name -> protowire.Hash
+	57, // 57: protowire.BlockGhostdagDataHashPair.ghostdagData:type_name -> protowire.GhostdagData
+	13, // 58: protowire.GhostdagData.selectedParent:type_name -> protowire.Hash
+	13, // 59: protowire.GhostdagData.mergeSetBlues:type_name -> protowire.Hash
+	13, // 60: protowire.GhostdagData.mergeSetReds:type_name -> protowire.Hash
+	58, // 61: protowire.GhostdagData.bluesAnticoneSizes:type_name -> protowire.BluesAnticoneSizes
+	13, // 62: protowire.BluesAnticoneSizes.blueHash:type_name -> protowire.Hash
+	11, // 63: protowire.PruningPointsMessage.headers:type_name -> protowire.BlockHeader
+	63, // 64: protowire.PruningPointProofMessage.headers:type_name -> protowire.PruningPointProofHeaderArray
+	11, // 65: protowire.PruningPointProofHeaderArray.headers:type_name -> protowire.BlockHeader
+	10, // 66: protowire.BlockWithTrustedDataV4Message.block:type_name -> protowire.BlockMessage
+	55, // 67: protowire.TrustedDataMessage.daaWindow:type_name -> protowire.DaaBlockV4
+	56, // 68: protowire.TrustedDataMessage.ghostdagData:type_name -> protowire.BlockGhostdagDataHashPair
+	13, // 69: protowire.RequestConsensusAtomicStateHashMessage.blockHash:type_name -> protowire.Hash
+	13, // 70: protowire.ConsensusAtomicStateHashMessage.blockHash:type_name -> protowire.Hash
+	13, // 71: protowire.RequestAtomicTokenStateHashMessage.blockHash:type_name -> protowire.Hash
+	13, // 72: protowire.AtomicTokenStateHashMessage.blockHash:type_name -> protowire.Hash
+	13, // 73: protowire.RequestFastIntentsMessage.intentIds:type_name -> protowire.Hash
+	13, // 74: protowire.FastIntentMessage.intentId:type_name -> protowire.Hash
+	4,  // 75: protowire.FastIntentMessage.baseTransaction:type_name -> protowire.TransactionMessage
+	13, // 76: protowire.FastMicroblockMessage.intentIds:type_name -> protowire.Hash
+	77, // [77:77] is the sub-list for method output_type
+	77, // [77:77] is the sub-list for method input_type
+	77, // [77:77] is the sub-list for extension type_name
+	77, // [77:77] is the sub-list for extension extendee
+	0,  // [0:77] is the sub-list for field type_name
 }
 
 func init() { file_p2p_proto_init() }
@@ -4336,15 +4653,15 @@ func file_p2p_proto_init() {
 	if File_p2p_proto != nil {
 		return
 	}
-	file_p2p_proto_msgTypes[27].OneofWrappers = []any{}
-	file_p2p_proto_msgTypes[71].OneofWrappers = []any{}
+	file_p2p_proto_msgTypes[32].OneofWrappers = []any{}
+	file_p2p_proto_msgTypes[76].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_p2p_proto_rawDesc), len(file_p2p_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   72,
+			NumMessages:   77,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,151 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_CompactBlock) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_CompactBlock is nil")
+	}
+	return x.CompactBlock.toAppMessage()
+}
+
+func (x *CompactBlockMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CompactBlockMessage is nil")
+	}
+	transactionCount := len(x.ShortIds) + len(x.PrefilledTransactions)
+	if transactionCount > appmessage.MaxCompactBlockTransactions {
+		return nil, errors.Errorf("too many transactions for message "+
+			"[count %d, max %d]", transactionCount, appmessage.MaxCompactBlockTransactions)
+	}
+	header, err := x.Header.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	prefilledTransactions := make([]*appmessage.PrefilledTransaction, len(x.PrefilledTransactions))
+	for i, protoPrefilledTransaction := range x.PrefilledTransactions {
+		if protoPrefilledTransaction == nil {
+			return nil, errors.Wrapf(errorNil, "PrefilledTransactionMessage is nil")
+		}
+		msgTx, err := protoPrefilledTransaction.Transaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		prefilledTransactions[i] = &appmessage.PrefilledTransaction{
+			Index:       protoPrefilledTransaction.Index,
+			Transaction: msgTx.(*appmessage.MsgTx),
+		}
+	}
+
+	return appmessage.NewMsgCompactBlock(header, x.ShortIdNonce, x.ShortIds, prefilledTransactions), nil
+}
+
+func (x *CryptixdMessage_CompactBlock) fromAppMessage(message *appmessage.MsgCompactBlock) error {
+	transactionCount := len(message.ShortIDs) + len(message.PrefilledTransactions)
+	if transactionCount > appmessage.MaxCompactBlockTransactions {
+		return errors.Errorf("too many transactions for message "+
+			"[count %d, max %d]", transactionCount, appmessage.MaxCompactBlockTransactions)
+	}
+	protoHeader := new(BlockHeader)
+	err := protoHeader.fromAppMessage(&message.Header)
+	if err != nil {
+		return err
+	}
+
+	protoPrefilledTransactions := make([]*PrefilledTransactionMessage, len(message.PrefilledTransactions))
+	for i, prefilledTransaction := range message.PrefilledTransactions {
+		protoTx := new(TransactionMessage)
+		protoTx.fromAppMessage(prefilledTransaction.Transaction)
+		protoPrefilledTransactions[i] = &PrefilledTransactionMessage{
+			Index:       prefilledTransaction.Index,
+			Transaction: protoTx,
+		}
+	}
+
+	x.CompactBlock = &CompactBlockMessage{
+		Header:                protoHeader,
+		ShortIdNonce:          message.ShortIDNonce,
+		ShortIds:              append([]uint64(nil), message.ShortIDs...),
+		PrefilledTransactions: protoPrefilledTransactions,
+	}
+	return nil
+}
+
+func (x *CryptixdMessage_RequestBlockTransactions) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_RequestBlockTransactions is nil")
+	}
+	if x.RequestBlockTransactions == nil {
+		return nil, errors.Wrapf(errorNil, "RequestBlockTransactionsMessage is nil")
+	}
+	if len(x.RequestBlockTransactions.Indexes) > appmessage.MaxCompactBlockTransactions {
+		return nil, errors.Errorf("too many indexes for message "+
+			"[count %d, max %d]", len(x.RequestBlockTransactions.Indexes), appmessage.MaxCompactBlockTransactions)
+	}
+	blockHash, err := x.RequestBlockTransactions.BlockHash.toDomain()
+	if err != nil {
+		return nil, err
+	}
+	return appmessage.NewMsgRequestBlockTransactions(blockHash, x.RequestBlockTransactions.Indexes), nil
+}
+
+func (x *CryptixdMessage_RequestBlockTransactions) fromAppMessage(message *appmessage.MsgRequestBlockTransactions) error {
+	if len(message.Indexes) > appmessage.MaxCompactBlockTransactions {
+		return errors.Errorf("too many indexes for message "+
+			"[count %d, max %d]", len(message.Indexes), appmessage.MaxCompactBlockTransactions)
+	}
+	x.RequestBlockTransactions = &RequestBlockTransactionsMessage{
+		BlockHash: domainHashToProto(message.BlockHash),
+		Indexes:   append([]uint32(nil), message.Indexes...),
+	}
+	return nil
+}
+
+func (x *CryptixdMessage_BlockTransactions) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_BlockTransactions is nil")
+	}
+	if x.BlockTransactions == nil {
+		return nil, errors.Wrapf(errorNil, "BlockTransactionsMessage is nil")
+	}
+	if len(x.BlockTransactions.Transactions) > appmessage.MaxCompactBlockTransactions {
+		return nil, errors.Errorf("too many transactions for message "+
+			"[count %d, max %d]", len(x.BlockTransactions.Transactions), appmessage.MaxCompactBlockTransactions)
+	}
+	blockHash, err := x.BlockTransactions.BlockHash.toDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*appmessage.MsgTx, len(x.BlockTransactions.Transactions))
+	for i, protoTx := range x.BlockTransactions.Transactions {
+		msgTx, err := protoTx.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = msgTx.(*appmessage.MsgTx)
+	}
+	return appmessage.NewMsgBlockTransactions(blockHash, transactions), nil
+}
+
+func (x *CryptixdMessage_BlockTransactions) fromAppMessage(message *appmessage.MsgBlockTransactions) error {
+	if len(message.Transactions) > appmessage.MaxCompactBlockTransactions {
+		return errors.Errorf("too many transactions for message "+
+			"[count %d, max %d]", len(message.Transactions), appmessage.MaxCompactBlockTransactions)
+	}
+	protoTransactions := make([]*TransactionMessage, len(message.Transactions))
+	for i, tx := range message.Transactions {
+		protoTx := new(TransactionMessage)
+		protoTx.fromAppMessage(tx)
+		protoTransactions[i] = protoTx
+	}
+	x.BlockTransactions = &BlockTransactionsMessage{
+		BlockHash:    domainHashToProto(message.BlockHash),
+		Transactions: protoTransactions,
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ func (x *RequestAddressesMessage) toAppMessage() (appmessage.Message, error) {
 	return &appmessage.MsgRequestAddresses{
 		IncludeAllSubnetworks: x.IncludeAllSubnetworks,
 		SubnetworkID:          subnetworkID,
+		IncludeOnionAddresses: x.IncludeOnionAddresses,
 	}, nil
 
 }
@@ -33,6 +34,7 @@ func (x *CryptixdMessage_RequestAddresses) fromAppMessage(msgGetAddresses *appme
 	x.RequestAddresses = &RequestAddressesMessage{
 		IncludeAllSubnetworks: msgGetAddresses.IncludeAllSubnetworks,
 		SubnetworkId:          domainSubnetworkIDToProto(msgGetAddresses.SubnetworkID),
+		IncludeOnionAddresses: msgGetAddresses.IncludeOnionAddresses,
 	}
 	return nil
 }
@@ -0,0 +1,50 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_TransactionPackage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_TransactionPackage is nil")
+	}
+	return x.TransactionPackage.toAppMessage()
+}
+
+func (x *TransactionPackageMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "TransactionPackageMessage is nil")
+	}
+	if len(x.Transactions) > appmessage.MaxTransactionPackageTransactions {
+		return nil, errors.Errorf("too many transactions for message "+
+			"[count %d, max %d]", len(x.Transactions), appmessage.MaxTransactionPackageTransactions)
+	}
+
+	transactions := make([]*appmessage.MsgTx, len(x.Transactions))
+	for i, protoTx := range x.Transactions {
+		msgTx, err := protoTx.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = msgTx.(*appmessage.MsgTx)
+	}
+	return appmessage.NewMsgTransactionPackage(transactions), nil
+}
+
+func (x *CryptixdMessage_TransactionPackage) fromAppMessage(message *appmessage.MsgTransactionPackage) error {
+	if len(message.Transactions) > appmessage.MaxTransactionPackageTransactions {
+		return errors.Errorf("too many transactions for message "+
+			"[count %d, max %d]", len(message.Transactions), appmessage.MaxTransactionPackageTransactions)
+	}
+	protoTransactions := make([]*TransactionMessage, len(message.Transactions))
+	for i, tx := range message.Transactions {
+		protoTx := new(TransactionMessage)
+		protoTx.fromAppMessage(tx)
+		protoTransactions[i] = protoTx
+	}
+	x.TransactionPackage = &TransactionPackageMessage{
+		Transactions: protoTransactions,
+	}
+	return nil
+}
@@ -2130,6 +2130,9 @@ type GetConnectedPeerInfoMessage struct {
 	IsIbdPeer bool `protobuf:"varint,11,opt,name=isIbdPeer,proto3" json:"isIbdPeer,omitempty"`
 	// Unified node identity advertised during handshake (hex-encoded BLAKE3-256 over x-only pubkey).
 	UnifiedNodeId string `protobuf:"bytes,12,opt,name=unifiedNodeId,proto3" json:"unifiedNodeId,omitempty"`
+	// The current ban score of this peer. The peer gets banned once it reaches
+	// --banthreshold. Scores decay over time.
+	BanScore      uint32 `protobuf:"varint,18,opt,name=banScore,proto3" json:"banScore,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2269,6 +2272,13 @@ func (x *GetConnectedPeerInfoMessage) GetUnifiedNodeId() string {
 	return ""
 }
 
+func (x *GetConnectedPeerInfoMessage) GetBanScore() uint32 {
+	if x != nil {
+		return x.BanScore
+	}
+	return 0
+}
+
 // AddPeerRequestMessage adds a peer to cryptixd's outgoing connection list.
 // This will, in most cases, result in cryptixd connecting to said peer.
 type AddPeerRequestMessage struct {
@@ -7839,256 +7849,4570 @@ func (x *SubmitTransactionReplacementResponseMessage) GetError() *RPCError {
 	return nil
 }
 
-var File_rpc_proto protoreflect.FileDescriptor
+// RpcAtomicAsset describes a CAT asset as recorded in the Atomic consensus state.
+//
+// Token amounts are unsigned 128-bit integers and are therefore encoded as decimal strings.
+type RpcAtomicAsset struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AssetId              string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	CreatorOwnerId       string                 `protobuf:"bytes,2,opt,name=creatorOwnerId,proto3" json:"creatorOwnerId,omitempty"`
+	AssetClass           uint32                 `protobuf:"varint,3,opt,name=assetClass,proto3" json:"assetClass,omitempty"`
+	TokenVersion         uint32                 `protobuf:"varint,4,opt,name=tokenVersion,proto3" json:"tokenVersion,omitempty"`
+	MintAuthorityOwnerId string                 `protobuf:"bytes,5,opt,name=mintAuthorityOwnerId,proto3" json:"mintAuthorityOwnerId,omitempty"`
+	Decimals             uint32                 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	SupplyMode           uint32                 `protobuf:"varint,7,opt,name=supplyMode,proto3" json:"supplyMode,omitempty"`
+	MaxSupply            string                 `protobuf:"bytes,8,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
+	TotalSupply          string                 `protobuf:"bytes,9,opt,name=totalSupply,proto3" json:"totalSupply,omitempty"`
+	Name                 string                 `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol               string                 `protobuf:"bytes,11,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Hex-encoded, since asset metadata is not required to be valid UTF-8
+	Metadata         string            `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PlatformTag      string            `protobuf:"bytes,13,opt,name=platformTag,proto3" json:"platformTag,omitempty"`
+	CreatedBlockHash string            `protobuf:"bytes,14,opt,name=createdBlockHash,proto3" json:"createdBlockHash,omitempty"`
+	CreatedDaaScore  uint64            `protobuf:"varint,15,opt,name=createdDaaScore,proto3" json:"createdDaaScore,omitempty"`
+	CreatedAt        uint64            `protobuf:"varint,16,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Liquidity        *RpcLiquidityPool `protobuf:"bytes,17,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
 
-const file_rpc_proto_rawDesc = "" +
-	"\n" +
-	"\trpc.proto\x12\tprotowire\"$\n" +
-	"\bRPCError\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xbe\x01\n" +
-	"\bRpcBlock\x121\n" +
-	"\x06header\x18\x01 \x01(\v2\x19.protowire.RpcBlockHeaderR\x06header\x12=\n" +
-	"\ftransactions\x18\x02 \x03(\v2\x19.protowire.RpcTransactionR\ftransactions\x12@\n" +
-	"\vverboseData\x18\x03 \x01(\v2\x1e.protowire.RpcBlockVerboseDataR\vverboseData\"\xab\x03\n" +
-	"\x0eRpcBlockHeader\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\rR\aversion\x129\n" +
-	"\aparents\x18\f \x03(\v2\x1f.protowire.RpcBlockLevelParentsR\aparents\x12&\n" +
-	"\x0ehashMerkleRoot\x18\x03 \x01(\tR\x0ehashMerkleRoot\x122\n" +
-	"\x14acceptedIdMerkleRoot\x18\x04 \x01(\tR\x14acceptedIdMerkleRoot\x12&\n" +
-	"\x0eutxoCommitment\x18\x05 \x01(\tR\x0eutxoCommitment\x12\x1c\n" +
-	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12\x12\n" +
-	"\x04bits\x18\a \x01(\rR\x04bits\x12\x14\n" +
-	"\x05nonce\x18\b \x01(\x04R\x05nonce\x12\x1a\n" +
-	"\bdaaScore\x18\t \x01(\x04R\bdaaScore\x12\x1a\n" +
-	"\bblueWork\x18\n" +
-	" \x01(\tR\bblueWork\x12\"\n" +
-	"\fpruningPoint\x18\x0e \x01(\tR\fpruningPoint\x12\x1c\n" +
-	"\tblueScore\x18\r \x01(\x04R\tblueScore\":\n" +
-	"\x14RpcBlockLevelParents\x12\"\n" +
-	"\fparentHashes\x18\x01 \x03(\tR\fparentHashes\"\x91\x03\n" +
-	"\x13RpcBlockVerboseData\x12\x12\n" +
-	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1e\n" +
-	"\n" +
-	"difficulty\x18\v \x01(\x01R\n" +
-	"difficulty\x12.\n" +
-	"\x12selectedParentHash\x18\r \x01(\tR\x12selectedParentHash\x12&\n" +
-	"\x0etransactionIds\x18\x0e \x03(\tR\x0etransactionIds\x12\"\n" +
-	"\fisHeaderOnly\x18\x0f \x01(\bR\fisHeaderOnly\x12\x1c\n" +
-	"\tblueScore\x18\x10 \x01(\x04R\tblueScore\x12&\n" +
-	"\x0echildrenHashes\x18\x11 \x03(\tR\x0echildrenHashes\x120\n" +
-	"\x13mergeSetBluesHashes\x18\x12 \x03(\tR\x13mergeSetBluesHashes\x12.\n" +
-	"\x12mergeSetRedsHashes\x18\x13 \x03(\tR\x12mergeSetRedsHashes\x12\"\n" +
-	"\fisChainBlock\x18\x14 \x01(\bR\fisChainBlock\"\xd1\x02\n" +
-	"\x0eRpcTransaction\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\rR\aversion\x126\n" +
-	"\x06inputs\x18\x02 \x03(\v2\x1e.protowire.RpcTransactionInputR\x06inputs\x129\n" +
-	"\aoutputs\x18\x03 \x03(\v2\x1f.protowire.RpcTransactionOutputR\aoutputs\x12\x1a\n" +
-	"\blockTime\x18\x04 \x01(\x04R\blockTime\x12\"\n" +
-	"\fsubnetworkId\x18\x05 \x01(\tR\fsubnetworkId\x12\x10\n" +
-	"\x03gas\x18\x06 \x01(\x04R\x03gas\x12\x18\n" +
-	"\apayload\x18\b \x01(\tR\apayload\x12F\n" +
-	"\vverboseData\x18\t \x01(\v2$.protowire.RpcTransactionVerboseDataR\vverboseData\"\x8c\x02\n" +
-	"\x13RpcTransactionInput\x12B\n" +
-	"\x10previousOutpoint\x18\x01 \x01(\v2\x16.protowire.RpcOutpointR\x10previousOutpoint\x12(\n" +
-	"\x0fsignatureScript\x18\x02 \x01(\tR\x0fsignatureScript\x12\x1a\n" +
-	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12\x1e\n" +
-	"\n" +
-	"sigOpCount\x18\x05 \x01(\rR\n" +
-	"sigOpCount\x12K\n" +
-	"\vverboseData\x18\x04 \x01(\v2).protowire.RpcTransactionInputVerboseDataR\vverboseData\"X\n" +
-	"\x12RpcScriptPublicKey\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\rR\aversion\x12(\n" +
-	"\x0fscriptPublicKey\x18\x02 \x01(\tR\x0fscriptPublicKey\"\xc5\x01\n" +
-	"\x14RpcTransactionOutput\x12\x16\n" +
-	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
-	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12L\n" +
-	"\vverboseData\x18\x03 \x01(\v2*.protowire.RpcTransactionOutputVerboseDataR\vverboseData\"I\n" +
-	"\vRpcOutpoint\x12$\n" +
-	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x14\n" +
-	"\x05index\x18\x02 \x01(\rR\x05index\"\xb5\x01\n" +
-	"\fRpcUtxoEntry\x12\x16\n" +
-	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
-	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12$\n" +
-	"\rblockDaaScore\x18\x03 \x01(\x04R\rblockDaaScore\x12\x1e\n" +
-	"\n" +
-	"isCoinbase\x18\x04 \x01(\bR\n" +
-	"isCoinbase\"\xa5\x01\n" +
-	"\x19RpcTransactionVerboseData\x12$\n" +
-	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x12\n" +
-	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x12\n" +
-	"\x04mass\x18\x04 \x01(\x04R\x04mass\x12\x1c\n" +
-	"\tblockHash\x18\f \x01(\tR\tblockHash\x12\x1c\n" +
-	"\tblockTime\x18\x0e \x01(\x04R\tblockTime\" \n" +
-	"\x1eRpcTransactionInputVerboseData\"\x8b\x01\n" +
-	"\x1fRpcTransactionOutputVerboseData\x120\n" +
-	"\x13scriptPublicKeyType\x18\x05 \x01(\tR\x13scriptPublicKeyType\x126\n" +
-	"\x16scriptPublicKeyAddress\x18\x06 \x01(\tR\x16scriptPublicKeyAddress\"!\n" +
-	"\x1fGetCurrentNetworkRequestMessage\"v\n" +
-	" GetCurrentNetworkResponseMessage\x12&\n" +
-	"\x0ecurrentNetwork\x18\x01 \x01(\tR\x0ecurrentNetwork\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"t\n" +
-	"\x19SubmitBlockRequestMessage\x12)\n" +
-	"\x05block\x18\x02 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12,\n" +
-	"\x11allowNonDAABlocks\x18\x03 \x01(\bR\x11allowNonDAABlocks\"\xdc\x01\n" +
-	"\x1aSubmitBlockResponseMessage\x12V\n" +
-	"\frejectReason\x18\x01 \x01(\x0e22.protowire.SubmitBlockResponseMessage.RejectReasonR\frejectReason\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\":\n" +
-	"\fRejectReason\x12\b\n" +
-	"\x04NONE\x10\x00\x12\x11\n" +
-	"\rBLOCK_INVALID\x10\x01\x12\r\n" +
-	"\tIS_IN_IBD\x10\x02\"^\n" +
-	"\x1eGetBlockTemplateRequestMessage\x12\x1e\n" +
-	"\n" +
-	"payAddress\x18\x01 \x01(\tR\n" +
-	"payAddress\x12\x1c\n" +
-	"\textraData\x18\x02 \x01(\tR\textraData\"\x94\x01\n" +
-	"\x1fGetBlockTemplateResponseMessage\x12)\n" +
-	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12\x1a\n" +
-	"\bisSynced\x18\x02 \x01(\bR\bisSynced\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\" \n" +
-	"\x1eNotifyBlockAddedRequestMessage\"M\n" +
-	"\x1fNotifyBlockAddedResponseMessage\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"J\n" +
-	"\x1dBlockAddedNotificationMessage\x12)\n" +
-	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\" \n" +
-	"\x1eGetPeerAddressesRequestMessage\"\xf5\x01\n" +
-	"\x1fGetPeerAddressesResponseMessage\x12L\n" +
-	"\taddresses\x18\x01 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\taddresses\x12X\n" +
-	"\x0fbannedAddresses\x18\x02 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\x0fbannedAddresses\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"9\n" +
-	"#GetPeerAddressesKnownAddressMessage\x12\x12\n" +
-	"\x04Addr\x18\x01 \x01(\tR\x04Addr\"\"\n" +
-	" GetSelectedTipHashRequestMessage\"y\n" +
-	"!GetSelectedTipHashResponseMessage\x12(\n" +
-	"\x0fselectedTipHash\x18\x01 \x01(\tR\x0fselectedTipHash\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x97\x01\n" +
-	"\x1dGetMempoolEntryRequestMessage\x12\x12\n" +
-	"\x04txId\x18\x01 \x01(\tR\x04txId\x12,\n" +
-	"\x11includeOrphanPool\x18\x02 \x01(\bR\x11includeOrphanPool\x124\n" +
-	"\x15filterTransactionPool\x18\x03 \x01(\bR\x15filterTransactionPool\"{\n" +
-	"\x1eGetMempoolEntryResponseMessage\x12-\n" +
-	"\x05entry\x18\x01 \x01(\v2\x17.protowire.MempoolEntryR\x05entry\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x85\x01\n" +
-	"\x1fGetMempoolEntriesRequestMessage\x12,\n" +
-	"\x11includeOrphanPool\x18\x01 \x01(\bR\x11includeOrphanPool\x124\n" +
-	"\x15filterTransactionPool\x18\x02 \x01(\bR\x15filterTransactionPool\"\x81\x01\n" +
-	" GetMempoolEntriesResponseMessage\x121\n" +
-	"\aentries\x18\x01 \x03(\v2\x17.protowire.MempoolEntryR\aentries\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"y\n" +
-	"\fMempoolEntry\x12\x10\n" +
-	"\x03fee\x18\x01 \x01(\x04R\x03fee\x12;\n" +
-	"\vtransaction\x18\x03 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12\x1a\n" +
-	"\bisOrphan\x18\x04 \x01(\bR\bisOrphan\"$\n" +
-	"\"GetConnectedPeerInfoRequestMessage\"\x8f\x01\n" +
-	"#GetConnectedPeerInfoResponseMessage\x12<\n" +
-	"\x05infos\x18\x01 \x03(\v2&.protowire.GetConnectedPeerInfoMessageR\x05infos\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xcb\x04\n" +
-	"\x1bGetConnectedPeerInfoMessage\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\x12*\n" +
-	"\x10lastPingDuration\x18\x03 \x01(\x03R\x10lastPingDuration\x12\x1e\n" +
-	"\n" +
-	"isOutbound\x18\x06 \x01(\bR\n" +
-	"isOutbound\x12\x1e\n" +
-	"\n" +
-	"timeOffset\x18\a \x01(\x03R\n" +
-	"timeOffset\x12\x1c\n" +
-	"\tuserAgent\x18\b \x01(\tR\tuserAgent\x12<\n" +
-	"\x19advertisedProtocolVersion\x18\t \x01(\rR\x19advertisedProtocolVersion\x12.\n" +
-	"\x12advertisedServices\x18\r \x01(\x04R\x12advertisedServices\x12&\n" +
-	"\x0eisHfaFastchain\x18\x0e \x01(\bR\x0eisHfaFastchain\x12(\n" +
-	"\x0fisCryptixAtomic\x18\x0f \x01(\bR\x0fisCryptixAtomic\x12.\n" +
-	"\x12isStrongNodeClaims\x18\x10 \x01(\bR\x12isStrongNodeClaims\x12\x1e\n" +
-	"\n" +
-	"isArchival\x18\x11 \x01(\bR\n" +
-	"isArchival\x12$\n" +
-	"\rtimeConnected\x18\n" +
-	" \x01(\x03R\rtimeConnected\x12\x1c\n" +
-	"\tisIbdPeer\x18\v \x01(\bR\tisIbdPeer\x12$\n" +
-	"\runifiedNodeId\x18\f \x01(\tR\runifiedNodeId\"S\n" +
-	"\x15AddPeerRequestMessage\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12 \n" +
-	"\visPermanent\x18\x02 \x01(\bR\visPermanent\"D\n" +
-	"\x16AddPeerResponseMessage\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x80\x01\n" +
-	"\x1fSubmitTransactionRequestMessage\x12;\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12 \n" +
-	"\vallowOrphan\x18\x02 \x01(\bR\vallowOrphan\"t\n" +
-	" SubmitTransactionResponseMessage\x12$\n" +
-	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"}\n" +
-	"5NotifyVirtualSelectedParentChainChangedRequestMessage\x12D\n" +
-	"\x1dincludeAcceptedTransactionIds\x18\x01 \x01(\bR\x1dincludeAcceptedTransactionIds\"d\n" +
-	"6NotifyVirtualSelectedParentChainChangedResponseMessage\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x81\x02\n" +
-	"4VirtualSelectedParentChainChangedNotificationMessage\x128\n" +
-	"\x17removedChainBlockHashes\x18\x01 \x03(\tR\x17removedChainBlockHashes\x124\n" +
-	"\x15addedChainBlockHashes\x18\x03 \x03(\tR\x15addedChainBlockHashes\x12Y\n" +
-	"\x16acceptedTransactionIds\x18\x02 \x03(\v2!.protowire.AcceptedTransactionIdsR\x16acceptedTransactionIds\"^\n" +
-	"\x16GetBlockRequestMessage\x12\x12\n" +
-	"\x04hash\x18\x01 \x01(\tR\x04hash\x120\n" +
-	"\x13includeTransactions\x18\x03 \x01(\bR\x13includeTransactions\"p\n" +
-	"\x17GetBlockResponseMessage\x12)\n" +
-	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"A\n" +
-	"\x1bGetSubnetworkRequestMessage\x12\"\n" +
-	"\fsubnetworkId\x18\x01 \x01(\tR\fsubnetworkId\"f\n" +
-	"\x1cGetSubnetworkResponseMessage\x12\x1a\n" +
-	"\bgasLimit\x18\x01 \x01(\x04R\bgasLimit\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x9a\x01\n" +
-	"4GetVirtualSelectedParentChainFromBlockRequestMessage\x12\x1c\n" +
-	"\tstartHash\x18\x01 \x01(\tR\tstartHash\x12D\n" +
-	"\x1dincludeAcceptedTransactionIds\x18\x02 \x01(\bR\x1dincludeAcceptedTransactionIds\"\x80\x01\n" +
-	"\x16AcceptedTransactionIds\x12.\n" +
-	"\x12acceptingBlockHash\x18\x01 \x01(\tR\x12acceptingBlockHash\x126\n" +
-	"\x16acceptedTransactionIds\x18\x02 \x03(\tR\x16acceptedTransactionIds\"\xae\x02\n" +
-	"5GetVirtualSelectedParentChainFromBlockResponseMessage\x128\n" +
-	"\x17removedChainBlockHashes\x18\x01 \x03(\tR\x17removedChainBlockHashes\x124\n" +
-	"\x15addedChainBlockHashes\x18\x03 \x03(\tR\x15addedChainBlockHashes\x12Y\n" +
-	"\x16acceptedTransactionIds\x18\x02 \x03(\v2!.protowire.AcceptedTransactionIdsR\x16acceptedTransactionIds\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x8b\x01\n" +
-	"\x17GetBlocksRequestMessage\x12\x18\n" +
-	"\alowHash\x18\x01 \x01(\tR\alowHash\x12$\n" +
-	"\rincludeBlocks\x18\x02 \x01(\bR\rincludeBlocks\x120\n" +
-	"\x13includeTransactions\x18\x03 \x01(\bR\x13includeTransactions\"\x95\x01\n" +
-	"\x18GetBlocksResponseMessage\x12 \n" +
-	"\vblockHashes\x18\x04 \x03(\tR\vblockHashes\x12+\n" +
-	"\x06blocks\x18\x03 \x03(\v2\x13.protowire.RpcBlockR\x06blocks\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x1d\n" +
-	"\x1bGetBlockCountRequestMessage\"\x8c\x01\n" +
-	"\x1cGetBlockCountResponseMessage\x12\x1e\n" +
-	"\n" +
-	"blockCount\x18\x01 \x01(\x04R\n" +
-	"blockCount\x12 \n" +
-	"\vheaderCount\x18\x02 \x01(\x04R\vheaderCount\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x1f\n" +
-	"\x1dGetBlockDagInfoRequestMessage\"\x9e\x03\n" +
-	"\x1eGetBlockDagInfoResponseMessage\x12 \n" +
-	"\vnetworkName\x18\x01 \x01(\tR\vnetworkName\x12\x1e\n" +
-	"\n" +
-	"blockCount\x18\x02 \x01(\x04R\n" +
-	"blockCount\x12 \n" +
-	"\vheaderCount\x18\x03 \x01(\x04R\vheaderCount\x12\x1c\n" +
-	"\ttipHashes\x18\x04 \x03(\tR\ttipHashes\x12\x1e\n" +
-	"\n" +
-	"difficulty\x18\x05 \x01(\x01R\n" +
-	"difficulty\x12&\n" +
-	"\x0epastMedianTime\x18\x06 \x01(\x03R\x0epastMedianTime\x120\n" +
-	"\x13virtualParentHashes\x18\a \x03(\tR\x13virtualParentHashes\x12*\n" +
-	"\x10pruningPointHash\x18\b \x01(\tR\x10pruningPointHash\x12(\n" +
-	"\x0fvirtualDaaScore\x18\t \x01(\x04R\x0fvirtualDaaScore\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"U\n" +
-	"%ResolveFinalityConflictRequestMessage\x12,\n" +
-	"\x11finalityBlockHash\x18\x01 \x01(\tR\x11finalityBlockHash\"T\n" +
-	"&ResolveFinalityConflictResponseMessage\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"'\n" +
-	"%NotifyFinalityConflictsRequestMessage\"T\n" +
-	"&NotifyFinalityConflictsResponseMessage\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"U\n" +
-	"#FinalityConflictNotificationMessage\x12.\n" +
-	"\x12violatingBlockHash\x18\x01 \x01(\tR\x12violatingBlockHash\"[\n" +
-	"+FinalityConflictResolvedNotificationMessage\x12,\n" +
-	"\x11finalityBlockHash\x18\x01 \x01(\tR\x11finalityBlockHash\"\x18\n" +
+func (x *RpcAtomicAsset) Reset() {
+	*x = RpcAtomicAsset{}
+	mi := &file_rpc_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicAsset) ProtoMessage() {}
+
+func (x *RpcAtomicAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicAsset.ProtoReflect.Descriptor instead.
+func (*RpcAtomicAsset) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *RpcAtomicAsset) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetCreatorOwnerId() string {
+	if x != nil {
+		return x.CreatorOwnerId
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetAssetClass() uint32 {
+	if x != nil {
+		return x.AssetClass
+	}
+	return 0
+}
+
+func (x *RpcAtomicAsset) GetTokenVersion() uint32 {
+	if x != nil {
+		return x.TokenVersion
+	}
+	return 0
+}
+
+func (x *RpcAtomicAsset) GetMintAuthorityOwnerId() string {
+	if x != nil {
+		return x.MintAuthorityOwnerId
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *RpcAtomicAsset) GetSupplyMode() uint32 {
+	if x != nil {
+		return x.SupplyMode
+	}
+	return 0
+}
+
+func (x *RpcAtomicAsset) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetPlatformTag() string {
+	if x != nil {
+		return x.PlatformTag
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetCreatedBlockHash() string {
+	if x != nil {
+		return x.CreatedBlockHash
+	}
+	return ""
+}
+
+func (x *RpcAtomicAsset) GetCreatedDaaScore() uint64 {
+	if x != nil {
+		return x.CreatedDaaScore
+	}
+	return 0
+}
+
+func (x *RpcAtomicAsset) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RpcAtomicAsset) GetLiquidity() *RpcLiquidityPool {
+	if x != nil {
+		return x.Liquidity
+	}
+	return nil
+}
+
+type RpcLiquidityFeeRecipient struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId        string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Address        string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	UnclaimedSompi uint64                 `protobuf:"varint,3,opt,name=unclaimedSompi,proto3" json:"unclaimedSompi,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RpcLiquidityFeeRecipient) Reset() {
+	*x = RpcLiquidityFeeRecipient{}
+	mi := &file_rpc_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcLiquidityFeeRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcLiquidityFeeRecipient) ProtoMessage() {}
+
+func (x *RpcLiquidityFeeRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcLiquidityFeeRecipient.ProtoReflect.Descriptor instead.
+func (*RpcLiquidityFeeRecipient) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *RpcLiquidityFeeRecipient) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RpcLiquidityFeeRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RpcLiquidityFeeRecipient) GetUnclaimedSompi() uint64 {
+	if x != nil {
+		return x.UnclaimedSompi
+	}
+	return 0
+}
+
+// RpcLiquidityPool describes the bonding-curve pool of a liquidity-class CAT asset.
+type RpcLiquidityPool struct {
+	state                               protoimpl.MessageState      `protogen:"open.v1"`
+	PoolNonce                           uint64                      `protobuf:"varint,1,opt,name=poolNonce,proto3" json:"poolNonce,omitempty"`
+	CurveVersion                        uint32                      `protobuf:"varint,2,opt,name=curveVersion,proto3" json:"curveVersion,omitempty"`
+	CurveMode                           uint32                      `protobuf:"varint,3,opt,name=curveMode,proto3" json:"curveMode,omitempty"`
+	IndividualVirtualCpayReservesSompi  uint64                      `protobuf:"varint,4,opt,name=individualVirtualCpayReservesSompi,proto3" json:"individualVirtualCpayReservesSompi,omitempty"`
+	IndividualVirtualTokenMultiplierBps uint32                      `protobuf:"varint,5,opt,name=individualVirtualTokenMultiplierBps,proto3" json:"individualVirtualTokenMultiplierBps,omitempty"`
+	RealCpayReservesSompi               uint64                      `protobuf:"varint,6,opt,name=realCpayReservesSompi,proto3" json:"realCpayReservesSompi,omitempty"`
+	RealTokenReserves                   string                      `protobuf:"bytes,7,opt,name=realTokenReserves,proto3" json:"realTokenReserves,omitempty"`
+	VirtualCpayReserves                 uint64                      `protobuf:"varint,8,opt,name=virtualCpayReserves,proto3" json:"virtualCpayReserves,omitempty"`
+	VirtualTokenReserves                string                      `protobuf:"bytes,9,opt,name=virtualTokenReserves,proto3" json:"virtualTokenReserves,omitempty"`
+	UnclaimedFeeTotalSompi              uint64                      `protobuf:"varint,10,opt,name=unclaimedFeeTotalSompi,proto3" json:"unclaimedFeeTotalSompi,omitempty"`
+	FeeBps                              uint32                      `protobuf:"varint,11,opt,name=feeBps,proto3" json:"feeBps,omitempty"`
+	FeeRecipients                       []*RpcLiquidityFeeRecipient `protobuf:"bytes,12,rep,name=feeRecipients,proto3" json:"feeRecipients,omitempty"`
+	VaultOutpoint                       *RpcOutpoint                `protobuf:"bytes,13,opt,name=vaultOutpoint,proto3" json:"vaultOutpoint,omitempty"`
+	VaultValueSompi                     uint64                      `protobuf:"varint,14,opt,name=vaultValueSompi,proto3" json:"vaultValueSompi,omitempty"`
+	UnlockTargetSompi                   uint64                      `protobuf:"varint,15,opt,name=unlockTargetSompi,proto3" json:"unlockTargetSompi,omitempty"`
+	Unlocked                            bool                        `protobuf:"varint,16,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
+}
+
+func (x *RpcLiquidityPool) Reset() {
+	*x = RpcLiquidityPool{}
+	mi := &file_rpc_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcLiquidityPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcLiquidityPool) ProtoMessage() {}
+
+func (x *RpcLiquidityPool) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcLiquidityPool.ProtoReflect.Descriptor instead.
+func (*RpcLiquidityPool) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *RpcLiquidityPool) GetPoolNonce() uint64 {
+	if x != nil {
+		return x.PoolNonce
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetCurveVersion() uint32 {
+	if x != nil {
+		return x.CurveVersion
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetCurveMode() uint32 {
+	if x != nil {
+		return x.CurveMode
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetIndividualVirtualCpayReservesSompi() uint64 {
+	if x != nil {
+		return x.IndividualVirtualCpayReservesSompi
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetIndividualVirtualTokenMultiplierBps() uint32 {
+	if x != nil {
+		return x.IndividualVirtualTokenMultiplierBps
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetRealCpayReservesSompi() uint64 {
+	if x != nil {
+		return x.RealCpayReservesSompi
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetRealTokenReserves() string {
+	if x != nil {
+		return x.RealTokenReserves
+	}
+	return ""
+}
+
+func (x *RpcLiquidityPool) GetVirtualCpayReserves() uint64 {
+	if x != nil {
+		return x.VirtualCpayReserves
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetVirtualTokenReserves() string {
+	if x != nil {
+		return x.VirtualTokenReserves
+	}
+	return ""
+}
+
+func (x *RpcLiquidityPool) GetUnclaimedFeeTotalSompi() uint64 {
+	if x != nil {
+		return x.UnclaimedFeeTotalSompi
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetFeeBps() uint32 {
+	if x != nil {
+		return x.FeeBps
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetFeeRecipients() []*RpcLiquidityFeeRecipient {
+	if x != nil {
+		return x.FeeRecipients
+	}
+	return nil
+}
+
+func (x *RpcLiquidityPool) GetVaultOutpoint() *RpcOutpoint {
+	if x != nil {
+		return x.VaultOutpoint
+	}
+	return nil
+}
+
+func (x *RpcLiquidityPool) GetVaultValueSompi() uint64 {
+	if x != nil {
+		return x.VaultValueSompi
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetUnlockTargetSompi() uint64 {
+	if x != nil {
+		return x.UnlockTargetSompi
+	}
+	return 0
+}
+
+func (x *RpcLiquidityPool) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+// GetAtomicAssetRequestMessage requests a single CAT asset from the Atomic state
+// at the virtual selected parent.
+type GetAtomicAssetRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicAssetRequestMessage) Reset() {
+	*x = GetAtomicAssetRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicAssetRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicAssetRequestMessage) ProtoMessage() {}
+
+func (x *GetAtomicAssetRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicAssetRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicAssetRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetAtomicAssetRequestMessage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+type GetAtomicAssetResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *RpcAtomicAsset        `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	BlockHash     string                 `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicAssetResponseMessage) Reset() {
+	*x = GetAtomicAssetResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicAssetResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicAssetResponseMessage) ProtoMessage() {}
+
+func (x *GetAtomicAssetResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicAssetResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicAssetResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetAtomicAssetResponseMessage) GetAsset() *RpcAtomicAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *GetAtomicAssetResponseMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetAtomicAssetResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetAtomicAssetsRequestMessage requests a page of CAT assets, ordered by asset ID,
+// starting at startAssetId (inclusive). An empty startAssetId starts at the first asset.
+type GetAtomicAssetsRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartAssetId  string                 `protobuf:"bytes,1,opt,name=startAssetId,proto3" json:"startAssetId,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicAssetsRequestMessage) Reset() {
+	*x = GetAtomicAssetsRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicAssetsRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicAssetsRequestMessage) ProtoMessage() {}
+
+func (x *GetAtomicAssetsRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicAssetsRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicAssetsRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetAtomicAssetsRequestMessage) GetStartAssetId() string {
+	if x != nil {
+		return x.StartAssetId
+	}
+	return ""
+}
+
+func (x *GetAtomicAssetsRequestMessage) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAtomicAssetsResponseMessage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Assets []*RpcAtomicAsset      `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The asset ID to pass as startAssetId to fetch the next page. Empty when there are no more assets.
+	NextAssetId   string    `protobuf:"bytes,2,opt,name=nextAssetId,proto3" json:"nextAssetId,omitempty"`
+	BlockHash     string    `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Error         *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicAssetsResponseMessage) Reset() {
+	*x = GetAtomicAssetsResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicAssetsResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicAssetsResponseMessage) ProtoMessage() {}
+
+func (x *GetAtomicAssetsResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicAssetsResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicAssetsResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetAtomicAssetsResponseMessage) GetAssets() []*RpcAtomicAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *GetAtomicAssetsResponseMessage) GetNextAssetId() string {
+	if x != nil {
+		return x.NextAssetId
+	}
+	return ""
+}
+
+func (x *GetAtomicAssetsResponseMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetAtomicAssetsResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RpcAtomicBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcAtomicBalance) Reset() {
+	*x = RpcAtomicBalance{}
+	mi := &file_rpc_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicBalance) ProtoMessage() {}
+
+func (x *RpcAtomicBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicBalance.ProtoReflect.Descriptor instead.
+func (*RpcAtomicBalance) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RpcAtomicBalance) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *RpcAtomicBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+// GetAtomicBalancesByOwnerRequestMessage requests all CAT balances held by an owner.
+// The owner is given either as an owner ID or as an address, from which the owner ID is derived.
+type GetAtomicBalancesByOwnerRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicBalancesByOwnerRequestMessage) Reset() {
+	*x = GetAtomicBalancesByOwnerRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicBalancesByOwnerRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicBalancesByOwnerRequestMessage) ProtoMessage() {}
+
+func (x *GetAtomicBalancesByOwnerRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicBalancesByOwnerRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicBalancesByOwnerRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetAtomicBalancesByOwnerRequestMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicBalancesByOwnerRequestMessage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetAtomicBalancesByOwnerResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Balances      []*RpcAtomicBalance    `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	BlockHash     string                 `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) Reset() {
+	*x = GetAtomicBalancesByOwnerResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicBalancesByOwnerResponseMessage) ProtoMessage() {}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicBalancesByOwnerResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicBalancesByOwnerResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) GetBalances() []*RpcAtomicBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetAtomicNonceRequestMessage requests the next nonce expected for an owner.
+// When assetId is empty the owner-scoped nonce (used by asset creation) is returned,
+// otherwise the asset-scoped nonce (used by transfer, mint, burn and trades).
+type GetAtomicNonceRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AssetId       string                 `protobuf:"bytes,3,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicNonceRequestMessage) Reset() {
+	*x = GetAtomicNonceRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicNonceRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicNonceRequestMessage) ProtoMessage() {}
+
+func (x *GetAtomicNonceRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicNonceRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicNonceRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetAtomicNonceRequestMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicNonceRequestMessage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetAtomicNonceRequestMessage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+type GetAtomicNonceResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	NextNonce     uint64                 `protobuf:"varint,2,opt,name=nextNonce,proto3" json:"nextNonce,omitempty"`
+	BlockHash     string                 `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicNonceResponseMessage) Reset() {
+	*x = GetAtomicNonceResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicNonceResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicNonceResponseMessage) ProtoMessage() {}
+
+func (x *GetAtomicNonceResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicNonceResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicNonceResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetAtomicNonceResponseMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicNonceResponseMessage) GetNextNonce() uint64 {
+	if x != nil {
+		return x.NextNonce
+	}
+	return 0
+}
+
+func (x *GetAtomicNonceResponseMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetAtomicNonceResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetLiquidityPoolRequestMessage requests the liquidity pool of a liquidity-class CAT asset.
+type GetLiquidityPoolRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiquidityPoolRequestMessage) Reset() {
+	*x = GetLiquidityPoolRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiquidityPoolRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiquidityPoolRequestMessage) ProtoMessage() {}
+
+func (x *GetLiquidityPoolRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiquidityPoolRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetLiquidityPoolRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetLiquidityPoolRequestMessage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+type GetLiquidityPoolResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          *RpcLiquidityPool      `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	BlockHash     string                 `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiquidityPoolResponseMessage) Reset() {
+	*x = GetLiquidityPoolResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiquidityPoolResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiquidityPoolResponseMessage) ProtoMessage() {}
+
+func (x *GetLiquidityPoolResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiquidityPoolResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetLiquidityPoolResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetLiquidityPoolResponseMessage) GetPool() *RpcLiquidityPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *GetLiquidityPoolResponseMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetLiquidityPoolResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// NotifyAtomicStateChangedRequestMessage registers this connection for
+// atomicStateChanged notifications. Events are sent only if they involve one of
+// the given owners or assets. Leave both lists empty to get all events.
+//
+// See: AtomicStateChangedNotificationMessage
+type NotifyAtomicStateChangedRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerIds      []string               `protobuf:"bytes,1,rep,name=ownerIds,proto3" json:"ownerIds,omitempty"`
+	AssetIds      []string               `protobuf:"bytes,2,rep,name=assetIds,proto3" json:"assetIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyAtomicStateChangedRequestMessage) Reset() {
+	*x = NotifyAtomicStateChangedRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyAtomicStateChangedRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyAtomicStateChangedRequestMessage) ProtoMessage() {}
+
+func (x *NotifyAtomicStateChangedRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyAtomicStateChangedRequestMessage.ProtoReflect.Descriptor instead.
+func (*NotifyAtomicStateChangedRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *NotifyAtomicStateChangedRequestMessage) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *NotifyAtomicStateChangedRequestMessage) GetAssetIds() []string {
+	if x != nil {
+		return x.AssetIds
+	}
+	return nil
+}
+
+type NotifyAtomicStateChangedResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyAtomicStateChangedResponseMessage) Reset() {
+	*x = NotifyAtomicStateChangedResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyAtomicStateChangedResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyAtomicStateChangedResponseMessage) ProtoMessage() {}
+
+func (x *NotifyAtomicStateChangedResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyAtomicStateChangedResponseMessage.ProtoReflect.Descriptor instead.
+func (*NotifyAtomicStateChangedResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *NotifyAtomicStateChangedResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// AtomicStateChangedNotificationMessage is sent whenever chain blocks that
+// accept CAT transactions are added to or removed from the selected parent
+// chain. Events of removed blocks are sent in reverse order with reversed set.
+//
+// See: NotifyAtomicStateChangedRequestMessage
+type AtomicStateChangedNotificationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*RpcAtomicEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AtomicStateChangedNotificationMessage) Reset() {
+	*x = AtomicStateChangedNotificationMessage{}
+	mi := &file_rpc_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AtomicStateChangedNotificationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtomicStateChangedNotificationMessage) ProtoMessage() {}
+
+func (x *AtomicStateChangedNotificationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtomicStateChangedNotificationMessage.ProtoReflect.Descriptor instead.
+func (*AtomicStateChangedNotificationMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *AtomicStateChangedNotificationMessage) GetEvents() []*RpcAtomicEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type RpcAtomicEvent struct {
+	state              protoimpl.MessageState    `protogen:"open.v1"`
+	Kind               string                    `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // One of assetCreated, transfer, mint, burn, buy, sell and feeClaim
+	TransactionId      string                    `protobuf:"bytes,2,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	AcceptingBlockHash string                    `protobuf:"bytes,3,opt,name=acceptingBlockHash,proto3" json:"acceptingBlockHash,omitempty"`
+	AssetId            string                    `protobuf:"bytes,4,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	OwnerId            string                    `protobuf:"bytes,5,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Amount             string                    `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	CpayAmountSompi    uint64                    `protobuf:"varint,7,opt,name=cpayAmountSompi,proto3" json:"cpayAmountSompi,omitempty"`
+	Recipients         []string                  `protobuf:"bytes,8,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	BalanceChanges     []*RpcAtomicBalanceChange `protobuf:"bytes,9,rep,name=balanceChanges,proto3" json:"balanceChanges,omitempty"`
+	Pool               *RpcLiquidityPool         `protobuf:"bytes,10,opt,name=pool,proto3" json:"pool,omitempty"`
+	Reversed           bool                      `protobuf:"varint,11,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RpcAtomicEvent) Reset() {
+	*x = RpcAtomicEvent{}
+	mi := &file_rpc_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicEvent) ProtoMessage() {}
+
+func (x *RpcAtomicEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicEvent.ProtoReflect.Descriptor instead.
+func (*RpcAtomicEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *RpcAtomicEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RpcAtomicEvent) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RpcAtomicEvent) GetAcceptingBlockHash() string {
+	if x != nil {
+		return x.AcceptingBlockHash
+	}
+	return ""
+}
+
+func (x *RpcAtomicEvent) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *RpcAtomicEvent) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RpcAtomicEvent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RpcAtomicEvent) GetCpayAmountSompi() uint64 {
+	if x != nil {
+		return x.CpayAmountSompi
+	}
+	return 0
+}
+
+func (x *RpcAtomicEvent) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *RpcAtomicEvent) GetBalanceChanges() []*RpcAtomicBalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+func (x *RpcAtomicEvent) GetPool() *RpcLiquidityPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *RpcAtomicEvent) GetReversed() bool {
+	if x != nil {
+		return x.Reversed
+	}
+	return false
+}
+
+type RpcAtomicBalanceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcAtomicBalanceChange) Reset() {
+	*x = RpcAtomicBalanceChange{}
+	mi := &file_rpc_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicBalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicBalanceChange) ProtoMessage() {}
+
+func (x *RpcAtomicBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicBalanceChange.ProtoReflect.Descriptor instead.
+func (*RpcAtomicBalanceChange) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *RpcAtomicBalanceChange) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RpcAtomicBalanceChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *RpcAtomicBalanceChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// StopNotifyingAtomicStateChangedRequestMessage unregisters this connection
+// from atomicStateChanged notifications.
+type StopNotifyingAtomicStateChangedRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopNotifyingAtomicStateChangedRequestMessage) Reset() {
+	*x = StopNotifyingAtomicStateChangedRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopNotifyingAtomicStateChangedRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopNotifyingAtomicStateChangedRequestMessage) ProtoMessage() {}
+
+func (x *StopNotifyingAtomicStateChangedRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopNotifyingAtomicStateChangedRequestMessage.ProtoReflect.Descriptor instead.
+func (*StopNotifyingAtomicStateChangedRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{158}
+}
+
+type StopNotifyingAtomicStateChangedResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopNotifyingAtomicStateChangedResponseMessage) Reset() {
+	*x = StopNotifyingAtomicStateChangedResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopNotifyingAtomicStateChangedResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopNotifyingAtomicStateChangedResponseMessage) ProtoMessage() {}
+
+func (x *StopNotifyingAtomicStateChangedResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopNotifyingAtomicStateChangedResponseMessage.ProtoReflect.Descriptor instead.
+func (*StopNotifyingAtomicStateChangedResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *StopNotifyingAtomicStateChangedResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// SubmitFastIntentRequestMessage submits a fast intent for the given base
+// transaction. The base transaction is also added to the mempool, and the
+// intent is relayed to fastchain peers. Leave clientCreatedAtMs at 0 to use
+// the node's current time.
+type SubmitFastIntentRequestMessage struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Transaction       *RpcTransaction        `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	IntentNonce       uint64                 `protobuf:"varint,2,opt,name=intentNonce,proto3" json:"intentNonce,omitempty"`
+	ClientCreatedAtMs uint64                 `protobuf:"varint,3,opt,name=clientCreatedAtMs,proto3" json:"clientCreatedAtMs,omitempty"`
+	MaxFee            uint64                 `protobuf:"varint,4,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubmitFastIntentRequestMessage) Reset() {
+	*x = SubmitFastIntentRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFastIntentRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFastIntentRequestMessage) ProtoMessage() {}
+
+func (x *SubmitFastIntentRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFastIntentRequestMessage.ProtoReflect.Descriptor instead.
+func (*SubmitFastIntentRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *SubmitFastIntentRequestMessage) GetTransaction() *RpcTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *SubmitFastIntentRequestMessage) GetIntentNonce() uint64 {
+	if x != nil {
+		return x.IntentNonce
+	}
+	return 0
+}
+
+func (x *SubmitFastIntentRequestMessage) GetClientCreatedAtMs() uint64 {
+	if x != nil {
+		return x.ClientCreatedAtMs
+	}
+	return 0
+}
+
+func (x *SubmitFastIntentRequestMessage) GetMaxFee() uint64 {
+	if x != nil {
+		return x.MaxFee
+	}
+	return 0
+}
+
+type SubmitFastIntentResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intentId,proto3" json:"intentId,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFastIntentResponseMessage) Reset() {
+	*x = SubmitFastIntentResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFastIntentResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFastIntentResponseMessage) ProtoMessage() {}
+
+func (x *SubmitFastIntentResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFastIntentResponseMessage.ProtoReflect.Descriptor instead.
+func (*SubmitFastIntentResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *SubmitFastIntentResponseMessage) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *SubmitFastIntentResponseMessage) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SubmitFastIntentResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetFastIntentStatusRequestMessage requests the status of a fast intent in
+// the node's intent pool
+type GetFastIntentStatusRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intentId,proto3" json:"intentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFastIntentStatusRequestMessage) Reset() {
+	*x = GetFastIntentStatusRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFastIntentStatusRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFastIntentStatusRequestMessage) ProtoMessage() {}
+
+func (x *GetFastIntentStatusRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFastIntentStatusRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetFastIntentStatusRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetFastIntentStatusRequestMessage) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+type GetFastIntentStatusResponseMessage struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IntentId          string                 `protobuf:"bytes,1,opt,name=intentId,proto3" json:"intentId,omitempty"`
+	TransactionId     string                 `protobuf:"bytes,2,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	Status            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // Either pending or microblocked
+	MaxFee            uint64                 `protobuf:"varint,4,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+	ClientCreatedAtMs uint64                 `protobuf:"varint,5,opt,name=clientCreatedAtMs,proto3" json:"clientCreatedAtMs,omitempty"`
+	ReceivedAtMs      uint64                 `protobuf:"varint,6,opt,name=receivedAtMs,proto3" json:"receivedAtMs,omitempty"`
+	MicroblockTimeMs  uint64                 `protobuf:"varint,7,opt,name=microblockTimeMs,proto3" json:"microblockTimeMs,omitempty"`
+	Error             *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetFastIntentStatusResponseMessage) Reset() {
+	*x = GetFastIntentStatusResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFastIntentStatusResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFastIntentStatusResponseMessage) ProtoMessage() {}
+
+func (x *GetFastIntentStatusResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFastIntentStatusResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetFastIntentStatusResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetMaxFee() uint64 {
+	if x != nil {
+		return x.MaxFee
+	}
+	return 0
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetClientCreatedAtMs() uint64 {
+	if x != nil {
+		return x.ClientCreatedAtMs
+	}
+	return 0
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetReceivedAtMs() uint64 {
+	if x != nil {
+		return x.ReceivedAtMs
+	}
+	return 0
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetMicroblockTimeMs() uint64 {
+	if x != nil {
+		return x.MicroblockTimeMs
+	}
+	return 0
+}
+
+func (x *GetFastIntentStatusResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetAtomicHistoryByOwnerRequestMessage requests the token events that touch
+// the given owner, oldest first. Pass the nextCursor of a response as cursor
+// to get the next page. limit defaults to 100 and is at most 1000.
+//
+// This call is only available when this cryptixd was started with `--atomicindex`
+type GetAtomicHistoryByOwnerRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicHistoryByOwnerRequestMessage) Reset() {
+	*x = GetAtomicHistoryByOwnerRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicHistoryByOwnerRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicHistoryByOwnerRequestMessage) ProtoMessage() {}
+
+func (x *GetAtomicHistoryByOwnerRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicHistoryByOwnerRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicHistoryByOwnerRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *GetAtomicHistoryByOwnerRequestMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByOwnerRequestMessage) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByOwnerRequestMessage) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RpcAtomicHistoryEntry struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AcceptingDaaScore uint64                 `protobuf:"varint,1,opt,name=acceptingDaaScore,proto3" json:"acceptingDaaScore,omitempty"`
+	Event             *RpcAtomicEvent        `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"` // The pool of the event is not recorded
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RpcAtomicHistoryEntry) Reset() {
+	*x = RpcAtomicHistoryEntry{}
+	mi := &file_rpc_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicHistoryEntry) ProtoMessage() {}
+
+func (x *RpcAtomicHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicHistoryEntry.ProtoReflect.Descriptor instead.
+func (*RpcAtomicHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *RpcAtomicHistoryEntry) GetAcceptingDaaScore() uint64 {
+	if x != nil {
+		return x.AcceptingDaaScore
+	}
+	return 0
+}
+
+func (x *RpcAtomicHistoryEntry) GetEvent() *RpcAtomicEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type GetAtomicHistoryByOwnerResponseMessage struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	OwnerId       string                   `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Entries       []*RpcAtomicHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor    string                   `protobuf:"bytes,3,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` // Empty when this is the last page
+	Error         *RPCError                `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) Reset() {
+	*x = GetAtomicHistoryByOwnerResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicHistoryByOwnerResponseMessage) ProtoMessage() {}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicHistoryByOwnerResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicHistoryByOwnerResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) GetEntries() []*RpcAtomicHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetAtomicHistoryByAssetRequestMessage requests the token events of the given
+// asset, oldest first. Paging works like in GetAtomicHistoryByOwnerRequestMessage.
+//
+// This call is only available when this cryptixd was started with `--atomicindex`
+type GetAtomicHistoryByAssetRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicHistoryByAssetRequestMessage) Reset() {
+	*x = GetAtomicHistoryByAssetRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicHistoryByAssetRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicHistoryByAssetRequestMessage) ProtoMessage() {}
+
+func (x *GetAtomicHistoryByAssetRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicHistoryByAssetRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicHistoryByAssetRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetAtomicHistoryByAssetRequestMessage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByAssetRequestMessage) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByAssetRequestMessage) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAtomicHistoryByAssetResponseMessage struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	AssetId       string                   `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	Entries       []*RpcAtomicHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor    string                   `protobuf:"bytes,3,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` // Empty when this is the last page
+	Error         *RPCError                `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) Reset() {
+	*x = GetAtomicHistoryByAssetResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicHistoryByAssetResponseMessage) ProtoMessage() {}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicHistoryByAssetResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicHistoryByAssetResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) GetEntries() []*RpcAtomicHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// SimulateAtomicTransactionRequestMessage applies a transaction to a copy of
+// the virtual Atomic state without submitting it. The transaction does not
+// have to be signed.
+type SimulateAtomicTransactionRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *RpcTransaction        `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateAtomicTransactionRequestMessage) Reset() {
+	*x = SimulateAtomicTransactionRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateAtomicTransactionRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateAtomicTransactionRequestMessage) ProtoMessage() {}
+
+func (x *SimulateAtomicTransactionRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateAtomicTransactionRequestMessage.ProtoReflect.Descriptor instead.
+func (*SimulateAtomicTransactionRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *SimulateAtomicTransactionRequestMessage) GetTransaction() *RpcTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type SimulateAtomicTransactionResponseMessage struct {
+	state           protoimpl.MessageState             `protogen:"open.v1"`
+	TransactionId   string                             `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	DaaScore        uint64                             `protobuf:"varint,2,opt,name=daaScore,proto3" json:"daaScore,omitempty"`
+	Fee             uint64                             `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	ValidationError string                             `protobuf:"bytes,4,opt,name=validationError,proto3" json:"validationError,omitempty"` // Why the transaction itself is invalid, e.g. a missing signature
+	AtomicError     string                             `protobuf:"bytes,5,opt,name=atomicError,proto3" json:"atomicError,omitempty"`         // The Atomic rule the payload violates. No changes are returned when set
+	BalanceChanges  []*RpcAtomicSimulatedBalanceChange `protobuf:"bytes,6,rep,name=balanceChanges,proto3" json:"balanceChanges,omitempty"`
+	NonceChanges    []*RpcAtomicSimulatedNonceChange   `protobuf:"bytes,7,rep,name=nonceChanges,proto3" json:"nonceChanges,omitempty"`
+	PoolChanges     []*RpcAtomicSimulatedPoolChange    `protobuf:"bytes,8,rep,name=poolChanges,proto3" json:"poolChanges,omitempty"`
+	StateGrowth     *RpcAtomicStateGrowth              `protobuf:"bytes,9,opt,name=stateGrowth,proto3" json:"stateGrowth,omitempty"`
+	Error           *RPCError                          `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) Reset() {
+	*x = SimulateAtomicTransactionResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateAtomicTransactionResponseMessage) ProtoMessage() {}
+
+func (x *SimulateAtomicTransactionResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateAtomicTransactionResponseMessage.ProtoReflect.Descriptor instead.
+func (*SimulateAtomicTransactionResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetDaaScore() uint64 {
+	if x != nil {
+		return x.DaaScore
+	}
+	return 0
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetValidationError() string {
+	if x != nil {
+		return x.ValidationError
+	}
+	return ""
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetAtomicError() string {
+	if x != nil {
+		return x.AtomicError
+	}
+	return ""
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetBalanceChanges() []*RpcAtomicSimulatedBalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetNonceChanges() []*RpcAtomicSimulatedNonceChange {
+	if x != nil {
+		return x.NonceChanges
+	}
+	return nil
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetPoolChanges() []*RpcAtomicSimulatedPoolChange {
+	if x != nil {
+		return x.PoolChanges
+	}
+	return nil
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetStateGrowth() *RpcAtomicStateGrowth {
+	if x != nil {
+		return x.StateGrowth
+	}
+	return nil
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RpcAtomicSimulatedBalanceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Before        string                 `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcAtomicSimulatedBalanceChange) Reset() {
+	*x = RpcAtomicSimulatedBalanceChange{}
+	mi := &file_rpc_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicSimulatedBalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicSimulatedBalanceChange) ProtoMessage() {}
+
+func (x *RpcAtomicSimulatedBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicSimulatedBalanceChange.ProtoReflect.Descriptor instead.
+func (*RpcAtomicSimulatedBalanceChange) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *RpcAtomicSimulatedBalanceChange) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *RpcAtomicSimulatedBalanceChange) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RpcAtomicSimulatedBalanceChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *RpcAtomicSimulatedBalanceChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type RpcAtomicSimulatedNonceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=assetId,proto3" json:"assetId,omitempty"` // Empty for owner-scoped nonces
+	Before        uint64                 `protobuf:"varint,3,opt,name=before,proto3" json:"before,omitempty"`
+	After         uint64                 `protobuf:"varint,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcAtomicSimulatedNonceChange) Reset() {
+	*x = RpcAtomicSimulatedNonceChange{}
+	mi := &file_rpc_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicSimulatedNonceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicSimulatedNonceChange) ProtoMessage() {}
+
+func (x *RpcAtomicSimulatedNonceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicSimulatedNonceChange.ProtoReflect.Descriptor instead.
+func (*RpcAtomicSimulatedNonceChange) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *RpcAtomicSimulatedNonceChange) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RpcAtomicSimulatedNonceChange) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *RpcAtomicSimulatedNonceChange) GetBefore() uint64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *RpcAtomicSimulatedNonceChange) GetAfter() uint64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+type RpcAtomicSimulatedPoolChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	Before        *RpcLiquidityPool      `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"` // Not set for pools created by the transaction
+	After         *RpcLiquidityPool      `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcAtomicSimulatedPoolChange) Reset() {
+	*x = RpcAtomicSimulatedPoolChange{}
+	mi := &file_rpc_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicSimulatedPoolChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicSimulatedPoolChange) ProtoMessage() {}
+
+func (x *RpcAtomicSimulatedPoolChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicSimulatedPoolChange.ProtoReflect.Descriptor instead.
+func (*RpcAtomicSimulatedPoolChange) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *RpcAtomicSimulatedPoolChange) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *RpcAtomicSimulatedPoolChange) GetBefore() *RpcLiquidityPool {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *RpcAtomicSimulatedPoolChange) GetAfter() *RpcLiquidityPool {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type RpcAtomicStateGrowth struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	NewAssets          uint64                 `protobuf:"varint,1,opt,name=newAssets,proto3" json:"newAssets,omitempty"`
+	NewBalanceKeys     uint64                 `protobuf:"varint,2,opt,name=newBalanceKeys,proto3" json:"newBalanceKeys,omitempty"`
+	NewNonceKeys       uint64                 `protobuf:"varint,3,opt,name=newNonceKeys,proto3" json:"newNonceKeys,omitempty"`
+	NewPools           uint64                 `protobuf:"varint,4,opt,name=newPools,proto3" json:"newPools,omitempty"`
+	NewAnchorOwnerKeys uint64                 `protobuf:"varint,5,opt,name=newAnchorOwnerKeys,proto3" json:"newAnchorOwnerKeys,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RpcAtomicStateGrowth) Reset() {
+	*x = RpcAtomicStateGrowth{}
+	mi := &file_rpc_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAtomicStateGrowth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAtomicStateGrowth) ProtoMessage() {}
+
+func (x *RpcAtomicStateGrowth) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAtomicStateGrowth.ProtoReflect.Descriptor instead.
+func (*RpcAtomicStateGrowth) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *RpcAtomicStateGrowth) GetNewAssets() uint64 {
+	if x != nil {
+		return x.NewAssets
+	}
+	return 0
+}
+
+func (x *RpcAtomicStateGrowth) GetNewBalanceKeys() uint64 {
+	if x != nil {
+		return x.NewBalanceKeys
+	}
+	return 0
+}
+
+func (x *RpcAtomicStateGrowth) GetNewNonceKeys() uint64 {
+	if x != nil {
+		return x.NewNonceKeys
+	}
+	return 0
+}
+
+func (x *RpcAtomicStateGrowth) GetNewPools() uint64 {
+	if x != nil {
+		return x.NewPools
+	}
+	return 0
+}
+
+func (x *RpcAtomicStateGrowth) GetNewAnchorOwnerKeys() uint64 {
+	if x != nil {
+		return x.NewAnchorOwnerKeys
+	}
+	return 0
+}
+
+// GetAtomicBalanceProofRequestMessage requests a Merkle proof of a balance in
+// the Atomic state of the virtual selected parent. Exactly one of ownerId and
+// address must be set. Only available once the Atomic Merkle root hardfork is
+// active.
+type GetAtomicBalanceProofRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtomicBalanceProofRequestMessage) Reset() {
+	*x = GetAtomicBalanceProofRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicBalanceProofRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicBalanceProofRequestMessage) ProtoMessage() {}
+
+func (x *GetAtomicBalanceProofRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicBalanceProofRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicBalanceProofRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *GetAtomicBalanceProofRequestMessage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofRequestMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofRequestMessage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// The utxoCommitment of the block header is the Atomic header commitment of
+// rawUtxoCommitment and atomicMerkleRoot
+type GetAtomicBalanceProofResponseMessage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash          string                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	DaaScore           uint64                 `protobuf:"varint,2,opt,name=daaScore,proto3" json:"daaScore,omitempty"`
+	UtxoCommitment     string                 `protobuf:"bytes,3,opt,name=utxoCommitment,proto3" json:"utxoCommitment,omitempty"`
+	RawUtxoCommitment  string                 `protobuf:"bytes,4,opt,name=rawUtxoCommitment,proto3" json:"rawUtxoCommitment,omitempty"`
+	AtomicMerkleRoot   string                 `protobuf:"bytes,5,opt,name=atomicMerkleRoot,proto3" json:"atomicMerkleRoot,omitempty"`
+	AssetId            string                 `protobuf:"bytes,6,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	OwnerId            string                 `protobuf:"bytes,7,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Balance            string                 `protobuf:"bytes,8,opt,name=balance,proto3" json:"balance,omitempty"`
+	Included           bool                   `protobuf:"varint,9,opt,name=included,proto3" json:"included,omitempty"`
+	Siblings           []string               `protobuf:"bytes,10,rep,name=siblings,proto3" json:"siblings,omitempty"`           // Ordered from the root downwards
+	OtherLeafPath      string                 `protobuf:"bytes,11,opt,name=otherLeafPath,proto3" json:"otherLeafPath,omitempty"` // Only set for proofs of absence that end at another leaf
+	OtherLeafEntryHash string                 `protobuf:"bytes,12,opt,name=otherLeafEntryHash,proto3" json:"otherLeafEntryHash,omitempty"`
+	Error              *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) Reset() {
+	*x = GetAtomicBalanceProofResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtomicBalanceProofResponseMessage) ProtoMessage() {}
+
+func (x *GetAtomicBalanceProofResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtomicBalanceProofResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAtomicBalanceProofResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetDaaScore() uint64 {
+	if x != nil {
+		return x.DaaScore
+	}
+	return 0
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetUtxoCommitment() string {
+	if x != nil {
+		return x.UtxoCommitment
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetRawUtxoCommitment() string {
+	if x != nil {
+		return x.RawUtxoCommitment
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetAtomicMerkleRoot() string {
+	if x != nil {
+		return x.AtomicMerkleRoot
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetIncluded() bool {
+	if x != nil {
+		return x.Included
+	}
+	return false
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetSiblings() []string {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetOtherLeafPath() string {
+	if x != nil {
+		return x.OtherLeafPath
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetOtherLeafEntryHash() string {
+	if x != nil {
+		return x.OtherLeafEntryHash
+	}
+	return ""
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetStrongNodeClaimsRequestMessage requests the strong-node claim window
+// leaderboard. Winning claims of the window blocks and pending claims for
+// still unknown blocks are only included if requested.
+type GetStrongNodeClaimsRequestMessage struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	IncludeWinningClaims bool                   `protobuf:"varint,1,opt,name=includeWinningClaims,proto3" json:"includeWinningClaims,omitempty"`
+	IncludePendingClaims bool                   `protobuf:"varint,2,opt,name=includePendingClaims,proto3" json:"includePendingClaims,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetStrongNodeClaimsRequestMessage) Reset() {
+	*x = GetStrongNodeClaimsRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStrongNodeClaimsRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrongNodeClaimsRequestMessage) ProtoMessage() {}
+
+func (x *GetStrongNodeClaimsRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrongNodeClaimsRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetStrongNodeClaimsRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *GetStrongNodeClaimsRequestMessage) GetIncludeWinningClaims() bool {
+	if x != nil {
+		return x.IncludeWinningClaims
+	}
+	return false
+}
+
+func (x *GetStrongNodeClaimsRequestMessage) GetIncludePendingClaims() bool {
+	if x != nil {
+		return x.IncludePendingClaims
+	}
+	return false
+}
+
+type GetStrongNodeClaimsResponseMessage struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	Enabled          bool                       `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	HardforkActive   bool                       `protobuf:"varint,2,opt,name=hardforkActive,proto3" json:"hardforkActive,omitempty"`
+	RuntimeAvailable bool                       `protobuf:"varint,3,opt,name=runtimeAvailable,proto3" json:"runtimeAvailable,omitempty"`
+	WindowSize       uint32                     `protobuf:"varint,4,opt,name=windowSize,proto3" json:"windowSize,omitempty"`
+	ConflictTotal    uint64                     `protobuf:"varint,5,opt,name=conflictTotal,proto3" json:"conflictTotal,omitempty"`
+	Entries          []*RpcStrongNodeClaimEntry `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries,omitempty"`             // Ordered by claimedBlocks, descending
+	WinningClaims    []*RpcBlockProducerClaim   `protobuf:"bytes,7,rep,name=winningClaims,proto3" json:"winningClaims,omitempty"` // Ordered from the oldest window block
+	PendingClaims    []*RpcBlockProducerClaim   `protobuf:"bytes,8,rep,name=pendingClaims,proto3" json:"pendingClaims,omitempty"` // Ordered by receivedAtMs
+	Error            *RPCError                  `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) Reset() {
+	*x = GetStrongNodeClaimsResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrongNodeClaimsResponseMessage) ProtoMessage() {}
+
+func (x *GetStrongNodeClaimsResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrongNodeClaimsResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetStrongNodeClaimsResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetHardforkActive() bool {
+	if x != nil {
+		return x.HardforkActive
+	}
+	return false
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetRuntimeAvailable() bool {
+	if x != nil {
+		return x.RuntimeAvailable
+	}
+	return false
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetWindowSize() uint32 {
+	if x != nil {
+		return x.WindowSize
+	}
+	return 0
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetConflictTotal() uint64 {
+	if x != nil {
+		return x.ConflictTotal
+	}
+	return 0
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetEntries() []*RpcStrongNodeClaimEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetWinningClaims() []*RpcBlockProducerClaim {
+	if x != nil {
+		return x.WinningClaims
+	}
+	return nil
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetPendingClaims() []*RpcBlockProducerClaim {
+	if x != nil {
+		return x.PendingClaims
+	}
+	return nil
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RpcStrongNodeClaimEntry struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	NodeId             string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	PublicKeyXOnly     string                 `protobuf:"bytes,2,opt,name=publicKeyXOnly,proto3" json:"publicKeyXOnly,omitempty"`
+	ClaimedBlocks      uint32                 `protobuf:"varint,3,opt,name=claimedBlocks,proto3" json:"claimedBlocks,omitempty"`
+	ShareBps           uint32                 `protobuf:"varint,4,opt,name=shareBps,proto3" json:"shareBps,omitempty"`
+	LastClaimBlockHash string                 `protobuf:"bytes,5,opt,name=lastClaimBlockHash,proto3" json:"lastClaimBlockHash,omitempty"`
+	LastClaimTimeMs    uint64                 `protobuf:"varint,6,opt,name=lastClaimTimeMs,proto3" json:"lastClaimTimeMs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RpcStrongNodeClaimEntry) Reset() {
+	*x = RpcStrongNodeClaimEntry{}
+	mi := &file_rpc_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcStrongNodeClaimEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcStrongNodeClaimEntry) ProtoMessage() {}
+
+func (x *RpcStrongNodeClaimEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcStrongNodeClaimEntry.ProtoReflect.Descriptor instead.
+func (*RpcStrongNodeClaimEntry) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *RpcStrongNodeClaimEntry) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RpcStrongNodeClaimEntry) GetPublicKeyXOnly() string {
+	if x != nil {
+		return x.PublicKeyXOnly
+	}
+	return ""
+}
+
+func (x *RpcStrongNodeClaimEntry) GetClaimedBlocks() uint32 {
+	if x != nil {
+		return x.ClaimedBlocks
+	}
+	return 0
+}
+
+func (x *RpcStrongNodeClaimEntry) GetShareBps() uint32 {
+	if x != nil {
+		return x.ShareBps
+	}
+	return 0
+}
+
+func (x *RpcStrongNodeClaimEntry) GetLastClaimBlockHash() string {
+	if x != nil {
+		return x.LastClaimBlockHash
+	}
+	return ""
+}
+
+func (x *RpcStrongNodeClaimEntry) GetLastClaimTimeMs() uint64 {
+	if x != nil {
+		return x.LastClaimTimeMs
+	}
+	return 0
+}
+
+type RpcBlockProducerClaim struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash      string                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	NodeId         string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	PublicKeyXOnly string                 `protobuf:"bytes,3,opt,name=publicKeyXOnly,proto3" json:"publicKeyXOnly,omitempty"`
+	ClaimId        string                 `protobuf:"bytes,4,opt,name=claimId,proto3" json:"claimId,omitempty"`
+	ReceivedAtMs   uint64                 `protobuf:"varint,5,opt,name=receivedAtMs,proto3" json:"receivedAtMs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RpcBlockProducerClaim) Reset() {
+	*x = RpcBlockProducerClaim{}
+	mi := &file_rpc_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcBlockProducerClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcBlockProducerClaim) ProtoMessage() {}
+
+func (x *RpcBlockProducerClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcBlockProducerClaim.ProtoReflect.Descriptor instead.
+func (*RpcBlockProducerClaim) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *RpcBlockProducerClaim) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *RpcBlockProducerClaim) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RpcBlockProducerClaim) GetPublicKeyXOnly() string {
+	if x != nil {
+		return x.PublicKeyXOnly
+	}
+	return ""
+}
+
+func (x *RpcBlockProducerClaim) GetClaimId() string {
+	if x != nil {
+		return x.ClaimId
+	}
+	return ""
+}
+
+func (x *RpcBlockProducerClaim) GetReceivedAtMs() uint64 {
+	if x != nil {
+		return x.ReceivedAtMs
+	}
+	return 0
+}
+
+// NotifyBlockProducerClaimWinnerRequestMessage registers this connection for
+// BlockProducerClaimWinner notifications.
+//
+// See: BlockProducerClaimWinnerNotificationMessage
+type NotifyBlockProducerClaimWinnerRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyBlockProducerClaimWinnerRequestMessage) Reset() {
+	*x = NotifyBlockProducerClaimWinnerRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyBlockProducerClaimWinnerRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyBlockProducerClaimWinnerRequestMessage) ProtoMessage() {}
+
+func (x *NotifyBlockProducerClaimWinnerRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyBlockProducerClaimWinnerRequestMessage.ProtoReflect.Descriptor instead.
+func (*NotifyBlockProducerClaimWinnerRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{181}
+}
+
+type NotifyBlockProducerClaimWinnerResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyBlockProducerClaimWinnerResponseMessage) Reset() {
+	*x = NotifyBlockProducerClaimWinnerResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyBlockProducerClaimWinnerResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyBlockProducerClaimWinnerResponseMessage) ProtoMessage() {}
+
+func (x *NotifyBlockProducerClaimWinnerResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyBlockProducerClaimWinnerResponseMessage.ProtoReflect.Descriptor instead.
+func (*NotifyBlockProducerClaimWinnerResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *NotifyBlockProducerClaimWinnerResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// BlockProducerClaimWinnerNotificationMessage is sent whenever chain blocks in
+// the strong-node claim window get a new winning claim, either because the
+// block entered the window or because a better claim for it arrived.
+//
+// See: NotifyBlockProducerClaimWinnerRequestMessage
+type BlockProducerClaimWinnerNotificationMessage struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Winners       []*RpcBlockProducerClaim `protobuf:"bytes,1,rep,name=winners,proto3" json:"winners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockProducerClaimWinnerNotificationMessage) Reset() {
+	*x = BlockProducerClaimWinnerNotificationMessage{}
+	mi := &file_rpc_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockProducerClaimWinnerNotificationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProducerClaimWinnerNotificationMessage) ProtoMessage() {}
+
+func (x *BlockProducerClaimWinnerNotificationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProducerClaimWinnerNotificationMessage.ProtoReflect.Descriptor instead.
+func (*BlockProducerClaimWinnerNotificationMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *BlockProducerClaimWinnerNotificationMessage) GetWinners() []*RpcBlockProducerClaim {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+// BanNodeIDRequestMessage bans the given unified node ID and disconnects
+// all peers that use it. The ban is persisted and survives restarts.
+// A durationSeconds of 0 bans the node ID until it's explicitly unbanned.
+type BanNodeIDRequestMessage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NodeId          string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationSeconds uint64                 `protobuf:"varint,3,opt,name=durationSeconds,proto3" json:"durationSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BanNodeIDRequestMessage) Reset() {
+	*x = BanNodeIDRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanNodeIDRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanNodeIDRequestMessage) ProtoMessage() {}
+
+func (x *BanNodeIDRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanNodeIDRequestMessage.ProtoReflect.Descriptor instead.
+func (*BanNodeIDRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *BanNodeIDRequestMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *BanNodeIDRequestMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanNodeIDRequestMessage) GetDurationSeconds() uint64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type BanNodeIDResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanNodeIDResponseMessage) Reset() {
+	*x = BanNodeIDResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanNodeIDResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanNodeIDResponseMessage) ProtoMessage() {}
+
+func (x *BanNodeIDResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanNodeIDResponseMessage.ProtoReflect.Descriptor instead.
+func (*BanNodeIDResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *BanNodeIDResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// UnbanNodeIDRequestMessage unbans the given unified node ID.
+type UnbanNodeIDRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanNodeIDRequestMessage) Reset() {
+	*x = UnbanNodeIDRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanNodeIDRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanNodeIDRequestMessage) ProtoMessage() {}
+
+func (x *UnbanNodeIDRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanNodeIDRequestMessage.ProtoReflect.Descriptor instead.
+func (*UnbanNodeIDRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *UnbanNodeIDRequestMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type UnbanNodeIDResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanNodeIDResponseMessage) Reset() {
+	*x = UnbanNodeIDResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanNodeIDResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanNodeIDResponseMessage) ProtoMessage() {}
+
+func (x *UnbanNodeIDResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanNodeIDResponseMessage.ProtoReflect.Descriptor instead.
+func (*UnbanNodeIDResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *UnbanNodeIDResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// ListBansRequestMessage requests all the active IP and unified node ID bans.
+type ListBansRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansRequestMessage) Reset() {
+	*x = ListBansRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansRequestMessage) ProtoMessage() {}
+
+func (x *ListBansRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansRequestMessage.ProtoReflect.Descriptor instead.
+func (*ListBansRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{188}
+}
+
+type ListBansResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BannedIps     []*RpcBannedIP         `protobuf:"bytes,1,rep,name=bannedIps,proto3" json:"bannedIps,omitempty"`
+	BannedNodeIds []*RpcBannedNodeID     `protobuf:"bytes,2,rep,name=bannedNodeIds,proto3" json:"bannedNodeIds,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansResponseMessage) Reset() {
+	*x = ListBansResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansResponseMessage) ProtoMessage() {}
+
+func (x *ListBansResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansResponseMessage.ProtoReflect.Descriptor instead.
+func (*ListBansResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *ListBansResponseMessage) GetBannedIps() []*RpcBannedIP {
+	if x != nil {
+		return x.BannedIps
+	}
+	return nil
+}
+
+func (x *ListBansResponseMessage) GetBannedNodeIds() []*RpcBannedNodeID {
+	if x != nil {
+		return x.BannedNodeIds
+	}
+	return nil
+}
+
+func (x *ListBansResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RpcBannedIP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	BannedAtMs    int64                  `protobuf:"varint,2,opt,name=bannedAtMs,proto3" json:"bannedAtMs,omitempty"`
+	ExpiresAtMs   int64                  `protobuf:"varint,3,opt,name=expiresAtMs,proto3" json:"expiresAtMs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcBannedIP) Reset() {
+	*x = RpcBannedIP{}
+	mi := &file_rpc_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcBannedIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcBannedIP) ProtoMessage() {}
+
+func (x *RpcBannedIP) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcBannedIP.ProtoReflect.Descriptor instead.
+func (*RpcBannedIP) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *RpcBannedIP) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *RpcBannedIP) GetBannedAtMs() int64 {
+	if x != nil {
+		return x.BannedAtMs
+	}
+	return 0
+}
+
+func (x *RpcBannedIP) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+type RpcBannedNodeID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BannedAtMs    int64                  `protobuf:"varint,3,opt,name=bannedAtMs,proto3" json:"bannedAtMs,omitempty"`
+	ExpiresAtMs   int64                  `protobuf:"varint,4,opt,name=expiresAtMs,proto3" json:"expiresAtMs,omitempty"` // 0 for bans that never expire
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcBannedNodeID) Reset() {
+	*x = RpcBannedNodeID{}
+	mi := &file_rpc_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcBannedNodeID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcBannedNodeID) ProtoMessage() {}
+
+func (x *RpcBannedNodeID) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcBannedNodeID.ProtoReflect.Descriptor instead.
+func (*RpcBannedNodeID) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *RpcBannedNodeID) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RpcBannedNodeID) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RpcBannedNodeID) GetBannedAtMs() int64 {
+	if x != nil {
+		return x.BannedAtMs
+	}
+	return 0
+}
+
+func (x *RpcBannedNodeID) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+// GetAntiFraudStateRequestMessage requests the state of the AntiFraud
+// connection banlist: the active signed snapshot, where it came from, the
+// connected peers it cuts and the snapshots offered by peers.
+type GetAntiFraudStateRequestMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeEntries bool                   `protobuf:"varint,1,opt,name=includeEntries,proto3" json:"includeEntries,omitempty"` // Also return every banned IP and node ID of the active snapshot
+	IncludeDiff    bool                   `protobuf:"varint,2,opt,name=includeDiff,proto3" json:"includeDiff,omitempty"`       // Also diff the active snapshot against the previous one
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAntiFraudStateRequestMessage) Reset() {
+	*x = GetAntiFraudStateRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAntiFraudStateRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAntiFraudStateRequestMessage) ProtoMessage() {}
+
+func (x *GetAntiFraudStateRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAntiFraudStateRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetAntiFraudStateRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *GetAntiFraudStateRequestMessage) GetIncludeEntries() bool {
+	if x != nil {
+		return x.IncludeEntries
+	}
+	return false
+}
+
+func (x *GetAntiFraudStateRequestMessage) GetIncludeDiff() bool {
+	if x != nil {
+		return x.IncludeDiff
+	}
+	return false
+}
+
+type GetAntiFraudStateResponseMessage struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	RuntimeEnabled    bool                      `protobuf:"varint,1,opt,name=runtimeEnabled,proto3" json:"runtimeEnabled,omitempty"`
+	PeerFallback      bool                      `protobuf:"varint,2,opt,name=peerFallback,proto3" json:"peerFallback,omitempty"`
+	SeedEnabled       bool                      `protobuf:"varint,3,opt,name=seedEnabled,proto3" json:"seedEnabled,omitempty"`
+	HasSnapshot       bool                      `protobuf:"varint,4,opt,name=hasSnapshot,proto3" json:"hasSnapshot,omitempty"`
+	Source            string                    `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"` // "seed", "peerMajority" or "disk"
+	SnapshotSeq       uint64                    `protobuf:"varint,6,opt,name=snapshotSeq,proto3" json:"snapshotSeq,omitempty"`
+	GeneratedAtMs     uint64                    `protobuf:"varint,7,opt,name=generatedAtMs,proto3" json:"generatedAtMs,omitempty"`
+	SigningKeyId      uint32                    `protobuf:"varint,8,opt,name=signingKeyId,proto3" json:"signingKeyId,omitempty"`
+	RootHash          string                    `protobuf:"bytes,9,opt,name=rootHash,proto3" json:"rootHash,omitempty"`
+	BannedIpCount     uint32                    `protobuf:"varint,10,opt,name=bannedIpCount,proto3" json:"bannedIpCount,omitempty"`
+	BannedNodeIdCount uint32                    `protobuf:"varint,11,opt,name=bannedNodeIdCount,proto3" json:"bannedNodeIdCount,omitempty"`
+	HashWindow        []string                  `protobuf:"bytes,12,rep,name=hashWindow,proto3" json:"hashWindow,omitempty"`
+	PeerVotes         []*RpcAntiFraudPeerVote   `protobuf:"bytes,13,rep,name=peerVotes,proto3" json:"peerVotes,omitempty"`
+	Matches           []*RpcAntiFraudEntryMatch `protobuf:"bytes,14,rep,name=matches,proto3" json:"matches,omitempty"`
+	BannedIps         []string                  `protobuf:"bytes,15,rep,name=bannedIps,proto3" json:"bannedIps,omitempty"`
+	BannedNodeIds     []string                  `protobuf:"bytes,16,rep,name=bannedNodeIds,proto3" json:"bannedNodeIds,omitempty"`
+	Diff              *RpcAntiFraudSnapshotDiff `protobuf:"bytes,17,opt,name=diff,proto3" json:"diff,omitempty"` // Not set if includeDiff is false or there's no previous snapshot
+	Error             *RPCError                 `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAntiFraudStateResponseMessage) Reset() {
+	*x = GetAntiFraudStateResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAntiFraudStateResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAntiFraudStateResponseMessage) ProtoMessage() {}
+
+func (x *GetAntiFraudStateResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAntiFraudStateResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetAntiFraudStateResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetRuntimeEnabled() bool {
+	if x != nil {
+		return x.RuntimeEnabled
+	}
+	return false
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetPeerFallback() bool {
+	if x != nil {
+		return x.PeerFallback
+	}
+	return false
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetSeedEnabled() bool {
+	if x != nil {
+		return x.SeedEnabled
+	}
+	return false
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetHasSnapshot() bool {
+	if x != nil {
+		return x.HasSnapshot
+	}
+	return false
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetSnapshotSeq() uint64 {
+	if x != nil {
+		return x.SnapshotSeq
+	}
+	return 0
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetGeneratedAtMs() uint64 {
+	if x != nil {
+		return x.GeneratedAtMs
+	}
+	return 0
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetSigningKeyId() uint32 {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return 0
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetRootHash() string {
+	if x != nil {
+		return x.RootHash
+	}
+	return ""
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetBannedIpCount() uint32 {
+	if x != nil {
+		return x.BannedIpCount
+	}
+	return 0
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetBannedNodeIdCount() uint32 {
+	if x != nil {
+		return x.BannedNodeIdCount
+	}
+	return 0
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetHashWindow() []string {
+	if x != nil {
+		return x.HashWindow
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetPeerVotes() []*RpcAntiFraudPeerVote {
+	if x != nil {
+		return x.PeerVotes
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetMatches() []*RpcAntiFraudEntryMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetBannedIps() []string {
+	if x != nil {
+		return x.BannedIps
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetBannedNodeIds() []string {
+	if x != nil {
+		return x.BannedNodeIds
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetDiff() *RpcAntiFraudSnapshotDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RpcAntiFraudPeerVote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeerId        string                 `protobuf:"bytes,1,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	SnapshotSeq   uint64                 `protobuf:"varint,2,opt,name=snapshotSeq,proto3" json:"snapshotSeq,omitempty"`
+	RootHash      string                 `protobuf:"bytes,3,opt,name=rootHash,proto3" json:"rootHash,omitempty"`
+	ReceivedAtMs  int64                  `protobuf:"varint,4,opt,name=receivedAtMs,proto3" json:"receivedAtMs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcAntiFraudPeerVote) Reset() {
+	*x = RpcAntiFraudPeerVote{}
+	mi := &file_rpc_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAntiFraudPeerVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAntiFraudPeerVote) ProtoMessage() {}
+
+func (x *RpcAntiFraudPeerVote) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAntiFraudPeerVote.ProtoReflect.Descriptor instead.
+func (*RpcAntiFraudPeerVote) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *RpcAntiFraudPeerVote) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *RpcAntiFraudPeerVote) GetSnapshotSeq() uint64 {
+	if x != nil {
+		return x.SnapshotSeq
+	}
+	return 0
+}
+
+func (x *RpcAntiFraudPeerVote) GetRootHash() string {
+	if x != nil {
+		return x.RootHash
+	}
+	return ""
+}
+
+func (x *RpcAntiFraudPeerVote) GetReceivedAtMs() int64 {
+	if x != nil {
+		return x.ReceivedAtMs
+	}
+	return 0
+}
+
+// RpcAntiFraudEntryMatch is an entry of the active snapshot together with the
+// connected peers it cuts
+type RpcAntiFraudEntryMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         string                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	IsNodeId      bool                   `protobuf:"varint,2,opt,name=isNodeId,proto3" json:"isNodeId,omitempty"`
+	Peers         []string               `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcAntiFraudEntryMatch) Reset() {
+	*x = RpcAntiFraudEntryMatch{}
+	mi := &file_rpc_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAntiFraudEntryMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAntiFraudEntryMatch) ProtoMessage() {}
+
+func (x *RpcAntiFraudEntryMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAntiFraudEntryMatch.ProtoReflect.Descriptor instead.
+func (*RpcAntiFraudEntryMatch) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *RpcAntiFraudEntryMatch) GetEntry() string {
+	if x != nil {
+		return x.Entry
+	}
+	return ""
+}
+
+func (x *RpcAntiFraudEntryMatch) GetIsNodeId() bool {
+	if x != nil {
+		return x.IsNodeId
+	}
+	return false
+}
+
+func (x *RpcAntiFraudEntryMatch) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type RpcAntiFraudSnapshotDiff struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PreviousSnapshotSeq uint64                 `protobuf:"varint,1,opt,name=previousSnapshotSeq,proto3" json:"previousSnapshotSeq,omitempty"`
+	PreviousRootHash    string                 `protobuf:"bytes,2,opt,name=previousRootHash,proto3" json:"previousRootHash,omitempty"`
+	AddedIps            []string               `protobuf:"bytes,3,rep,name=addedIps,proto3" json:"addedIps,omitempty"`
+	RemovedIps          []string               `protobuf:"bytes,4,rep,name=removedIps,proto3" json:"removedIps,omitempty"`
+	AddedNodeIds        []string               `protobuf:"bytes,5,rep,name=addedNodeIds,proto3" json:"addedNodeIds,omitempty"`
+	RemovedNodeIds      []string               `protobuf:"bytes,6,rep,name=removedNodeIds,proto3" json:"removedNodeIds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RpcAntiFraudSnapshotDiff) Reset() {
+	*x = RpcAntiFraudSnapshotDiff{}
+	mi := &file_rpc_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcAntiFraudSnapshotDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAntiFraudSnapshotDiff) ProtoMessage() {}
+
+func (x *RpcAntiFraudSnapshotDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAntiFraudSnapshotDiff.ProtoReflect.Descriptor instead.
+func (*RpcAntiFraudSnapshotDiff) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *RpcAntiFraudSnapshotDiff) GetPreviousSnapshotSeq() uint64 {
+	if x != nil {
+		return x.PreviousSnapshotSeq
+	}
+	return 0
+}
+
+func (x *RpcAntiFraudSnapshotDiff) GetPreviousRootHash() string {
+	if x != nil {
+		return x.PreviousRootHash
+	}
+	return ""
+}
+
+func (x *RpcAntiFraudSnapshotDiff) GetAddedIps() []string {
+	if x != nil {
+		return x.AddedIps
+	}
+	return nil
+}
+
+func (x *RpcAntiFraudSnapshotDiff) GetRemovedIps() []string {
+	if x != nil {
+		return x.RemovedIps
+	}
+	return nil
+}
+
+func (x *RpcAntiFraudSnapshotDiff) GetAddedNodeIds() []string {
+	if x != nil {
+		return x.AddedNodeIds
+	}
+	return nil
+}
+
+func (x *RpcAntiFraudSnapshotDiff) GetRemovedNodeIds() []string {
+	if x != nil {
+		return x.RemovedNodeIds
+	}
+	return nil
+}
+
+// SubmitTransactionPackageRequestMessage submits a topologically sorted set of
+// dependent transactions to the mempool. The transactions are validated together,
+// so a parent below the minimum relay fee is accepted if its descendants in the
+// package pay for it, and either all of them are accepted or none.
+type SubmitTransactionPackageRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*RpcTransaction      `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTransactionPackageRequestMessage) Reset() {
+	*x = SubmitTransactionPackageRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTransactionPackageRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTransactionPackageRequestMessage) ProtoMessage() {}
+
+func (x *SubmitTransactionPackageRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTransactionPackageRequestMessage.ProtoReflect.Descriptor instead.
+func (*SubmitTransactionPackageRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *SubmitTransactionPackageRequestMessage) GetTransactions() []*RpcTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type SubmitTransactionPackageResponseMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The transaction IDs of the submitted transactions, in submission order
+	TransactionIds []string  `protobuf:"bytes,1,rep,name=transactionIds,proto3" json:"transactionIds,omitempty"`
+	Error          *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitTransactionPackageResponseMessage) Reset() {
+	*x = SubmitTransactionPackageResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTransactionPackageResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTransactionPackageResponseMessage) ProtoMessage() {}
+
+func (x *SubmitTransactionPackageResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTransactionPackageResponseMessage.ProtoReflect.Descriptor instead.
+func (*SubmitTransactionPackageResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *SubmitTransactionPackageResponseMessage) GetTransactionIds() []string {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *SubmitTransactionPackageResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetTransactionStatusRequestMessage requests where a transaction is in its
+// lifecycle: in the mempool, waiting in the orphan pool, recently rejected or
+// removed from the mempool, or accepted by the selected chain.
+type GetTransactionStatusRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusRequestMessage) Reset() {
+	*x = GetTransactionStatusRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatusRequestMessage) ProtoMessage() {}
+
+func (x *GetTransactionStatusRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatusRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatusRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *GetTransactionStatusRequestMessage) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type GetTransactionStatusResponseMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of "in_mempool", "orphan", "rejected", "accepted" or "unknown"
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Set if the status is "in_mempool". feeRateRank is the 1-based position of
+	// the transaction among the mempool transactions ordered by fee rate, from the
+	// highest. Fee rates are measured in sompi/gram.
+	FeeRate                 float64  `protobuf:"fixed64,2,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	PackageFeeRate          float64  `protobuf:"fixed64,3,opt,name=packageFeeRate,proto3" json:"packageFeeRate,omitempty"`
+	FeeRateRank             uint64   `protobuf:"varint,4,opt,name=feeRateRank,proto3" json:"feeRateRank,omitempty"`
+	MempoolTransactionCount uint64   `protobuf:"varint,5,opt,name=mempoolTransactionCount,proto3" json:"mempoolTransactionCount,omitempty"`
+	AtomicSlots             []string `protobuf:"bytes,6,rep,name=atomicSlots,proto3" json:"atomicSlots,omitempty"`
+	// Set if the status is "orphan"
+	MissingOutpoints []*RpcOutpoint `protobuf:"bytes,7,rep,name=missingOutpoints,proto3" json:"missingOutpoints,omitempty"`
+	// Set if the status is "rejected"
+	RejectCode   string `protobuf:"bytes,8,opt,name=rejectCode,proto3" json:"rejectCode,omitempty"`
+	RejectReason string `protobuf:"bytes,9,opt,name=rejectReason,proto3" json:"rejectReason,omitempty"`
+	RejectedAtMs int64  `protobuf:"varint,10,opt,name=rejectedAtMs,proto3" json:"rejectedAtMs,omitempty"`
+	// Set if the status is "accepted"
+	AcceptingBlockHash string    `protobuf:"bytes,11,opt,name=acceptingBlockHash,proto3" json:"acceptingBlockHash,omitempty"`
+	Confirmations      uint64    `protobuf:"varint,12,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Error              *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusResponseMessage) Reset() {
+	*x = GetTransactionStatusResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatusResponseMessage) ProtoMessage() {}
+
+func (x *GetTransactionStatusResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatusResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatusResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *GetTransactionStatusResponseMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponseMessage) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetPackageFeeRate() float64 {
+	if x != nil {
+		return x.PackageFeeRate
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetFeeRateRank() uint64 {
+	if x != nil {
+		return x.FeeRateRank
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetMempoolTransactionCount() uint64 {
+	if x != nil {
+		return x.MempoolTransactionCount
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetAtomicSlots() []string {
+	if x != nil {
+		return x.AtomicSlots
+	}
+	return nil
+}
+
+func (x *GetTransactionStatusResponseMessage) GetMissingOutpoints() []*RpcOutpoint {
+	if x != nil {
+		return x.MissingOutpoints
+	}
+	return nil
+}
+
+func (x *GetTransactionStatusResponseMessage) GetRejectCode() string {
+	if x != nil {
+		return x.RejectCode
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponseMessage) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponseMessage) GetRejectedAtMs() int64 {
+	if x != nil {
+		return x.RejectedAtMs
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetAcceptingBlockHash() string {
+	if x != nil {
+		return x.AcceptingBlockHash
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponseMessage) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_rpc_proto protoreflect.FileDescriptor
+
+const file_rpc_proto_rawDesc = "" +
+	"\n" +
+	"\trpc.proto\x12\tprotowire\"$\n" +
+	"\bRPCError\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xbe\x01\n" +
+	"\bRpcBlock\x121\n" +
+	"\x06header\x18\x01 \x01(\v2\x19.protowire.RpcBlockHeaderR\x06header\x12=\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x19.protowire.RpcTransactionR\ftransactions\x12@\n" +
+	"\vverboseData\x18\x03 \x01(\v2\x1e.protowire.RpcBlockVerboseDataR\vverboseData\"\xab\x03\n" +
+	"\x0eRpcBlockHeader\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x129\n" +
+	"\aparents\x18\f \x03(\v2\x1f.protowire.RpcBlockLevelParentsR\aparents\x12&\n" +
+	"\x0ehashMerkleRoot\x18\x03 \x01(\tR\x0ehashMerkleRoot\x122\n" +
+	"\x14acceptedIdMerkleRoot\x18\x04 \x01(\tR\x14acceptedIdMerkleRoot\x12&\n" +
+	"\x0eutxoCommitment\x18\x05 \x01(\tR\x0eutxoCommitment\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12\x12\n" +
+	"\x04bits\x18\a \x01(\rR\x04bits\x12\x14\n" +
+	"\x05nonce\x18\b \x01(\x04R\x05nonce\x12\x1a\n" +
+	"\bdaaScore\x18\t \x01(\x04R\bdaaScore\x12\x1a\n" +
+	"\bblueWork\x18\n" +
+	" \x01(\tR\bblueWork\x12\"\n" +
+	"\fpruningPoint\x18\x0e \x01(\tR\fpruningPoint\x12\x1c\n" +
+	"\tblueScore\x18\r \x01(\x04R\tblueScore\":\n" +
+	"\x14RpcBlockLevelParents\x12\"\n" +
+	"\fparentHashes\x18\x01 \x03(\tR\fparentHashes\"\x91\x03\n" +
+	"\x13RpcBlockVerboseData\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\v \x01(\x01R\n" +
+	"difficulty\x12.\n" +
+	"\x12selectedParentHash\x18\r \x01(\tR\x12selectedParentHash\x12&\n" +
+	"\x0etransactionIds\x18\x0e \x03(\tR\x0etransactionIds\x12\"\n" +
+	"\fisHeaderOnly\x18\x0f \x01(\bR\fisHeaderOnly\x12\x1c\n" +
+	"\tblueScore\x18\x10 \x01(\x04R\tblueScore\x12&\n" +
+	"\x0echildrenHashes\x18\x11 \x03(\tR\x0echildrenHashes\x120\n" +
+	"\x13mergeSetBluesHashes\x18\x12 \x03(\tR\x13mergeSetBluesHashes\x12.\n" +
+	"\x12mergeSetRedsHashes\x18\x13 \x03(\tR\x12mergeSetRedsHashes\x12\"\n" +
+	"\fisChainBlock\x18\x14 \x01(\bR\fisChainBlock\"\xd1\x02\n" +
+	"\x0eRpcTransaction\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x126\n" +
+	"\x06inputs\x18\x02 \x03(\v2\x1e.protowire.RpcTransactionInputR\x06inputs\x129\n" +
+	"\aoutputs\x18\x03 \x03(\v2\x1f.protowire.RpcTransactionOutputR\aoutputs\x12\x1a\n" +
+	"\blockTime\x18\x04 \x01(\x04R\blockTime\x12\"\n" +
+	"\fsubnetworkId\x18\x05 \x01(\tR\fsubnetworkId\x12\x10\n" +
+	"\x03gas\x18\x06 \x01(\x04R\x03gas\x12\x18\n" +
+	"\apayload\x18\b \x01(\tR\apayload\x12F\n" +
+	"\vverboseData\x18\t \x01(\v2$.protowire.RpcTransactionVerboseDataR\vverboseData\"\x8c\x02\n" +
+	"\x13RpcTransactionInput\x12B\n" +
+	"\x10previousOutpoint\x18\x01 \x01(\v2\x16.protowire.RpcOutpointR\x10previousOutpoint\x12(\n" +
+	"\x0fsignatureScript\x18\x02 \x01(\tR\x0fsignatureScript\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12\x1e\n" +
+	"\n" +
+	"sigOpCount\x18\x05 \x01(\rR\n" +
+	"sigOpCount\x12K\n" +
+	"\vverboseData\x18\x04 \x01(\v2).protowire.RpcTransactionInputVerboseDataR\vverboseData\"X\n" +
+	"\x12RpcScriptPublicKey\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x12(\n" +
+	"\x0fscriptPublicKey\x18\x02 \x01(\tR\x0fscriptPublicKey\"\xc5\x01\n" +
+	"\x14RpcTransactionOutput\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
+	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12L\n" +
+	"\vverboseData\x18\x03 \x01(\v2*.protowire.RpcTransactionOutputVerboseDataR\vverboseData\"I\n" +
+	"\vRpcOutpoint\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\"\xb5\x01\n" +
+	"\fRpcUtxoEntry\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
+	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12$\n" +
+	"\rblockDaaScore\x18\x03 \x01(\x04R\rblockDaaScore\x12\x1e\n" +
+	"\n" +
+	"isCoinbase\x18\x04 \x01(\bR\n" +
+	"isCoinbase\"\xa5\x01\n" +
+	"\x19RpcTransactionVerboseData\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x12\n" +
+	"\x04mass\x18\x04 \x01(\x04R\x04mass\x12\x1c\n" +
+	"\tblockHash\x18\f \x01(\tR\tblockHash\x12\x1c\n" +
+	"\tblockTime\x18\x0e \x01(\x04R\tblockTime\" \n" +
+	"\x1eRpcTransactionInputVerboseData\"\x8b\x01\n" +
+	"\x1fRpcTransactionOutputVerboseData\x120\n" +
+	"\x13scriptPublicKeyType\x18\x05 \x01(\tR\x13scriptPublicKeyType\x126\n" +
+	"\x16scriptPublicKeyAddress\x18\x06 \x01(\tR\x16scriptPublicKeyAddress\"!\n" +
+	"\x1fGetCurrentNetworkRequestMessage\"v\n" +
+	" GetCurrentNetworkResponseMessage\x12&\n" +
+	"\x0ecurrentNetwork\x18\x01 \x01(\tR\x0ecurrentNetwork\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"t\n" +
+	"\x19SubmitBlockRequestMessage\x12)\n" +
+	"\x05block\x18\x02 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12,\n" +
+	"\x11allowNonDAABlocks\x18\x03 \x01(\bR\x11allowNonDAABlocks\"\xdc\x01\n" +
+	"\x1aSubmitBlockResponseMessage\x12V\n" +
+	"\frejectReason\x18\x01 \x01(\x0e22.protowire.SubmitBlockResponseMessage.RejectReasonR\frejectReason\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\":\n" +
+	"\fRejectReason\x12\b\n" +
+	"\x04NONE\x10\x00\x12\x11\n" +
+	"\rBLOCK_INVALID\x10\x01\x12\r\n" +
+	"\tIS_IN_IBD\x10\x02\"^\n" +
+	"\x1eGetBlockTemplateRequestMessage\x12\x1e\n" +
+	"\n" +
+	"payAddress\x18\x01 \x01(\tR\n" +
+	"payAddress\x12\x1c\n" +
+	"\textraData\x18\x02 \x01(\tR\textraData\"\x94\x01\n" +
+	"\x1fGetBlockTemplateResponseMessage\x12)\n" +
+	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12\x1a\n" +
+	"\bisSynced\x18\x02 \x01(\bR\bisSynced\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\" \n" +
+	"\x1eNotifyBlockAddedRequestMessage\"M\n" +
+	"\x1fNotifyBlockAddedResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"J\n" +
+	"\x1dBlockAddedNotificationMessage\x12)\n" +
+	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\" \n" +
+	"\x1eGetPeerAddressesRequestMessage\"\xf5\x01\n" +
+	"\x1fGetPeerAddressesResponseMessage\x12L\n" +
+	"\taddresses\x18\x01 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\taddresses\x12X\n" +
+	"\x0fbannedAddresses\x18\x02 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\x0fbannedAddresses\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"9\n" +
+	"#GetPeerAddressesKnownAddressMessage\x12\x12\n" +
+	"\x04Addr\x18\x01 \x01(\tR\x04Addr\"\"\n" +
+	" GetSelectedTipHashRequestMessage\"y\n" +
+	"!GetSelectedTipHashResponseMessage\x12(\n" +
+	"\x0fselectedTipHash\x18\x01 \x01(\tR\x0fselectedTipHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x97\x01\n" +
+	"\x1dGetMempoolEntryRequestMessage\x12\x12\n" +
+	"\x04txId\x18\x01 \x01(\tR\x04txId\x12,\n" +
+	"\x11includeOrphanPool\x18\x02 \x01(\bR\x11includeOrphanPool\x124\n" +
+	"\x15filterTransactionPool\x18\x03 \x01(\bR\x15filterTransactionPool\"{\n" +
+	"\x1eGetMempoolEntryResponseMessage\x12-\n" +
+	"\x05entry\x18\x01 \x01(\v2\x17.protowire.MempoolEntryR\x05entry\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x85\x01\n" +
+	"\x1fGetMempoolEntriesRequestMessage\x12,\n" +
+	"\x11includeOrphanPool\x18\x01 \x01(\bR\x11includeOrphanPool\x124\n" +
+	"\x15filterTransactionPool\x18\x02 \x01(\bR\x15filterTransactionPool\"\x81\x01\n" +
+	" GetMempoolEntriesResponseMessage\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.protowire.MempoolEntryR\aentries\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"y\n" +
+	"\fMempoolEntry\x12\x10\n" +
+	"\x03fee\x18\x01 \x01(\x04R\x03fee\x12;\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12\x1a\n" +
+	"\bisOrphan\x18\x04 \x01(\bR\bisOrphan\"$\n" +
+	"\"GetConnectedPeerInfoRequestMessage\"\x8f\x01\n" +
+	"#GetConnectedPeerInfoResponseMessage\x12<\n" +
+	"\x05infos\x18\x01 \x03(\v2&.protowire.GetConnectedPeerInfoMessageR\x05infos\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xe7\x04\n" +
+	"\x1bGetConnectedPeerInfoMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12*\n" +
+	"\x10lastPingDuration\x18\x03 \x01(\x03R\x10lastPingDuration\x12\x1e\n" +
+	"\n" +
+	"isOutbound\x18\x06 \x01(\bR\n" +
+	"isOutbound\x12\x1e\n" +
+	"\n" +
+	"timeOffset\x18\a \x01(\x03R\n" +
+	"timeOffset\x12\x1c\n" +
+	"\tuserAgent\x18\b \x01(\tR\tuserAgent\x12<\n" +
+	"\x19advertisedProtocolVersion\x18\t \x01(\rR\x19advertisedProtocolVersion\x12.\n" +
+	"\x12advertisedServices\x18\r \x01(\x04R\x12advertisedServices\x12&\n" +
+	"\x0eisHfaFastchain\x18\x0e \x01(\bR\x0eisHfaFastchain\x12(\n" +
+	"\x0fisCryptixAtomic\x18\x0f \x01(\bR\x0fisCryptixAtomic\x12.\n" +
+	"\x12isStrongNodeClaims\x18\x10 \x01(\bR\x12isStrongNodeClaims\x12\x1e\n" +
+	"\n" +
+	"isArchival\x18\x11 \x01(\bR\n" +
+	"isArchival\x12$\n" +
+	"\rtimeConnected\x18\n" +
+	" \x01(\x03R\rtimeConnected\x12\x1c\n" +
+	"\tisIbdPeer\x18\v \x01(\bR\tisIbdPeer\x12$\n" +
+	"\runifiedNodeId\x18\f \x01(\tR\runifiedNodeId\x12\x1a\n" +
+	"\bbanScore\x18\x12 \x01(\rR\bbanScore\"S\n" +
+	"\x15AddPeerRequestMessage\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12 \n" +
+	"\visPermanent\x18\x02 \x01(\bR\visPermanent\"D\n" +
+	"\x16AddPeerResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x80\x01\n" +
+	"\x1fSubmitTransactionRequestMessage\x12;\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12 \n" +
+	"\vallowOrphan\x18\x02 \x01(\bR\vallowOrphan\"t\n" +
+	" SubmitTransactionResponseMessage\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"}\n" +
+	"5NotifyVirtualSelectedParentChainChangedRequestMessage\x12D\n" +
+	"\x1dincludeAcceptedTransactionIds\x18\x01 \x01(\bR\x1dincludeAcceptedTransactionIds\"d\n" +
+	"6NotifyVirtualSelectedParentChainChangedResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x81\x02\n" +
+	"4VirtualSelectedParentChainChangedNotificationMessage\x128\n" +
+	"\x17removedChainBlockHashes\x18\x01 \x03(\tR\x17removedChainBlockHashes\x124\n" +
+	"\x15addedChainBlockHashes\x18\x03 \x03(\tR\x15addedChainBlockHashes\x12Y\n" +
+	"\x16acceptedTransactionIds\x18\x02 \x03(\v2!.protowire.AcceptedTransactionIdsR\x16acceptedTransactionIds\"^\n" +
+	"\x16GetBlockRequestMessage\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x120\n" +
+	"\x13includeTransactions\x18\x03 \x01(\bR\x13includeTransactions\"p\n" +
+	"\x17GetBlockResponseMessage\x12)\n" +
+	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"A\n" +
+	"\x1bGetSubnetworkRequestMessage\x12\"\n" +
+	"\fsubnetworkId\x18\x01 \x01(\tR\fsubnetworkId\"f\n" +
+	"\x1cGetSubnetworkResponseMessage\x12\x1a\n" +
+	"\bgasLimit\x18\x01 \x01(\x04R\bgasLimit\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x9a\x01\n" +
+	"4GetVirtualSelectedParentChainFromBlockRequestMessage\x12\x1c\n" +
+	"\tstartHash\x18\x01 \x01(\tR\tstartHash\x12D\n" +
+	"\x1dincludeAcceptedTransactionIds\x18\x02 \x01(\bR\x1dincludeAcceptedTransactionIds\"\x80\x01\n" +
+	"\x16AcceptedTransactionIds\x12.\n" +
+	"\x12acceptingBlockHash\x18\x01 \x01(\tR\x12acceptingBlockHash\x126\n" +
+	"\x16acceptedTransactionIds\x18\x02 \x03(\tR\x16acceptedTransactionIds\"\xae\x02\n" +
+	"5GetVirtualSelectedParentChainFromBlockResponseMessage\x128\n" +
+	"\x17removedChainBlockHashes\x18\x01 \x03(\tR\x17removedChainBlockHashes\x124\n" +
+	"\x15addedChainBlockHashes\x18\x03 \x03(\tR\x15addedChainBlockHashes\x12Y\n" +
+	"\x16acceptedTransactionIds\x18\x02 \x03(\v2!.protowire.AcceptedTransactionIdsR\x16acceptedTransactionIds\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x8b\x01\n" +
+	"\x17GetBlocksRequestMessage\x12\x18\n" +
+	"\alowHash\x18\x01 \x01(\tR\alowHash\x12$\n" +
+	"\rincludeBlocks\x18\x02 \x01(\bR\rincludeBlocks\x120\n" +
+	"\x13includeTransactions\x18\x03 \x01(\bR\x13includeTransactions\"\x95\x01\n" +
+	"\x18GetBlocksResponseMessage\x12 \n" +
+	"\vblockHashes\x18\x04 \x03(\tR\vblockHashes\x12+\n" +
+	"\x06blocks\x18\x03 \x03(\v2\x13.protowire.RpcBlockR\x06blocks\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x1d\n" +
+	"\x1bGetBlockCountRequestMessage\"\x8c\x01\n" +
+	"\x1cGetBlockCountResponseMessage\x12\x1e\n" +
+	"\n" +
+	"blockCount\x18\x01 \x01(\x04R\n" +
+	"blockCount\x12 \n" +
+	"\vheaderCount\x18\x02 \x01(\x04R\vheaderCount\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x1f\n" +
+	"\x1dGetBlockDagInfoRequestMessage\"\x9e\x03\n" +
+	"\x1eGetBlockDagInfoResponseMessage\x12 \n" +
+	"\vnetworkName\x18\x01 \x01(\tR\vnetworkName\x12\x1e\n" +
+	"\n" +
+	"blockCount\x18\x02 \x01(\x04R\n" +
+	"blockCount\x12 \n" +
+	"\vheaderCount\x18\x03 \x01(\x04R\vheaderCount\x12\x1c\n" +
+	"\ttipHashes\x18\x04 \x03(\tR\ttipHashes\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x05 \x01(\x01R\n" +
+	"difficulty\x12&\n" +
+	"\x0epastMedianTime\x18\x06 \x01(\x03R\x0epastMedianTime\x120\n" +
+	"\x13virtualParentHashes\x18\a \x03(\tR\x13virtualParentHashes\x12*\n" +
+	"\x10pruningPointHash\x18\b \x01(\tR\x10pruningPointHash\x12(\n" +
+	"\x0fvirtualDaaScore\x18\t \x01(\x04R\x0fvirtualDaaScore\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"U\n" +
+	"%ResolveFinalityConflictRequestMessage\x12,\n" +
+	"\x11finalityBlockHash\x18\x01 \x01(\tR\x11finalityBlockHash\"T\n" +
+	"&ResolveFinalityConflictResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"'\n" +
+	"%NotifyFinalityConflictsRequestMessage\"T\n" +
+	"&NotifyFinalityConflictsResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"U\n" +
+	"#FinalityConflictNotificationMessage\x12.\n" +
+	"\x12violatingBlockHash\x18\x01 \x01(\tR\x12violatingBlockHash\"[\n" +
+	"+FinalityConflictResolvedNotificationMessage\x12,\n" +
+	"\x11finalityBlockHash\x18\x01 \x01(\tR\x11finalityBlockHash\"\x18\n" +
 	"\x16ShutDownRequestMessage\"E\n" +
 	"\x17ShutDownResponseMessage\x12*\n" +
 	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"p\n" +
@@ -8347,6 +12671,345 @@ const file_rpc_proto_rawDesc = "" +
 	"+SubmitTransactionReplacementResponseMessage\x12$\n" +
 	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12K\n" +
 	"\x13replacedTransaction\x18\x02 \x01(\v2\x19.protowire.RpcTransactionR\x13replacedTransaction\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xdf\x04\n" +
+	"\x0eRpcAtomicAsset\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\x12&\n" +
+	"\x0ecreatorOwnerId\x18\x02 \x01(\tR\x0ecreatorOwnerId\x12\x1e\n" +
+	"\n" +
+	"assetClass\x18\x03 \x01(\rR\n" +
+	"assetClass\x12\"\n" +
+	"\ftokenVersion\x18\x04 \x01(\rR\ftokenVersion\x122\n" +
+	"\x14mintAuthorityOwnerId\x18\x05 \x01(\tR\x14mintAuthorityOwnerId\x12\x1a\n" +
+	"\bdecimals\x18\x06 \x01(\rR\bdecimals\x12\x1e\n" +
+	"\n" +
+	"supplyMode\x18\a \x01(\rR\n" +
+	"supplyMode\x12\x1c\n" +
+	"\tmaxSupply\x18\b \x01(\tR\tmaxSupply\x12 \n" +
+	"\vtotalSupply\x18\t \x01(\tR\vtotalSupply\x12\x12\n" +
+	"\x04name\x18\n" +
+	" \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\v \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bmetadata\x18\f \x01(\tR\bmetadata\x12 \n" +
+	"\vplatformTag\x18\r \x01(\tR\vplatformTag\x12*\n" +
+	"\x10createdBlockHash\x18\x0e \x01(\tR\x10createdBlockHash\x12(\n" +
+	"\x0fcreatedDaaScore\x18\x0f \x01(\x04R\x0fcreatedDaaScore\x12\x1c\n" +
+	"\tcreatedAt\x18\x10 \x01(\x04R\tcreatedAt\x129\n" +
+	"\tliquidity\x18\x11 \x01(\v2\x1b.protowire.RpcLiquidityPoolR\tliquidity\"v\n" +
+	"\x18RpcLiquidityFeeRecipient\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12&\n" +
+	"\x0eunclaimedSompi\x18\x03 \x01(\x04R\x0eunclaimedSompi\"\xab\x06\n" +
+	"\x10RpcLiquidityPool\x12\x1c\n" +
+	"\tpoolNonce\x18\x01 \x01(\x04R\tpoolNonce\x12\"\n" +
+	"\fcurveVersion\x18\x02 \x01(\rR\fcurveVersion\x12\x1c\n" +
+	"\tcurveMode\x18\x03 \x01(\rR\tcurveMode\x12N\n" +
+	"\"individualVirtualCpayReservesSompi\x18\x04 \x01(\x04R\"individualVirtualCpayReservesSompi\x12P\n" +
+	"#individualVirtualTokenMultiplierBps\x18\x05 \x01(\rR#individualVirtualTokenMultiplierBps\x124\n" +
+	"\x15realCpayReservesSompi\x18\x06 \x01(\x04R\x15realCpayReservesSompi\x12,\n" +
+	"\x11realTokenReserves\x18\a \x01(\tR\x11realTokenReserves\x120\n" +
+	"\x13virtualCpayReserves\x18\b \x01(\x04R\x13virtualCpayReserves\x122\n" +
+	"\x14virtualTokenReserves\x18\t \x01(\tR\x14virtualTokenReserves\x126\n" +
+	"\x16unclaimedFeeTotalSompi\x18\n" +
+	" \x01(\x04R\x16unclaimedFeeTotalSompi\x12\x16\n" +
+	"\x06feeBps\x18\v \x01(\rR\x06feeBps\x12I\n" +
+	"\rfeeRecipients\x18\f \x03(\v2#.protowire.RpcLiquidityFeeRecipientR\rfeeRecipients\x12<\n" +
+	"\rvaultOutpoint\x18\r \x01(\v2\x16.protowire.RpcOutpointR\rvaultOutpoint\x12(\n" +
+	"\x0fvaultValueSompi\x18\x0e \x01(\x04R\x0fvaultValueSompi\x12,\n" +
+	"\x11unlockTargetSompi\x18\x0f \x01(\x04R\x11unlockTargetSompi\x12\x1a\n" +
+	"\bunlocked\x18\x10 \x01(\bR\bunlocked\"8\n" +
+	"\x1cGetAtomicAssetRequestMessage\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\"\x9a\x01\n" +
+	"\x1dGetAtomicAssetResponseMessage\x12/\n" +
+	"\x05asset\x18\x01 \x01(\v2\x19.protowire.RpcAtomicAssetR\x05asset\x12\x1c\n" +
+	"\tblockHash\x18\x02 \x01(\tR\tblockHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"Y\n" +
+	"\x1dGetAtomicAssetsRequestMessage\x12\"\n" +
+	"\fstartAssetId\x18\x01 \x01(\tR\fstartAssetId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\xbf\x01\n" +
+	"\x1eGetAtomicAssetsResponseMessage\x121\n" +
+	"\x06assets\x18\x01 \x03(\v2\x19.protowire.RpcAtomicAssetR\x06assets\x12 \n" +
+	"\vnextAssetId\x18\x02 \x01(\tR\vnextAssetId\x12\x1c\n" +
+	"\tblockHash\x18\x03 \x01(\tR\tblockHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"F\n" +
+	"\x10RpcAtomicBalance\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\"\\\n" +
+	"&GetAtomicBalancesByOwnerRequestMessage\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\xc6\x01\n" +
+	"'GetAtomicBalancesByOwnerResponseMessage\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x127\n" +
+	"\bbalances\x18\x02 \x03(\v2\x1b.protowire.RpcAtomicBalanceR\bbalances\x12\x1c\n" +
+	"\tblockHash\x18\x03 \x01(\tR\tblockHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"l\n" +
+	"\x1cGetAtomicNonceRequestMessage\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\aassetId\x18\x03 \x01(\tR\aassetId\"\xa1\x01\n" +
+	"\x1dGetAtomicNonceResponseMessage\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12\x1c\n" +
+	"\tnextNonce\x18\x02 \x01(\x04R\tnextNonce\x12\x1c\n" +
+	"\tblockHash\x18\x03 \x01(\tR\tblockHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\":\n" +
+	"\x1eGetLiquidityPoolRequestMessage\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\"\x9c\x01\n" +
+	"\x1fGetLiquidityPoolResponseMessage\x12/\n" +
+	"\x04pool\x18\x01 \x01(\v2\x1b.protowire.RpcLiquidityPoolR\x04pool\x12\x1c\n" +
+	"\tblockHash\x18\x02 \x01(\tR\tblockHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"`\n" +
+	"&NotifyAtomicStateChangedRequestMessage\x12\x1a\n" +
+	"\bownerIds\x18\x01 \x03(\tR\bownerIds\x12\x1a\n" +
+	"\bassetIds\x18\x02 \x03(\tR\bassetIds\"U\n" +
+	"'NotifyAtomicStateChangedResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"Z\n" +
+	"%AtomicStateChangedNotificationMessage\x121\n" +
+	"\x06events\x18\x01 \x03(\v2\x19.protowire.RpcAtomicEventR\x06events\"\xa8\x03\n" +
+	"\x0eRpcAtomicEvent\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12$\n" +
+	"\rtransactionId\x18\x02 \x01(\tR\rtransactionId\x12.\n" +
+	"\x12acceptingBlockHash\x18\x03 \x01(\tR\x12acceptingBlockHash\x12\x18\n" +
+	"\aassetId\x18\x04 \x01(\tR\aassetId\x12\x18\n" +
+	"\aownerId\x18\x05 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12(\n" +
+	"\x0fcpayAmountSompi\x18\a \x01(\x04R\x0fcpayAmountSompi\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\b \x03(\tR\n" +
+	"recipients\x12I\n" +
+	"\x0ebalanceChanges\x18\t \x03(\v2!.protowire.RpcAtomicBalanceChangeR\x0ebalanceChanges\x12/\n" +
+	"\x04pool\x18\n" +
+	" \x01(\v2\x1b.protowire.RpcLiquidityPoolR\x04pool\x12\x1a\n" +
+	"\breversed\x18\v \x01(\bR\breversed\"`\n" +
+	"\x16RpcAtomicBalanceChange\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"/\n" +
+	"-StopNotifyingAtomicStateChangedRequestMessage\"\\\n" +
+	".StopNotifyingAtomicStateChangedResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xc5\x01\n" +
+	"\x1eSubmitFastIntentRequestMessage\x12;\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12 \n" +
+	"\vintentNonce\x18\x02 \x01(\x04R\vintentNonce\x12,\n" +
+	"\x11clientCreatedAtMs\x18\x03 \x01(\x04R\x11clientCreatedAtMs\x12\x16\n" +
+	"\x06maxFee\x18\x04 \x01(\x04R\x06maxFee\"\x8f\x01\n" +
+	"\x1fSubmitFastIntentResponseMessage\x12\x1a\n" +
+	"\bintentId\x18\x01 \x01(\tR\bintentId\x12$\n" +
+	"\rtransactionId\x18\x02 \x01(\tR\rtransactionId\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"?\n" +
+	"!GetFastIntentStatusRequestMessage\x12\x1a\n" +
+	"\bintentId\x18\x01 \x01(\tR\bintentId\"\xc0\x02\n" +
+	"\"GetFastIntentStatusResponseMessage\x12\x1a\n" +
+	"\bintentId\x18\x01 \x01(\tR\bintentId\x12$\n" +
+	"\rtransactionId\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06maxFee\x18\x04 \x01(\x04R\x06maxFee\x12,\n" +
+	"\x11clientCreatedAtMs\x18\x05 \x01(\x04R\x11clientCreatedAtMs\x12\"\n" +
+	"\freceivedAtMs\x18\x06 \x01(\x04R\freceivedAtMs\x12*\n" +
+	"\x10microblockTimeMs\x18\a \x01(\x04R\x10microblockTimeMs\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"o\n" +
+	"%GetAtomicHistoryByOwnerRequestMessage\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"v\n" +
+	"\x15RpcAtomicHistoryEntry\x12,\n" +
+	"\x11acceptingDaaScore\x18\x01 \x01(\x04R\x11acceptingDaaScore\x12/\n" +
+	"\x05event\x18\x02 \x01(\v2\x19.protowire.RpcAtomicEventR\x05event\"\xca\x01\n" +
+	"&GetAtomicHistoryByOwnerResponseMessage\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12:\n" +
+	"\aentries\x18\x02 \x03(\v2 .protowire.RpcAtomicHistoryEntryR\aentries\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"o\n" +
+	"%GetAtomicHistoryByAssetRequestMessage\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"\xca\x01\n" +
+	"&GetAtomicHistoryByAssetResponseMessage\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\x12:\n" +
+	"\aentries\x18\x02 \x03(\v2 .protowire.RpcAtomicHistoryEntryR\aentries\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"f\n" +
+	"'SimulateAtomicTransactionRequestMessage\x12;\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\"\xa6\x04\n" +
+	"(SimulateAtomicTransactionResponseMessage\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x1a\n" +
+	"\bdaaScore\x18\x02 \x01(\x04R\bdaaScore\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\x04R\x03fee\x12(\n" +
+	"\x0fvalidationError\x18\x04 \x01(\tR\x0fvalidationError\x12 \n" +
+	"\vatomicError\x18\x05 \x01(\tR\vatomicError\x12R\n" +
+	"\x0ebalanceChanges\x18\x06 \x03(\v2*.protowire.RpcAtomicSimulatedBalanceChangeR\x0ebalanceChanges\x12L\n" +
+	"\fnonceChanges\x18\a \x03(\v2(.protowire.RpcAtomicSimulatedNonceChangeR\fnonceChanges\x12I\n" +
+	"\vpoolChanges\x18\b \x03(\v2'.protowire.RpcAtomicSimulatedPoolChangeR\vpoolChanges\x12A\n" +
+	"\vstateGrowth\x18\t \x01(\v2\x1f.protowire.RpcAtomicStateGrowthR\vstateGrowth\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x83\x01\n" +
+	"\x1fRpcAtomicSimulatedBalanceChange\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\x12\x18\n" +
+	"\aownerId\x18\x02 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06before\x18\x03 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\tR\x05after\"\x81\x01\n" +
+	"\x1dRpcAtomicSimulatedNonceChange\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\tR\aownerId\x12\x18\n" +
+	"\aassetId\x18\x02 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06before\x18\x03 \x01(\x04R\x06before\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\x04R\x05after\"\xa0\x01\n" +
+	"\x1cRpcAtomicSimulatedPoolChange\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\x123\n" +
+	"\x06before\x18\x02 \x01(\v2\x1b.protowire.RpcLiquidityPoolR\x06before\x121\n" +
+	"\x05after\x18\x03 \x01(\v2\x1b.protowire.RpcLiquidityPoolR\x05after\"\xcc\x01\n" +
+	"\x14RpcAtomicStateGrowth\x12\x1c\n" +
+	"\tnewAssets\x18\x01 \x01(\x04R\tnewAssets\x12&\n" +
+	"\x0enewBalanceKeys\x18\x02 \x01(\x04R\x0enewBalanceKeys\x12\"\n" +
+	"\fnewNonceKeys\x18\x03 \x01(\x04R\fnewNonceKeys\x12\x1a\n" +
+	"\bnewPools\x18\x04 \x01(\x04R\bnewPools\x12.\n" +
+	"\x12newAnchorOwnerKeys\x18\x05 \x01(\x04R\x12newAnchorOwnerKeys\"s\n" +
+	"#GetAtomicBalanceProofRequestMessage\x12\x18\n" +
+	"\aassetId\x18\x01 \x01(\tR\aassetId\x12\x18\n" +
+	"\aownerId\x18\x02 \x01(\tR\aownerId\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"\xea\x03\n" +
+	"$GetAtomicBalanceProofResponseMessage\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\tR\tblockHash\x12\x1a\n" +
+	"\bdaaScore\x18\x02 \x01(\x04R\bdaaScore\x12&\n" +
+	"\x0eutxoCommitment\x18\x03 \x01(\tR\x0eutxoCommitment\x12,\n" +
+	"\x11rawUtxoCommitment\x18\x04 \x01(\tR\x11rawUtxoCommitment\x12*\n" +
+	"\x10atomicMerkleRoot\x18\x05 \x01(\tR\x10atomicMerkleRoot\x12\x18\n" +
+	"\aassetId\x18\x06 \x01(\tR\aassetId\x12\x18\n" +
+	"\aownerId\x18\a \x01(\tR\aownerId\x12\x18\n" +
+	"\abalance\x18\b \x01(\tR\abalance\x12\x1a\n" +
+	"\bincluded\x18\t \x01(\bR\bincluded\x12\x1a\n" +
+	"\bsiblings\x18\n" +
+	" \x03(\tR\bsiblings\x12$\n" +
+	"\rotherLeafPath\x18\v \x01(\tR\rotherLeafPath\x12.\n" +
+	"\x12otherLeafEntryHash\x18\f \x01(\tR\x12otherLeafEntryHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x8b\x01\n" +
+	"!GetStrongNodeClaimsRequestMessage\x122\n" +
+	"\x14includeWinningClaims\x18\x01 \x01(\bR\x14includeWinningClaims\x122\n" +
+	"\x14includePendingClaims\x18\x02 \x01(\bR\x14includePendingClaims\"\xd2\x03\n" +
+	"\"GetStrongNodeClaimsResponseMessage\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12&\n" +
+	"\x0ehardforkActive\x18\x02 \x01(\bR\x0ehardforkActive\x12*\n" +
+	"\x10runtimeAvailable\x18\x03 \x01(\bR\x10runtimeAvailable\x12\x1e\n" +
+	"\n" +
+	"windowSize\x18\x04 \x01(\rR\n" +
+	"windowSize\x12$\n" +
+	"\rconflictTotal\x18\x05 \x01(\x04R\rconflictTotal\x12<\n" +
+	"\aentries\x18\x06 \x03(\v2\".protowire.RpcStrongNodeClaimEntryR\aentries\x12F\n" +
+	"\rwinningClaims\x18\a \x03(\v2 .protowire.RpcBlockProducerClaimR\rwinningClaims\x12F\n" +
+	"\rpendingClaims\x18\b \x03(\v2 .protowire.RpcBlockProducerClaimR\rpendingClaims\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xf5\x01\n" +
+	"\x17RpcStrongNodeClaimEntry\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12&\n" +
+	"\x0epublicKeyXOnly\x18\x02 \x01(\tR\x0epublicKeyXOnly\x12$\n" +
+	"\rclaimedBlocks\x18\x03 \x01(\rR\rclaimedBlocks\x12\x1a\n" +
+	"\bshareBps\x18\x04 \x01(\rR\bshareBps\x12.\n" +
+	"\x12lastClaimBlockHash\x18\x05 \x01(\tR\x12lastClaimBlockHash\x12(\n" +
+	"\x0flastClaimTimeMs\x18\x06 \x01(\x04R\x0flastClaimTimeMs\"\xb3\x01\n" +
+	"\x15RpcBlockProducerClaim\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\tR\tblockHash\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12&\n" +
+	"\x0epublicKeyXOnly\x18\x03 \x01(\tR\x0epublicKeyXOnly\x12\x18\n" +
+	"\aclaimId\x18\x04 \x01(\tR\aclaimId\x12\"\n" +
+	"\freceivedAtMs\x18\x05 \x01(\x04R\freceivedAtMs\".\n" +
+	",NotifyBlockProducerClaimWinnerRequestMessage\"[\n" +
+	"-NotifyBlockProducerClaimWinnerResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"i\n" +
+	"+BlockProducerClaimWinnerNotificationMessage\x12:\n" +
+	"\awinners\x18\x01 \x03(\v2 .protowire.RpcBlockProducerClaimR\awinners\"s\n" +
+	"\x17BanNodeIDRequestMessage\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12(\n" +
+	"\x0fdurationSeconds\x18\x03 \x01(\x04R\x0fdurationSeconds\"F\n" +
+	"\x18BanNodeIDResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"3\n" +
+	"\x19UnbanNodeIDRequestMessage\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\"H\n" +
+	"\x1aUnbanNodeIDResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x18\n" +
+	"\x16ListBansRequestMessage\"\xbd\x01\n" +
+	"\x17ListBansResponseMessage\x124\n" +
+	"\tbannedIps\x18\x01 \x03(\v2\x16.protowire.RpcBannedIPR\tbannedIps\x12@\n" +
+	"\rbannedNodeIds\x18\x02 \x03(\v2\x1a.protowire.RpcBannedNodeIDR\rbannedNodeIds\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"_\n" +
+	"\vRpcBannedIP\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x1e\n" +
+	"\n" +
+	"bannedAtMs\x18\x02 \x01(\x03R\n" +
+	"bannedAtMs\x12 \n" +
+	"\vexpiresAtMs\x18\x03 \x01(\x03R\vexpiresAtMs\"\x83\x01\n" +
+	"\x0fRpcBannedNodeID\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"bannedAtMs\x18\x03 \x01(\x03R\n" +
+	"bannedAtMs\x12 \n" +
+	"\vexpiresAtMs\x18\x04 \x01(\x03R\vexpiresAtMs\"k\n" +
+	"\x1fGetAntiFraudStateRequestMessage\x12&\n" +
+	"\x0eincludeEntries\x18\x01 \x01(\bR\x0eincludeEntries\x12 \n" +
+	"\vincludeDiff\x18\x02 \x01(\bR\vincludeDiff\"\xeb\x05\n" +
+	" GetAntiFraudStateResponseMessage\x12&\n" +
+	"\x0eruntimeEnabled\x18\x01 \x01(\bR\x0eruntimeEnabled\x12\"\n" +
+	"\fpeerFallback\x18\x02 \x01(\bR\fpeerFallback\x12 \n" +
+	"\vseedEnabled\x18\x03 \x01(\bR\vseedEnabled\x12 \n" +
+	"\vhasSnapshot\x18\x04 \x01(\bR\vhasSnapshot\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12 \n" +
+	"\vsnapshotSeq\x18\x06 \x01(\x04R\vsnapshotSeq\x12$\n" +
+	"\rgeneratedAtMs\x18\a \x01(\x04R\rgeneratedAtMs\x12\"\n" +
+	"\fsigningKeyId\x18\b \x01(\rR\fsigningKeyId\x12\x1a\n" +
+	"\brootHash\x18\t \x01(\tR\brootHash\x12$\n" +
+	"\rbannedIpCount\x18\n" +
+	" \x01(\rR\rbannedIpCount\x12,\n" +
+	"\x11bannedNodeIdCount\x18\v \x01(\rR\x11bannedNodeIdCount\x12\x1e\n" +
+	"\n" +
+	"hashWindow\x18\f \x03(\tR\n" +
+	"hashWindow\x12=\n" +
+	"\tpeerVotes\x18\r \x03(\v2\x1f.protowire.RpcAntiFraudPeerVoteR\tpeerVotes\x12;\n" +
+	"\amatches\x18\x0e \x03(\v2!.protowire.RpcAntiFraudEntryMatchR\amatches\x12\x1c\n" +
+	"\tbannedIps\x18\x0f \x03(\tR\tbannedIps\x12$\n" +
+	"\rbannedNodeIds\x18\x10 \x03(\tR\rbannedNodeIds\x127\n" +
+	"\x04diff\x18\x11 \x01(\v2#.protowire.RpcAntiFraudSnapshotDiffR\x04diff\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x90\x01\n" +
+	"\x14RpcAntiFraudPeerVote\x12\x16\n" +
+	"\x06peerId\x18\x01 \x01(\tR\x06peerId\x12 \n" +
+	"\vsnapshotSeq\x18\x02 \x01(\x04R\vsnapshotSeq\x12\x1a\n" +
+	"\brootHash\x18\x03 \x01(\tR\brootHash\x12\"\n" +
+	"\freceivedAtMs\x18\x04 \x01(\x03R\freceivedAtMs\"`\n" +
+	"\x16RpcAntiFraudEntryMatch\x12\x14\n" +
+	"\x05entry\x18\x01 \x01(\tR\x05entry\x12\x1a\n" +
+	"\bisNodeId\x18\x02 \x01(\bR\bisNodeId\x12\x14\n" +
+	"\x05peers\x18\x03 \x03(\tR\x05peers\"\x80\x02\n" +
+	"\x18RpcAntiFraudSnapshotDiff\x120\n" +
+	"\x13previousSnapshotSeq\x18\x01 \x01(\x04R\x13previousSnapshotSeq\x12*\n" +
+	"\x10previousRootHash\x18\x02 \x01(\tR\x10previousRootHash\x12\x1a\n" +
+	"\baddedIps\x18\x03 \x03(\tR\baddedIps\x12\x1e\n" +
+	"\n" +
+	"removedIps\x18\x04 \x03(\tR\n" +
+	"removedIps\x12\"\n" +
+	"\faddedNodeIds\x18\x05 \x03(\tR\faddedNodeIds\x12&\n" +
+	"\x0eremovedNodeIds\x18\x06 \x03(\tR\x0eremovedNodeIds\"g\n" +
+	"&SubmitTransactionPackageRequestMessage\x12=\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x19.protowire.RpcTransactionR\ftransactions\"}\n" +
+	"'SubmitTransactionPackageResponseMessage\x12&\n" +
+	"\x0etransactionIds\x18\x01 \x03(\tR\x0etransactionIds\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"J\n" +
+	"\"GetTransactionStatusRequestMessage\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\"\xab\x04\n" +
+	"#GetTransactionStatusResponseMessage\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\afeeRate\x18\x02 \x01(\x01R\afeeRate\x12&\n" +
+	"\x0epackageFeeRate\x18\x03 \x01(\x01R\x0epackageFeeRate\x12 \n" +
+	"\vfeeRateRank\x18\x04 \x01(\x04R\vfeeRateRank\x128\n" +
+	"\x17mempoolTransactionCount\x18\x05 \x01(\x04R\x17mempoolTransactionCount\x12 \n" +
+	"\vatomicSlots\x18\x06 \x03(\tR\vatomicSlots\x12B\n" +
+	"\x10missingOutpoints\x18\a \x03(\v2\x16.protowire.RpcOutpointR\x10missingOutpoints\x12\x1e\n" +
+	"\n" +
+	"rejectCode\x18\b \x01(\tR\n" +
+	"rejectCode\x12\"\n" +
+	"\frejectReason\x18\t \x01(\tR\frejectReason\x12\"\n" +
+	"\frejectedAtMs\x18\n" +
+	" \x01(\x03R\frejectedAtMs\x12.\n" +
+	"\x12acceptingBlockHash\x18\v \x01(\tR\x12acceptingBlockHash\x12$\n" +
+	"\rconfirmations\x18\f \x01(\x04R\rconfirmations\x12*\n" +
 	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05errorB/Z-github.com/cryptix-network/cryptixd/protowireb\x06proto3"
 
 var (
@@ -8362,7 +13025,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 }
 
 var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 139)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 201)
 var file_rpc_proto_goTypes = []any{
 	(SubmitBlockResponseMessage_RejectReason)(0), // 0: protowire.SubmitBlockResponseMessage.RejectReason
 	(*RPCError)(nil),                                                   // 1: protowire.RPCError
@@ -8504,6 +13167,68 @@ var file_rpc_proto_goTypes = []any{
 	(*GetCurrentBlockColorResponseMessage)(nil),                        // 137: protowire.GetCurrentBlockColorResponseMessage
 	(*SubmitTransactionReplacementRequestMessage)(nil),                 // 138: protowire.SubmitTransactionReplacementRequestMessage
 	(*SubmitTransactionReplacementResponseMessage)(nil),                // 139: protowire.SubmitTransactionReplacementResponseMessage
+	(*RpcAtomicAsset)(nil),                                             // 140: protowire.RpcAtomicAsset
+	(*RpcLiquidityFeeRecipient)(nil),                                   // 141: protowire.RpcLiquidityFeeRecipient
+	(*RpcLiquidityPool)(nil),                                           // 142: protowire.RpcLiquidityPool
+	(*GetAtomicAssetRequestMessage)(nil),                               // 143: protowire.GetAtomicAssetRequestMessage
+	(*GetAtomicAssetResponseMessage)(nil),                              // 144: protowire.GetAtomicAssetResponseMessage
+	(*GetAtomicAssetsRequestMessage)(nil),                              // 145: protowire.GetAtomicAssetsRequestMessage
+	(*GetAtomicAssetsResponseMessage)(nil),                             // 146: protowire.GetAtomicAssetsResponseMessage
+	(*RpcAtomicBalance)(nil),                                           // 147: protowire.RpcAtomicBalance
+	(*GetAtomicBalancesByOwnerRequestMessage)(nil),                     // 148: protowire.GetAtomicBalancesByOwnerRequestMessage
+	(*GetAtomicBalancesByOwnerResponseMessage)(nil),                    // 149: protowire.GetAtomicBalancesByOwnerResponseMessage
+	(*GetAtomicNonceRequestMessage)(nil),                               // 150: protowire.GetAtomicNonceRequestMessage
+	(*GetAtomicNonceResponseMessage)(nil),                              // 151: protowire.GetAtomicNonceResponseMessage
+	(*GetLiquidityPoolRequestMessage)(nil),                             // 152: protowire.GetLiquidityPoolRequestMessage
+	(*GetLiquidityPoolResponseMessage)(nil),                            // 153: protowire.GetLiquidityPoolResponseMessage
+	(*NotifyAtomicStateChangedRequestMessage)(nil),                     // 154: protowire.NotifyAtomicStateChangedRequestMessage
+	(*NotifyAtomicStateChangedResponseMessage)(nil),                    // 155: protowire.NotifyAtomicStateChangedResponseMessage
+	(*AtomicStateChangedNotificationMessage)(nil),                      // 156: protowire.AtomicStateChangedNotificationMessage
+	(*RpcAtomicEvent)(nil),                                             // 157: protowire.RpcAtomicEvent
+	(*RpcAtomicBalanceChange)(nil),                                     // 158: protowire.RpcAtomicBalanceChange
+	(*StopNotifyingAtomicStateChangedRequestMessage)(nil),              // 159: protowire.StopNotifyingAtomicStateChangedRequestMessage
+	(*StopNotifyingAtomicStateChangedResponseMessage)(nil),             // 160: protowire.StopNotifyingAtomicStateChangedResponseMessage
+	(*SubmitFastIntentRequestMessage)(nil),                             // 161: protowire.SubmitFastIntentRequestMessage
+	(*SubmitFastIntentResponseMessage)(nil),                            // 162: protowire.SubmitFastIntentResponseMessage
+	(*GetFastIntentStatusRequestMessage)(nil),                          // 163: protowire.GetFastIntentStatusRequestMessage
+	(*GetFastIntentStatusResponseMessage)(nil),                         // 164: protowire.GetFastIntentStatusResponseMessage
+	(*GetAtomicHistoryByOwnerRequestMessage)(nil),                      // 165: protowire.GetAtomicHistoryByOwnerRequestMessage
+	(*RpcAtomicHistoryEntry)(nil),                                      // 166: protowire.RpcAtomicHistoryEntry
+	(*GetAtomicHistoryByOwnerResponseMessage)(nil),                     // 167: protowire.GetAtomicHistoryByOwnerResponseMessage
+	(*GetAtomicHistoryByAssetRequestMessage)(nil),                      // 168: protowire.GetAtomicHistoryByAssetRequestMessage
+	(*GetAtomicHistoryByAssetResponseMessage)(nil),                     // 169: protowire.GetAtomicHistoryByAssetResponseMessage
+	(*SimulateAtomicTransactionRequestMessage)(nil),                    // 170: protowire.SimulateAtomicTransactionRequestMessage
+	(*SimulateAtomicTransactionResponseMessage)(nil),                   // 171: protowire.SimulateAtomicTransactionResponseMessage
+	(*RpcAtomicSimulatedBalanceChange)(nil),                            // 172: protowire.RpcAtomicSimulatedBalanceChange
+	(*RpcAtomicSimulatedNonceChange)(nil),                              // 173: protowire.RpcAtomicSimulatedNonceChange
+	(*RpcAtomicSimulatedPoolChange)(nil),                               // 174: protowire.RpcAtomicSimulatedPoolChange
+	(*RpcAtomicStateGrowth)(nil),                                       // 175: protowire.RpcAtomicStateGrowth
+	(*GetAtomicBalanceProofRequestMessage)(nil),                        // 176: protowire.GetAtomicBalanceProofRequestMessage
+	(*GetAtomicBalanceProofResponseMessage)(nil),                       // 177: protowire.GetAtomicBalanceProofResponseMessage
+	(*GetStrongNodeClaimsRequestMessage)(nil),                          // 178: protowire.GetStrongNodeClaimsRequestMessage
+	(*GetStrongNodeClaimsResponseMessage)(nil),                         // 179: protowire.GetStrongNodeClaimsResponseMessage
+	(*RpcStrongNodeClaimEntry)(nil),                                    // 180: protowire.RpcStrongNodeClaimEntry
+	(*RpcBlockProducerClaim)(nil),                                      // 181: protowire.RpcBlockProducerClaim
+	(*NotifyBlockProducerClaimWinnerRequestMessage)(nil),               // 182: protowire.NotifyBlockProducerClaimWinnerRequestMessage
+	(*NotifyBlockProducerClaimWinnerResponseMessage)(nil),              // 183: protowire.NotifyBlockProducerClaimWinnerResponseMessage
+	(*BlockProducerClaimWinnerNotificationMessage)(nil),                // 184: protowire.BlockProducerClaimWinnerNotificationMessage
+	(*BanNodeIDRequestMessage)(nil),                                    // 185: protowire.BanNodeIDRequestMessage
+	(*BanNodeIDResponseMessage)(nil),                                   // 186: protowire.BanNodeIDResponseMessage
+	(*UnbanNodeIDRequestMessage)(nil),                                  // 187: protowire.UnbanNodeIDRequestMessage
+	(*UnbanNodeIDResponseMessage)(nil),                                 // 188: protowire.UnbanNodeIDResponseMessage
+	(*ListBansRequestMessage)(nil),                                     // 189: protowire.ListBansRequestMessage
+	(*ListBansResponseMessage)(nil),                                    // 190: protowire.ListBansResponseMessage
+	(*RpcBannedIP)(nil),                                                // 191: protowire.RpcBannedIP
+	(*RpcBannedNodeID)(nil),                                            // 192: protowire.RpcBannedNodeID
+	(*GetAntiFraudStateRequestMessage)(nil),                            // 193: protowire.GetAntiFraudStateRequestMessage
+	(*GetAntiFraudStateResponseMessage)(nil),                           // 194: protowire.GetAntiFraudStateResponseMessage
+	(*RpcAntiFraudPeerVote)(nil),                                       // 195: protowire.RpcAntiFraudPeerVote
+	(*RpcAntiFraudEntryMatch)(nil),                                     // 196: protowire.RpcAntiFraudEntryMatch
+	(*RpcAntiFraudSnapshotDiff)(nil),                                   // 197: protowire.RpcAntiFraudSnapshotDiff
+	(*SubmitTransactionPackageRequestMessage)(nil),                     // 198: protowire.SubmitTransactionPackageRequestMessage
+	(*SubmitTransactionPackageResponseMessage)(nil),                    // 199: protowire.SubmitTransactionPackageResponseMessage
+	(*GetTransactionStatusRequestMessage)(nil),                         // 200: protowire.GetTransactionStatusRequestMessage
+	(*GetTransactionStatusResponseMessage)(nil),                        // 201: protowire.GetTransactionStatusResponseMessage
 }
 var file_rpc_proto_depIdxs = []int32{
 	3,   // 0: protowire.RpcBlock.header:type_name -> protowire.RpcBlockHeader
@@ -8607,11 +13332,64 @@ var file_rpc_proto_depIdxs = []int32{
 	6,   // 98: protowire.SubmitTransactionReplacementRequestMessage.transaction:type_name -> protowire.RpcTransaction
 	6,   // 99: protowire.SubmitTransactionReplacementResponseMessage.replacedTransaction:type_name -> protowire.RpcTransaction
 	1,   // 100: protowire.SubmitTransactionReplacementResponseMessage.error:type_name -> protowire.RPCError
-	101, // [101:101] is the sub-list for method output_type
-	101, // [101:101] is the sub-list for method input_type
-	101, // [101:101] is the sub-list for extension type_name
-	101, // [101:101] is the sub-list for extension extendee
-	0,   // [0:101] is the sub-list for field type_name
+	142, // 101: protowire.RpcAtomicAsset.liquidity:type_name -> protowire.RpcLiquidityPool
+	141, // 102: protowire.RpcLiquidityPool.feeRecipients:type_name -> protowire.RpcLiquidityFeeRecipient
+	10,  // 103: protowire.RpcLiquidityPool.vaultOutpoint:type_name -> protowire.RpcOutpoint
+	140, // 104: protowire.GetAtomicAssetResponseMessage.asset:type_name -> protowire.RpcAtomicAsset
+	1,   // 105: protowire.GetAtomicAssetResponseMessage.error:type_name -> protowire.RPCError
+	140, // 106: protowire.GetAtomicAssetsResponseMessage.assets:type_name -> protowire.RpcAtomicAsset
+	1,   // 107: protowire.GetAtomicAssetsResponseMessage.error:type_name -> protowire.RPCError
+	147, // 108: protowire.GetAtomicBalancesByOwnerResponseMessage.balances:type_name -> protowire.RpcAtomicBalance
+	1,   // 109: protowire.GetAtomicBalancesByOwnerResponseMessage.error:type_name -> protowire.RPCError
+	1,   // 110: protowire.GetAtomicNonceResponseMessage.error:type_name -> protowire.RPCError
+	142, // 111: protowire.GetLiquidityPoolResponseMessage.pool:type_name -> protowire.RpcLiquidityPool
+	1,   // 112: protowire.GetLiquidityPoolResponseMessage.error:type_name -> protowire.RPCError
+	1,   // 113: protowire.NotifyAtomicStateChangedResponseMessage.error:type_name -> protowire.RPCError
+	157, // 114: protowire.AtomicStateChangedNotificationMessage.events:type_name -> protowire.RpcAtomicEvent
+	158, // 115: protowire.RpcAtomicEvent.balanceChanges:type_name -> protowire.RpcAtomicBalanceChange
+	142, // 116: protowire.RpcAtomicEvent.pool:type_name -> protowire.RpcLiquidityPool
+	1,   // 117: protowire.StopNotifyingAtomicStateChangedResponseMessage.error:type_name -> protowire.RPCError
+	6,   // 118: protowire.SubmitFastIntentRequestMessage.transaction:type_name -> protowire.RpcTransaction
+	1,   // 119: protowire.SubmitFastIntentResponseMessage.error:type_name -> protowire.RPCError
+	1,   // 120: protowire.GetFastIntentStatusResponseMessage.error:type_name -> protowire.RPCError
+	157, // 121: protowire.RpcAtomicHistoryEntry.event:type_name -> protowire.RpcAtomicEvent
+	166, // 122: protowire.GetAtomicHistoryByOwnerResponseMessage.entries:type_name -> protowire.RpcAtomicHistoryEntry
+	1,   // 123: protowire.GetAtomicHistoryByOwnerResponseMessage.error:type_name -> protowire.RPCError
+	166, // 124: protowire.GetAtomicHistoryByAssetResponseMessage.entries:type_name -> protowire.RpcAtomicHistoryEntry
+	1,   // 125: protowire.GetAtomicHistoryByAssetResponseMessage.error:type_name -> protowire.RPCError
+	6,   // 126: protowire.SimulateAtomicTransactionRequestMessage.transaction:type_name -> protowire.RpcTransaction
+	172, // 127: protowire.SimulateAtomicTransactionResponseMessage.balanceChanges:type_name -> protowire.RpcAtomicSimulatedBalanceChange
+	173, // 128: protowire.SimulateAtomicTransactionResponseMessage.nonceChanges:type_name -> protowire.RpcAtomicSimulatedNonceChange
+	174, // 129: protowire.SimulateAtomicTransactionResponseMessage.poolChanges:type_name -> protowire.RpcAtomicSimulatedPoolChange
+	175, // 130: protowire.SimulateAtomicTransactionResponseMessage.stateGrowth:type_name -> protowire.RpcAtomicStateGrowth
+	1,   // 131: protowire.SimulateAtomicTransactionResponseMessage.error:type_name -> protowire.RPCError
+	142, // 132: protowire.RpcAtomicSimulatedPoolChange.before:type_name -> protowire.RpcLiquidityPool
+	142, // 133: protowire.RpcAtomicSimulatedPoolChange.after:type_name -> protowire.RpcLiquidityPool
+	1,   // 134: protowire.GetAtomicBalanceProofResponseMessage.error:type_name -> protowire.RPCError
+	180, // 135: protowire.GetStrongNodeClaimsResponseMessage.entries:type_name -> protowire.RpcStrongNodeClaimEntry
+	181, // 136: protowire.GetStrongNodeClaimsResponseMessage.winningClaims:type_name -> protowire.RpcBlockProducerClaim
+	181, // 137: protowire.GetStrongNodeClaimsResponseMessage.pendingClaims:type_name -> protowire.RpcBlockProducerClaim
+	1,   // 138: protowire.GetStrongNodeClaimsResponseMessage.error:type_name -> protowire.RPCError
+	1,   // 139: protowire.NotifyBlockProducerClaimWinnerResponseMessage.error:type_name -> protowire.RPCError
+	181, // 140: protowire.BlockProducerClaimWinnerNotificationMessage.winners:type_name -> protowire.RpcBlockProducerClaim
+	1,   // 141: protowire.BanNodeIDResponseMessage.error:type_name -> protowire.RPCError
+	1,   // 142: protowire.UnbanNodeIDResponseMessage.error:type_name -> protowire.RPCError
+	191, // 143: protowire.ListBansResponseMessage.bannedIps:type_name -> protowire.RpcBannedIP
+	192, // 144: protowire.ListBansResponseMessage.bannedNodeIds:type_name -> protowire.RpcBannedNodeID
+	1,   // 145: protowire.ListBansResponseMessage.error:type_name -> protowire.RPCError
+	195, // 146: protowire.GetAntiFraudStateResponseMessage.peerVotes:type_name -> protowire.RpcAntiFraudPeerVote
+	196, // 147: protowire.GetAntiFraudStateResponseMessage.matches:type_name -> protowire.RpcAntiFraudEntryMatch
+	197, // 148: protowire.GetAntiFraudStateResponseMessage.diff:type_name -> protowire.RpcAntiFraudSnapshotDiff
+	1,   // 149: protowire.GetAntiFraudStateResponseMessage.error:type_name -> protowire.RPCError
+	6,   // 150: protowire.SubmitTransactionPackageRequestMessage.transactions:type_name -> protowire.RpcTransaction
+	1,   // 151: protowire.SubmitTransactionPackageResponseMessage.error:type_name -> protowire.RPCError
+	10,  // 152: protowire.GetTransactionStatusResponseMessage.missingOutpoints:type_name -> protowire.RpcOutpoint
+	1,   // 153: protowire.GetTransactionStatusResponseMessage.error:type_name -> protowire.RPCError
+	154, // [154:154] is the sub-list for method output_type
+	154, // [154:154] is the sub-list for method input_type
+	154, // [154:154] is the sub-list for extension type_name
+	154, // [154:154] is the sub-list for extension extendee
+	0,   // [0:154] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -8625,7 +13403,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   139,
+			NumMessages:   201,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
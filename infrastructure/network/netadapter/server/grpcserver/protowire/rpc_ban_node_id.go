@@ -0,0 +1,65 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_BanNodeIDRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_BanNodeIDRequest is nil")
+	}
+	return x.BanNodeIDRequest.toAppMessage()
+}
+
+func (x *BanNodeIDRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BanNodeIDRequestMessage is nil")
+	}
+	return &appmessage.BanNodeIDRequestMessage{
+		NodeID:          x.NodeId,
+		Reason:          x.Reason,
+		DurationSeconds: x.DurationSeconds,
+	}, nil
+}
+
+func (x *CryptixdMessage_BanNodeIDRequest) fromAppMessage(message *appmessage.BanNodeIDRequestMessage) error {
+	x.BanNodeIDRequest = &BanNodeIDRequestMessage{
+		NodeId:          message.NodeID,
+		Reason:          message.Reason,
+		DurationSeconds: message.DurationSeconds,
+	}
+	return nil
+}
+
+func (x *CryptixdMessage_BanNodeIDResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_BanNodeIDResponse is nil")
+	}
+	return x.BanNodeIDResponse.toAppMessage()
+}
+
+func (x *BanNodeIDResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BanNodeIDResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.BanNodeIDResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *CryptixdMessage_BanNodeIDResponse) fromAppMessage(message *appmessage.BanNodeIDResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.BanNodeIDResponse = &BanNodeIDResponseMessage{
+		Error: err,
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAntiFraudStateRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAntiFraudStateRequest is nil")
+	}
+	return x.GetAntiFraudStateRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAntiFraudStateRequest) fromAppMessage(message *appmessage.GetAntiFraudStateRequestMessage) error {
+	x.GetAntiFraudStateRequest = &GetAntiFraudStateRequestMessage{
+		IncludeEntries: message.IncludeEntries,
+		IncludeDiff:    message.IncludeDiff,
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAntiFraudStateRequestMessage is nil")
+	}
+	return &appmessage.GetAntiFraudStateRequestMessage{
+		IncludeEntries: x.IncludeEntries,
+		IncludeDiff:    x.IncludeDiff,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAntiFraudStateResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAntiFraudStateResponse is nil")
+	}
+	return x.GetAntiFraudStateResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAntiFraudStateResponse) fromAppMessage(message *appmessage.GetAntiFraudStateResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	peerVotes := make([]*RpcAntiFraudPeerVote, len(message.PeerVotes))
+	for i, peerVote := range message.PeerVotes {
+		peerVotes[i] = &RpcAntiFraudPeerVote{
+			PeerId:       peerVote.PeerID,
+			SnapshotSeq:  peerVote.SnapshotSeq,
+			RootHash:     peerVote.RootHash,
+			ReceivedAtMs: peerVote.ReceivedAtMs,
+		}
+	}
+	matches := make([]*RpcAntiFraudEntryMatch, len(message.Matches))
+	for i, match := range message.Matches {
+		matches[i] = &RpcAntiFraudEntryMatch{
+			Entry:    match.Entry,
+			IsNodeId: match.IsNodeID,
+			Peers:    match.Peers,
+		}
+	}
+	var diff *RpcAntiFraudSnapshotDiff
+	if message.Diff != nil {
+		diff = &RpcAntiFraudSnapshotDiff{
+			PreviousSnapshotSeq: message.Diff.PreviousSnapshotSeq,
+			PreviousRootHash:    message.Diff.PreviousRootHash,
+			AddedIps:            message.Diff.AddedIPs,
+			RemovedIps:          message.Diff.RemovedIPs,
+			AddedNodeIds:        message.Diff.AddedNodeIDs,
+			RemovedNodeIds:      message.Diff.RemovedNodeIDs,
+		}
+	}
+	x.GetAntiFraudStateResponse = &GetAntiFraudStateResponseMessage{
+		RuntimeEnabled:    message.RuntimeEnabled,
+		PeerFallback:      message.PeerFallback,
+		SeedEnabled:       message.SeedEnabled,
+		HasSnapshot:       message.HasSnapshot,
+		Source:            message.Source,
+		SnapshotSeq:       message.SnapshotSeq,
+		GeneratedAtMs:     message.GeneratedAtMs,
+		SigningKeyId:      message.SigningKeyID,
+		RootHash:          message.RootHash,
+		BannedIpCount:     message.BannedIPCount,
+		BannedNodeIdCount: message.BannedNodeIDCount,
+		HashWindow:        message.HashWindow,
+		PeerVotes:         peerVotes,
+		Matches:           matches,
+		BannedIps:         message.BannedIPs,
+		BannedNodeIds:     message.BannedNodeIDs,
+		Diff:              diff,
+		Error:             err,
+	}
+	return nil
+}
+
+func (x *GetAntiFraudStateResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAntiFraudStateResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	peerVotes := make([]*appmessage.RPCAntiFraudPeerVote, len(x.PeerVotes))
+	for i, peerVote := range x.PeerVotes {
+		if peerVote == nil {
+			return nil, errors.Wrapf(errorNil, "RpcAntiFraudPeerVote is nil")
+		}
+		peerVotes[i] = &appmessage.RPCAntiFraudPeerVote{
+			PeerID:       peerVote.PeerId,
+			SnapshotSeq:  peerVote.SnapshotSeq,
+			RootHash:     peerVote.RootHash,
+			ReceivedAtMs: peerVote.ReceivedAtMs,
+		}
+	}
+	matches := make([]*appmessage.RPCAntiFraudEntryMatch, len(x.Matches))
+	for i, match := range x.Matches {
+		if match == nil {
+			return nil, errors.Wrapf(errorNil, "RpcAntiFraudEntryMatch is nil")
+		}
+		matches[i] = &appmessage.RPCAntiFraudEntryMatch{
+			Entry:    match.Entry,
+			IsNodeID: match.IsNodeId,
+			Peers:    match.Peers,
+		}
+	}
+	// Diff is an optional field
+	var diff *appmessage.RPCAntiFraudSnapshotDiff
+	if x.Diff != nil {
+		diff = &appmessage.RPCAntiFraudSnapshotDiff{
+			PreviousSnapshotSeq: x.Diff.PreviousSnapshotSeq,
+			PreviousRootHash:    x.Diff.PreviousRootHash,
+			AddedIPs:            x.Diff.AddedIps,
+			RemovedIPs:          x.Diff.RemovedIps,
+			AddedNodeIDs:        x.Diff.AddedNodeIds,
+			RemovedNodeIDs:      x.Diff.RemovedNodeIds,
+		}
+	}
+
+	return &appmessage.GetAntiFraudStateResponseMessage{
+		RuntimeEnabled:    x.RuntimeEnabled,
+		PeerFallback:      x.PeerFallback,
+		SeedEnabled:       x.SeedEnabled,
+		HasSnapshot:       x.HasSnapshot,
+		Source:            x.Source,
+		SnapshotSeq:       x.SnapshotSeq,
+		GeneratedAtMs:     x.GeneratedAtMs,
+		SigningKeyID:      x.SigningKeyId,
+		RootHash:          x.RootHash,
+		BannedIPCount:     x.BannedIpCount,
+		BannedNodeIDCount: x.BannedNodeIdCount,
+		HashWindow:        x.HashWindow,
+		PeerVotes:         peerVotes,
+		Matches:           matches,
+		BannedIPs:         x.BannedIps,
+		BannedNodeIDs:     x.BannedNodeIds,
+		Diff:              diff,
+		Error:             rpcErr,
+	}, nil
+}
@@ -0,0 +1,216 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAtomicAssetRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicAssetRequest is nil")
+	}
+	return x.GetAtomicAssetRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicAssetRequest) fromAppMessage(message *appmessage.GetAtomicAssetRequestMessage) error {
+	x.GetAtomicAssetRequest = &GetAtomicAssetRequestMessage{
+		AssetId: message.AssetID,
+	}
+	return nil
+}
+
+func (x *GetAtomicAssetRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicAssetRequestMessage is nil")
+	}
+	return &appmessage.GetAtomicAssetRequestMessage{
+		AssetID: x.AssetId,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAtomicAssetResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicAssetResponse is nil")
+	}
+	return x.GetAtomicAssetResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicAssetResponse) fromAppMessage(message *appmessage.GetAtomicAssetResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	var asset *RpcAtomicAsset
+	if message.Asset != nil {
+		asset = &RpcAtomicAsset{}
+		asset.fromAppMessage(message.Asset)
+	}
+	x.GetAtomicAssetResponse = &GetAtomicAssetResponseMessage{
+		Asset:     asset,
+		BlockHash: message.BlockHash,
+		Error:     err,
+	}
+	return nil
+}
+
+func (x *GetAtomicAssetResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicAssetResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && x.Asset != nil {
+		return nil, errors.New("GetAtomicAssetResponseMessage contains both an error and a response")
+	}
+
+	var asset *appmessage.RPCAtomicAsset
+	if rpcErr == nil {
+		asset, err = x.Asset.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetAtomicAssetResponseMessage{
+		Asset:     asset,
+		BlockHash: x.BlockHash,
+		Error:     rpcErr,
+	}, nil
+}
+
+func (x *RpcAtomicAsset) toAppMessage() (*appmessage.RPCAtomicAsset, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcAtomicAsset is nil")
+	}
+	var liquidity *appmessage.RPCLiquidityPool
+	if x.Liquidity != nil {
+		var err error
+		liquidity, err = x.Liquidity.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appmessage.RPCAtomicAsset{
+		AssetID:              x.AssetId,
+		CreatorOwnerID:       x.CreatorOwnerId,
+		AssetClass:           x.AssetClass,
+		TokenVersion:         x.TokenVersion,
+		MintAuthorityOwnerID: x.MintAuthorityOwnerId,
+		Decimals:             x.Decimals,
+		SupplyMode:           x.SupplyMode,
+		MaxSupply:            x.MaxSupply,
+		TotalSupply:          x.TotalSupply,
+		Name:                 x.Name,
+		Symbol:               x.Symbol,
+		Metadata:             x.Metadata,
+		PlatformTag:          x.PlatformTag,
+		CreatedBlockHash:     x.CreatedBlockHash,
+		CreatedDAAScore:      x.CreatedDaaScore,
+		CreatedAt:            x.CreatedAt,
+		Liquidity:            liquidity,
+	}, nil
+}
+
+func (x *RpcAtomicAsset) fromAppMessage(message *appmessage.RPCAtomicAsset) {
+	var liquidity *RpcLiquidityPool
+	if message.Liquidity != nil {
+		liquidity = &RpcLiquidityPool{}
+		liquidity.fromAppMessage(message.Liquidity)
+	}
+	*x = RpcAtomicAsset{
+		AssetId:              message.AssetID,
+		CreatorOwnerId:       message.CreatorOwnerID,
+		AssetClass:           message.AssetClass,
+		TokenVersion:         message.TokenVersion,
+		MintAuthorityOwnerId: message.MintAuthorityOwnerID,
+		Decimals:             message.Decimals,
+		SupplyMode:           message.SupplyMode,
+		MaxSupply:            message.MaxSupply,
+		TotalSupply:          message.TotalSupply,
+		Name:                 message.Name,
+		Symbol:               message.Symbol,
+		Metadata:             message.Metadata,
+		PlatformTag:          message.PlatformTag,
+		CreatedBlockHash:     message.CreatedBlockHash,
+		CreatedDaaScore:      message.CreatedDAAScore,
+		CreatedAt:            message.CreatedAt,
+		Liquidity:            liquidity,
+	}
+}
+
+func (x *RpcLiquidityPool) toAppMessage() (*appmessage.RPCLiquidityPool, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcLiquidityPool is nil")
+	}
+	vaultOutpoint, err := x.VaultOutpoint.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	feeRecipients := make([]*appmessage.RPCLiquidityFeeRecipient, len(x.FeeRecipients))
+	for i, recipient := range x.FeeRecipients {
+		if recipient == nil {
+			return nil, errors.Wrapf(errorNil, "RpcLiquidityFeeRecipient is nil")
+		}
+		feeRecipients[i] = &appmessage.RPCLiquidityFeeRecipient{
+			OwnerID:        recipient.OwnerId,
+			Address:        recipient.Address,
+			UnclaimedSompi: recipient.UnclaimedSompi,
+		}
+	}
+	return &appmessage.RPCLiquidityPool{
+		PoolNonce:                           x.PoolNonce,
+		CurveVersion:                        x.CurveVersion,
+		CurveMode:                           x.CurveMode,
+		IndividualVirtualCPayReservesSompi:  x.IndividualVirtualCpayReservesSompi,
+		IndividualVirtualTokenMultiplierBPS: x.IndividualVirtualTokenMultiplierBps,
+		RealCPayReservesSompi:               x.RealCpayReservesSompi,
+		RealTokenReserves:                   x.RealTokenReserves,
+		VirtualCPayReserves:                 x.VirtualCpayReserves,
+		VirtualTokenReserves:                x.VirtualTokenReserves,
+		UnclaimedFeeTotalSompi:              x.UnclaimedFeeTotalSompi,
+		FeeBPS:                              x.FeeBps,
+		FeeRecipients:                       feeRecipients,
+		VaultOutpoint:                       vaultOutpoint,
+		VaultValueSompi:                     x.VaultValueSompi,
+		UnlockTargetSompi:                   x.UnlockTargetSompi,
+		Unlocked:                            x.Unlocked,
+	}, nil
+}
+
+func (x *RpcLiquidityPool) fromAppMessage(message *appmessage.RPCLiquidityPool) {
+	feeRecipients := make([]*RpcLiquidityFeeRecipient, len(message.FeeRecipients))
+	for i, recipient := range message.FeeRecipients {
+		feeRecipients[i] = &RpcLiquidityFeeRecipient{
+			OwnerId:        recipient.OwnerID,
+			Address:        recipient.Address,
+			UnclaimedSompi: recipient.UnclaimedSompi,
+		}
+	}
+	var vaultOutpoint *RpcOutpoint
+	if message.VaultOutpoint != nil {
+		vaultOutpoint = &RpcOutpoint{}
+		vaultOutpoint.fromAppMessage(message.VaultOutpoint)
+	}
+	*x = RpcLiquidityPool{
+		PoolNonce:                           message.PoolNonce,
+		CurveVersion:                        message.CurveVersion,
+		CurveMode:                           message.CurveMode,
+		IndividualVirtualCpayReservesSompi:  message.IndividualVirtualCPayReservesSompi,
+		IndividualVirtualTokenMultiplierBps: message.IndividualVirtualTokenMultiplierBPS,
+		RealCpayReservesSompi:               message.RealCPayReservesSompi,
+		RealTokenReserves:                   message.RealTokenReserves,
+		VirtualCpayReserves:                 message.VirtualCPayReserves,
+		VirtualTokenReserves:                message.VirtualTokenReserves,
+		UnclaimedFeeTotalSompi:              message.UnclaimedFeeTotalSompi,
+		FeeBps:                              message.FeeBPS,
+		FeeRecipients:                       feeRecipients,
+		VaultOutpoint:                       vaultOutpoint,
+		VaultValueSompi:                     message.VaultValueSompi,
+		UnlockTargetSompi:                   message.UnlockTargetSompi,
+		Unlocked:                            message.Unlocked,
+	}
+}
@@ -0,0 +1,87 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAtomicAssetsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicAssetsRequest is nil")
+	}
+	return x.GetAtomicAssetsRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicAssetsRequest) fromAppMessage(message *appmessage.GetAtomicAssetsRequestMessage) error {
+	x.GetAtomicAssetsRequest = &GetAtomicAssetsRequestMessage{
+		StartAssetId: message.StartAssetID,
+		Limit:        message.Limit,
+	}
+	return nil
+}
+
+func (x *GetAtomicAssetsRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicAssetsRequestMessage is nil")
+	}
+	return &appmessage.GetAtomicAssetsRequestMessage{
+		StartAssetID: x.StartAssetId,
+		Limit:        x.Limit,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAtomicAssetsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicAssetsResponse is nil")
+	}
+	return x.GetAtomicAssetsResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicAssetsResponse) fromAppMessage(message *appmessage.GetAtomicAssetsResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	assets := make([]*RpcAtomicAsset, len(message.Assets))
+	for i, asset := range message.Assets {
+		assets[i] = &RpcAtomicAsset{}
+		assets[i].fromAppMessage(asset)
+	}
+	x.GetAtomicAssetsResponse = &GetAtomicAssetsResponseMessage{
+		Assets:      assets,
+		NextAssetId: message.NextAssetID,
+		BlockHash:   message.BlockHash,
+		Error:       err,
+	}
+	return nil
+}
+
+func (x *GetAtomicAssetsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicAssetsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Assets) != 0 {
+		return nil, errors.New("GetAtomicAssetsResponseMessage contains both an error and a response")
+	}
+
+	assets := make([]*appmessage.RPCAtomicAsset, len(x.Assets))
+	for i, asset := range x.Assets {
+		assets[i], err = asset.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetAtomicAssetsResponseMessage{
+		Assets:      assets,
+		NextAssetID: x.NextAssetId,
+		BlockHash:   x.BlockHash,
+		Error:       rpcErr,
+	}, nil
+}
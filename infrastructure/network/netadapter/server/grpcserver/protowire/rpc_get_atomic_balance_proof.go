@@ -0,0 +1,89 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAtomicBalanceProofRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicBalanceProofRequest is nil")
+	}
+	return x.GetAtomicBalanceProofRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicBalanceProofRequest) fromAppMessage(message *appmessage.GetAtomicBalanceProofRequestMessage) error {
+	x.GetAtomicBalanceProofRequest = &GetAtomicBalanceProofRequestMessage{
+		AssetId: message.AssetID,
+		OwnerId: message.OwnerID,
+		Address: message.Address,
+	}
+	return nil
+}
+
+func (x *GetAtomicBalanceProofRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicBalanceProofRequestMessage is nil")
+	}
+	return &appmessage.GetAtomicBalanceProofRequestMessage{
+		AssetID: x.AssetId,
+		OwnerID: x.OwnerId,
+		Address: x.Address,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAtomicBalanceProofResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicBalanceProofResponse is nil")
+	}
+	return x.GetAtomicBalanceProofResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicBalanceProofResponse) fromAppMessage(message *appmessage.GetAtomicBalanceProofResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.GetAtomicBalanceProofResponse = &GetAtomicBalanceProofResponseMessage{
+		BlockHash:          message.BlockHash,
+		DaaScore:           message.DAAScore,
+		UtxoCommitment:     message.UTXOCommitment,
+		RawUtxoCommitment:  message.RawUTXOCommitment,
+		AtomicMerkleRoot:   message.AtomicMerkleRoot,
+		AssetId:            message.AssetID,
+		OwnerId:            message.OwnerID,
+		Balance:            message.Balance,
+		Included:           message.Included,
+		Siblings:           message.Siblings,
+		OtherLeafPath:      message.OtherLeafPath,
+		OtherLeafEntryHash: message.OtherLeafEntryHash,
+		Error:              err,
+	}
+	return nil
+}
+
+func (x *GetAtomicBalanceProofResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicBalanceProofResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.GetAtomicBalanceProofResponseMessage{
+		BlockHash:          x.BlockHash,
+		DAAScore:           x.DaaScore,
+		UTXOCommitment:     x.UtxoCommitment,
+		RawUTXOCommitment:  x.RawUtxoCommitment,
+		AtomicMerkleRoot:   x.AtomicMerkleRoot,
+		AssetID:            x.AssetId,
+		OwnerID:            x.OwnerId,
+		Balance:            x.Balance,
+		Included:           x.Included,
+		Siblings:           x.Siblings,
+		OtherLeafPath:      x.OtherLeafPath,
+		OtherLeafEntryHash: x.OtherLeafEntryHash,
+		Error:              rpcErr,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAtomicBalancesByOwnerRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicBalancesByOwnerRequest is nil")
+	}
+	return x.GetAtomicBalancesByOwnerRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicBalancesByOwnerRequest) fromAppMessage(message *appmessage.GetAtomicBalancesByOwnerRequestMessage) error {
+	x.GetAtomicBalancesByOwnerRequest = &GetAtomicBalancesByOwnerRequestMessage{
+		OwnerId: message.OwnerID,
+		Address: message.Address,
+	}
+	return nil
+}
+
+func (x *GetAtomicBalancesByOwnerRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicBalancesByOwnerRequestMessage is nil")
+	}
+	return &appmessage.GetAtomicBalancesByOwnerRequestMessage{
+		OwnerID: x.OwnerId,
+		Address: x.Address,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAtomicBalancesByOwnerResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicBalancesByOwnerResponse is nil")
+	}
+	return x.GetAtomicBalancesByOwnerResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicBalancesByOwnerResponse) fromAppMessage(message *appmessage.GetAtomicBalancesByOwnerResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	balances := make([]*RpcAtomicBalance, len(message.Balances))
+	for i, balance := range message.Balances {
+		balances[i] = &RpcAtomicBalance{
+			AssetId: balance.AssetID,
+			Balance: balance.Balance,
+		}
+	}
+	x.GetAtomicBalancesByOwnerResponse = &GetAtomicBalancesByOwnerResponseMessage{
+		OwnerId:   message.OwnerID,
+		Balances:  balances,
+		BlockHash: message.BlockHash,
+		Error:     err,
+	}
+	return nil
+}
+
+func (x *GetAtomicBalancesByOwnerResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicBalancesByOwnerResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Balances) != 0 {
+		return nil, errors.New("GetAtomicBalancesByOwnerResponseMessage contains both an error and a response")
+	}
+
+	balances := make([]*appmessage.RPCAtomicBalance, len(x.Balances))
+	for i, balance := range x.Balances {
+		if balance == nil {
+			return nil, errors.Wrapf(errorNil, "RpcAtomicBalance is nil")
+		}
+		balances[i] = &appmessage.RPCAtomicBalance{
+			AssetID: balance.AssetId,
+			Balance: balance.Balance,
+		}
+	}
+
+	return &appmessage.GetAtomicBalancesByOwnerResponseMessage{
+		OwnerID:   x.OwnerId,
+		Balances:  balances,
+		BlockHash: x.BlockHash,
+		Error:     rpcErr,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAtomicHistoryByAssetRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicHistoryByAssetRequest is nil")
+	}
+	return x.GetAtomicHistoryByAssetRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicHistoryByAssetRequest) fromAppMessage(message *appmessage.GetAtomicHistoryByAssetRequestMessage) error {
+	x.GetAtomicHistoryByAssetRequest = &GetAtomicHistoryByAssetRequestMessage{
+		AssetId: message.AssetID,
+		Cursor:  message.Cursor,
+		Limit:   message.Limit,
+	}
+	return nil
+}
+
+func (x *GetAtomicHistoryByAssetRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicHistoryByAssetRequestMessage is nil")
+	}
+	return &appmessage.GetAtomicHistoryByAssetRequestMessage{
+		AssetID: x.AssetId,
+		Cursor:  x.Cursor,
+		Limit:   x.Limit,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAtomicHistoryByAssetResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicHistoryByAssetResponse is nil")
+	}
+	return x.GetAtomicHistoryByAssetResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicHistoryByAssetResponse) fromAppMessage(message *appmessage.GetAtomicHistoryByAssetResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.GetAtomicHistoryByAssetResponse = &GetAtomicHistoryByAssetResponseMessage{
+		AssetId:    message.AssetID,
+		Entries:    rpcAtomicHistoryEntriesFromAppMessage(message.Entries),
+		NextCursor: message.NextCursor,
+		Error:      err,
+	}
+	return nil
+}
+
+func (x *GetAtomicHistoryByAssetResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicHistoryByAssetResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	entries, err := rpcAtomicHistoryEntriesToAppMessage(x.Entries)
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.GetAtomicHistoryByAssetResponseMessage{
+		AssetID:    x.AssetId,
+		Entries:    entries,
+		NextCursor: x.NextCursor,
+		Error:      rpcErr,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAtomicHistoryByOwnerRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicHistoryByOwnerRequest is nil")
+	}
+	return x.GetAtomicHistoryByOwnerRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicHistoryByOwnerRequest) fromAppMessage(message *appmessage.GetAtomicHistoryByOwnerRequestMessage) error {
+	x.GetAtomicHistoryByOwnerRequest = &GetAtomicHistoryByOwnerRequestMessage{
+		OwnerId: message.OwnerID,
+		Cursor:  message.Cursor,
+		Limit:   message.Limit,
+	}
+	return nil
+}
+
+func (x *GetAtomicHistoryByOwnerRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicHistoryByOwnerRequestMessage is nil")
+	}
+	return &appmessage.GetAtomicHistoryByOwnerRequestMessage{
+		OwnerID: x.OwnerId,
+		Cursor:  x.Cursor,
+		Limit:   x.Limit,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAtomicHistoryByOwnerResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicHistoryByOwnerResponse is nil")
+	}
+	return x.GetAtomicHistoryByOwnerResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicHistoryByOwnerResponse) fromAppMessage(message *appmessage.GetAtomicHistoryByOwnerResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.GetAtomicHistoryByOwnerResponse = &GetAtomicHistoryByOwnerResponseMessage{
+		OwnerId:    message.OwnerID,
+		Entries:    rpcAtomicHistoryEntriesFromAppMessage(message.Entries),
+		NextCursor: message.NextCursor,
+		Error:      err,
+	}
+	return nil
+}
+
+func (x *GetAtomicHistoryByOwnerResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicHistoryByOwnerResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	entries, err := rpcAtomicHistoryEntriesToAppMessage(x.Entries)
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.GetAtomicHistoryByOwnerResponseMessage{
+		OwnerID:    x.OwnerId,
+		Entries:    entries,
+		NextCursor: x.NextCursor,
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *RpcAtomicHistoryEntry) toAppMessage() (*appmessage.RPCAtomicHistoryEntry, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcAtomicHistoryEntry is nil")
+	}
+	event, err := x.Event.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.RPCAtomicHistoryEntry{
+		AcceptingDAAScore: x.AcceptingDaaScore,
+		Event:             event,
+	}, nil
+}
+
+func rpcAtomicHistoryEntriesToAppMessage(entries []*RpcAtomicHistoryEntry) ([]*appmessage.RPCAtomicHistoryEntry, error) {
+	appEntries := make([]*appmessage.RPCAtomicHistoryEntry, len(entries))
+	for i, entry := range entries {
+		appEntry, err := entry.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		appEntries[i] = appEntry
+	}
+	return appEntries, nil
+}
+
+func rpcAtomicHistoryEntriesFromAppMessage(entries []*appmessage.RPCAtomicHistoryEntry) []*RpcAtomicHistoryEntry {
+	rpcEntries := make([]*RpcAtomicHistoryEntry, len(entries))
+	for i, entry := range entries {
+		event := &RpcAtomicEvent{}
+		event.fromAppMessage(entry.Event)
+		rpcEntries[i] = &RpcAtomicHistoryEntry{
+			AcceptingDaaScore: entry.AcceptingDAAScore,
+			Event:             event,
+		}
+	}
+	return rpcEntries
+}
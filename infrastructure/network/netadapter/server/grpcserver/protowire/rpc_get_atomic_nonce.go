@@ -0,0 +1,72 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetAtomicNonceRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicNonceRequest is nil")
+	}
+	return x.GetAtomicNonceRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicNonceRequest) fromAppMessage(message *appmessage.GetAtomicNonceRequestMessage) error {
+	x.GetAtomicNonceRequest = &GetAtomicNonceRequestMessage{
+		OwnerId: message.OwnerID,
+		Address: message.Address,
+		AssetId: message.AssetID,
+	}
+	return nil
+}
+
+func (x *GetAtomicNonceRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicNonceRequestMessage is nil")
+	}
+	return &appmessage.GetAtomicNonceRequestMessage{
+		OwnerID: x.OwnerId,
+		Address: x.Address,
+		AssetID: x.AssetId,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetAtomicNonceResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetAtomicNonceResponse is nil")
+	}
+	return x.GetAtomicNonceResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetAtomicNonceResponse) fromAppMessage(message *appmessage.GetAtomicNonceResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.GetAtomicNonceResponse = &GetAtomicNonceResponseMessage{
+		OwnerId:   message.OwnerID,
+		NextNonce: message.NextNonce,
+		BlockHash: message.BlockHash,
+		Error:     err,
+	}
+	return nil
+}
+
+func (x *GetAtomicNonceResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAtomicNonceResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	return &appmessage.GetAtomicNonceResponseMessage{
+		OwnerID:   x.OwnerId,
+		NextNonce: x.NextNonce,
+		BlockHash: x.BlockHash,
+		Error:     rpcErr,
+	}, nil
+}
@@ -43,6 +43,7 @@ func (x *CryptixdMessage_GetConnectedPeerInfoResponse) fromAppMessage(message *a
 			TimeConnected:             info.TimeConnected,
 			IsIbdPeer:                 info.IsIBDPeer,
 			UnifiedNodeId:             info.UnifiedNodeID,
+			BanScore:                  info.BanScore,
 		}
 	}
 	x.GetConnectedPeerInfoResponse = &GetConnectedPeerInfoResponseMessage{
@@ -100,5 +101,6 @@ func (x *GetConnectedPeerInfoMessage) toAppMessage() (*appmessage.GetConnectedPe
 		TimeConnected:             x.TimeConnected,
 		IsIBDPeer:                 x.IsIbdPeer,
 		UnifiedNodeID:             x.UnifiedNodeId,
+		BanScore:                  x.BanScore,
 	}, nil
 }
@@ -0,0 +1,75 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetFastIntentStatusRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetFastIntentStatusRequest is nil")
+	}
+	return x.GetFastIntentStatusRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetFastIntentStatusRequest) fromAppMessage(message *appmessage.GetFastIntentStatusRequestMessage) error {
+	x.GetFastIntentStatusRequest = &GetFastIntentStatusRequestMessage{
+		IntentId: message.IntentID,
+	}
+	return nil
+}
+
+func (x *GetFastIntentStatusRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetFastIntentStatusRequestMessage is nil")
+	}
+	return &appmessage.GetFastIntentStatusRequestMessage{
+		IntentID: x.IntentId,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetFastIntentStatusResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetFastIntentStatusResponse is nil")
+	}
+	return x.GetFastIntentStatusResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetFastIntentStatusResponse) fromAppMessage(message *appmessage.GetFastIntentStatusResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.GetFastIntentStatusResponse = &GetFastIntentStatusResponseMessage{
+		IntentId:          message.IntentID,
+		TransactionId:     message.TransactionID,
+		Status:            message.Status,
+		MaxFee:            message.MaxFee,
+		ClientCreatedAtMs: message.ClientCreatedAtMs,
+		ReceivedAtMs:      message.ReceivedAtMs,
+		MicroblockTimeMs:  message.MicroblockTimeMs,
+		Error:             err,
+	}
+	return nil
+}
+
+func (x *GetFastIntentStatusResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetFastIntentStatusResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.GetFastIntentStatusResponseMessage{
+		IntentID:          x.IntentId,
+		TransactionID:     x.TransactionId,
+		Status:            x.Status,
+		MaxFee:            x.MaxFee,
+		ClientCreatedAtMs: x.ClientCreatedAtMs,
+		ReceivedAtMs:      x.ReceivedAtMs,
+		MicroblockTimeMs:  x.MicroblockTimeMs,
+		Error:             rpcErr,
+	}, nil
+}
@@ -65,3 +65,33 @@ func feeRateBucketsToAppMessage(protoBuckets []*RpcFeerateBucket) []appmessage.R
 	}
 	return appMsgBuckets
 }
+
+func (x *CryptixdMessage_GetFeeEstimateResponse) fromAppMessage(message *appmessage.GetFeeEstimateResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.GetFeeEstimateResponse = &GetFeeEstimateResponseMessage{
+		Estimate: &RpcFeeEstimate{
+			PriorityBucket: &RpcFeerateBucket{
+				Feerate:          message.Estimate.PriorityBucket.Feerate,
+				EstimatedSeconds: message.Estimate.PriorityBucket.EstimatedSeconds,
+			},
+			NormalBuckets: feeRateBucketsFromAppMessage(message.Estimate.NormalBuckets),
+			LowBuckets:    feeRateBucketsFromAppMessage(message.Estimate.LowBuckets),
+		},
+		Error: err,
+	}
+	return nil
+}
+
+func feeRateBucketsFromAppMessage(appMsgBuckets []appmessage.RPCFeeRateBucket) []*RpcFeerateBucket {
+	protoBuckets := make([]*RpcFeerateBucket, len(appMsgBuckets))
+	for i, bucket := range appMsgBuckets {
+		protoBuckets[i] = &RpcFeerateBucket{
+			Feerate:          bucket.Feerate,
+			EstimatedSeconds: bucket.EstimatedSeconds,
+		}
+	}
+	return protoBuckets
+}
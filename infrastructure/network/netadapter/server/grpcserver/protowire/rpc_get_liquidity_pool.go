@@ -0,0 +1,83 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetLiquidityPoolRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetLiquidityPoolRequest is nil")
+	}
+	return x.GetLiquidityPoolRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetLiquidityPoolRequest) fromAppMessage(message *appmessage.GetLiquidityPoolRequestMessage) error {
+	x.GetLiquidityPoolRequest = &GetLiquidityPoolRequestMessage{
+		AssetId: message.AssetID,
+	}
+	return nil
+}
+
+func (x *GetLiquidityPoolRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetLiquidityPoolRequestMessage is nil")
+	}
+	return &appmessage.GetLiquidityPoolRequestMessage{
+		AssetID: x.AssetId,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetLiquidityPoolResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetLiquidityPoolResponse is nil")
+	}
+	return x.GetLiquidityPoolResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetLiquidityPoolResponse) fromAppMessage(message *appmessage.GetLiquidityPoolResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	var pool *RpcLiquidityPool
+	if message.Pool != nil {
+		pool = &RpcLiquidityPool{}
+		pool.fromAppMessage(message.Pool)
+	}
+	x.GetLiquidityPoolResponse = &GetLiquidityPoolResponseMessage{
+		Pool:      pool,
+		BlockHash: message.BlockHash,
+		Error:     err,
+	}
+	return nil
+}
+
+func (x *GetLiquidityPoolResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetLiquidityPoolResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && x.Pool != nil {
+		return nil, errors.New("GetLiquidityPoolResponseMessage contains both an error and a response")
+	}
+
+	var pool *appmessage.RPCLiquidityPool
+	if rpcErr == nil {
+		pool, err = x.Pool.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetLiquidityPoolResponseMessage{
+		Pool:      pool,
+		BlockHash: x.BlockHash,
+		Error:     rpcErr,
+	}, nil
+}
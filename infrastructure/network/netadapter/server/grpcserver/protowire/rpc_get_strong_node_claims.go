@@ -0,0 +1,145 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetStrongNodeClaimsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetStrongNodeClaimsRequest is nil")
+	}
+	return x.GetStrongNodeClaimsRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetStrongNodeClaimsRequest) fromAppMessage(message *appmessage.GetStrongNodeClaimsRequestMessage) error {
+	x.GetStrongNodeClaimsRequest = &GetStrongNodeClaimsRequestMessage{
+		IncludeWinningClaims: message.IncludeWinningClaims,
+		IncludePendingClaims: message.IncludePendingClaims,
+	}
+	return nil
+}
+
+func (x *GetStrongNodeClaimsRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetStrongNodeClaimsRequestMessage is nil")
+	}
+	return &appmessage.GetStrongNodeClaimsRequestMessage{
+		IncludeWinningClaims: x.IncludeWinningClaims,
+		IncludePendingClaims: x.IncludePendingClaims,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetStrongNodeClaimsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetStrongNodeClaimsResponse is nil")
+	}
+	return x.GetStrongNodeClaimsResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetStrongNodeClaimsResponse) fromAppMessage(message *appmessage.GetStrongNodeClaimsResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	entries := make([]*RpcStrongNodeClaimEntry, len(message.Entries))
+	for i, entry := range message.Entries {
+		entries[i] = &RpcStrongNodeClaimEntry{
+			NodeId:             entry.NodeID,
+			PublicKeyXOnly:     entry.PublicKeyXOnly,
+			ClaimedBlocks:      entry.ClaimedBlocks,
+			ShareBps:           entry.ShareBPS,
+			LastClaimBlockHash: entry.LastClaimBlockHash,
+			LastClaimTimeMs:    entry.LastClaimTimeMs,
+		}
+	}
+	x.GetStrongNodeClaimsResponse = &GetStrongNodeClaimsResponseMessage{
+		Enabled:          message.Enabled,
+		HardforkActive:   message.HardforkActive,
+		RuntimeAvailable: message.RuntimeAvailable,
+		WindowSize:       message.WindowSize,
+		ConflictTotal:    message.ConflictTotal,
+		Entries:          entries,
+		WinningClaims:    blockProducerClaimsFromAppMessage(message.WinningClaims),
+		PendingClaims:    blockProducerClaimsFromAppMessage(message.PendingClaims),
+		Error:            err,
+	}
+	return nil
+}
+
+func (x *GetStrongNodeClaimsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetStrongNodeClaimsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	entries := make([]*appmessage.RPCStrongNodeClaimEntry, len(x.Entries))
+	for i, entry := range x.Entries {
+		if entry == nil {
+			return nil, errors.Wrapf(errorNil, "RpcStrongNodeClaimEntry is nil")
+		}
+		entries[i] = &appmessage.RPCStrongNodeClaimEntry{
+			NodeID:             entry.NodeId,
+			PublicKeyXOnly:     entry.PublicKeyXOnly,
+			ClaimedBlocks:      entry.ClaimedBlocks,
+			ShareBPS:           entry.ShareBps,
+			LastClaimBlockHash: entry.LastClaimBlockHash,
+			LastClaimTimeMs:    entry.LastClaimTimeMs,
+		}
+	}
+	winningClaims, err := blockProducerClaimsToAppMessage(x.WinningClaims)
+	if err != nil {
+		return nil, err
+	}
+	pendingClaims, err := blockProducerClaimsToAppMessage(x.PendingClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appmessage.GetStrongNodeClaimsResponseMessage{
+		Enabled:          x.Enabled,
+		HardforkActive:   x.HardforkActive,
+		RuntimeAvailable: x.RuntimeAvailable,
+		WindowSize:       x.WindowSize,
+		ConflictTotal:    x.ConflictTotal,
+		Entries:          entries,
+		WinningClaims:    winningClaims,
+		PendingClaims:    pendingClaims,
+		Error:            rpcErr,
+	}, nil
+}
+
+func blockProducerClaimsFromAppMessage(claims []*appmessage.RPCBlockProducerClaim) []*RpcBlockProducerClaim {
+	protoClaims := make([]*RpcBlockProducerClaim, len(claims))
+	for i, claim := range claims {
+		protoClaims[i] = &RpcBlockProducerClaim{
+			BlockHash:      claim.BlockHash,
+			NodeId:         claim.NodeID,
+			PublicKeyXOnly: claim.PublicKeyXOnly,
+			ClaimId:        claim.ClaimID,
+			ReceivedAtMs:   claim.ReceivedAtMs,
+		}
+	}
+	return protoClaims
+}
+
+func blockProducerClaimsToAppMessage(protoClaims []*RpcBlockProducerClaim) ([]*appmessage.RPCBlockProducerClaim, error) {
+	claims := make([]*appmessage.RPCBlockProducerClaim, len(protoClaims))
+	for i, claim := range protoClaims {
+		if claim == nil {
+			return nil, errors.Wrapf(errorNil, "RpcBlockProducerClaim is nil")
+		}
+		claims[i] = &appmessage.RPCBlockProducerClaim{
+			BlockHash:      claim.BlockHash,
+			NodeID:         claim.NodeId,
+			PublicKeyXOnly: claim.PublicKeyXOnly,
+			ClaimID:        claim.ClaimId,
+			ReceivedAtMs:   claim.ReceivedAtMs,
+		}
+	}
+	return claims, nil
+}
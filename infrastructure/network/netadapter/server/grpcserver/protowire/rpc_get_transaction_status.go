@@ -0,0 +1,101 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_GetTransactionStatusRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetTransactionStatusRequest is nil")
+	}
+	return x.GetTransactionStatusRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetTransactionStatusRequest) fromAppMessage(
+	message *appmessage.GetTransactionStatusRequestMessage) error {
+
+	x.GetTransactionStatusRequest = &GetTransactionStatusRequestMessage{
+		TransactionId: message.TransactionID,
+	}
+	return nil
+}
+
+func (x *GetTransactionStatusRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionStatusRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionStatusRequestMessage{
+		TransactionID: x.TransactionId,
+	}, nil
+}
+
+func (x *CryptixdMessage_GetTransactionStatusResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_GetTransactionStatusResponse is nil")
+	}
+	return x.GetTransactionStatusResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_GetTransactionStatusResponse) fromAppMessage(
+	message *appmessage.GetTransactionStatusResponseMessage) error {
+
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	missingOutpoints := make([]*RpcOutpoint, len(message.MissingOutpoints))
+	for i, outpoint := range message.MissingOutpoints {
+		missingOutpoints[i] = &RpcOutpoint{}
+		missingOutpoints[i].fromAppMessage(outpoint)
+	}
+	x.GetTransactionStatusResponse = &GetTransactionStatusResponseMessage{
+		Status:                  message.Status,
+		FeeRate:                 message.FeeRate,
+		PackageFeeRate:          message.PackageFeeRate,
+		FeeRateRank:             message.FeeRateRank,
+		MempoolTransactionCount: message.MempoolTransactionCount,
+		AtomicSlots:             message.AtomicSlots,
+		MissingOutpoints:        missingOutpoints,
+		RejectCode:              message.RejectCode,
+		RejectReason:            message.RejectReason,
+		RejectedAtMs:            message.RejectedAtMs,
+		AcceptingBlockHash:      message.AcceptingBlockHash,
+		Confirmations:           message.Confirmations,
+		Error:                   err,
+	}
+	return nil
+}
+
+func (x *GetTransactionStatusResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionStatusResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	missingOutpoints := make([]*appmessage.RPCOutpoint, len(x.MissingOutpoints))
+	for i, outpoint := range x.MissingOutpoints {
+		missingOutpoints[i], err = outpoint.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appmessage.GetTransactionStatusResponseMessage{
+		Status:                  x.Status,
+		FeeRate:                 x.FeeRate,
+		PackageFeeRate:          x.PackageFeeRate,
+		FeeRateRank:             x.FeeRateRank,
+		MempoolTransactionCount: x.MempoolTransactionCount,
+		AtomicSlots:             x.AtomicSlots,
+		MissingOutpoints:        missingOutpoints,
+		RejectCode:              x.RejectCode,
+		RejectReason:            x.RejectReason,
+		RejectedAtMs:            x.RejectedAtMs,
+		AcceptingBlockHash:      x.AcceptingBlockHash,
+		Confirmations:           x.Confirmations,
+		Error:                   rpcErr,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_ListBansRequest) toAppMessage() (appmessage.Message, error) {
+	return &appmessage.ListBansRequestMessage{}, nil
+}
+
+func (x *CryptixdMessage_ListBansRequest) fromAppMessage(_ *appmessage.ListBansRequestMessage) error {
+	x.ListBansRequest = &ListBansRequestMessage{}
+	return nil
+}
+
+func (x *CryptixdMessage_ListBansResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_ListBansResponse is nil")
+	}
+	return x.ListBansResponse.toAppMessage()
+}
+
+func (x *ListBansResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ListBansResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	bannedIPs := make([]*appmessage.RPCBannedIP, len(x.BannedIps))
+	for i, bannedIP := range x.BannedIps {
+		if bannedIP == nil {
+			return nil, errors.Wrapf(errorNil, "RpcBannedIP is nil")
+		}
+		bannedIPs[i] = &appmessage.RPCBannedIP{
+			IP:          bannedIP.Ip,
+			BannedAtMs:  bannedIP.BannedAtMs,
+			ExpiresAtMs: bannedIP.ExpiresAtMs,
+		}
+	}
+	bannedNodeIDs := make([]*appmessage.RPCBannedNodeID, len(x.BannedNodeIds))
+	for i, bannedNodeID := range x.BannedNodeIds {
+		if bannedNodeID == nil {
+			return nil, errors.Wrapf(errorNil, "RpcBannedNodeID is nil")
+		}
+		bannedNodeIDs[i] = &appmessage.RPCBannedNodeID{
+			NodeID:      bannedNodeID.NodeId,
+			Reason:      bannedNodeID.Reason,
+			BannedAtMs:  bannedNodeID.BannedAtMs,
+			ExpiresAtMs: bannedNodeID.ExpiresAtMs,
+		}
+	}
+
+	return &appmessage.ListBansResponseMessage{
+		BannedIPs:     bannedIPs,
+		BannedNodeIDs: bannedNodeIDs,
+		Error:         rpcErr,
+	}, nil
+}
+
+func (x *CryptixdMessage_ListBansResponse) fromAppMessage(message *appmessage.ListBansResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	bannedIPs := make([]*RpcBannedIP, len(message.BannedIPs))
+	for i, bannedIP := range message.BannedIPs {
+		bannedIPs[i] = &RpcBannedIP{
+			Ip:          bannedIP.IP,
+			BannedAtMs:  bannedIP.BannedAtMs,
+			ExpiresAtMs: bannedIP.ExpiresAtMs,
+		}
+	}
+	bannedNodeIDs := make([]*RpcBannedNodeID, len(message.BannedNodeIDs))
+	for i, bannedNodeID := range message.BannedNodeIDs {
+		bannedNodeIDs[i] = &RpcBannedNodeID{
+			NodeId:      bannedNodeID.NodeID,
+			Reason:      bannedNodeID.Reason,
+			BannedAtMs:  bannedNodeID.BannedAtMs,
+			ExpiresAtMs: bannedNodeID.ExpiresAtMs,
+		}
+	}
+	x.ListBansResponse = &ListBansResponseMessage{
+		BannedIps:     bannedIPs,
+		BannedNodeIds: bannedNodeIDs,
+		Error:         err,
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_NotifyAtomicStateChangedRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_NotifyAtomicStateChangedRequest is nil")
+	}
+	return x.NotifyAtomicStateChangedRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_NotifyAtomicStateChangedRequest) fromAppMessage(message *appmessage.NotifyAtomicStateChangedRequestMessage) error {
+	x.NotifyAtomicStateChangedRequest = &NotifyAtomicStateChangedRequestMessage{
+		OwnerIds: message.OwnerIDs,
+		AssetIds: message.AssetIDs,
+	}
+	return nil
+}
+
+func (x *NotifyAtomicStateChangedRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyAtomicStateChangedRequestMessage is nil")
+	}
+	return &appmessage.NotifyAtomicStateChangedRequestMessage{
+		OwnerIDs: x.OwnerIds,
+		AssetIDs: x.AssetIds,
+	}, nil
+}
+
+func (x *CryptixdMessage_NotifyAtomicStateChangedResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_NotifyAtomicStateChangedResponse is nil")
+	}
+	return x.NotifyAtomicStateChangedResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_NotifyAtomicStateChangedResponse) fromAppMessage(message *appmessage.NotifyAtomicStateChangedResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.NotifyAtomicStateChangedResponse = &NotifyAtomicStateChangedResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *NotifyAtomicStateChangedResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyAtomicStateChangedResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.NotifyAtomicStateChangedResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *CryptixdMessage_AtomicStateChangedNotification) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_AtomicStateChangedNotification is nil")
+	}
+	return x.AtomicStateChangedNotification.toAppMessage()
+}
+
+func (x *CryptixdMessage_AtomicStateChangedNotification) fromAppMessage(message *appmessage.AtomicStateChangedNotificationMessage) error {
+	events := make([]*RpcAtomicEvent, len(message.Events))
+	for i, event := range message.Events {
+		events[i] = &RpcAtomicEvent{}
+		events[i].fromAppMessage(event)
+	}
+	x.AtomicStateChangedNotification = &AtomicStateChangedNotificationMessage{
+		Events: events,
+	}
+	return nil
+}
+
+func (x *AtomicStateChangedNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "AtomicStateChangedNotificationMessage is nil")
+	}
+	events := make([]*appmessage.RPCAtomicEvent, len(x.Events))
+	for i, event := range x.Events {
+		appEvent, err := event.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		events[i] = appEvent
+	}
+	return &appmessage.AtomicStateChangedNotificationMessage{
+		Events: events,
+	}, nil
+}
+
+func (x *RpcAtomicEvent) toAppMessage() (*appmessage.RPCAtomicEvent, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcAtomicEvent is nil")
+	}
+	balanceChanges := make([]*appmessage.RPCAtomicBalanceChange, len(x.BalanceChanges))
+	for i, change := range x.BalanceChanges {
+		appChange, err := change.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		balanceChanges[i] = appChange
+	}
+	var pool *appmessage.RPCLiquidityPool
+	if x.Pool != nil {
+		var err error
+		pool, err = x.Pool.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appmessage.RPCAtomicEvent{
+		Kind:               x.Kind,
+		TransactionID:      x.TransactionId,
+		AcceptingBlockHash: x.AcceptingBlockHash,
+		AssetID:            x.AssetId,
+		OwnerID:            x.OwnerId,
+		Amount:             x.Amount,
+		CPayAmountSompi:    x.CpayAmountSompi,
+		Recipients:         x.Recipients,
+		BalanceChanges:     balanceChanges,
+		Pool:               pool,
+		Reversed:           x.Reversed,
+	}, nil
+}
+
+func (x *RpcAtomicEvent) fromAppMessage(message *appmessage.RPCAtomicEvent) {
+	balanceChanges := make([]*RpcAtomicBalanceChange, len(message.BalanceChanges))
+	for i, change := range message.BalanceChanges {
+		balanceChanges[i] = &RpcAtomicBalanceChange{
+			OwnerId: change.OwnerID,
+			Before:  change.Before,
+			After:   change.After,
+		}
+	}
+	var pool *RpcLiquidityPool
+	if message.Pool != nil {
+		pool = &RpcLiquidityPool{}
+		pool.fromAppMessage(message.Pool)
+	}
+	*x = RpcAtomicEvent{
+		Kind:               message.Kind,
+		TransactionId:      message.TransactionID,
+		AcceptingBlockHash: message.AcceptingBlockHash,
+		AssetId:            message.AssetID,
+		OwnerId:            message.OwnerID,
+		Amount:             message.Amount,
+		CpayAmountSompi:    message.CPayAmountSompi,
+		Recipients:         message.Recipients,
+		BalanceChanges:     balanceChanges,
+		Pool:               pool,
+		Reversed:           message.Reversed,
+	}
+}
+
+func (x *RpcAtomicBalanceChange) toAppMessage() (*appmessage.RPCAtomicBalanceChange, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcAtomicBalanceChange is nil")
+	}
+	return &appmessage.RPCAtomicBalanceChange{
+		OwnerID: x.OwnerId,
+		Before:  x.Before,
+		After:   x.After,
+	}, nil
+}
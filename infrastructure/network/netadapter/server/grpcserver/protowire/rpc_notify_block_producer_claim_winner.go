@@ -0,0 +1,74 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_NotifyBlockProducerClaimWinnerRequest) toAppMessage() (appmessage.Message, error) {
+	return &appmessage.NotifyBlockProducerClaimWinnerRequestMessage{}, nil
+}
+
+func (x *CryptixdMessage_NotifyBlockProducerClaimWinnerRequest) fromAppMessage(_ *appmessage.NotifyBlockProducerClaimWinnerRequestMessage) error {
+	x.NotifyBlockProducerClaimWinnerRequest = &NotifyBlockProducerClaimWinnerRequestMessage{}
+	return nil
+}
+
+func (x *CryptixdMessage_NotifyBlockProducerClaimWinnerResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_NotifyBlockProducerClaimWinnerResponse is nil")
+	}
+	return x.NotifyBlockProducerClaimWinnerResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_NotifyBlockProducerClaimWinnerResponse) fromAppMessage(message *appmessage.NotifyBlockProducerClaimWinnerResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.NotifyBlockProducerClaimWinnerResponse = &NotifyBlockProducerClaimWinnerResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *NotifyBlockProducerClaimWinnerResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyBlockProducerClaimWinnerResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.NotifyBlockProducerClaimWinnerResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *CryptixdMessage_BlockProducerClaimWinnerNotification) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_BlockProducerClaimWinnerNotification is nil")
+	}
+	return x.BlockProducerClaimWinnerNotification.toAppMessage()
+}
+
+func (x *CryptixdMessage_BlockProducerClaimWinnerNotification) fromAppMessage(message *appmessage.BlockProducerClaimWinnerNotificationMessage) error {
+	x.BlockProducerClaimWinnerNotification = &BlockProducerClaimWinnerNotificationMessage{
+		Winners: blockProducerClaimsFromAppMessage(message.Winners),
+	}
+	return nil
+}
+
+func (x *BlockProducerClaimWinnerNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BlockProducerClaimWinnerNotificationMessage is nil")
+	}
+	winners, err := blockProducerClaimsToAppMessage(x.Winners)
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.BlockProducerClaimWinnerNotificationMessage{
+		Winners: winners,
+	}, nil
+}
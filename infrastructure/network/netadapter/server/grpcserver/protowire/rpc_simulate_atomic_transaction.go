@@ -0,0 +1,178 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_SimulateAtomicTransactionRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_SimulateAtomicTransactionRequest is nil")
+	}
+	return x.SimulateAtomicTransactionRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_SimulateAtomicTransactionRequest) fromAppMessage(message *appmessage.SimulateAtomicTransactionRequestMessage) error {
+	x.SimulateAtomicTransactionRequest = &SimulateAtomicTransactionRequestMessage{
+		Transaction: &RpcTransaction{},
+	}
+	x.SimulateAtomicTransactionRequest.Transaction.fromAppMessage(message.Transaction)
+	return nil
+}
+
+func (x *SimulateAtomicTransactionRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SimulateAtomicTransactionRequestMessage is nil")
+	}
+	rpcTransaction, err := x.Transaction.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.SimulateAtomicTransactionRequestMessage{
+		Transaction: rpcTransaction,
+	}, nil
+}
+
+func (x *CryptixdMessage_SimulateAtomicTransactionResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_SimulateAtomicTransactionResponse is nil")
+	}
+	return x.SimulateAtomicTransactionResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_SimulateAtomicTransactionResponse) fromAppMessage(message *appmessage.SimulateAtomicTransactionResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	balanceChanges := make([]*RpcAtomicSimulatedBalanceChange, len(message.BalanceChanges))
+	for i, change := range message.BalanceChanges {
+		balanceChanges[i] = &RpcAtomicSimulatedBalanceChange{
+			AssetId: change.AssetID,
+			OwnerId: change.OwnerID,
+			Before:  change.Before,
+			After:   change.After,
+		}
+	}
+	nonceChanges := make([]*RpcAtomicSimulatedNonceChange, len(message.NonceChanges))
+	for i, change := range message.NonceChanges {
+		nonceChanges[i] = &RpcAtomicSimulatedNonceChange{
+			OwnerId: change.OwnerID,
+			AssetId: change.AssetID,
+			Before:  change.Before,
+			After:   change.After,
+		}
+	}
+	poolChanges := make([]*RpcAtomicSimulatedPoolChange, len(message.PoolChanges))
+	for i, change := range message.PoolChanges {
+		poolChanges[i] = &RpcAtomicSimulatedPoolChange{AssetId: change.AssetID}
+		if change.Before != nil {
+			poolChanges[i].Before = &RpcLiquidityPool{}
+			poolChanges[i].Before.fromAppMessage(change.Before)
+		}
+		if change.After != nil {
+			poolChanges[i].After = &RpcLiquidityPool{}
+			poolChanges[i].After.fromAppMessage(change.After)
+		}
+	}
+	var stateGrowth *RpcAtomicStateGrowth
+	if message.StateGrowth != nil {
+		stateGrowth = &RpcAtomicStateGrowth{
+			NewAssets:          message.StateGrowth.NewAssets,
+			NewBalanceKeys:     message.StateGrowth.NewBalanceKeys,
+			NewNonceKeys:       message.StateGrowth.NewNonceKeys,
+			NewPools:           message.StateGrowth.NewPools,
+			NewAnchorOwnerKeys: message.StateGrowth.NewAnchorOwnerKeys,
+		}
+	}
+	x.SimulateAtomicTransactionResponse = &SimulateAtomicTransactionResponseMessage{
+		TransactionId:   message.TransactionID,
+		DaaScore:        message.DAAScore,
+		Fee:             message.Fee,
+		ValidationError: message.ValidationError,
+		AtomicError:     message.AtomicError,
+		BalanceChanges:  balanceChanges,
+		NonceChanges:    nonceChanges,
+		PoolChanges:     poolChanges,
+		StateGrowth:     stateGrowth,
+		Error:           err,
+	}
+	return nil
+}
+
+func (x *SimulateAtomicTransactionResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SimulateAtomicTransactionResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	balanceChanges := make([]*appmessage.RPCAtomicSimulatedBalanceChange, len(x.BalanceChanges))
+	for i, change := range x.BalanceChanges {
+		if change == nil {
+			return nil, errors.Wrapf(errorNil, "RpcAtomicSimulatedBalanceChange is nil")
+		}
+		balanceChanges[i] = &appmessage.RPCAtomicSimulatedBalanceChange{
+			AssetID: change.AssetId,
+			OwnerID: change.OwnerId,
+			Before:  change.Before,
+			After:   change.After,
+		}
+	}
+	nonceChanges := make([]*appmessage.RPCAtomicSimulatedNonceChange, len(x.NonceChanges))
+	for i, change := range x.NonceChanges {
+		if change == nil {
+			return nil, errors.Wrapf(errorNil, "RpcAtomicSimulatedNonceChange is nil")
+		}
+		nonceChanges[i] = &appmessage.RPCAtomicSimulatedNonceChange{
+			OwnerID: change.OwnerId,
+			AssetID: change.AssetId,
+			Before:  change.Before,
+			After:   change.After,
+		}
+	}
+	poolChanges := make([]*appmessage.RPCAtomicSimulatedPoolChange, len(x.PoolChanges))
+	for i, change := range x.PoolChanges {
+		if change == nil {
+			return nil, errors.Wrapf(errorNil, "RpcAtomicSimulatedPoolChange is nil")
+		}
+		poolChanges[i] = &appmessage.RPCAtomicSimulatedPoolChange{AssetID: change.AssetId}
+		// Before is not set for pools created by the transaction
+		if change.Before != nil {
+			poolChanges[i].Before, err = change.Before.toAppMessage()
+			if err != nil {
+				return nil, err
+			}
+		}
+		poolChanges[i].After, err = change.After.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var stateGrowth *appmessage.RPCAtomicStateGrowth
+	if x.StateGrowth != nil {
+		stateGrowth = &appmessage.RPCAtomicStateGrowth{
+			NewAssets:          x.StateGrowth.NewAssets,
+			NewBalanceKeys:     x.StateGrowth.NewBalanceKeys,
+			NewNonceKeys:       x.StateGrowth.NewNonceKeys,
+			NewPools:           x.StateGrowth.NewPools,
+			NewAnchorOwnerKeys: x.StateGrowth.NewAnchorOwnerKeys,
+		}
+	}
+
+	return &appmessage.SimulateAtomicTransactionResponseMessage{
+		TransactionID:   x.TransactionId,
+		DAAScore:        x.DaaScore,
+		Fee:             x.Fee,
+		ValidationError: x.ValidationError,
+		AtomicError:     x.AtomicError,
+		BalanceChanges:  balanceChanges,
+		NonceChanges:    nonceChanges,
+		PoolChanges:     poolChanges,
+		StateGrowth:     stateGrowth,
+		Error:           rpcErr,
+	}, nil
+}
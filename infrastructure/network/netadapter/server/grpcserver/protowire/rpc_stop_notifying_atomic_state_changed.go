@@ -0,0 +1,57 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_StopNotifyingAtomicStateChangedRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_StopNotifyingAtomicStateChangedRequest is nil")
+	}
+	return x.StopNotifyingAtomicStateChangedRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_StopNotifyingAtomicStateChangedRequest) fromAppMessage(_ *appmessage.StopNotifyingAtomicStateChangedRequestMessage) error {
+	x.StopNotifyingAtomicStateChangedRequest = &StopNotifyingAtomicStateChangedRequestMessage{}
+	return nil
+}
+
+func (x *StopNotifyingAtomicStateChangedRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "StopNotifyingAtomicStateChangedRequestMessage is nil")
+	}
+	return &appmessage.StopNotifyingAtomicStateChangedRequestMessage{}, nil
+}
+
+func (x *CryptixdMessage_StopNotifyingAtomicStateChangedResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_StopNotifyingAtomicStateChangedResponse is nil")
+	}
+	return x.StopNotifyingAtomicStateChangedResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_StopNotifyingAtomicStateChangedResponse) fromAppMessage(message *appmessage.StopNotifyingAtomicStateChangedResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.StopNotifyingAtomicStateChangedResponse = &StopNotifyingAtomicStateChangedResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *StopNotifyingAtomicStateChangedResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "StopNotifyingAtomicStateChangedResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.StopNotifyingAtomicStateChangedResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
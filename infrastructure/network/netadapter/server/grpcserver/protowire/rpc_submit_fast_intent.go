@@ -0,0 +1,76 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_SubmitFastIntentRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_SubmitFastIntentRequest is nil")
+	}
+	return x.SubmitFastIntentRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_SubmitFastIntentRequest) fromAppMessage(message *appmessage.SubmitFastIntentRequestMessage) error {
+	x.SubmitFastIntentRequest = &SubmitFastIntentRequestMessage{
+		Transaction:       &RpcTransaction{},
+		IntentNonce:       message.IntentNonce,
+		ClientCreatedAtMs: message.ClientCreatedAtMs,
+		MaxFee:            message.MaxFee,
+	}
+	x.SubmitFastIntentRequest.Transaction.fromAppMessage(message.Transaction)
+	return nil
+}
+
+func (x *SubmitFastIntentRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitFastIntentRequestMessage is nil")
+	}
+	rpcTransaction, err := x.Transaction.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.SubmitFastIntentRequestMessage{
+		Transaction:       rpcTransaction,
+		IntentNonce:       x.IntentNonce,
+		ClientCreatedAtMs: x.ClientCreatedAtMs,
+		MaxFee:            x.MaxFee,
+	}, nil
+}
+
+func (x *CryptixdMessage_SubmitFastIntentResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_SubmitFastIntentResponse is nil")
+	}
+	return x.SubmitFastIntentResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_SubmitFastIntentResponse) fromAppMessage(message *appmessage.SubmitFastIntentResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.SubmitFastIntentResponse = &SubmitFastIntentResponseMessage{
+		IntentId:      message.IntentID,
+		TransactionId: message.TransactionID,
+		Error:         err,
+	}
+	return nil
+}
+
+func (x *SubmitFastIntentResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitFastIntentResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.SubmitFastIntentResponseMessage{
+		IntentID:      x.IntentId,
+		TransactionID: x.TransactionId,
+		Error:         rpcErr,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_SubmitTransactionPackageRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_SubmitTransactionPackageRequest is nil")
+	}
+	return x.SubmitTransactionPackageRequest.toAppMessage()
+}
+
+func (x *CryptixdMessage_SubmitTransactionPackageRequest) fromAppMessage(
+	message *appmessage.SubmitTransactionPackageRequestMessage) error {
+
+	transactions := make([]*RpcTransaction, len(message.Transactions))
+	for i, transaction := range message.Transactions {
+		transactions[i] = &RpcTransaction{}
+		transactions[i].fromAppMessage(transaction)
+	}
+	x.SubmitTransactionPackageRequest = &SubmitTransactionPackageRequestMessage{
+		Transactions: transactions,
+	}
+	return nil
+}
+
+func (x *SubmitTransactionPackageRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionPackageRequestMessage is nil")
+	}
+	transactions := make([]*appmessage.RPCTransaction, len(x.Transactions))
+	for i, transaction := range x.Transactions {
+		rpcTransaction, err := transaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = rpcTransaction
+	}
+	return &appmessage.SubmitTransactionPackageRequestMessage{
+		Transactions: transactions,
+	}, nil
+}
+
+func (x *CryptixdMessage_SubmitTransactionPackageResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_SubmitTransactionPackageResponse is nil")
+	}
+	return x.SubmitTransactionPackageResponse.toAppMessage()
+}
+
+func (x *CryptixdMessage_SubmitTransactionPackageResponse) fromAppMessage(
+	message *appmessage.SubmitTransactionPackageResponseMessage) error {
+
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.SubmitTransactionPackageResponse = &SubmitTransactionPackageResponseMessage{
+		TransactionIds: message.TransactionIDs,
+		Error:          err,
+	}
+	return nil
+}
+
+func (x *SubmitTransactionPackageResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionPackageResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.SubmitTransactionPackageResponseMessage{
+		TransactionIDs: x.TransactionIds,
+		Error:          rpcErr,
+	}, nil
+}
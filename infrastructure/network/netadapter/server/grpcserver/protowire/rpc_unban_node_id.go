@@ -0,0 +1,61 @@
+package protowire
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CryptixdMessage_UnbanNodeIDRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_UnbanNodeIDRequest is nil")
+	}
+	return x.UnbanNodeIDRequest.toAppMessage()
+}
+
+func (x *UnbanNodeIDRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "UnbanNodeIDRequestMessage is nil")
+	}
+	return &appmessage.UnbanNodeIDRequestMessage{
+		NodeID: x.NodeId,
+	}, nil
+}
+
+func (x *CryptixdMessage_UnbanNodeIDRequest) fromAppMessage(message *appmessage.UnbanNodeIDRequestMessage) error {
+	x.UnbanNodeIDRequest = &UnbanNodeIDRequestMessage{
+		NodeId: message.NodeID,
+	}
+	return nil
+}
+
+func (x *CryptixdMessage_UnbanNodeIDResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CryptixdMessage_UnbanNodeIDResponse is nil")
+	}
+	return x.UnbanNodeIDResponse.toAppMessage()
+}
+
+func (x *UnbanNodeIDResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "UnbanNodeIDResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.UnbanNodeIDResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *CryptixdMessage_UnbanNodeIDResponse) fromAppMessage(message *appmessage.UnbanNodeIDResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.UnbanNodeIDResponse = &UnbanNodeIDResponseMessage{
+		Error: err,
+	}
+	return nil
+}
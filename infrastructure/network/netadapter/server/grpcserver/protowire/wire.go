@@ -464,6 +464,34 @@ func toP2PPayload(message appmessage.Message) (isCryptixdMessage_Payload, error)
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.MsgCompactBlock:
+		payload := new(CryptixdMessage_CompactBlock)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgRequestBlockTransactions:
+		payload := new(CryptixdMessage_RequestBlockTransactions)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgBlockTransactions:
+		payload := new(CryptixdMessage_BlockTransactions)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgTransactionPackage:
+		payload := new(CryptixdMessage_TransactionPackage)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
@@ -1080,6 +1108,13 @@ func toRPCPayload(message appmessage.Message) (isCryptixdMessage_Payload, error)
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.GetFeeEstimateResponseMessage:
+		payload := new(CryptixdMessage_GetFeeEstimateResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	case *appmessage.SubmitTransactionReplacementRequestMessage:
 		payload := new(CryptixdMessage_SubmitTransactionReplacementRequest)
 		err := payload.fromAppMessage(message)
@@ -1094,6 +1129,314 @@ func toRPCPayload(message appmessage.Message) (isCryptixdMessage_Payload, error)
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.GetAtomicAssetRequestMessage:
+		payload := new(CryptixdMessage_GetAtomicAssetRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicAssetResponseMessage:
+		payload := new(CryptixdMessage_GetAtomicAssetResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicAssetsRequestMessage:
+		payload := new(CryptixdMessage_GetAtomicAssetsRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicAssetsResponseMessage:
+		payload := new(CryptixdMessage_GetAtomicAssetsResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicBalancesByOwnerRequestMessage:
+		payload := new(CryptixdMessage_GetAtomicBalancesByOwnerRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicBalancesByOwnerResponseMessage:
+		payload := new(CryptixdMessage_GetAtomicBalancesByOwnerResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicNonceRequestMessage:
+		payload := new(CryptixdMessage_GetAtomicNonceRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicNonceResponseMessage:
+		payload := new(CryptixdMessage_GetAtomicNonceResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetLiquidityPoolRequestMessage:
+		payload := new(CryptixdMessage_GetLiquidityPoolRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetLiquidityPoolResponseMessage:
+		payload := new(CryptixdMessage_GetLiquidityPoolResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyAtomicStateChangedRequestMessage:
+		payload := new(CryptixdMessage_NotifyAtomicStateChangedRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyAtomicStateChangedResponseMessage:
+		payload := new(CryptixdMessage_NotifyAtomicStateChangedResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.AtomicStateChangedNotificationMessage:
+		payload := new(CryptixdMessage_AtomicStateChangedNotification)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.StopNotifyingAtomicStateChangedRequestMessage:
+		payload := new(CryptixdMessage_StopNotifyingAtomicStateChangedRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.StopNotifyingAtomicStateChangedResponseMessage:
+		payload := new(CryptixdMessage_StopNotifyingAtomicStateChangedResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitFastIntentRequestMessage:
+		payload := new(CryptixdMessage_SubmitFastIntentRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitFastIntentResponseMessage:
+		payload := new(CryptixdMessage_SubmitFastIntentResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetFastIntentStatusRequestMessage:
+		payload := new(CryptixdMessage_GetFastIntentStatusRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetFastIntentStatusResponseMessage:
+		payload := new(CryptixdMessage_GetFastIntentStatusResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicHistoryByOwnerRequestMessage:
+		payload := new(CryptixdMessage_GetAtomicHistoryByOwnerRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicHistoryByOwnerResponseMessage:
+		payload := new(CryptixdMessage_GetAtomicHistoryByOwnerResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicHistoryByAssetRequestMessage:
+		payload := new(CryptixdMessage_GetAtomicHistoryByAssetRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicHistoryByAssetResponseMessage:
+		payload := new(CryptixdMessage_GetAtomicHistoryByAssetResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SimulateAtomicTransactionRequestMessage:
+		payload := new(CryptixdMessage_SimulateAtomicTransactionRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SimulateAtomicTransactionResponseMessage:
+		payload := new(CryptixdMessage_SimulateAtomicTransactionResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicBalanceProofRequestMessage:
+		payload := new(CryptixdMessage_GetAtomicBalanceProofRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAtomicBalanceProofResponseMessage:
+		payload := new(CryptixdMessage_GetAtomicBalanceProofResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetStrongNodeClaimsRequestMessage:
+		payload := new(CryptixdMessage_GetStrongNodeClaimsRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetStrongNodeClaimsResponseMessage:
+		payload := new(CryptixdMessage_GetStrongNodeClaimsResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyBlockProducerClaimWinnerRequestMessage:
+		payload := new(CryptixdMessage_NotifyBlockProducerClaimWinnerRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyBlockProducerClaimWinnerResponseMessage:
+		payload := new(CryptixdMessage_NotifyBlockProducerClaimWinnerResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.BlockProducerClaimWinnerNotificationMessage:
+		payload := new(CryptixdMessage_BlockProducerClaimWinnerNotification)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.BanNodeIDRequestMessage:
+		payload := new(CryptixdMessage_BanNodeIDRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.BanNodeIDResponseMessage:
+		payload := new(CryptixdMessage_BanNodeIDResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.UnbanNodeIDRequestMessage:
+		payload := new(CryptixdMessage_UnbanNodeIDRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.UnbanNodeIDResponseMessage:
+		payload := new(CryptixdMessage_UnbanNodeIDResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ListBansRequestMessage:
+		payload := new(CryptixdMessage_ListBansRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ListBansResponseMessage:
+		payload := new(CryptixdMessage_ListBansResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAntiFraudStateRequestMessage:
+		payload := new(CryptixdMessage_GetAntiFraudStateRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAntiFraudStateResponseMessage:
+		payload := new(CryptixdMessage_GetAntiFraudStateResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitTransactionPackageRequestMessage:
+		payload := new(CryptixdMessage_SubmitTransactionPackageRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitTransactionPackageResponseMessage:
+		payload := new(CryptixdMessage_SubmitTransactionPackageResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionStatusRequestMessage:
+		payload := new(CryptixdMessage_GetTransactionStatusRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionStatusResponseMessage:
+		payload := new(CryptixdMessage_GetTransactionStatusResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
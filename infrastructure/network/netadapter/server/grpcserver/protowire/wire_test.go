@@ -3,6 +3,7 @@ package protowire
 import (
 	"bytes"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/cryptix-network/cryptixd/app/appmessage"
@@ -315,3 +316,118 @@ func testDomainHash(t *testing.T, b byte) *externalapi.DomainHash {
 
 	return hash
 }
+
+func TestCompactBlockMessagesRoundTrip(t *testing.T) {
+	blockHash := testDomainHash(t, 0x44)
+	header := appmessage.NewBlockHeader(1, []externalapi.BlockLevelParents{{blockHash}}, blockHash, blockHash, blockHash,
+		0x1d00ffff, 7, 100, 90, big.NewInt(12345), blockHash)
+	tx := &appmessage.MsgTx{
+		Version:      1,
+		TxIn:         []*appmessage.TxIn{},
+		TxOut:        []*appmessage.TxOut{},
+		SubnetworkID: subnetworks.SubnetworkIDCoinbase,
+		Payload:      []byte{0x01, 0x02},
+	}
+
+	messages := []appmessage.Message{
+		appmessage.NewMsgCompactBlock(header, 99, []uint64{1, 1<<48 - 1},
+			[]*appmessage.PrefilledTransaction{{Index: 0, Transaction: tx}}),
+		appmessage.NewMsgRequestBlockTransactions(blockHash, []uint32{1, 2}),
+		appmessage.NewMsgBlockTransactions(blockHash, []*appmessage.MsgTx{tx}),
+	}
+
+	for _, input := range messages {
+		protoMessage, err := FromAppMessage(input)
+		if err != nil {
+			t.Fatalf("FromAppMessage(%T) failed: %v", input, err)
+		}
+
+		appMsg, err := protoMessage.ToAppMessage()
+		if err != nil {
+			t.Fatalf("ToAppMessage(%T) failed: %v", input, err)
+		}
+
+		switch got := appMsg.(type) {
+		case *appmessage.MsgCompactBlock:
+			if got.ShortIDNonce != 99 || len(got.ShortIDs) != 2 || got.ShortIDs[1] != 1<<48-1 ||
+				len(got.PrefilledTransactions) != 1 || !bytes.Equal(got.PrefilledTransactions[0].Transaction.Payload, tx.Payload) ||
+				got.Header.BlueScore != 90 || !got.Header.HashMerkleRoot.Equal(blockHash) {
+				t.Fatalf("compact block mismatch")
+			}
+		case *appmessage.MsgRequestBlockTransactions:
+			if !got.BlockHash.Equal(blockHash) || len(got.Indexes) != 2 || got.Indexes[1] != 2 {
+				t.Fatalf("block transactions request mismatch")
+			}
+		case *appmessage.MsgBlockTransactions:
+			if !got.BlockHash.Equal(blockHash) || len(got.Transactions) != 1 || !bytes.Equal(got.Transactions[0].Payload, tx.Payload) {
+				t.Fatalf("block transactions mismatch")
+			}
+		default:
+			t.Fatalf("unexpected message type %T", appMsg)
+		}
+	}
+}
+
+func TestTransactionPackageRoundTrip(t *testing.T) {
+	parent := &appmessage.MsgTx{
+		Version:      1,
+		TxIn:         []*appmessage.TxIn{},
+		TxOut:        []*appmessage.TxOut{},
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+		Payload:      []byte{},
+	}
+	child := &appmessage.MsgTx{
+		Version:      1,
+		TxIn:         []*appmessage.TxIn{},
+		TxOut:        []*appmessage.TxOut{},
+		SubnetworkID: subnetworks.SubnetworkIDPayload,
+		Payload:      []byte{0x03, 0x04},
+	}
+
+	protoMessage, err := FromAppMessage(appmessage.NewMsgTransactionPackage([]*appmessage.MsgTx{parent, child}))
+	if err != nil {
+		t.Fatalf("FromAppMessage failed: %v", err)
+	}
+	appMsg, err := protoMessage.ToAppMessage()
+	if err != nil {
+		t.Fatalf("ToAppMessage failed: %v", err)
+	}
+	got, ok := appMsg.(*appmessage.MsgTransactionPackage)
+	if !ok {
+		t.Fatalf("unexpected message type %T", appMsg)
+	}
+	if len(got.Transactions) != 2 || !bytes.Equal(got.Transactions[1].Payload, child.Payload) {
+		t.Fatalf("transaction package mismatch")
+	}
+
+	oversized := make([]*appmessage.MsgTx, appmessage.MaxTransactionPackageTransactions+1)
+	for i := range oversized {
+		oversized[i] = parent
+	}
+	_, err = FromAppMessage(appmessage.NewMsgTransactionPackage(oversized))
+	if err == nil {
+		t.Fatalf("expected an oversized transaction package to be rejected")
+	}
+}
+
+func TestGetTransactionStatusResponseRoundTrip(t *testing.T) {
+	response := appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusOrphan)
+	response.MissingOutpoints = []*appmessage.RPCOutpoint{{TransactionID: "aa", Index: 1}, {TransactionID: "bb", Index: 2}}
+
+	protoMessage, err := FromAppMessage(response)
+	if err != nil {
+		t.Fatalf("FromAppMessage failed: %v", err)
+	}
+	appMsg, err := protoMessage.ToAppMessage()
+	if err != nil {
+		t.Fatalf("ToAppMessage failed: %v", err)
+	}
+	got, ok := appMsg.(*appmessage.GetTransactionStatusResponseMessage)
+	if !ok {
+		t.Fatalf("unexpected message type %T", appMsg)
+	}
+	if got.Status != appmessage.TransactionStatusOrphan || got.Error != nil || len(got.MissingOutpoints) != 2 ||
+		*got.MissingOutpoints[1] != *response.MissingOutpoints[1] {
+		t.Fatalf("transaction status mismatch: %+v", got)
+	}
+}
@@ -0,0 +1,99 @@
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/securetransport"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const secureTransportAuthType = "cryptix-secure-transport"
+
+// secureTransportCredentials runs gRPC over the encrypted P2P transport.
+// Unless requireEncryption is set, inbound connections that don't start the
+// transport's handshake are served as plaintext, so that peers without it can
+// still connect.
+type secureTransportCredentials struct {
+	config            *securetransport.Config
+	requireEncryption bool
+
+	// expectedNodeID, if set, is the node ID an outbound connection must
+	// authenticate as
+	expectedNodeID *[32]byte
+}
+
+type secureTransportAuthInfo struct {
+	credentials.CommonAuthInfo
+	peerPubKeyXOnly [32]byte
+}
+
+func (secureTransportAuthInfo) AuthType() string {
+	return secureTransportAuthType
+}
+
+func (c *secureTransportCredentials) ClientHandshake(ctx context.Context, _ string, rawConn net.Conn) (
+	net.Conn, credentials.AuthInfo, error) {
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err := rawConn.SetDeadline(deadline)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer rawConn.SetDeadline(time.Time{})
+	}
+	conn, err := securetransport.Client(rawConn, c.config, c.expectedNodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, newSecureTransportAuthInfo(conn), nil
+}
+
+func (c *secureTransportCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, err := securetransport.Accept(rawConn, c.config)
+	if err != nil {
+		return nil, nil, err
+	}
+	secureConn, ok := conn.(*securetransport.Conn)
+	if !ok {
+		if c.requireEncryption {
+			return nil, nil, errors.Errorf("refusing plaintext connection from %s", rawConn.RemoteAddr())
+		}
+		return conn, nil, nil
+	}
+	return secureConn, newSecureTransportAuthInfo(secureConn), nil
+}
+
+func newSecureTransportAuthInfo(conn *securetransport.Conn) *secureTransportAuthInfo {
+	return &secureTransportAuthInfo{
+		CommonAuthInfo:  credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		peerPubKeyXOnly: conn.PeerPubKeyXOnly(),
+	}
+}
+
+func (c *secureTransportCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: secureTransportAuthType}
+}
+
+func (c *secureTransportCredentials) Clone() credentials.TransportCredentials {
+	clone := *c
+	return &clone
+}
+
+func (c *secureTransportCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// transportPubKeyXOnly returns the identity public key a peer authenticated
+// its transport with, or nil if it connected in plaintext
+func transportPubKeyXOnly(peerInfo *peer.Peer) *[32]byte {
+	authInfo, ok := peerInfo.AuthInfo.(*secureTransportAuthInfo)
+	if !ok {
+		return nil
+	}
+	pubKey := authInfo.peerPubKeyXOnly
+	return &pubKey
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/cryptix-network/cryptixd/app/appmessage"
 	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
 )
 
@@ -31,6 +32,7 @@ type Server interface {
 type P2PServer interface {
 	Server
 	Connect(address string) (Connection, error)
+	OnionListenerAddress() net.Addr
 }
 
 // Connection represents a server connection.
@@ -40,7 +42,9 @@ type Connection interface {
 	Disconnect()
 	IsConnected() bool
 	IsOutbound() bool
+	IsOnionInbound() bool
 	SetOnDisconnectedHandler(onDisconnectedHandler OnDisconnectedHandler)
 	SetOnInvalidMessageHandler(onInvalidMessageHandler OnInvalidMessageHandler)
-	Address() *net.TCPAddr
+	NetAddress() *appmessage.NetAddress
+	TransportPubKeyXOnly() ([32]byte, bool)
 }
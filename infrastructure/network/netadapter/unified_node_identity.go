@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/securetransport"
 	secp256k1 "github.com/cryptix-network/go-secp256k1"
 	"github.com/pkg/errors"
 	"github.com/zeebo/blake3"
@@ -285,6 +286,26 @@ func persistUnifiedNodeIdentity(identityFile string, disk *unifiedNodeIdentityDi
 	return nil
 }
 
+// newSecureTransportConfig returns the configuration of the encrypted P2P
+// transport, which authenticates with the unified node identity
+func newSecureTransportConfig(identity *UnifiedNodeIdentity, networkName string) (*securetransport.Config, error) {
+	networkCode, err := networkCodeFromName(networkName)
+	if err != nil {
+		return nil, err
+	}
+	return &securetransport.Config{
+		Identity: &securetransport.Identity{
+			PrivateKey:  identity.PrivateKey,
+			PubKeyXOnly: identity.PubKeyXOnly,
+			PowNonce:    identity.PowNonce,
+		},
+		NetworkCode: networkCode,
+		VerifyIdentity: func(pubKeyXOnly [32]byte, powNonce uint64) bool {
+			return isValidNodePoWNonce(networkCode, pubKeyXOnly, powNonce)
+		},
+	}, nil
+}
+
 func networkCodeFromName(name string) (uint8, error) {
 	lower := strings.ToLower(strings.TrimSpace(name))
 	switch {
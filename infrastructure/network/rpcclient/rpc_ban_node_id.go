@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// BanNodeID sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) BanNodeID(nodeID string, reason string, durationSeconds uint64) (*appmessage.BanNodeIDResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewBanNodeIDRequestMessage(nodeID, reason, durationSeconds))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdBanNodeIDResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	banNodeIDResponse := response.(*appmessage.BanNodeIDResponseMessage)
+	if banNodeIDResponse.Error != nil {
+		return nil, c.convertRPCError(banNodeIDResponse.Error)
+	}
+	return banNodeIDResponse, nil
+}
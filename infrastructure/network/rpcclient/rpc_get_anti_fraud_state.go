@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAntiFraudState sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAntiFraudState(includeEntries bool, includeDiff bool) (*appmessage.GetAntiFraudStateResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAntiFraudStateRequestMessage(includeEntries, includeDiff))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAntiFraudStateResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAntiFraudStateResponse := response.(*appmessage.GetAntiFraudStateResponseMessage)
+	if getAntiFraudStateResponse.Error != nil {
+		return nil, c.convertRPCError(getAntiFraudStateResponse.Error)
+	}
+	return getAntiFraudStateResponse, nil
+}
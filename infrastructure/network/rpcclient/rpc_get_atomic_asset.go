@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAtomicAsset sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAtomicAsset(assetID string) (*appmessage.GetAtomicAssetResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAtomicAssetRequestMessage(assetID))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAtomicAssetResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAtomicAssetResponse := response.(*appmessage.GetAtomicAssetResponseMessage)
+	if getAtomicAssetResponse.Error != nil {
+		return nil, c.convertRPCError(getAtomicAssetResponse.Error)
+	}
+	return getAtomicAssetResponse, nil
+}
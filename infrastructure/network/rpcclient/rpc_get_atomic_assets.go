@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAtomicAssets sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAtomicAssets(startAssetID string, limit uint32) (*appmessage.GetAtomicAssetsResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAtomicAssetsRequestMessage(startAssetID, limit))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAtomicAssetsResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAtomicAssetsResponse := response.(*appmessage.GetAtomicAssetsResponseMessage)
+	if getAtomicAssetsResponse.Error != nil {
+		return nil, c.convertRPCError(getAtomicAssetsResponse.Error)
+	}
+	return getAtomicAssetsResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAtomicBalanceProof sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAtomicBalanceProof(assetID string, ownerID string, address string) (*appmessage.GetAtomicBalanceProofResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAtomicBalanceProofRequestMessage(assetID, ownerID, address))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAtomicBalanceProofResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAtomicBalanceProofResponse := response.(*appmessage.GetAtomicBalanceProofResponseMessage)
+	if getAtomicBalanceProofResponse.Error != nil {
+		return nil, c.convertRPCError(getAtomicBalanceProofResponse.Error)
+	}
+	return getAtomicBalanceProofResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAtomicBalancesByOwner sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAtomicBalancesByOwner(ownerID string, address string) (*appmessage.GetAtomicBalancesByOwnerResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAtomicBalancesByOwnerRequestMessage(ownerID, address))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAtomicBalancesByOwnerResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAtomicBalancesByOwnerResponse := response.(*appmessage.GetAtomicBalancesByOwnerResponseMessage)
+	if getAtomicBalancesByOwnerResponse.Error != nil {
+		return nil, c.convertRPCError(getAtomicBalancesByOwnerResponse.Error)
+	}
+	return getAtomicBalancesByOwnerResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAtomicHistoryByAsset sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAtomicHistoryByAsset(assetID string, cursor string, limit uint32) (*appmessage.GetAtomicHistoryByAssetResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAtomicHistoryByAssetRequestMessage(assetID, cursor, limit))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAtomicHistoryByAssetResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAtomicHistoryByAssetResponse := response.(*appmessage.GetAtomicHistoryByAssetResponseMessage)
+	if getAtomicHistoryByAssetResponse.Error != nil {
+		return nil, c.convertRPCError(getAtomicHistoryByAssetResponse.Error)
+	}
+	return getAtomicHistoryByAssetResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAtomicHistoryByOwner sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAtomicHistoryByOwner(ownerID string, cursor string, limit uint32) (*appmessage.GetAtomicHistoryByOwnerResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAtomicHistoryByOwnerRequestMessage(ownerID, cursor, limit))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAtomicHistoryByOwnerResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAtomicHistoryByOwnerResponse := response.(*appmessage.GetAtomicHistoryByOwnerResponseMessage)
+	if getAtomicHistoryByOwnerResponse.Error != nil {
+		return nil, c.convertRPCError(getAtomicHistoryByOwnerResponse.Error)
+	}
+	return getAtomicHistoryByOwnerResponse, nil
+}
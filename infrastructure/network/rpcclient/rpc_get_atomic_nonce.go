@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetAtomicNonce sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAtomicNonce(ownerID string, address string, assetID string) (*appmessage.GetAtomicNonceResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetAtomicNonceRequestMessage(ownerID, address, assetID))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetAtomicNonceResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getAtomicNonceResponse := response.(*appmessage.GetAtomicNonceResponseMessage)
+	if getAtomicNonceResponse.Error != nil {
+		return nil, c.convertRPCError(getAtomicNonceResponse.Error)
+	}
+	return getAtomicNonceResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetFastIntentStatus sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetFastIntentStatus(intentID string) (*appmessage.GetFastIntentStatusResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetFastIntentStatusRequestMessage(intentID))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetFastIntentStatusResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getFastIntentStatusResponse := response.(*appmessage.GetFastIntentStatusResponseMessage)
+	if getFastIntentStatusResponse.Error != nil {
+		return nil, c.convertRPCError(getFastIntentStatusResponse.Error)
+	}
+	return getFastIntentStatusResponse, nil
+}
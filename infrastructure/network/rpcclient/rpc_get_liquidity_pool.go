@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetLiquidityPool sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetLiquidityPool(assetID string) (*appmessage.GetLiquidityPoolResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetLiquidityPoolRequestMessage(assetID))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetLiquidityPoolResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getLiquidityPoolResponse := response.(*appmessage.GetLiquidityPoolResponseMessage)
+	if getLiquidityPoolResponse.Error != nil {
+		return nil, c.convertRPCError(getLiquidityPoolResponse.Error)
+	}
+	return getLiquidityPoolResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// GetStrongNodeClaims sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetStrongNodeClaims(includeWinningClaims bool, includePendingClaims bool) (*appmessage.GetStrongNodeClaimsResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetStrongNodeClaimsRequestMessage(includeWinningClaims, includePendingClaims))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetStrongNodeClaimsResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getStrongNodeClaimsResponse := response.(*appmessage.GetStrongNodeClaimsResponseMessage)
+	if getStrongNodeClaimsResponse.Error != nil {
+		return nil, c.convertRPCError(getStrongNodeClaimsResponse.Error)
+	}
+	return getStrongNodeClaimsResponse, nil
+}
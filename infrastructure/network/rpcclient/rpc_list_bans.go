@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// ListBans sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) ListBans() (*appmessage.ListBansResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewListBansRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdListBansResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	listBansResponse := response.(*appmessage.ListBansResponseMessage)
+	if listBansResponse.Error != nil {
+		return nil, c.convertRPCError(listBansResponse.Error)
+	}
+	return listBansResponse, nil
+}
@@ -0,0 +1,40 @@
+package rpcclient
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	routerpkg "github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// RegisterForAtomicStateChangedNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
+// Additionally, it starts listening for the appropriate notification using the given handler function
+func (c *RPCClient) RegisterForAtomicStateChangedNotifications(ownerIDs []string, assetIDs []string,
+	onAtomicStateChanged func(notification *appmessage.AtomicStateChangedNotificationMessage)) error {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyAtomicStateChangedRequestMessage(ownerIDs, assetIDs))
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdNotifyAtomicStateChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	notifyAtomicStateChangedResponse := response.(*appmessage.NotifyAtomicStateChangedResponseMessage)
+	if notifyAtomicStateChangedResponse.Error != nil {
+		return c.convertRPCError(notifyAtomicStateChangedResponse.Error)
+	}
+	spawn("RegisterForAtomicStateChangedNotifications", func() {
+		for {
+			notification, err := c.route(appmessage.CmdAtomicStateChangedNotificationMessage).Dequeue()
+			if err != nil {
+				if errors.Is(err, routerpkg.ErrRouteClosed) {
+					break
+				}
+				panic(err)
+			}
+			atomicStateChangedNotification := notification.(*appmessage.AtomicStateChangedNotificationMessage)
+			onAtomicStateChanged(atomicStateChangedNotification)
+		}
+	})
+	return nil
+}
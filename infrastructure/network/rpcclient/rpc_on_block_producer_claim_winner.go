@@ -0,0 +1,40 @@
+package rpcclient
+
+import (
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	routerpkg "github.com/cryptix-network/cryptixd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// RegisterForBlockProducerClaimWinnerNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
+// Additionally, it starts listening for the appropriate notification using the given handler function
+func (c *RPCClient) RegisterForBlockProducerClaimWinnerNotifications(
+	onBlockProducerClaimWinner func(notification *appmessage.BlockProducerClaimWinnerNotificationMessage)) error {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyBlockProducerClaimWinnerRequestMessage())
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdNotifyBlockProducerClaimWinnerResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	notifyBlockProducerClaimWinnerResponse := response.(*appmessage.NotifyBlockProducerClaimWinnerResponseMessage)
+	if notifyBlockProducerClaimWinnerResponse.Error != nil {
+		return c.convertRPCError(notifyBlockProducerClaimWinnerResponse.Error)
+	}
+	spawn("RegisterForBlockProducerClaimWinnerNotifications", func() {
+		for {
+			notification, err := c.route(appmessage.CmdBlockProducerClaimWinnerNotificationMessage).Dequeue()
+			if err != nil {
+				if errors.Is(err, routerpkg.ErrRouteClosed) {
+					break
+				}
+				panic(err)
+			}
+			blockProducerClaimWinnerNotification := notification.(*appmessage.BlockProducerClaimWinnerNotificationMessage)
+			onBlockProducerClaimWinner(blockProducerClaimWinnerNotification)
+		}
+	})
+	return nil
+}
@@ -0,0 +1,21 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// SimulateAtomicTransaction sends an RPC request respective to the function's name and returns the RPC server's response.
+// Atomic rule violations are reported in the response's AtomicError rather than as an error.
+func (c *RPCClient) SimulateAtomicTransaction(transaction *appmessage.RPCTransaction) (*appmessage.SimulateAtomicTransactionResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewSimulateAtomicTransactionRequestMessage(transaction))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdSimulateAtomicTransactionResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	simulateAtomicTransactionResponse := response.(*appmessage.SimulateAtomicTransactionResponseMessage)
+	if simulateAtomicTransactionResponse.Error != nil {
+		return nil, c.convertRPCError(simulateAtomicTransactionResponse.Error)
+	}
+	return simulateAtomicTransactionResponse, nil
+}
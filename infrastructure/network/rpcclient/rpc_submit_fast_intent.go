@@ -0,0 +1,23 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// SubmitFastIntent sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) SubmitFastIntent(transaction *appmessage.RPCTransaction, intentNonce uint64,
+	clientCreatedAtMs uint64, maxFee uint64) (*appmessage.SubmitFastIntentResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(
+		appmessage.NewSubmitFastIntentRequestMessage(transaction, intentNonce, clientCreatedAtMs, maxFee))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdSubmitFastIntentResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	submitFastIntentResponse := response.(*appmessage.SubmitFastIntentResponseMessage)
+	if submitFastIntentResponse.Error != nil {
+		return nil, c.convertRPCError(submitFastIntentResponse.Error)
+	}
+	return submitFastIntentResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/cryptix-network/cryptixd/app/appmessage"
+
+// UnbanNodeID sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) UnbanNodeID(nodeID string) (*appmessage.UnbanNodeIDResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewUnbanNodeIDRequestMessage(nodeID))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdUnbanNodeIDResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	unbanNodeIDResponse := response.(*appmessage.UnbanNodeIDResponseMessage)
+	if unbanNodeIDResponse.Error != nil {
+		return nil, c.convertRPCError(unbanNodeIDResponse.Error)
+	}
+	return unbanNodeIDResponse, nil
+}
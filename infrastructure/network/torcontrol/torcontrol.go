@@ -0,0 +1,235 @@
+// Package torcontrol implements a minimal client for the Tor control port,
+// used to publish the node's P2P listener as an onion service.
+package torcontrol
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// NewOnionV3Key is the key type to pass to AddOnion for Tor to generate
+	// a fresh v3 onion service key
+	NewOnionV3Key = "NEW:ED25519-V3"
+
+	statusOK = 250
+)
+
+// Client is a minimal Tor control port client, supporting just what's needed
+// to publish an onion service
+type Client struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// Dial connects to the Tor control port at the given address
+func Dial(address string, timeout time.Duration) (*Client, error) {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to the Tor control port at %s", address)
+	}
+	return &Client{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+	}, nil
+}
+
+// Close closes the connection to the control port. Onion services added
+// through this client are removed by Tor once the connection is closed.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Authenticate authenticates to the control port using the first method
+// that both Tor and the given settings allow: no authentication, a password,
+// or the cookie file Tor points us to
+func (c *Client) Authenticate(password string) error {
+	lines, err := c.command("PROTOCOLINFO 1")
+	if err != nil {
+		return err
+	}
+	methods, cookieFile := parseProtocolInfo(lines)
+
+	switch {
+	case methods["NULL"]:
+		_, err = c.command("AUTHENTICATE")
+	case password != "" && methods["HASHEDPASSWORD"]:
+		_, err = c.command("AUTHENTICATE " + quote(password))
+	case methods["COOKIE"] && cookieFile != "":
+		cookie, readErr := os.ReadFile(cookieFile)
+		if readErr != nil {
+			return errors.Wrapf(readErr, "could not read the Tor control cookie file")
+		}
+		_, err = c.command("AUTHENTICATE " + hex.EncodeToString(cookie))
+	case methods["HASHEDPASSWORD"]:
+		return errors.New("the Tor control port requires a password")
+	default:
+		return errors.Errorf("no supported Tor control authentication method in %v", methods)
+	}
+	return err
+}
+
+// AddOnion publishes an onion service that forwards virtualPort to target.
+// privateKey is either a key previously returned from AddOnion, or
+// NewOnionV3Key. It returns the service ID, which is the onion host without
+// the ".onion" suffix, and the private key of the service if a new one was
+// generated.
+func (c *Client) AddOnion(privateKey string, virtualPort uint16, target string) (
+	serviceID string, newPrivateKey string, err error) {
+
+	lines, err := c.command(fmt.Sprintf("ADD_ONION %s Port=%d,%s", privateKey, virtualPort, target))
+	if err != nil {
+		return "", "", err
+	}
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "ServiceID="):
+			serviceID = strings.TrimPrefix(line, "ServiceID=")
+		case strings.HasPrefix(line, "PrivateKey="):
+			newPrivateKey = strings.TrimPrefix(line, "PrivateKey=")
+		}
+	}
+	if serviceID == "" {
+		return "", "", errors.New("ADD_ONION reply is missing the service ID")
+	}
+	return serviceID, newPrivateKey, nil
+}
+
+// DelOnion removes an onion service published with AddOnion
+func (c *Client) DelOnion(serviceID string) error {
+	_, err := c.command("DEL_ONION " + serviceID)
+	return err
+}
+
+// command sends the given command and returns the lines of its reply, without
+// their status codes. A reply with a status other than 250 is returned as an
+// error.
+func (c *Client) command(command string) ([]string, error) {
+	_, err := c.conn.Write([]byte(command + "\r\n"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var lines []string
+	for {
+		line, err := c.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if len(line) < 4 {
+			return nil, errors.Errorf("malformed Tor control reply line %q", line)
+		}
+		status, err := strconv.Atoi(line[:3])
+		if err != nil {
+			return nil, errors.Errorf("malformed Tor control reply line %q", line)
+		}
+		separator, text := line[3], line[4:]
+		if status != statusOK {
+			return nil, errors.Errorf("Tor control command %s failed: %d %s",
+				strings.SplitN(command, " ", 2)[0], status, text)
+		}
+		lines = append(lines, text)
+
+		switch separator {
+		case ' ':
+			return lines, nil
+		case '-':
+		case '+':
+			// A data reply continues until a line holding a single dot
+			for {
+				dataLine, err := c.readLine()
+				if err != nil {
+					return nil, err
+				}
+				if dataLine == "." {
+					break
+				}
+				lines = append(lines, dataLine)
+			}
+		default:
+			return nil, errors.Errorf("malformed Tor control reply line %q", line)
+		}
+	}
+}
+
+func (c *Client) readLine() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// parseProtocolInfo extracts the allowed authentication methods and the
+// cookie file path from a PROTOCOLINFO reply
+func parseProtocolInfo(lines []string) (methods map[string]bool, cookieFile string) {
+	methods = make(map[string]bool)
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "AUTH ") {
+			continue
+		}
+		for _, field := range splitFields(strings.TrimPrefix(line, "AUTH ")) {
+			switch {
+			case strings.HasPrefix(field, "METHODS="):
+				for _, method := range strings.Split(strings.TrimPrefix(field, "METHODS="), ",") {
+					methods[method] = true
+				}
+			case strings.HasPrefix(field, "COOKIEFILE="):
+				cookieFile = unquote(strings.TrimPrefix(field, "COOKIEFILE="))
+			}
+		}
+	}
+	return methods, cookieFile
+}
+
+// splitFields splits a reply line on spaces that aren't inside a quoted
+// string
+func splitFields(line string) []string {
+	var fields []string
+	var field strings.Builder
+	inQuotes, escaped := false, false
+	for _, char := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case char == '\\' && inQuotes:
+			escaped = true
+		case char == '"':
+			inQuotes = !inQuotes
+		case char == ' ' && !inQuotes:
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+			continue
+		}
+		field.WriteRune(char)
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+func quote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func unquote(value string) string {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return value
+	}
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return value[1 : len(value)-1]
+	}
+	return unquoted
+}
@@ -0,0 +1,158 @@
+package torcontrol
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testServiceID = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad"
+
+// startFakeControlPort starts a stand-in Tor control port that accepts the
+// given AUTHENTICATE argument, and records the commands it received
+func startFakeControlPort(t *testing.T, authLine string, expectedAuth string) (string, *[]string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %s", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	var received []string
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		authenticated := false
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.TrimRight(line, "\r\n")
+			received = append(received, command)
+
+			var reply string
+			switch {
+			case command == "PROTOCOLINFO 1":
+				reply = "250-PROTOCOLINFO 1\r\n" + authLine + "\r\n250-VERSION Tor=\"0.4.8.9\"\r\n250 OK\r\n"
+			case strings.HasPrefix(command, "AUTHENTICATE"):
+				if strings.TrimSpace(strings.TrimPrefix(command, "AUTHENTICATE")) != expectedAuth {
+					reply = "515 Authentication failed\r\n"
+					break
+				}
+				authenticated = true
+				reply = "250 OK\r\n"
+			case !authenticated:
+				reply = "514 Authentication required.\r\n"
+			case strings.HasPrefix(command, "ADD_ONION NEW:ED25519-V3 "):
+				reply = fmt.Sprintf("250-ServiceID=%s\r\n250-PrivateKey=ED25519-V3:c2VjcmV0\r\n250 OK\r\n", testServiceID)
+			case strings.HasPrefix(command, "ADD_ONION "):
+				reply = fmt.Sprintf("250-ServiceID=%s\r\n250 OK\r\n", testServiceID)
+			case command == "DEL_ONION "+testServiceID:
+				reply = "250 OK\r\n"
+			default:
+				reply = "510 Unrecognized command\r\n"
+			}
+			_, err = conn.Write([]byte(reply))
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return listener.Addr().String(), &received
+}
+
+func TestAddOnion(t *testing.T) {
+	address, received := startFakeControlPort(t,
+		`250-AUTH METHODS=HASHEDPASSWORD`, `"pass\"word"`)
+
+	client, err := Dial(address, time.Second)
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	defer client.Close()
+
+	err = client.Authenticate(`pass"word`)
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+
+	serviceID, privateKey, err := client.AddOnion(NewOnionV3Key, 19111, "127.0.0.1:19111")
+	if err != nil {
+		t.Fatalf("AddOnion: %s", err)
+	}
+	if serviceID != testServiceID {
+		t.Fatalf("Unexpected service ID. Want: %s, got: %s", testServiceID, serviceID)
+	}
+	if privateKey != "ED25519-V3:c2VjcmV0" {
+		t.Fatalf("Unexpected private key %s", privateKey)
+	}
+
+	_, privateKey, err = client.AddOnion("ED25519-V3:c2VjcmV0", 19111, "127.0.0.1:19111")
+	if err != nil {
+		t.Fatalf("AddOnion: %s", err)
+	}
+	if privateKey != "" {
+		t.Fatalf("Unexpected private key returned for an existing key")
+	}
+
+	err = client.DelOnion(serviceID)
+	if err != nil {
+		t.Fatalf("DelOnion: %s", err)
+	}
+	if (*received)[2] != "ADD_ONION NEW:ED25519-V3 Port=19111,127.0.0.1:19111" {
+		t.Fatalf("Unexpected ADD_ONION command %q", (*received)[2])
+	}
+}
+
+func TestAuthenticateWithCookie(t *testing.T) {
+	cookie := []byte("0123456789abcdef0123456789abcdef")
+	cookieFile := filepath.Join(t.TempDir(), "control_auth_cookie")
+	err := os.WriteFile(cookieFile, cookie, 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+
+	address, _ := startFakeControlPort(t,
+		fmt.Sprintf(`250-AUTH METHODS=COOKIE,SAFECOOKIE COOKIEFILE="%s"`, cookieFile), hex.EncodeToString(cookie))
+
+	client, err := Dial(address, time.Second)
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	defer client.Close()
+
+	err = client.Authenticate("")
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+}
+
+func TestCommandFailure(t *testing.T) {
+	address, _ := startFakeControlPort(t, `250-AUTH METHODS=NULL`, "")
+
+	client, err := Dial(address, time.Second)
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	defer client.Close()
+
+	err = client.Authenticate("")
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+	err = client.DelOnion("unknown")
+	if err == nil {
+		t.Fatalf("DelOnion of an unknown service unexpectedly succeeded")
+	}
+}
@@ -0,0 +1,87 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cryptix-network/cryptixd/app/appmessage"
+	"github.com/cryptix-network/cryptixd/domain/consensus/utils/transactionhelper"
+)
+
+func TestFastIntentRelay(t *testing.T) {
+	harnesses, teardown := setupHarnesses(t, []*harnessParams{
+		{
+			p2pAddress:              p2pAddress1,
+			rpcAddress:              rpcAddress1,
+			miningAddress:           miningAddress1,
+			miningAddressPrivateKey: miningAddress1PrivateKey,
+			fastchain:               true,
+		},
+		{
+			p2pAddress:              p2pAddress2,
+			rpcAddress:              rpcAddress2,
+			miningAddress:           miningAddress3,
+			miningAddressPrivateKey: miningAddress3PrivateKey,
+			fastchain:               true,
+		},
+	})
+	defer teardown()
+	submitter, receiver := harnesses[0], harnesses[1]
+
+	connect(t, submitter, receiver)
+
+	receiverBlockAddedChan := make(chan *appmessage.RPCBlockHeader)
+	setOnBlockAddedHandler(t, receiver, func(notification *appmessage.BlockAddedNotificationMessage) {
+		receiverBlockAddedChan <- notification.Block.Header
+	})
+	// skip the first block because it's paying to genesis script
+	mineNextBlock(t, submitter)
+	waitForPayeeToReceiveBlock(t, receiverBlockAddedChan)
+	// use the second block to get money to pay with
+	secondBlock := mineNextBlock(t, submitter)
+	waitForPayeeToReceiveBlock(t, receiverBlockAddedChan)
+
+	// Mine BlockCoinbaseMaturity more blocks for our money to mature
+	for i := uint64(0); i < submitter.config.ActiveNetParams.BlockCoinbaseMaturity; i++ {
+		mineNextBlock(t, submitter)
+		waitForPayeeToReceiveBlock(t, receiverBlockAddedChan)
+	}
+
+	msgTx := generateTx(t, secondBlock.Transactions[transactionhelper.CoinbaseTransactionIndex], submitter, receiver)
+	rpcTransaction := appmessage.DomainTransactionToRPCTransaction(appmessage.MsgTxToDomainTransaction(msgTx))
+	response, err := submitter.rpcClient.SubmitFastIntent(rpcTransaction, 1, 0, 10_000)
+	if err != nil {
+		t.Fatalf("Error submitting fast intent: %+v", err)
+	}
+
+	intentRelayedChan := make(chan struct{})
+	spawn("TestFastIntentRelay-WaitForIntentPropagation", func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			getFastIntentStatusResponse, err := receiver.rpcClient.GetFastIntentStatus(response.IntentID)
+			if err != nil {
+				if strings.Contains(err.Error(), "not found") {
+					continue
+				}
+				t.Errorf("Error getting fast intent status: %+v", err)
+				return
+			}
+			if getFastIntentStatusResponse.TransactionID != response.TransactionID {
+				t.Errorf("Expected fast intent %s to have transaction %s, got %s",
+					response.IntentID, response.TransactionID, getFastIntentStatusResponse.TransactionID)
+				return
+			}
+			close(intentRelayedChan)
+			return
+		}
+	})
+
+	select {
+	case <-intentRelayedChan:
+	case <-time.After(defaultTimeout):
+		t.Fatalf("Timeout waiting for the fast intent to be relayed")
+	}
+}
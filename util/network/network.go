@@ -2,6 +2,7 @@ package network
 
 import (
 	"net"
+	"strings"
 )
 
 // NormalizeAddresses returns a new slice with all the passed peer addresses
@@ -19,8 +20,17 @@ func NormalizeAddresses(addrs []string, defaultPort string) ([]string, error) {
 }
 
 // NormalizeAddress returns addr with the passed default port appended if
-// there is not already a port specified.
+// there is not already a port specified. A node ID prefix, as in
+// "<node-id>@<host>:<port>", is kept as is.
 func NormalizeAddress(addr, defaultPort string) (string, error) {
+	if at := strings.LastIndex(addr, "@"); at >= 0 {
+		hostPort, err := NormalizeAddress(addr[at+1:], defaultPort)
+		if err != nil {
+			return "", err
+		}
+		return addr[:at+1] + hostPort, nil
+	}
+
 	_, _, err := net.SplitHostPort(addr)
 	// net.SplitHostPort returns an error if the given host is missing a
 	// port, but theoretically it can return an error for other reasons,